@@ -114,9 +114,14 @@ func (s *KeeperTestHelper) CreateTestContextWithMultiStore() (sdk.Context, sdk.C
 
 // CreateTestContext creates a test context.
 func (s *KeeperTestHelper) Commit() {
+	s.App.Commit()
+	s.beginNextBlock()
+}
+
+// beginNextBlock begins the block after the last committed one, and sets s.Ctx to it.
+func (s *KeeperTestHelper) beginNextBlock() {
 	oldHeight := s.Ctx.BlockHeight()
 	oldHeader := s.Ctx.BlockHeader()
-	s.App.Commit()
 	newHeader := tmtypes.Header{Height: oldHeight + 1, ChainID: oldHeader.ChainID, Time: oldHeader.Time.Add(time.Second)}
 	s.App.BeginBlock(abci.RequestBeginBlock{Header: newHeader})
 	s.Ctx = s.App.GetBaseApp().NewContext(false, newHeader)
@@ -389,11 +394,14 @@ func (s *KeeperTestHelper) BuildTx(
 }
 
 // StateNotAltered validates that app state is not altered. Fails if it is.
+// The committed state is compared before the next block begins, as begin blockers
+// (e.g. the downtime detector's) alter state every block.
 func (s *KeeperTestHelper) StateNotAltered() {
 	oldState := s.App.ExportState(s.Ctx)
-	s.Commit()
-	newState := s.App.ExportState(s.Ctx)
+	s.App.Commit()
+	newState := s.App.ExportState(s.App.GetBaseApp().NewContext(true, s.Ctx.BlockHeader()))
 	s.Require().Equal(oldState, newState)
+	s.beginNextBlock()
 }
 
 // CreateRandomAccounts is a function return a list of randomly generated AccAddresses
@@ -28,8 +28,10 @@ import (
 	ica "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts"
 
 	_ "github.com/osmosis-labs/osmosis/v13/client/docs/statik"
+	downtimemodule "github.com/osmosis-labs/osmosis/v13/x/downtime-detector/downtimedetector_module"
 	"github.com/osmosis-labs/osmosis/v13/x/epochs"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm"
+	gammclient "github.com/osmosis-labs/osmosis/v13/x/gamm/client"
 	ibc_hooks "github.com/osmosis-labs/osmosis/v13/x/ibc-hooks"
 	ibc_rate_limit "github.com/osmosis-labs/osmosis/v13/x/ibc-rate-limit"
 	"github.com/osmosis-labs/osmosis/v13/x/incentives"
@@ -63,11 +65,13 @@ var AppModuleBasics = []module.AppModuleBasic{
 			upgradeclient.CancelProposalHandler,
 			poolincentivesclient.UpdatePoolIncentivesHandler,
 			poolincentivesclient.ReplacePoolIncentivesHandler,
+			poolincentivesclient.CreateExternalIncentiveGaugeHandler,
 			ibcclientclient.UpdateClientProposalHandler,
 			ibcclientclient.UpgradeProposalHandler,
 			superfluidclient.SetSuperfluidAssetsProposalHandler,
 			superfluidclient.RemoveSuperfluidAssetsProposalHandler,
 			superfluidclient.UpdateUnpoolWhitelistProposalHandler,
+			gammclient.SetPoolPausedProposalHandler,
 		)...,
 	),
 	params.AppModuleBasic{},
@@ -81,6 +85,7 @@ var AppModuleBasics = []module.AppModuleBasic{
 	vesting.AppModuleBasic{},
 	gamm.AppModuleBasic{},
 	twapmodule.AppModuleBasic{},
+	downtimemodule.AppModuleBasic{},
 	txfees.AppModuleBasic{},
 	incentives.AppModuleBasic{},
 	lockup.AppModuleBasic{},
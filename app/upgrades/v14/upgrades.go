@@ -1,14 +1,73 @@
 package v14
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	"github.com/osmosis-labs/osmosis/v13/app/keepers"
 	"github.com/osmosis-labs/osmosis/v13/app/upgrades"
+	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	incentivestypes "github.com/osmosis-labs/osmosis/v13/x/incentives/types"
+	poolincentivestypes "github.com/osmosis-labs/osmosis/v13/x/pool-incentives/types"
+	superfluidtypes "github.com/osmosis-labs/osmosis/v13/x/superfluid/types"
 )
 
+// setSuperfluidCapParams sets the superfluid delegation cap params added in v14,
+// without caps, so that superfluid staking behaves as before until governance sets them.
+// The new keys are not in the store yet, so the params can not be read with GetParams.
+func setSuperfluidCapParams(ctx sdk.Context, keepers *keepers.AppKeepers) error {
+	paramSpace, ok := keepers.ParamsKeeper.GetSubspace(superfluidtypes.ModuleName)
+	if !ok {
+		return fmt.Errorf("superfluid param subspace not found")
+	}
+	params := superfluidtypes.DefaultParams()
+	paramSpace.Get(ctx, superfluidtypes.KeyMinimumRiskFactor, &params.MinimumRiskFactor)
+	paramSpace.SetParamSet(ctx, &params)
+	return nil
+}
+
+// setGammParams sets the gamm circuit breaker and protocol fee params added in v14,
+// with the circuit breaker and protocol fee disabled until governance enables them.
+func setGammParams(ctx sdk.Context, keepers *keepers.AppKeepers) error {
+	paramSpace, ok := keepers.ParamsKeeper.GetSubspace(gammtypes.ModuleName)
+	if !ok {
+		return fmt.Errorf("gamm param subspace not found")
+	}
+	params := gammtypes.DefaultParams()
+	paramSpace.Get(ctx, gammtypes.KeyPoolCreationFee, &params.PoolCreationFee)
+	paramSpace.SetParamSet(ctx, &params)
+	return nil
+}
+
+// setIncentivesParams sets the gauge fee and trader volume params added in v14, with the fees
+// that were previously hard-coded, no accepted fee denoms besides the base denom and the default trader volume limits.
+func setIncentivesParams(ctx sdk.Context, keepers *keepers.AppKeepers) error {
+	paramSpace, ok := keepers.ParamsKeeper.GetSubspace(incentivestypes.ModuleName)
+	if !ok {
+		return fmt.Errorf("incentives param subspace not found")
+	}
+	params := incentivestypes.DefaultParams()
+	paramSpace.Get(ctx, incentivestypes.KeyDistrEpochIdentifier, &params.DistrEpochIdentifier)
+	paramSpace.SetParamSet(ctx, &params)
+	return nil
+}
+
+// setPoolIncentivesParams sets the adaptive weight params added in v14, with adaptive weights disabled
+// until governance enables them.
+func setPoolIncentivesParams(ctx sdk.Context, keepers *keepers.AppKeepers) error {
+	paramSpace, ok := keepers.ParamsKeeper.GetSubspace(poolincentivestypes.ModuleName)
+	if !ok {
+		return fmt.Errorf("pool incentives param subspace not found")
+	}
+	params := poolincentivestypes.DefaultParams()
+	paramSpace.Get(ctx, poolincentivestypes.KeyMintedDenom, &params.MintedDenom)
+	paramSpace.SetParamSet(ctx, &params)
+	return nil
+}
+
 func CreateUpgradeHandler(
 	mm *module.Manager,
 	configurator module.Configurator,
@@ -16,6 +75,26 @@ func CreateUpgradeHandler(
 	keepers *keepers.AppKeepers,
 ) upgradetypes.UpgradeHandler {
 	return func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		if err := setSuperfluidCapParams(ctx, keepers); err != nil {
+			return nil, err
+		}
+		if err := setGammParams(ctx, keepers); err != nil {
+			return nil, err
+		}
+		if err := setIncentivesParams(ctx, keepers); err != nil {
+			return nil, err
+		}
+		if err := setPoolIncentivesParams(ctx, keepers); err != nil {
+			return nil, err
+		}
+		// create the reward positions of the existing locks, so incentives are claimed from reward accumulators
+		if err := keepers.IncentivesKeeper.MigrateToRewardAccumulators(ctx); err != nil {
+			return nil, err
+		}
+		// index the existing pools by their denoms, for the PoolsByDenom queries
+		if err := keepers.GAMMKeeper.IndexPoolsByDenom(ctx); err != nil {
+			return nil, err
+		}
 		return mm.RunMigrations(ctx, configurator, fromVM)
 	}
 }
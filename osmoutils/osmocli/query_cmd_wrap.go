@@ -36,7 +36,7 @@ type QueryDescriptor struct {
 }
 
 func SimpleQueryFromDescriptor[reqP proto.Message, querier any](desc QueryDescriptor, newQueryClientFn func(grpc1.ClientConn) querier) *cobra.Command {
-	numArgs := ParseNumFields[reqP]() - len(desc.CustomFlagOverrides)
+	numArgs := ParseNumFields[reqP]() - len(desc.CustomFlagOverrides) - len(desc.CustomFieldParsers)
 	if desc.HasPagination {
 		numArgs = numArgs - 1
 	}
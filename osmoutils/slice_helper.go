@@ -1,8 +1,11 @@
 package osmoutils
 
 import (
+	"errors"
 	"sort"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"golang.org/x/exp/constraints"
 )
 
@@ -49,3 +52,55 @@ func ContainsDuplicate[T any](arr []T) bool {
 	}
 	return false
 }
+
+// PaginateSlice returns the items of the requested page, for results that are computed in memory
+// rather than read from a store. It follows the semantics of the sdk's query.Paginate,
+// with the big endian encoded index of an item as its page key.
+// Does not mutate argument.
+func PaginateSlice[T any](items []T, pageRequest *query.PageRequest) ([]T, *query.PageResponse, error) {
+	// if the PageRequest is nil, use default PageRequest
+	if pageRequest == nil {
+		pageRequest = &query.PageRequest{}
+	}
+	if pageRequest.Offset > 0 && pageRequest.Key != nil {
+		return nil, nil, errors.New("invalid request, either offset or key is expected, got both")
+	}
+
+	limit := pageRequest.Limit
+	countTotal := pageRequest.CountTotal
+	if limit == 0 {
+		limit = query.DefaultLimit
+		// count total results when the limit is zero/not supplied
+		countTotal = true
+	}
+
+	start := pageRequest.Offset
+	if len(pageRequest.Key) != 0 {
+		if len(pageRequest.Key) != 8 {
+			return nil, nil, errors.New("invalid request, key is not an item index")
+		}
+		start = sdk.BigEndianToUint64(pageRequest.Key)
+		countTotal = false
+	}
+
+	if pageRequest.Reverse {
+		items = ReverseSlice(append([]T{}, items...))
+	}
+	total := uint64(len(items))
+	if start > total {
+		start = total
+	}
+	end := total
+	if limit < total-start {
+		end = start + limit
+	}
+
+	pageResponse := &query.PageResponse{}
+	if end < total {
+		pageResponse.NextKey = sdk.Uint64ToBigEndian(end)
+	}
+	if countTotal {
+		pageResponse.Total = total
+	}
+	return items[start:end], pageResponse, nil
+}
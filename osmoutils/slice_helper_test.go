@@ -5,6 +5,9 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
 	"github.com/osmosis-labs/osmosis/v13/osmoutils"
 )
 
@@ -28,3 +31,66 @@ func TestReverseSlice(t *testing.T) {
 		})
 	}
 }
+
+func TestPaginateSlice(t *testing.T) {
+	items := []string{"a", "b", "c", "d", "e"}
+	tests := map[string]struct {
+		pageRequest *query.PageRequest
+
+		expectedItems        []string
+		expectedPageResponse *query.PageResponse
+		expectErr            bool
+	}{
+		"nil page request": {
+			expectedItems:        items,
+			expectedPageResponse: &query.PageResponse{Total: 5},
+		},
+		"first page": {
+			pageRequest:          &query.PageRequest{Limit: 2},
+			expectedItems:        []string{"a", "b"},
+			expectedPageResponse: &query.PageResponse{NextKey: sdk.Uint64ToBigEndian(2)},
+		},
+		"page by key": {
+			pageRequest:          &query.PageRequest{Key: sdk.Uint64ToBigEndian(2), Limit: 2},
+			expectedItems:        []string{"c", "d"},
+			expectedPageResponse: &query.PageResponse{NextKey: sdk.Uint64ToBigEndian(4)},
+		},
+		"last page by offset with total": {
+			pageRequest:          &query.PageRequest{Offset: 4, Limit: 2, CountTotal: true},
+			expectedItems:        []string{"e"},
+			expectedPageResponse: &query.PageResponse{Total: 5},
+		},
+		"offset past the end": {
+			pageRequest:          &query.PageRequest{Offset: 10, Limit: 2},
+			expectedItems:        []string{},
+			expectedPageResponse: &query.PageResponse{},
+		},
+		"reverse": {
+			pageRequest:          &query.PageRequest{Limit: 2, Reverse: true},
+			expectedItems:        []string{"e", "d"},
+			expectedPageResponse: &query.PageResponse{NextKey: sdk.Uint64ToBigEndian(2)},
+		},
+		"both offset and key": {
+			pageRequest: &query.PageRequest{Key: sdk.Uint64ToBigEndian(2), Offset: 1},
+			expectErr:   true,
+		},
+		"invalid key": {
+			pageRequest: &query.PageRequest{Key: []byte{1}},
+			expectErr:   true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			page, pageResponse, err := osmoutils.PaginateSlice(items, tc.pageRequest)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedItems, page)
+			require.Equal(t, tc.expectedPageResponse, pageResponse)
+			// the input is not mutated
+			require.Equal(t, []string{"a", "b", "c", "d", "e"}, items)
+		})
+	}
+}
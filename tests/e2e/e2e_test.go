@@ -111,6 +111,28 @@ func (s *IntegrationTestSuite) TestSuperfluidVoting() {
 		10*time.Millisecond,
 		"superfluid delegation vote overwrite not working as expected",
 	)
+
+	// create a second text prop, where only the validators vote yes.
+	// The superfluid delegator does not vote, so the intermediary account delegation inherits the yes vote.
+	chainANode.SubmitTextProposal("superfluid vote inherit test", sdk.NewCoin(appparams.BaseCoinUnit, sdk.NewInt(config.InitialMinDeposit)), false)
+	chainA.LatestProposalNumber += 1
+	chainANode.DepositProposal(chainA.LatestProposalNumber, false)
+	for _, node := range chainA.NodeConfigs {
+		node.VoteYesProposal(initialization.ValidatorWalletName, chainA.LatestProposalNumber)
+	}
+
+	s.Eventually(
+		func() bool {
+			noTotal, yesTotal, noWithVetoTotal, abstainTotal, err := chainANode.QueryPropTally(chainA.LatestProposalNumber)
+			if err != nil {
+				return false
+			}
+			return yesTotal.IsPositive() && noTotal.IsZero() && noWithVetoTotal.IsZero() && abstainTotal.IsZero()
+		},
+		1*time.Minute,
+		10*time.Millisecond,
+		"superfluid delegation did not inherit the validator vote",
+	)
 }
 
 // Copy a file from A to B with io.Copy
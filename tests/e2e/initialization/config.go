@@ -338,9 +338,7 @@ func updateIncentivesGenesis(incentivesGenState *incentivestypes.GenesisState) {
 		time.Second * 180,
 		time.Second * 240,
 	}
-	incentivesGenState.Params = incentivestypes.Params{
-		DistrEpochIdentifier: "day",
-	}
+	incentivesGenState.Params.DistrEpochIdentifier = "day"
 }
 
 func updateMintGenesis(mintGenState *minttypes.GenesisState) {
@@ -21,6 +21,8 @@ type OsmosisQuery struct {
 	EstimateSwap *EstimateSwap `json:"estimate_swap,omitempty"`
 	/// Returns the admin of a denom, if the denom is a Token Factory denom.
 	DenomAdmin *DenomAdmin `json:"denom_admin,omitempty"`
+	/// Returns the IDs of the pools containing the denom, or both the denom and the paired denom if given.
+	PoolsByDenom *PoolsByDenom `json:"pools_by_denom,omitempty"`
 }
 
 type FullDenom struct {
@@ -40,6 +42,11 @@ type PoolState struct {
 	PoolId uint64 `json:"id"`
 }
 
+type PoolsByDenom struct {
+	Denom       string `json:"denom"`
+	PairedDenom string `json:"paired_denom,omitempty"`
+}
+
 type SpotPrice struct {
 	Swap        Swap `json:"swap"`
 	WithSwapFee bool `json:"with_swap_fee"`
@@ -89,6 +96,11 @@ type PoolStateResponse struct {
 	Shares wasmvmtypes.Coin `json:"shares"`
 }
 
+type PoolsByDenomResponse struct {
+	/// The IDs of the pools, in ascending order
+	PoolIds []uint64 `json:"pool_ids"`
+}
+
 type SpotPriceResponse struct {
 	/// How many output we would get for 1 input
 	Price string `json:"price"`
@@ -56,6 +56,23 @@ func (qp QueryPlugin) GetPoolState(ctx sdk.Context, poolID uint64) (*bindings.Po
 	}, nil
 }
 
+// GetPoolsByDenom is a query to get the ids of the pools containing the denom,
+// or both the denom and the paired denom if given.
+func (qp QueryPlugin) GetPoolsByDenom(ctx sdk.Context, poolsByDenom *bindings.PoolsByDenom) (*bindings.PoolsByDenomResponse, error) {
+	if poolsByDenom.Denom == "" {
+		return nil, wasmvmtypes.InvalidRequest{Err: "gamm pools by denom empty denom"}
+	}
+	if poolsByDenom.PairedDenom == "" {
+		return &bindings.PoolsByDenomResponse{PoolIds: qp.gammKeeper.GetPoolIdsByDenom(ctx, poolsByDenom.Denom)}, nil
+	}
+	if poolsByDenom.Denom == poolsByDenom.PairedDenom {
+		return nil, wasmvmtypes.InvalidRequest{Err: "gamm pools by denom same denom and paired denom"}
+	}
+	return &bindings.PoolsByDenomResponse{
+		PoolIds: qp.gammKeeper.GetPoolIdsByDenomPair(ctx, poolsByDenom.Denom, poolsByDenom.PairedDenom),
+	}, nil
+}
+
 // GetSpotPrice is a query to get spot price of denoms.
 func (qp QueryPlugin) GetSpotPrice(ctx sdk.Context, spotPrice *bindings.SpotPrice) (*sdk.Dec, error) {
 	if spotPrice == nil {
@@ -78,7 +95,7 @@ func (qp QueryPlugin) GetSpotPrice(ctx sdk.Context, spotPrice *bindings.SpotPric
 			return nil, sdkerrors.Wrap(err, "gamm get pool")
 		}
 
-		price = price.Mul(sdk.OneDec().Sub(poolData.GetSwapFee(ctx)))
+		price = price.Mul(sdk.OneDec().Sub(qp.gammKeeper.GetSwapFee(ctx, poolData)))
 	}
 
 	return &price, nil
@@ -109,6 +109,19 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 
 			return bz, nil
 
+		case contractQuery.PoolsByDenom != nil:
+			res, err := qp.GetPoolsByDenom(ctx, contractQuery.PoolsByDenom)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo pools by denom query")
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo pools by denom query response")
+			}
+
+			return bz, nil
+
 		case contractQuery.SpotPrice != nil:
 			spotPrice, err := qp.GetSpotPrice(ctx, contractQuery.SpotPrice)
 			if err != nil {
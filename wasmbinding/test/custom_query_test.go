@@ -107,6 +107,38 @@ func TestQueryPool(t *testing.T) {
 	assertValidShares(t, resp.Shares, atomPool)
 }
 
+func TestQueryPoolsByDenom(t *testing.T) {
+	actor := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, actor)
+
+	fundAccount(t, ctx, osmosis, actor, defaultFunds)
+
+	starPool := preparePool(t, ctx, osmosis, actor, []sdk.Coin{
+		sdk.NewInt64Coin("uosmo", 12000000),
+		sdk.NewInt64Coin("ustar", 240000000),
+	})
+	atomPool := preparePool(t, ctx, osmosis, actor, []sdk.Coin{
+		sdk.NewInt64Coin("uatom", 6000000),
+		sdk.NewInt64Coin("uosmo", 12000000),
+	})
+
+	// the reflect contract predates this query, so the custom querier is called directly
+	querier := wasmbinding.CustomQuerier(wasmbinding.NewQueryPlugin(osmosis.GAMMKeeper, osmosis.TwapKeeper, osmosis.TokenFactoryKeeper))
+	queryPoolsByDenom := func(query bindings.PoolsByDenom) []uint64 {
+		bz, err := json.Marshal(bindings.OsmosisQuery{PoolsByDenom: &query})
+		require.NoError(t, err)
+		bz, err = querier(ctx, bz)
+		require.NoError(t, err)
+		var resp bindings.PoolsByDenomResponse
+		require.NoError(t, json.Unmarshal(bz, &resp))
+		return resp.PoolIds
+	}
+
+	require.Equal(t, []uint64{starPool, atomPool}, queryPoolsByDenom(bindings.PoolsByDenom{Denom: "uosmo"}))
+	require.Equal(t, []uint64{atomPool}, queryPoolsByDenom(bindings.PoolsByDenom{Denom: "uatom", PairedDenom: "uosmo"}))
+	require.Empty(t, queryPoolsByDenom(bindings.PoolsByDenom{Denom: "uatom", PairedDenom: "ustar"}))
+}
+
 func TestQuerySpotPrice(t *testing.T) {
 	actor := RandomAccountAddress()
 	osmosis, ctx := SetupCustomApp(t, actor)
@@ -3,6 +3,7 @@ package wasmbinding
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -11,6 +12,8 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v13/wasmbinding"
 	"github.com/osmosis-labs/osmosis/v13/wasmbinding/bindings"
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/balancer"
+	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
 )
 
 func TestFullDenom(t *testing.T) {
@@ -307,6 +310,48 @@ func TestSpotPrice(t *testing.T) {
 	}
 }
 
+// mockDowntimeKeeper reports the chain as recovering from downtime.
+type mockDowntimeKeeper struct{}
+
+func (mockDowntimeKeeper) RecoveredSinceDowntimeOfLength(ctx sdk.Context, downtime, recovery time.Duration) (bool, error) {
+	return false, nil
+}
+
+func TestSpotPriceWithDynamicSwapFee(t *testing.T) {
+	actor := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, actor)
+	fundAccount(t, ctx, osmosis, actor, defaultFunds)
+
+	// the downtime surcharge raises the swap fee from 1% to 3% while the chain recovers.
+	poolParams := balancer.PoolParams{
+		SwapFee: sdk.NewDecWithPrec(1, 2),
+		ExitFee: sdk.ZeroDec(),
+		DynamicSwapFeeParams: &gammtypes.DynamicSwapFeeParams{
+			MinSwapFee:           sdk.ZeroDec(),
+			MaxSwapFee:           sdk.NewDecWithPrec(1, 1),
+			VolatilityMultiplier: sdk.ZeroDec(),
+			Downtime:             10 * time.Minute,
+			DowntimeRecovery:     time.Hour,
+			DowntimeSurcharge:    sdk.NewDecWithPrec(2, 2),
+		},
+	}
+	msg := balancer.NewMsgCreateBalancerPool(actor, poolParams, []balancer.PoolAsset{
+		{Weight: sdk.NewInt(100), Token: sdk.NewInt64Coin("uosmo", 12000000)},
+		{Weight: sdk.NewInt(100), Token: sdk.NewInt64Coin("ustar", 240000000)},
+	}, "")
+	poolId, err := osmosis.GAMMKeeper.CreatePool(ctx, &msg)
+	require.NoError(t, err)
+	osmosis.GAMMKeeper.SetDowntimeKeeper(mockDowntimeKeeper{})
+
+	queryPlugin := wasmbinding.NewQueryPlugin(osmosis.GAMMKeeper, osmosis.TwapKeeper, osmosis.TokenFactoryKeeper)
+	swap := bindings.Swap{PoolId: poolId, DenomIn: "uosmo", DenomOut: "ustar"}
+	price, err := queryPlugin.GetSpotPrice(ctx, &bindings.SpotPrice{Swap: swap, WithSwapFee: false})
+	require.NoError(t, err)
+	priceWithFee, err := queryPlugin.GetSpotPrice(ctx, &bindings.SpotPrice{Swap: swap, WithSwapFee: true})
+	require.NoError(t, err)
+	require.Equal(t, price.Mul(sdk.NewDecWithPrec(97, 2)), *priceWithFee)
+}
+
 func TestEstimateSwap(t *testing.T) {
 	actor := RandomAccountAddress()
 	osmosis, ctx := SetupCustomApp(t, actor)
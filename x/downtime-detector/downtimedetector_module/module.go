@@ -1,7 +1,6 @@
 package downtimemodule
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 
@@ -18,8 +17,6 @@ import (
 
 	downtimedetector "github.com/osmosis-labs/osmosis/v13/x/downtime-detector"
 	"github.com/osmosis-labs/osmosis/v13/x/downtime-detector/types"
-	twapcli "github.com/osmosis-labs/osmosis/v13/x/twap/client/cli"
-	"github.com/osmosis-labs/osmosis/v13/x/twap/client/queryproto"
 )
 
 var (
@@ -50,7 +47,6 @@ func (b AppModuleBasic) RegisterRESTRoutes(ctx client.Context, r *mux.Router) {
 }
 
 func (b AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
-	queryproto.RegisterQueryHandlerClient(context.Background(), mux, queryproto.NewQueryClient(clientCtx)) //nolint:errcheck
 }
 
 func (b AppModuleBasic) GetTxCmd() *cobra.Command {
@@ -58,7 +54,7 @@ func (b AppModuleBasic) GetTxCmd() *cobra.Command {
 }
 
 func (b AppModuleBasic) GetQueryCmd() *cobra.Command {
-	return twapcli.GetQueryCmd()
+	return nil
 }
 
 func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
@@ -71,7 +67,6 @@ type AppModule struct {
 }
 
 func (am AppModule) RegisterServices(cfg module.Configurator) {
-	// queryproto.RegisterQueryServer(cfg.QueryServer(), grpc.Querier{Q: twapclient.Querier{K: am.k}})
 }
 
 func NewAppModule(k downtimedetector.Keeper) AppModule {
@@ -110,7 +105,9 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(genState)
 }
 
-func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	am.k.BeginBlock(ctx)
+}
 
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
 	return []abci.ValidatorUpdate{}
@@ -1,6 +1,9 @@
 package downtimedetector
 
 import (
+	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v13/x/downtime-detector/types"
@@ -34,19 +37,99 @@ func NewKeeper(storeKey sdk.StoreKey, paramSpace paramtypes.Subspace) *Keeper {
 // 	k.paramSpace.SetParamSet(ctx, &params)
 // }
 
-// InitGenesis initializes the twap module's state from a provided genesis
-// state.
+// InitGenesis initializes the downtime detector module's state from a provided genesis
+// state. If no last block time is provided, the current block time is used, so that
+// the chain does not start out recovering from downtime.
 func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	if err := genState.Validate(); err != nil {
 		panic(err)
 	}
 
+	lastBlockTime := genState.LastBlockTime
+	if lastBlockTime.IsZero() {
+		lastBlockTime = ctx.BlockTime()
+	}
+	k.storeLastBlockTime(ctx, lastBlockTime)
+	for _, downtime := range genState.Downtimes {
+		k.storeLastDowntimeOfLength(ctx, downtime.DowntimeDuration, downtime.LastDowntime)
+	}
+
 	// k.SetParams(ctx, genState.Params)
 }
 
-// ExportGenesis returns the twap module's exported genesis.
+// ExportGenesis returns the downtime detector module's exported genesis.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	downtimes := []types.GenesisDowntimeEntry{}
+	for _, downtime := range types.DowntimeDurations {
+		lastDowntime, found := k.getLastDowntimeOfLength(ctx, downtime)
+		if !found {
+			continue
+		}
+		downtimes = append(downtimes, types.GenesisDowntimeEntry{DowntimeDuration: downtime, LastDowntime: lastDowntime})
+	}
+	lastBlockTime, _ := k.getLastBlockTime(ctx)
 	return &types.GenesisState{
+		Downtimes:     downtimes,
+		LastBlockTime: lastBlockTime,
 		// Params: k.GetParams(ctx),
 	}
 }
+
+// BeginBlock records the current block time. If the time since the last block
+// is at least one of the tracked downtime durations, the current block time is
+// stored as the last downtime for each of those durations.
+func (k Keeper) BeginBlock(ctx sdk.Context) {
+	lastBlockTime, found := k.getLastBlockTime(ctx)
+	if found {
+		timeSinceLastBlock := ctx.BlockTime().Sub(lastBlockTime)
+		for _, downtime := range types.DowntimeDurations {
+			if timeSinceLastBlock < downtime {
+				break
+			}
+			k.storeLastDowntimeOfLength(ctx, downtime, ctx.BlockTime())
+		}
+	}
+	k.storeLastBlockTime(ctx, ctx.BlockTime())
+}
+
+// RecoveredSinceDowntimeOfLength returns true if at least recovery time has passed
+// since the chain was last down for downtime. downtime must be one of types.DowntimeDurations.
+func (k Keeper) RecoveredSinceDowntimeOfLength(ctx sdk.Context, downtime, recovery time.Duration) (bool, error) {
+	if !types.IsSupportedDowntime(downtime) {
+		return false, fmt.Errorf("downtime duration %s is not supported", downtime)
+	}
+
+	lastDowntime, found := k.getLastDowntimeOfLength(ctx, downtime)
+	if !found {
+		return true, nil
+	}
+	return !ctx.BlockTime().Before(lastDowntime.Add(recovery)), nil
+}
+
+func (k Keeper) getLastBlockTime(ctx sdk.Context) (time.Time, bool) {
+	return k.getTime(ctx, types.GetLastBlockTimestampKey())
+}
+
+func (k Keeper) storeLastBlockTime(ctx sdk.Context, t time.Time) {
+	ctx.KVStore(k.storeKey).Set(types.GetLastBlockTimestampKey(), sdk.FormatTimeBytes(t))
+}
+
+func (k Keeper) getLastDowntimeOfLength(ctx sdk.Context, downtime time.Duration) (time.Time, bool) {
+	return k.getTime(ctx, types.GetLastDowntimeOfLengthKey(downtime))
+}
+
+func (k Keeper) storeLastDowntimeOfLength(ctx sdk.Context, downtime time.Duration, t time.Time) {
+	ctx.KVStore(k.storeKey).Set(types.GetLastDowntimeOfLengthKey(downtime), sdk.FormatTimeBytes(t))
+}
+
+func (k Keeper) getTime(ctx sdk.Context, key []byte) (time.Time, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return time.Time{}, false
+	}
+	t, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		panic(err)
+	}
+	return t, true
+}
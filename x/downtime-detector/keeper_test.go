@@ -0,0 +1,76 @@
+package downtimedetector_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	osmoapp "github.com/osmosis-labs/osmosis/v13/app"
+	"github.com/osmosis-labs/osmosis/v13/x/downtime-detector/types"
+)
+
+func TestRecoveredSinceDowntimeOfLength(t *testing.T) {
+	baseTime := time.Unix(1_000_000, 0).UTC()
+
+	tests := map[string]struct {
+		// block times after the base time, in order
+		blockTimes []time.Duration
+		downtime   time.Duration
+		recovery   time.Duration
+		expRecover bool
+		expErr     bool
+	}{
+		"no downtime": {
+			blockTimes: []time.Duration{5 * time.Second, 10 * time.Second},
+			downtime:   time.Minute,
+			recovery:   time.Minute,
+			expRecover: true,
+		},
+		"downtime shorter than queried downtime": {
+			blockTimes: []time.Duration{5 * time.Second, 95 * time.Second},
+			downtime:   2 * time.Minute,
+			recovery:   time.Hour,
+			expRecover: true,
+		},
+		"recovering from downtime": {
+			blockTimes: []time.Duration{5 * time.Second, 15 * time.Minute, 20 * time.Minute},
+			downtime:   10 * time.Minute,
+			recovery:   10 * time.Minute,
+			expRecover: false,
+		},
+		"recovered from downtime": {
+			blockTimes: []time.Duration{5 * time.Second, 15 * time.Minute, 20 * time.Minute, 25 * time.Minute},
+			downtime:   10 * time.Minute,
+			recovery:   10 * time.Minute,
+			expRecover: true,
+		},
+		"unsupported downtime": {
+			blockTimes: []time.Duration{5 * time.Second},
+			downtime:   7 * time.Minute,
+			recovery:   time.Minute,
+			expErr:     true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			app := osmoapp.Setup(false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockTime(baseTime)
+			app.DowntimeKeeper.InitGenesis(ctx, &types.GenesisState{LastBlockTime: baseTime})
+			for _, blockTime := range tc.blockTimes {
+				ctx = ctx.WithBlockTime(baseTime.Add(blockTime))
+				app.DowntimeKeeper.BeginBlock(ctx)
+			}
+
+			recovered, err := app.DowntimeKeeper.RecoveredSinceDowntimeOfLength(ctx, tc.downtime, tc.recovery)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expRecover, recovered)
+		})
+	}
+}
@@ -1,7 +1,7 @@
 package types
 
 const (
-	ModuleName = "downtime-detector"
+	ModuleName = "downtimedetector"
 	StoreKey   = ModuleName
 	RouterKey  = ModuleName
 
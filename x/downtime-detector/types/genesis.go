@@ -1,9 +1,16 @@
 package types
 
+import "fmt"
+
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{}
 }
 
 func (g *GenesisState) Validate() error {
+	for _, downtime := range g.Downtimes {
+		if !IsSupportedDowntime(downtime.DowntimeDuration) {
+			return fmt.Errorf("downtime duration %s is not supported", downtime.DowntimeDuration)
+		}
+	}
 	return nil
 }
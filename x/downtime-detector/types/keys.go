@@ -0,0 +1,60 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	lastBlockTimestampKey = []byte{0x01}
+	lastDowntimePrefix    = []byte{0x02}
+)
+
+// DowntimeDurations are the downtime durations the module tracks, and hence
+// the only downtime durations that can be queried.
+var DowntimeDurations = []time.Duration{
+	30 * time.Second,
+	time.Minute,
+	2 * time.Minute,
+	3 * time.Minute,
+	4 * time.Minute,
+	5 * time.Minute,
+	10 * time.Minute,
+	20 * time.Minute,
+	30 * time.Minute,
+	40 * time.Minute,
+	50 * time.Minute,
+	time.Hour,
+	90 * time.Minute,
+	2 * time.Hour,
+	150 * time.Minute,
+	3 * time.Hour,
+	4 * time.Hour,
+	5 * time.Hour,
+	6 * time.Hour,
+	9 * time.Hour,
+	12 * time.Hour,
+	18 * time.Hour,
+	24 * time.Hour,
+	36 * time.Hour,
+	48 * time.Hour,
+}
+
+// IsSupportedDowntime returns true if the downtime duration is tracked by the module.
+func IsSupportedDowntime(downtime time.Duration) bool {
+	for _, d := range DowntimeDurations {
+		if d == downtime {
+			return true
+		}
+	}
+	return false
+}
+
+func GetLastBlockTimestampKey() []byte {
+	return lastBlockTimestampKey
+}
+
+func GetLastDowntimeOfLengthKey(downtime time.Duration) []byte {
+	return append(lastDowntimePrefix, sdk.Uint64ToBigEndian(uint64(downtime))...)
+}
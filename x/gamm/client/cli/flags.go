@@ -40,6 +40,8 @@ const (
 	FlagSwapRouteDenoms = "swap-route-denoms"
 	// FlagScalingFactors represents the flag name for the scaling factors.
 	FlagScalingFactors = "scaling-factors"
+	// Will be parsed to []types.SwapAmountInSplitRoute.
+	FlagSplitRoutesFile = "routes-file"
 )
 
 type createBalancerPoolInputs struct {
@@ -60,6 +62,12 @@ type createStableswapPoolInputs struct {
 	ScalingFactors          string `json:"scaling-factors"`
 }
 
+type splitRouteInputs struct {
+	PoolIds       string `json:"pool-ids"`
+	Denoms        string `json:"denoms"`
+	TokenInAmount string `json:"token-in-amount"`
+}
+
 type smoothWeightChangeParamsInputs struct {
 	StartTime         string `json:"start-time"`
 	Duration          string `json:"duration"`
@@ -82,6 +90,13 @@ func FlagSetSwapAmountOutRoutes() *flag.FlagSet {
 	return fs
 }
 
+func FlagSetSplitRoutes() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagSplitRoutesFile, "", "Split routes json file path, holding a list of routes with their \"pool-ids\", \"denoms\" and \"token-in-amount\"")
+	return fs
+}
+
 func FlagSetCreatePool() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
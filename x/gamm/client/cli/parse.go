@@ -5,8 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/pflag"
+
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
 )
 
 // TODO: move these to exported types within an internal package
@@ -95,3 +100,56 @@ func parseCreateStableswapPoolFlags(fs *pflag.FlagSet) (*createStableswapPoolInp
 
 	return pool, nil
 }
+
+// parseSplitRoutesFile parses the split routes from the json file of the routes file flag.
+func parseSplitRoutesFile(fs *pflag.FlagSet) ([]types.SwapAmountInSplitRoute, error) {
+	routesFile, _ := fs.GetString(FlagSplitRoutesFile)
+
+	if routesFile == "" {
+		return nil, fmt.Errorf("must pass in a routes json using the --%s flag", FlagSplitRoutesFile)
+	}
+
+	contents, err := os.ReadFile(routesFile)
+	if err != nil {
+		return nil, err
+	}
+
+	routesInputs := []splitRouteInputs{}
+	dec := json.NewDecoder(bytes.NewReader(contents))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&routesInputs); err != nil {
+		return nil, err
+	}
+
+	routes := make([]types.SwapAmountInSplitRoute, 0, len(routesInputs))
+	for _, routeInputs := range routesInputs {
+		poolIds := strings.Split(routeInputs.PoolIds, ",")
+		denoms := strings.Split(routeInputs.Denoms, ",")
+		if len(poolIds) != len(denoms) {
+			return nil, fmt.Errorf("split route pool ids and denoms mismatch")
+		}
+
+		pools := make([]types.SwapAmountInRoute, 0, len(poolIds))
+		for i, poolIdStr := range poolIds {
+			poolId, err := strconv.ParseUint(strings.TrimSpace(poolIdStr), 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			pools = append(pools, types.SwapAmountInRoute{
+				PoolId:        poolId,
+				TokenOutDenom: strings.TrimSpace(denoms[i]),
+			})
+		}
+
+		tokenInAmount, ok := sdk.NewIntFromString(routeInputs.TokenInAmount)
+		if !ok {
+			return nil, fmt.Errorf("invalid split route token in amount: %s", routeInputs.TokenInAmount)
+		}
+
+		routes = append(routes, types.SwapAmountInSplitRoute{
+			Pools:         pools,
+			TokenInAmount: tokenInAmount,
+		})
+	}
+	return routes, nil
+}
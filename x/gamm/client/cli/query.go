@@ -35,9 +35,13 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryTotalLiquidity(),
 		GetCmdEstimateSwapExactAmountIn(),
 		GetCmdEstimateSwapExactAmountOut(),
+		GetCmdEstimateBestRoutesExactAmountIn(),
+		GetCmdEstimateSplitRouteSwapExactAmountIn(),
 		GetCmdTotalPoolLiquidity(),
 		GetCmdQueryPoolsWithFilter(),
 		GetCmdPoolType(),
+		GetCmdPoolsByDenom(),
+		GetCmdPoolsByDenomPair(),
 	)
 
 	return cmd
@@ -385,3 +389,54 @@ Example:
 		types.ModuleName, types.NewQueryClient,
 	)
 }
+
+// GetCmdPoolsByDenom returns the pools containing the denom.
+func GetCmdPoolsByDenom() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.QueryPoolsByDenomRequest](
+		"pools-by-denom [denom]",
+		"Query the pools containing a denom",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} pools-by-denom uosmo`,
+		types.ModuleName, types.NewQueryClient,
+	)
+}
+
+// GetCmdPoolsByDenomPair returns the pools containing both denoms.
+func GetCmdPoolsByDenomPair() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.QueryPoolsByDenomPairRequest](
+		"pools-by-denom-pair [denom-a] [denom-b]",
+		"Query the pools containing both denoms",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} pools-by-denom-pair uosmo ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2`,
+		types.ModuleName, types.NewQueryClient,
+	)
+}
+
+// GetCmdEstimateBestRoutesExactAmountIn returns the best routes for swapping the token in for the token out denom.
+func GetCmdEstimateBestRoutesExactAmountIn() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.QueryBestRoutesExactAmountInRequest](
+		"estimate-best-routes-exact-amount-in [token-in] [token-out-denom] [max-hops] [limit]",
+		"Query the routes with the highest estimated token out amounts for a swap",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} estimate-best-routes-exact-amount-in 1000000uosmo uatom 3 5`,
+		types.ModuleName, types.NewQueryClient,
+	)
+}
+
+// GetCmdEstimateSplitRouteSwapExactAmountIn returns the estimated total token out amount of a split route swap.
+func GetCmdEstimateSplitRouteSwapExactAmountIn() *cobra.Command {
+	cmd := osmocli.SimpleQueryFromDescriptor[*types.QuerySplitRouteSwapExactAmountInRequest](osmocli.QueryDescriptor{
+		Use:   "estimate-split-route-swap-exact-amount-in [token-in-denom]",
+		Short: "Query the estimated token out amount of a split route swap",
+		Long: osmocli.FormatLongDescDirect(`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} estimate-split-route-swap-exact-amount-in uosmo --routes-file=routes.json`, types.ModuleName),
+		QueryFnName: "EstimateSplitRouteSwapExactAmountIn",
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"Routes": osmocli.FlagOnlyParser(parseSplitRoutesFile),
+		},
+	}, types.NewQueryClient)
+
+	cmd.Flags().AddFlagSet(FlagSetSplitRoutes())
+	_ = cmd.MarkFlagRequired(FlagSplitRoutesFile)
+	return cmd
+}
@@ -74,6 +74,36 @@ func (s *QueryTestSuite) TestQueriesNeverAlterState() {
 			&types.QueryPoolTypeRequest{PoolId: 1},
 			&types.QueryPoolTypeResponse{},
 		},
+		{
+			"Query pools by denom",
+			"/osmosis.gamm.v1beta1.Query/PoolsByDenom",
+			&types.QueryPoolsByDenomRequest{Denom: fooDenom},
+			&types.QueryPoolsByDenomResponse{},
+		},
+		{
+			"Query pools by denom pair",
+			"/osmosis.gamm.v1beta1.Query/PoolsByDenomPair",
+			&types.QueryPoolsByDenomPairRequest{DenomA: fooDenom, DenomB: barDenom},
+			&types.QueryPoolsByDenomPairResponse{},
+		},
+		{
+			"Query estimate best routes exact amount in",
+			"/osmosis.gamm.v1beta1.Query/EstimateBestRoutesExactAmountIn",
+			&types.QueryBestRoutesExactAmountInRequest{TokenIn: "10" + fooDenom, TokenOutDenom: barDenom, MaxHops: 2, Limit: 3},
+			&types.QueryBestRoutesExactAmountInResponse{},
+		},
+		{
+			"Query estimate split route swap exact amount in",
+			"/osmosis.gamm.v1beta1.Query/EstimateSplitRouteSwapExactAmountIn",
+			&types.QuerySplitRouteSwapExactAmountInRequest{
+				Routes: []types.SwapAmountInSplitRoute{{
+					Pools:         []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: barDenom}},
+					TokenInAmount: sdk.NewInt(10),
+				}},
+				TokenInDenom: fooDenom,
+			},
+			&types.QuerySplitRouteSwapExactAmountInResponse{},
+		},
 		{
 			"Query spot price",
 			"/osmosis.gamm.v1beta1.Query/SpotPrice",
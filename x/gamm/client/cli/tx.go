@@ -19,6 +19,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func NewTxCmd() *cobra.Command {
@@ -36,11 +38,16 @@ func NewTxCmd() *cobra.Command {
 		NewExitPoolCmd(),
 		NewSwapExactAmountInCmd(),
 		NewSwapExactAmountOutCmd(),
+		NewSplitRouteSwapExactAmountInCmd(),
 		NewJoinSwapExternAmountIn(),
 		NewJoinSwapShareAmountOut(),
 		NewExitSwapExternAmountOut(),
 		NewExitSwapShareAmountIn(),
 		NewStableSwapAdjustScalingFactorsCmd(),
+		NewStableSwapSetScalingFactorRateProviderCmd(),
+		NewUpdateBalancerPoolWeightsCmd(),
+		NewAddBalancerPoolAssetCmd(),
+		NewRemoveBalancerPoolAssetCmd(),
 	)
 
 	return txCmd
@@ -166,6 +173,26 @@ func NewSwapExactAmountInCmd() *cobra.Command {
 	return cmd
 }
 
+func NewSplitRouteSwapExactAmountInCmd() *cobra.Command {
+	cmd := osmocli.BuildTxCli[*types.MsgSplitRouteSwapExactAmountIn](&osmocli.TxCliDesc{
+		Use:   "split-route-swap-exact-amount-in [token-in-denom] [token-out-min-amount]",
+		Short: "swap exact amounts in through several routes",
+		Long: `Swap exact amounts of the token in through several routes to the same token out denom,
+requiring the total token out amount to be at least the token out min amount.
+The routes are read from a json file, for example:
+[
+	{"pool-ids": "1", "denoms": "uatom", "token-in-amount": "600000"},
+	{"pool-ids": "2,3", "denoms": "uion,uatom", "token-in-amount": "400000"}
+]`,
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"Routes": osmocli.FlagOnlyParser(parseSplitRoutesFile),
+		}})
+
+	cmd.Flags().AddFlagSet(FlagSetSplitRoutes())
+	_ = cmd.MarkFlagRequired(FlagSplitRoutesFile)
+	return cmd
+}
+
 func NewSwapExactAmountOutCmd() *cobra.Command {
 	// Can't get rid of this parser without a break, because the args are out of order.
 	cmd := osmocli.TxCliDesc{
@@ -245,6 +272,59 @@ func NewStableSwapAdjustScalingFactorsCmd() *cobra.Command {
 	return cmd
 }
 
+func NewStableSwapSetScalingFactorRateProviderCmd() *cobra.Command {
+	return osmocli.TxCliDesc{
+		Use:   "set-scaling-factor-rate-provider [pool-id] [contract-address] [epoch-identifier] [max-change-per-epoch]",
+		Short: "set the rate provider contract that adjusts a stableswap pool's scaling factors every epoch",
+		Long: `Set the rate provider contract of a stableswap pool. At the end of every epoch of the identifier, the contract is queried
+for the redemption rates of the pool's assets, and the scaling factors move towards them by at most max-change-per-epoch.
+The sender must be the pool's scaling factor controller.`,
+		Example:          "osmosisd tx gamm set-scaling-factor-rate-provider 1 osmo1contract... day 0.01",
+		NumArgs:          4,
+		ParseAndBuildMsg: NewBuildStableSwapSetScalingFactorRateProviderMsg,
+	}.BuildCommandCustomFn()
+}
+
+func NewUpdateBalancerPoolWeightsCmd() *cobra.Command {
+	return osmocli.TxCliDesc{
+		Use:   "update-balancer-pool-weights [pool-id] [target-pool-weights] [duration]",
+		Short: "start a smooth weight change of a balancer pool, signed by the pool's future governor",
+		Long: `Start a smooth weight change of a balancer pool from its current weights to the target weights over the duration.
+The change starts a day after it is submitted, and must last at least a day. Any ongoing weight change is stopped
+and replaced. The sender must be the pool's future governor.`,
+		Example:          "osmosisd tx gamm update-balancer-pool-weights 1 4uatom,1uosmo 168h",
+		NumArgs:          3,
+		ParseAndBuildMsg: NewBuildUpdateBalancerPoolWeightsMsg,
+	}.BuildCommandCustomFn()
+}
+
+func NewAddBalancerPoolAssetCmd() *cobra.Command {
+	return osmocli.TxCliDesc{
+		Use:   "add-balancer-pool-asset [pool-id] [token] [weight] [duration] [price-denom] [reference-pool-id]",
+		Short: "add an asset to a balancer pool, signed by the pool's future governor",
+		Long: `Add an asset to a balancer pool, depositing the token at the weight that prices it at its 24h arithmetic twap
+in the price denom, an asset already in the pool, in the reference pool, and minting the sender the pool shares
+the deposit is worth at that price. The asset is then phased in to the weight over the duration like
+update-balancer-pool-weights. The spot prices between the existing assets are unchanged.
+The sender must be the pool's future governor.`,
+		Example:          "osmosisd tx gamm add-balancer-pool-asset 1 1000000uion 50 168h uosmo 2",
+		NumArgs:          6,
+		ParseAndBuildMsg: NewBuildAddBalancerPoolAssetMsg,
+	}.BuildCommandCustomFn()
+}
+
+func NewRemoveBalancerPoolAssetCmd() *cobra.Command {
+	return osmocli.TxCliDesc{
+		Use:   "remove-balancer-pool-asset [pool-id] [denom]",
+		Short: "remove a phased out asset from a balancer pool, signed by the pool's future governor",
+		Long: `Remove an asset from a balancer pool, once it has been phased out by bringing its weight close to zero with update-balancer-pool-weights.
+The sender must be the pool's future governor, and receives the remaining balance of the asset in exchange for the pool shares it is worth.`,
+		Example:          "osmosisd tx gamm remove-balancer-pool-asset 1 uion",
+		NumArgs:          2,
+		ParseAndBuildMsg: NewBuildRemoveBalancerPoolAssetMsg,
+	}.BuildCommandCustomFn()
+}
+
 func NewBuildCreateBalancerPoolMsg(clientCtx client.Context, fs *flag.FlagSet) (sdk.Msg, error) {
 	pool, err := parseCreateBalancerPoolFlags(fs)
 	if err != nil {
@@ -539,6 +619,93 @@ func NewStableSwapAdjustScalingFactorsMsg(clientCtx client.Context, _args []stri
 	return msg, nil
 }
 
+func NewBuildStableSwapSetScalingFactorRateProviderMsg(clientCtx client.Context, args []string, fs *flag.FlagSet) (sdk.Msg, error) {
+	poolID, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	maxChangePerEpoch, err := sdk.NewDecFromStr(args[3])
+	if err != nil {
+		return nil, err
+	}
+
+	rateProvider := &stableswap.ScalingFactorRateProvider{
+		ContractAddress:   args[1],
+		EpochIdentifier:   args[2],
+		MaxChangePerEpoch: maxChangePerEpoch,
+	}
+	msg := stableswap.NewMsgStableSwapSetScalingFactorRateProvider(clientCtx.GetFromAddress().String(), poolID, rateProvider)
+	return &msg, nil
+}
+
+func NewBuildUpdateBalancerPoolWeightsMsg(clientCtx client.Context, args []string, fs *flag.FlagSet) (sdk.Msg, error) {
+	poolID, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	targetPoolWeightCoins, err := sdk.ParseDecCoins(args[1])
+	if err != nil {
+		return nil, err
+	}
+	targetPoolWeights := make([]balancer.PoolAsset, len(targetPoolWeightCoins))
+	for i, weight := range targetPoolWeightCoins {
+		targetPoolWeights[i] = balancer.PoolAsset{
+			Weight: weight.Amount.RoundInt(),
+			Token:  sdk.NewCoin(weight.Denom, sdk.ZeroInt()),
+		}
+	}
+
+	duration, err := time.ParseDuration(args[2])
+	if err != nil {
+		return nil, fmt.Errorf("could not parse duration: %w", err)
+	}
+
+	msg := balancer.NewMsgUpdateBalancerPoolWeights(clientCtx.GetFromAddress(), poolID, targetPoolWeights, duration)
+	return &msg, nil
+}
+
+func NewBuildAddBalancerPoolAssetMsg(clientCtx client.Context, args []string, fs *flag.FlagSet) (sdk.Msg, error) {
+	poolID, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := sdk.ParseCoinNormalized(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	weight, ok := sdk.NewIntFromString(args[2])
+	if !ok {
+		return nil, fmt.Errorf("invalid weight: %s", args[2])
+	}
+
+	duration, err := time.ParseDuration(args[3])
+	if err != nil {
+		return nil, fmt.Errorf("could not parse duration: %w", err)
+	}
+
+	referencePoolID, err := strconv.ParseUint(args[5], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := balancer.NewMsgAddBalancerPoolAsset(clientCtx.GetFromAddress(), poolID, balancer.PoolAsset{Token: token, Weight: weight}, duration, args[4], referencePoolID)
+	return &msg, nil
+}
+
+func NewBuildRemoveBalancerPoolAssetMsg(clientCtx client.Context, args []string, fs *flag.FlagSet) (sdk.Msg, error) {
+	poolID, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := balancer.NewMsgRemoveBalancerPoolAsset(clientCtx.GetFromAddress(), poolID, args[1])
+	return &msg, nil
+}
+
 // ParseCoinsNoSort parses coins from coinsStr but does not sort them.
 // Returns error if parsing fails.
 func ParseCoinsNoSort(coinsStr string) (sdk.Coins, error) {
@@ -554,3 +721,69 @@ func ParseCoinsNoSort(coinsStr string) (sdk.Coins, error) {
 	}
 	return sdk.NormalizeCoins(decCoins), nil
 }
+
+func NewCmdSubmitSetPoolPausedProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-pool-paused [pool-id] [paused]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to pause or resume swaps and joins on a pool",
+		Long: "Submit a proposal to pause or resume swaps and joins on a pool. " +
+			"Exits are never paused. Pools paused by the circuit breaker can only be resumed by this proposal.",
+		Example: "osmosisd tx gov submit-proposal set-pool-paused 1 false --title \"Title\" --description \"Description\" --deposit 1000uosmo",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			paused, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetPoolPausedProposal(title, description, poolId, paused)
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
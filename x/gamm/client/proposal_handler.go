@@ -0,0 +1,10 @@
+package client
+
+import (
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/client/cli"
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/client/rest"
+
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+var SetPoolPausedProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitSetPoolPausedProposal, rest.ProposalSetPoolPausedRESTHandler)
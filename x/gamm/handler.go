@@ -0,0 +1,25 @@
+package gamm
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+)
+
+func NewSetPoolPausedProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.SetPoolPausedProposal:
+			return handleSetPoolPausedProposal(ctx, k, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized gamm proposal content type: %T", c)
+		}
+	}
+}
+
+func handleSetPoolPausedProposal(ctx sdk.Context, k keeper.Keeper, p *types.SetPoolPausedProposal) error {
+	return k.HandleSetPoolPausedProposal(ctx, p)
+}
@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+)
+
+// partialRoute is a route from the token in denom to denom searched by the best routes query.
+type partialRoute struct {
+	denom  string
+	routes []types.SwapAmountInRoute
+}
+
+// getMaxRoutePoolsConsidered returns the maximum number of pools the best routes query extends routes through.
+func (k Keeper) getMaxRoutePoolsConsidered(ctx sdk.Context) (maxRoutePoolsConsidered uint64) {
+	k.paramSpace.Get(ctx, types.KeyMaxRoutePoolsConsidered, &maxRoutePoolsConsidered)
+	return maxRoutePoolsConsidered
+}
+
+// EstimateBestRoutesExactAmountIn searches the routes of at most maxHops pools from the token in to
+// the token out denom, and returns at most limit of them with the highest estimated token out amounts,
+// sorted by descending token out amount. Like MultihopSwapExactAmountIn, the estimates apply the
+// swap fee discount of osmo routed multihops.
+// Routes through inactive pools, or through pools without enough liquidity for the swap, are skipped.
+func (k Keeper) EstimateBestRoutesExactAmountIn(
+	ctx sdk.Context,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	maxHops int,
+	limit int,
+) []types.EstimatedRoute {
+	estimatedRoutes := []types.EstimatedRoute{}
+	for _, routes := range k.searchRoutes(ctx, tokenIn.Denom, tokenOutDenom, maxHops) {
+		tokenOutAmount, err := k.estimateRouteExactAmountIn(ctx, routes, tokenIn)
+		if err != nil {
+			continue
+		}
+		estimatedRoutes = append(estimatedRoutes, types.EstimatedRoute{
+			Routes:         routes,
+			TokenOutAmount: tokenOutAmount,
+		})
+	}
+
+	// The routes are searched in a deterministic order, so the stable sort keeps the results deterministic.
+	// Among routes with the same token out amount, the shorter ones come first.
+	sort.SliceStable(estimatedRoutes, func(i, j int) bool {
+		if !estimatedRoutes[i].TokenOutAmount.Equal(estimatedRoutes[j].TokenOutAmount) {
+			return estimatedRoutes[i].TokenOutAmount.GT(estimatedRoutes[j].TokenOutAmount)
+		}
+		return len(estimatedRoutes[i].Routes) < len(estimatedRoutes[j].Routes)
+	})
+
+	if len(estimatedRoutes) > limit {
+		estimatedRoutes = estimatedRoutes[:limit]
+	}
+	return estimatedRoutes
+}
+
+// searchRoutes returns the routes of at most maxHops pools from the token in denom to the token out denom
+// through active pools, using the denom to pools index. Routes never visit a pool or a denom twice.
+// The search is breadth first, so shorter routes are found first, and stops once it has extended routes
+// through the max route pools considered param pools, which bounds the gas it consumes.
+func (k Keeper) searchRoutes(ctx sdk.Context, tokenInDenom, tokenOutDenom string, maxHops int) [][]types.SwapAmountInRoute {
+	maxPoolsConsidered := k.getMaxRoutePoolsConsidered(ctx)
+	poolsConsidered := uint64(0)
+	// pools caches the pools loaded by the search, with nil for inactive pools.
+	pools := map[uint64]types.CFMMPoolI{}
+
+	routes := [][]types.SwapAmountInRoute{}
+	frontier := []partialRoute{{denom: tokenInDenom}}
+	for hop := 0; hop < maxHops && len(frontier) > 0; hop++ {
+		nextFrontier := []partialRoute{}
+		for _, partial := range frontier {
+			k.iteratePoolIdsByDenom(ctx, partial.denom, func(poolId uint64) (stop bool) {
+				if poolsConsidered >= maxPoolsConsidered {
+					return true
+				}
+				if routeContainsPool(partial.routes, poolId) {
+					return false
+				}
+
+				pool, ok := pools[poolId]
+				if !ok {
+					var err error
+					pool, err = k.getPoolForSwap(ctx, poolId)
+					if err != nil {
+						pool = nil
+					}
+					pools[poolId] = pool
+				}
+				if pool == nil {
+					return false
+				}
+				poolsConsidered++
+
+				for _, coin := range pool.GetTotalPoolLiquidity(ctx) {
+					if coin.Denom == tokenInDenom || routeContainsDenom(partial.routes, coin.Denom) {
+						continue
+					}
+
+					extended := make([]types.SwapAmountInRoute, len(partial.routes), len(partial.routes)+1)
+					copy(extended, partial.routes)
+					extended = append(extended, types.SwapAmountInRoute{PoolId: poolId, TokenOutDenom: coin.Denom})
+
+					if coin.Denom == tokenOutDenom {
+						routes = append(routes, extended)
+					} else if hop+1 < maxHops {
+						nextFrontier = append(nextFrontier, partialRoute{denom: coin.Denom, routes: extended})
+					}
+				}
+				return false
+			})
+		}
+		frontier = nextFrontier
+	}
+	return routes
+}
+
+// estimateRouteExactAmountIn estimates the token out amount of the route,
+// returning an error rather than panicking on pool math failures.
+func (k Keeper) estimateRouteExactAmountIn(ctx sdk.Context, routes []types.SwapAmountInRoute, tokenIn sdk.Coin) (tokenOutAmount sdk.Int, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			tokenOutAmount = sdk.Int{}
+			err = fmt.Errorf("function estimateRouteExactAmountIn failed due to internal reason: %v", r)
+		}
+	}()
+
+	return k.MultihopEstimateOutGivenExactAmountIn(ctx, routes, tokenIn)
+}
+
+func routeContainsPool(routes []types.SwapAmountInRoute, poolId uint64) bool {
+	for _, route := range routes {
+		if route.PoolId == poolId {
+			return true
+		}
+	}
+	return false
+}
+
+func routeContainsDenom(routes []types.SwapAmountInRoute, denom string) bool {
+	for _, route := range routes {
+		if route.TokenOutDenom == denom {
+			return true
+		}
+	}
+	return false
+}
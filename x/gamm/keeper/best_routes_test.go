@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+)
+
+func (suite *KeeperTestSuite) TestEstimateBestRoutesExactAmountIn() {
+	var (
+		directRoute = []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "bar"}}
+		osmoRoute   = []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: "uosmo"}, {PoolId: 3, TokenOutDenom: "bar"}}
+	)
+
+	testcases := []struct {
+		name               string
+		tokenOutDenom      string
+		maxHops            int
+		limit              int
+		maxPoolsConsidered uint64
+		expRoutes          [][]types.SwapAmountInRoute
+	}{
+		{
+			name:               "one hop",
+			tokenOutDenom:      "bar",
+			maxHops:            1,
+			limit:              5,
+			maxPoolsConsidered: 100,
+			expRoutes:          [][]types.SwapAmountInRoute{directRoute},
+		},
+		{
+			name:               "two hops, the deeper route through uosmo first",
+			tokenOutDenom:      "bar",
+			maxHops:            2,
+			limit:              5,
+			maxPoolsConsidered: 100,
+			expRoutes:          [][]types.SwapAmountInRoute{osmoRoute, directRoute},
+		},
+		{
+			name:               "limited to the best route",
+			tokenOutDenom:      "bar",
+			maxHops:            2,
+			limit:              1,
+			maxPoolsConsidered: 100,
+			expRoutes:          [][]types.SwapAmountInRoute{osmoRoute},
+		},
+		{
+			name:               "search stops at the max pools considered",
+			tokenOutDenom:      "bar",
+			maxHops:            2,
+			limit:              5,
+			maxPoolsConsidered: 1,
+			expRoutes:          [][]types.SwapAmountInRoute{directRoute},
+		},
+		{
+			name:               "no routes",
+			tokenOutDenom:      "baz",
+			maxHops:            2,
+			limit:              5,
+			maxPoolsConsidered: 100,
+			expRoutes:          [][]types.SwapAmountInRoute{},
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			params := suite.App.GAMMKeeper.GetParams(suite.Ctx)
+			params.MaxRoutePoolsConsidered = tc.maxPoolsConsidered
+			suite.App.GAMMKeeper.SetParams(suite.Ctx, params)
+
+			// a shallow direct pool, and deep pools through uosmo
+			suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("foo", 10_000), sdk.NewInt64Coin("bar", 10_000))
+			suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("foo", 1_000_000), sdk.NewInt64Coin("uosmo", 1_000_000))
+			suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("uosmo", 1_000_000), sdk.NewInt64Coin("bar", 1_000_000))
+
+			tokenIn := sdk.NewInt64Coin("foo", 1000)
+			estimatedRoutes := suite.App.GAMMKeeper.EstimateBestRoutesExactAmountIn(suite.Ctx, tokenIn, tc.tokenOutDenom, tc.maxHops, tc.limit)
+
+			suite.Require().Len(estimatedRoutes, len(tc.expRoutes))
+			for i, estimatedRoute := range estimatedRoutes {
+				suite.Require().Equal(tc.expRoutes[i], estimatedRoute.Routes)
+
+				// the estimates match the estimates of the routes on their own
+				expTokenOutAmount, err := suite.App.GAMMKeeper.MultihopEstimateOutGivenExactAmountIn(suite.Ctx, estimatedRoute.Routes, tokenIn)
+				suite.Require().NoError(err)
+				suite.Require().Equal(expTokenOutAmount, estimatedRoute.TokenOutAmount)
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+)
+
+var sentinelExistsValue = []byte{1}
+
+// setPoolPaused pauses or resumes swaps and joins on the given pool.
+// Exits are never paused, so LPs can always withdraw their liquidity.
+func (k Keeper) setPoolPaused(ctx sdk.Context, poolId uint64, paused bool) error {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	pausablePool, ok := pool.(types.PausablePoolExtension)
+	if !ok {
+		return fmt.Errorf("pool with id %d does not support pausing", poolId)
+	}
+
+	pausablePool.SetPaused(paused)
+	if err := k.setPool(ctx, pausablePool); err != nil {
+		return err
+	}
+
+	eventType := types.TypeEvtPoolResumed
+	if paused {
+		eventType = types.TypeEvtPoolPaused
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+	))
+	return nil
+}
+
+// getCircuitBreakerMaxSpotPriceDeviation returns the max spot price deviation from the twap,
+// zero if the circuit breaker is disabled.
+func (k Keeper) getCircuitBreakerMaxSpotPriceDeviation(ctx sdk.Context) (maxDeviation sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyCircuitBreakerMaxSpotPriceDeviation, &maxDeviation)
+	return maxDeviation
+}
+
+// checkCircuitBreaker returns an error if the spot price of the given pool, in its
+// current (possibly uncommitted) state, deviates from the arithmetic twap over the
+// circuit breaker window by more than the circuit breaker max spot price deviation.
+// The pool is then recorded as tripped, to be paused in EndBlock even though the
+// state change that tripped the breaker is reverted.
+// The check is skipped when the circuit breaker is disabled, or when no twap
+// exists for the whole window, e.g. for newly created pools.
+func (k Keeper) checkCircuitBreaker(ctx sdk.Context, pool types.CFMMPoolI, denomA, denomB string) error {
+	maxDeviation := k.getCircuitBreakerMaxSpotPriceDeviation(ctx)
+	if !maxDeviation.IsPositive() {
+		return nil
+	}
+	var twapDuration time.Duration
+	k.paramSpace.Get(ctx, types.KeyCircuitBreakerTwapDuration, &twapDuration)
+
+	// always compare prices in the same direction, regardless of the swap direction.
+	baseDenom, quoteDenom := denomA, denomB
+	if quoteDenom < baseDenom {
+		baseDenom, quoteDenom = quoteDenom, baseDenom
+	}
+
+	deviation, found, err := k.spotPriceTwapDeviation(ctx, pool, baseDenom, quoteDenom, twapDuration)
+	if err != nil || !found {
+		return err
+	}
+
+	if deviation.GT(maxDeviation) {
+		k.recordTrippedPool(ctx, pool.GetId())
+		return sdkerrors.Wrapf(types.ErrCircuitBreakerTripped,
+			"pool %d spot price of %s in %s deviates %s from its twap, max deviation is %s",
+			pool.GetId(), baseDenom, quoteDenom, deviation, maxDeviation)
+	}
+	return nil
+}
+
+// spotPriceTwapDeviation returns the relative deviation of the pool's spot price
+// from its arithmetic twap over the given window, i.e. |spot price - twap| / twap.
+// found is false if there is no twap for the whole window.
+func (k Keeper) spotPriceTwapDeviation(ctx sdk.Context, pool types.CFMMPoolI, baseDenom, quoteDenom string, window time.Duration) (deviation sdk.Dec, found bool, err error) {
+	if k.twapKeeper == nil {
+		return sdk.Dec{}, false, nil
+	}
+
+	twap, err := k.twapKeeper.GetArithmeticTwapToNow(ctx, pool.GetId(), baseDenom, quoteDenom, ctx.BlockTime().Add(-window))
+	if err != nil || !twap.IsPositive() {
+		return sdk.Dec{}, false, nil
+	}
+
+	spotPrice, err := pool.SpotPrice(ctx, baseDenom, quoteDenom)
+	if err != nil {
+		return sdk.Dec{}, false, err
+	}
+
+	return spotPrice.Sub(twap).Abs().Quo(twap), true, nil
+}
+
+// checkCircuitBreakerAllPairs runs checkCircuitBreaker for every pair of assets in the pool.
+func (k Keeper) checkCircuitBreakerAllPairs(ctx sdk.Context, pool types.CFMMPoolI) error {
+	if !k.getCircuitBreakerMaxSpotPriceDeviation(ctx).IsPositive() {
+		return nil
+	}
+
+	liquidity := pool.GetTotalPoolLiquidity(ctx)
+	denoms := make([]string, 0, len(liquidity))
+	for _, coin := range liquidity {
+		denoms = append(denoms, coin.Denom)
+	}
+	for i := 0; i < len(denoms); i++ {
+		for j := i + 1; j < len(denoms); j++ {
+			if err := k.checkCircuitBreaker(ctx, pool, denoms[i], denoms[j]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// EndBlock pauses every pool whose circuit breaker was tripped in this block, e.g. by a
+// rejected swap, and every pool changed in this block whose spot price ended the block
+// beyond the circuit breaker threshold, e.g. through weight changes.
+// Paused pools stay paused until governance resumes them.
+func (k Keeper) EndBlock(ctx sdk.Context) {
+	for _, poolId := range k.getChangedPools(ctx) {
+		pool, err := k.GetPoolAndPoke(ctx, poolId)
+		if err != nil || !pool.IsActive(ctx) {
+			continue
+		}
+
+		err = k.checkCircuitBreakerAllPairs(ctx, pool)
+		if err == nil {
+			continue
+		}
+		ctx.Logger().Info(fmt.Sprintf("pausing pool %d: %s", poolId, err))
+	}
+
+	for _, poolId := range k.popTrippedPools() {
+		pool, err := k.GetPoolAndPoke(ctx, poolId)
+		if err != nil || !pool.IsActive(ctx) {
+			continue
+		}
+		if err := k.setPoolPaused(ctx, poolId, true); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to pause pool %d: %s", poolId, err))
+		}
+	}
+}
+
+// recordTrippedPool records that the circuit breaker of the pool was tripped in this block.
+// Only transactions being delivered are recorded, not those checked for the mempool or simulated,
+// so that every node pauses the same pools.
+func (k Keeper) recordTrippedPool(ctx sdk.Context, poolId uint64) {
+	if ctx.IsCheckTx() || ctx.IsReCheckTx() {
+		return
+	}
+	k.trippedPoolIds[poolId] = struct{}{}
+}
+
+// popTrippedPools returns the ids of the pools whose circuit breaker was tripped in this block,
+// in increasing order, and clears them.
+func (k Keeper) popTrippedPools() []uint64 {
+	poolIds := make([]uint64, 0, len(k.trippedPoolIds))
+	for poolId := range k.trippedPoolIds {
+		poolIds = append(poolIds, poolId)
+		delete(k.trippedPoolIds, poolId)
+	}
+	sort.Slice(poolIds, func(i, j int) bool { return poolIds[i] < poolIds[j] })
+	return poolIds
+}
+
+// trackChangedPool marks the pool as changed in this block, so that EndBlock
+// re-checks its price. The transient store is cleared on commit.
+func (k Keeper) trackChangedPool(ctx sdk.Context, poolId uint64) {
+	store := ctx.TransientStore(k.transientKey)
+	poolIdBz := make([]byte, 8)
+	binary.LittleEndian.PutUint64(poolIdBz, poolId)
+
+	store.Set(poolIdBz, sentinelExistsValue)
+}
+
+// getChangedPools returns all pool ids changed in this block.
+func (k Keeper) getChangedPools(ctx sdk.Context) []uint64 {
+	store := ctx.TransientStore(k.transientKey)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	changedPoolIds := []uint64{}
+	for ; iter.Valid(); iter.Next() {
+		changedPoolIds = append(changedPoolIds, binary.LittleEndian.Uint64(iter.Key()))
+	}
+	return changedPoolIds
+}
@@ -0,0 +1,253 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+)
+
+var circuitBreakerMaxDeviation = sdk.NewDecWithPrec(1, 1)
+
+// prepareCircuitBreakerPool creates a balancer pool with the circuit breaker enabled,
+// and moves block time past the twap window so that the pool has a twap.
+func (suite *KeeperTestSuite) prepareCircuitBreakerPool() uint64 {
+	params := suite.App.GAMMKeeper.GetParams(suite.Ctx)
+	params.CircuitBreakerMaxSpotPriceDeviation = circuitBreakerMaxDeviation
+	params.CircuitBreakerTwapDuration = 10 * time.Minute
+	suite.App.GAMMKeeper.SetParams(suite.Ctx, params)
+
+	poolId := suite.prepareCustomBalancerPool(defaultAcctFunds, defaultPoolAssets, defaultPoolParams)
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(20 * time.Minute))
+	return poolId
+}
+
+func (suite *KeeperTestSuite) TestHandleSetPoolPausedProposal() {
+	suite.SetupTest()
+	poolId := suite.prepareCustomBalancerPool(defaultAcctFunds, defaultPoolAssets, defaultPoolParams)
+	sender := suite.TestAccs[0]
+
+	proposal := types.NewSetPoolPausedProposal("title", "description", poolId, true)
+	suite.Require().NoError(suite.App.GAMMKeeper.HandleSetPoolPausedProposal(suite.Ctx, &proposal))
+
+	pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().False(pool.IsActive(suite.Ctx))
+
+	// swaps and joins are paused
+	_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, sender, poolId, sdk.NewInt64Coin("foo", 10), "bar", sdk.OneInt())
+	suite.Require().ErrorIs(err, types.ErrPoolLocked)
+	_, _, err = suite.App.GAMMKeeper.JoinPoolNoSwap(suite.Ctx, sender, poolId, types.OneShare, nil)
+	suite.Require().ErrorIs(err, types.ErrPoolLocked)
+	_, err = suite.App.GAMMKeeper.JoinSwapExactAmountIn(suite.Ctx, sender, poolId, sdk.NewCoins(sdk.NewInt64Coin("foo", 10)), sdk.OneInt())
+	suite.Require().ErrorIs(err, types.ErrPoolLocked)
+
+	// exits are not paused
+	_, err = suite.App.GAMMKeeper.ExitPool(suite.Ctx, sender, poolId, types.OneShare, sdk.Coins{})
+	suite.Require().NoError(err)
+
+	proposal = types.NewSetPoolPausedProposal("title", "description", poolId, false)
+	suite.Require().NoError(suite.App.GAMMKeeper.HandleSetPoolPausedProposal(suite.Ctx, &proposal))
+
+	_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, sender, poolId, sdk.NewInt64Coin("foo", 10), "bar", sdk.OneInt())
+	suite.Require().NoError(err)
+
+	// pool does not exist
+	proposal = types.NewSetPoolPausedProposal("title", "description", poolId+1, true)
+	suite.Require().Error(suite.App.GAMMKeeper.HandleSetPoolPausedProposal(suite.Ctx, &proposal))
+}
+
+func (suite *KeeperTestSuite) TestCircuitBreakerSwap() {
+	testcases := []struct {
+		name     string
+		disabled bool
+		tokenIn  sdk.Coin
+		expError error
+	}{
+		{
+			name:    "swap within max deviation",
+			tokenIn: sdk.NewInt64Coin("foo", 100),
+		},
+		{
+			name:     "swap beyond max deviation",
+			tokenIn:  sdk.NewInt64Coin("foo", 2000),
+			expError: types.ErrCircuitBreakerTripped,
+		},
+		{
+			name:     "swap beyond max deviation in the other direction",
+			tokenIn:  sdk.NewInt64Coin("bar", 2000),
+			expError: types.ErrCircuitBreakerTripped,
+		},
+		{
+			name:     "circuit breaker disabled",
+			disabled: true,
+			tokenIn:  sdk.NewInt64Coin("foo", 2000),
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			poolId := suite.prepareCircuitBreakerPool()
+			if tc.disabled {
+				params := suite.App.GAMMKeeper.GetParams(suite.Ctx)
+				params.CircuitBreakerMaxSpotPriceDeviation = sdk.ZeroDec()
+				suite.App.GAMMKeeper.SetParams(suite.Ctx, params)
+			}
+
+			tokenOutDenom := "bar"
+			if tc.tokenIn.Denom == "bar" {
+				tokenOutDenom = "foo"
+			}
+			_, err := suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], poolId, tc.tokenIn, tokenOutDenom, sdk.OneInt())
+			if tc.expError != nil {
+				suite.Require().ErrorIs(err, tc.expError)
+				return
+			}
+			suite.Require().NoError(err)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCircuitBreakerJoinExit() {
+	testcases := []struct {
+		name     string
+		join     sdk.Coin
+		exit     sdk.Coin
+		expError error
+	}{
+		{
+			name: "join within max deviation",
+			join: sdk.NewInt64Coin("foo", 100),
+		},
+		{
+			name:     "join beyond max deviation",
+			join:     sdk.NewInt64Coin("foo", 5000),
+			expError: types.ErrCircuitBreakerTripped,
+		},
+		{
+			name: "exit within max deviation",
+			exit: sdk.NewInt64Coin("bar", 100),
+		},
+		{
+			name:     "exit beyond max deviation",
+			exit:     sdk.NewInt64Coin("bar", 2000),
+			expError: types.ErrCircuitBreakerTripped,
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			poolId := suite.prepareCircuitBreakerPool()
+
+			var err error
+			if tc.join.IsValid() {
+				_, err = suite.App.GAMMKeeper.JoinSwapExactAmountIn(suite.Ctx, suite.TestAccs[0], poolId, sdk.NewCoins(tc.join), sdk.OneInt())
+			} else {
+				_, err = suite.App.GAMMKeeper.ExitSwapExactAmountOut(suite.Ctx, suite.TestAccs[0], poolId, tc.exit, types.InitPoolSharesSupply)
+			}
+			if tc.expError != nil {
+				suite.Require().ErrorIs(err, tc.expError)
+				return
+			}
+			suite.Require().NoError(err)
+		})
+	}
+
+	// exiting at the pool's ratio does not move its spot price.
+	suite.SetupTest()
+	poolId := suite.prepareCircuitBreakerPool()
+	_, err := suite.App.GAMMKeeper.ExitPool(suite.Ctx, suite.TestAccs[0], poolId, types.InitPoolSharesSupply.QuoRaw(2), sdk.Coins{})
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestCircuitBreakerEndBlock() {
+	testcases := []struct {
+		name      string
+		tokenIn   sdk.Coin
+		expPaused bool
+	}{
+		{
+			name:    "change within max deviation",
+			tokenIn: sdk.NewInt64Coin("foo", 100),
+		},
+		{
+			name:      "change beyond max deviation pauses the pool",
+			tokenIn:   sdk.NewInt64Coin("foo", 5000),
+			expPaused: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			poolId := suite.prepareCircuitBreakerPool()
+
+			// joins beyond the max deviation are rejected, so the pool is changed
+			// while the max deviation is loose, and checked against the tight one.
+			params := suite.App.GAMMKeeper.GetParams(suite.Ctx)
+			params.CircuitBreakerMaxSpotPriceDeviation = sdk.OneDec()
+			suite.App.GAMMKeeper.SetParams(suite.Ctx, params)
+			_, err := suite.App.GAMMKeeper.JoinSwapExactAmountIn(suite.Ctx, suite.TestAccs[0], poolId, sdk.NewCoins(tc.tokenIn), sdk.OneInt())
+			suite.Require().NoError(err)
+			params.CircuitBreakerMaxSpotPriceDeviation = circuitBreakerMaxDeviation
+			suite.App.GAMMKeeper.SetParams(suite.Ctx, params)
+
+			suite.App.GAMMKeeper.EndBlock(suite.Ctx)
+
+			pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(!tc.expPaused, pool.IsActive(suite.Ctx))
+		})
+	}
+}
+
+// TestCircuitBreakerSwapPausesPool tests that a swap tripping the circuit breaker pauses the pool at the end
+// of the block, even though the transaction of the swap is reverted.
+func (suite *KeeperTestSuite) TestCircuitBreakerSwapPausesPool() {
+	testcases := []struct {
+		name      string
+		checkTx   bool
+		tokenIn   sdk.Coin
+		expPaused bool
+	}{
+		{
+			name:    "swap within max deviation",
+			tokenIn: sdk.NewInt64Coin("foo", 100),
+		},
+		{
+			name:      "swap beyond max deviation pauses the pool",
+			tokenIn:   sdk.NewInt64Coin("foo", 2000),
+			expPaused: true,
+		},
+		{
+			name:    "swap beyond max deviation checked for the mempool",
+			checkTx: true,
+			tokenIn: sdk.NewInt64Coin("foo", 2000),
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			poolId := suite.prepareCircuitBreakerPool()
+
+			// the swap runs in a branch of the block state, which is discarded like that of a failed transaction.
+			txCtx, _ := suite.Ctx.WithIsCheckTx(tc.checkTx).CacheContext()
+			_, err := suite.App.GAMMKeeper.SwapExactAmountIn(txCtx, suite.TestAccs[0], poolId, tc.tokenIn, "bar", sdk.OneInt())
+			suite.Require().Equal(tc.expPaused || tc.checkTx, err != nil)
+
+			pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			suite.Require().True(pool.IsActive(suite.Ctx))
+
+			suite.App.GAMMKeeper.EndBlock(suite.Ctx)
+
+			pool, err = suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(!tc.expPaused, pool.IsActive(suite.Ctx))
+		})
+	}
+}
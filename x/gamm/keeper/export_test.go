@@ -1,8 +1,12 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
 	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 )
@@ -25,6 +29,18 @@ func (k Keeper) SetStableSwapScalingFactors(ctx sdk.Context, poolId uint64, scal
 	return k.setStableSwapScalingFactors(ctx, poolId, scalingFactors, sender)
 }
 
+func (k Keeper) UpdateBalancerPoolWeights(ctx sdk.Context, poolId uint64, sender string, targetPoolWeights []balancer.PoolAsset, duration time.Duration) error {
+	return k.updateBalancerPoolWeights(ctx, poolId, sender, targetPoolWeights, duration)
+}
+
+func (k Keeper) AddBalancerPoolAsset(ctx sdk.Context, poolId uint64, sender string, asset balancer.PoolAsset, duration time.Duration, priceDenom string, referencePoolId uint64) (sdk.Int, error) {
+	return k.addBalancerPoolAsset(ctx, poolId, sender, asset, duration, priceDenom, referencePoolId)
+}
+
+func (k Keeper) RemoveBalancerPoolAsset(ctx sdk.Context, poolId uint64, sender string, denom string) (sdk.Coin, sdk.Int, error) {
+	return k.removeBalancerPoolAsset(ctx, poolId, sender, denom)
+}
+
 func (k Keeper) GetOsmoRoutedMultihopTotalSwapFee(ctx sdk.Context, route types.MultihopRoute) (
 	totalPathSwapFee sdk.Dec, sumOfSwapFees sdk.Dec, err error) {
 	return k.getOsmoRoutedMultihopTotalSwapFee(ctx, route)
@@ -38,3 +54,15 @@ func (k Keeper) UnmarshalPoolLegacy(bz []byte) (swaproutertypes.PoolI, error) {
 	var acc swaproutertypes.PoolI
 	return acc, k.cdc.UnmarshalInterface(bz, &acc)
 }
+
+func (k Keeper) SetStableSwapScalingFactorRateProvider(ctx sdk.Context, poolId uint64, sender string, rateProvider *stableswap.ScalingFactorRateProvider) error {
+	return k.setStableSwapScalingFactorRateProvider(ctx, poolId, sender, rateProvider)
+}
+
+func (k Keeper) UpdateScalingFactorsFromRateProviders(ctx sdk.Context, epochIdentifier string) {
+	k.updateScalingFactorsFromRateProviders(ctx, epochIdentifier)
+}
+
+func (k Keeper) GetRateProviderPoolIds(ctx sdk.Context) []uint64 {
+	return k.getRateProviderPoolIds(ctx)
+}
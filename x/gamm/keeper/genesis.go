@@ -14,7 +14,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState, unpack
 	k.setNextPoolId(ctx, genState.NextPoolNumber)
 
 	// Sums up the liquidity in all genesis state pools to find the total liquidity across all pools.
-	// Also adds each genesis state pool to the x/gamm module's state
+	// Also adds each genesis state pool to the x/gamm module's state, and indexes it by its denoms and rate provider
 	liquidity := sdk.Coins{}
 	for _, any := range genState.Pools {
 		var pool types.CFMMPoolI
@@ -26,6 +26,8 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState, unpack
 		if err != nil {
 			panic(err)
 		}
+		k.setPoolDenoms(ctx, pool)
+		k.setRateProviderPool(ctx, pool)
 
 		poolAssets := pool.GetTotalPoolLiquidity(ctx)
 		for _, asset := range poolAssets {
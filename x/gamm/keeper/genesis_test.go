@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	"testing"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -43,7 +45,12 @@ func TestGammInitGenesis(t *testing.T) {
 		Pools:          []*codectypes.Any{any},
 		NextPoolNumber: 2,
 		Params: types.Params{
-			PoolCreationFee: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000_000_000)},
+			PoolCreationFee:                     sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000_000_000)},
+			CircuitBreakerMaxSpotPriceDeviation: sdk.ZeroDec(),
+			CircuitBreakerTwapDuration:          10 * time.Minute,
+			ProtocolFeeShare:                    sdk.ZeroDec(),
+			ProtocolFeeEpochIdentifier:          "day",
+			MaxRoutePoolsConsidered:             100,
 		},
 	}, app.AppCodec())
 
@@ -64,6 +71,8 @@ func TestGammInitGenesis(t *testing.T) {
 
 	liquidity := app.GAMMKeeper.GetTotalLiquidity(ctx)
 	require.Equal(t, liquidity, sdk.Coins{sdk.NewInt64Coin("nodetoken", 10), sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)})
+
+	require.Equal(t, []uint64{1}, app.GAMMKeeper.GetPoolIdsByDenomPair(ctx, "nodetoken", sdk.DefaultBondDenom))
 }
 
 func TestGammExportGenesis(t *testing.T) {
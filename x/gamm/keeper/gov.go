@@ -0,0 +1,11 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+)
+
+func (k Keeper) HandleSetPoolPausedProposal(ctx sdk.Context, p *types.SetPoolPausedProposal) error {
+	return k.setPoolPaused(ctx, p.PoolId, p.Paused)
+}
@@ -145,7 +145,7 @@ func (q Querier) CalcJoinPoolShares(ctx context.Context, req *types.QueryCalcJoi
 		return nil, err
 	}
 
-	numShares, newLiquidity, err := pool.CalcJoinPoolShares(sdkCtx, req.TokensIn, pool.GetSwapFee(sdkCtx))
+	numShares, newLiquidity, err := pool.CalcJoinPoolShares(sdkCtx, req.TokensIn, q.Keeper.GetSwapFee(sdkCtx, pool))
 	if err != nil {
 		return nil, err
 	}
@@ -214,6 +214,86 @@ func (q Querier) PoolsWithFilter(ctx context.Context, req *types.QueryPoolsWithF
 	}, nil
 }
 
+// PoolsByDenom queries the pools containing the denom, using the denom to pools index.
+func (q Querier) PoolsByDenom(ctx context.Context, req *types.QueryPoolsByDenomRequest) (*types.QueryPoolsByDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	pools, pageRes, err := q.paginatePoolsByDenom(sdkCtx, req.Denom, req.Pagination, func(uint64) bool { return true })
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPoolsByDenomResponse{
+		Pools:      pools,
+		Pagination: pageRes,
+	}, nil
+}
+
+// PoolsByDenomPair queries the pools containing both denoms, using the denom to pools index.
+func (q Querier) PoolsByDenomPair(ctx context.Context, req *types.QueryPoolsByDenomPairRequest) (*types.QueryPoolsByDenomPairResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.DenomA == "" || req.DenomB == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+	if req.DenomA == req.DenomB {
+		return nil, status.Error(codes.InvalidArgument, "denoms must be different")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	pools, pageRes, err := q.paginatePoolsByDenom(sdkCtx, req.DenomA, req.Pagination, func(poolId uint64) bool {
+		return q.Keeper.hasPoolDenom(sdkCtx, req.DenomB, poolId)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPoolsByDenomPairResponse{
+		Pools:      pools,
+		Pagination: pageRes,
+	}, nil
+}
+
+// paginatePoolsByDenom paginates over the pools containing the denom that match the filter.
+func (q Querier) paginatePoolsByDenom(ctx sdk.Context, denom string, pagination *query.PageRequest, filter func(poolId uint64) bool) ([]*codectypes.Any, *query.PageResponse, error) {
+	store := ctx.KVStore(q.Keeper.storeKey)
+	denomPoolsStore := prefix.NewStore(store, types.GetKeyPrefixDenomPools(denom))
+
+	pools := []*codectypes.Any{}
+	pageRes, err := query.FilteredPaginate(denomPoolsStore, pagination, func(key, _ []byte, accumulate bool) (bool, error) {
+		poolId := sdk.BigEndianToUint64(key)
+		if !filter(poolId) {
+			return false, nil
+		}
+
+		if accumulate {
+			pool, err := q.Keeper.GetPoolAndPoke(ctx, poolId)
+			if err != nil {
+				return false, err
+			}
+			any, err := codectypes.NewAnyWithValue(pool)
+			if err != nil {
+				return false, err
+			}
+			pools = append(pools, any)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return pools, pageRes, nil
+}
+
 // CalcExitPoolCoinsFromShares queries the amount of tokens you get by exiting a specific amount of shares
 func (q Querier) CalcExitPoolCoinsFromShares(ctx context.Context, req *types.QueryCalcExitPoolCoinsFromSharesRequest) (*types.QueryCalcExitPoolCoinsFromSharesResponse, error) {
 	if req == nil {
@@ -253,7 +333,7 @@ func (q Querier) CalcJoinPoolNoSwapShares(ctx context.Context, req *types.QueryC
 		return nil, err
 	}
 
-	sharesOut, tokensJoined, err := pool.CalcJoinPoolNoSwapShares(sdkCtx, req.TokensIn, pool.GetSwapFee(sdkCtx))
+	sharesOut, tokensJoined, err := pool.CalcJoinPoolNoSwapShares(sdkCtx, req.TokensIn, q.Keeper.GetSwapFee(sdkCtx, pool))
 	if err != nil {
 		return nil, err
 	}
@@ -420,6 +500,67 @@ func (q Querier) EstimateSwapExactAmountIn(ctx context.Context, req *types.Query
 	}, nil
 }
 
+// EstimateBestRoutesExactAmountIn estimates the best routes for swapping the token in for the token out denom.
+func (q Querier) EstimateBestRoutesExactAmountIn(ctx context.Context, req *types.QueryBestRoutesExactAmountInRequest) (*types.QueryBestRoutesExactAmountInResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tokenIn, err := sdk.ParseCoinNormalized(req.TokenIn)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
+	}
+	if err := sdk.ValidateDenom(req.TokenOutDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token out denom: %s", err.Error())
+	}
+	if tokenIn.Denom == req.TokenOutDenom {
+		return nil, status.Error(codes.InvalidArgument, "token in and token out denoms must be different")
+	}
+	if req.MaxHops == 0 || req.MaxHops > types.MaxBestRouteHops {
+		return nil, status.Errorf(codes.InvalidArgument, "max hops must be between 1 and %d", types.MaxBestRouteHops)
+	}
+	if req.Limit > types.MaxBestRoutes {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be at most %d", types.MaxBestRoutes)
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = 1
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	routes := q.Keeper.EstimateBestRoutesExactAmountIn(sdkCtx, tokenIn, req.TokenOutDenom, int(req.MaxHops), limit)
+
+	return &types.QueryBestRoutesExactAmountInResponse{
+		Routes: routes,
+	}, nil
+}
+
+// EstimateSplitRouteSwapExactAmountIn estimates the total token out amount of a split route swap.
+func (q Querier) EstimateSplitRouteSwapExactAmountIn(ctx context.Context, req *types.QuerySplitRouteSwapExactAmountInRequest) (*types.QuerySplitRouteSwapExactAmountInResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.TokenInDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token in denom: %s", err.Error())
+	}
+	if err := types.SwapAmountInSplitRoutes(req.Routes).Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	tokenOutAmount, err := q.Keeper.SplitRouteEstimateOutGivenExactAmountIn(sdkCtx, req.Routes, req.TokenInDenom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySplitRouteSwapExactAmountInResponse{
+		TokenOutAmount: tokenOutAmount,
+	}, nil
+}
+
 // EstimateSwapExactAmountOut estimates token output amount for a swap.
 func (q Querier) EstimateSwapExactAmountOut(ctx context.Context, req *types.QuerySwapExactAmountOutRequest) (*types.QuerySwapExactAmountOutResponse, error) {
 	if req == nil {
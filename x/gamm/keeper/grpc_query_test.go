@@ -4,6 +4,7 @@ import (
 	gocontext "context"
 	"errors"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -492,6 +493,118 @@ func (suite *KeeperTestSuite) TestQueryPools() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryPoolsByDenom() {
+	poolAsset := func(denom string) balancertypes.PoolAsset {
+		return balancertypes.PoolAsset{Weight: sdk.NewInt(100), Token: sdk.NewInt64Coin(denom, 10000)}
+	}
+
+	testcases := []struct {
+		name       string
+		denomA     string
+		denomB     string
+		pagination *query.PageRequest
+		expPoolIds []uint64
+		expErr     bool
+	}{
+		{
+			name:       "pools by denom",
+			denomA:     "foo",
+			expPoolIds: []uint64{1, 3, 4},
+		},
+		{
+			name:       "pools by denom with pagination",
+			denomA:     "foo",
+			pagination: &query.PageRequest{Offset: 1, Limit: 1},
+			expPoolIds: []uint64{3},
+		},
+		{
+			name:       "no pools by denom",
+			denomA:     "uosmo",
+			expPoolIds: []uint64{},
+		},
+		{
+			name:       "pools by denom pair",
+			denomA:     "bar",
+			denomB:     "baz",
+			expPoolIds: []uint64{2, 4},
+		},
+		{
+			name:       "pools by denom pair with pagination",
+			denomA:     "bar",
+			denomB:     "baz",
+			pagination: &query.PageRequest{Limit: 1},
+			expPoolIds: []uint64{2},
+		},
+		{
+			name:       "no pools by denom pair",
+			denomA:     "foo",
+			denomB:     "uosmo",
+			expPoolIds: []uint64{},
+		},
+		{
+			name:   "empty denom",
+			denomA: "",
+			expErr: true,
+		},
+		{
+			name:   "same denoms",
+			denomA: "foo",
+			denomB: "foo",
+			expErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			for _, denoms := range [][]string{{"foo", "bar"}, {"bar", "baz"}, {"foo", "baz"}, {"foo", "bar", "baz"}} {
+				poolAssets := []balancertypes.PoolAsset{}
+				for _, denom := range denoms {
+					poolAssets = append(poolAssets, poolAsset(denom))
+				}
+				suite.prepareCustomBalancerPool(defaultAcctFunds, poolAssets, defaultPoolParams)
+			}
+
+			var pools []*codectypes.Any
+			var err error
+			if tc.denomB == "" {
+				var res *types.QueryPoolsByDenomResponse
+				res, err = suite.queryClient.PoolsByDenom(gocontext.Background(), &types.QueryPoolsByDenomRequest{
+					Denom:      tc.denomA,
+					Pagination: tc.pagination,
+				})
+				if res != nil {
+					pools = res.Pools
+				}
+			} else {
+				var res *types.QueryPoolsByDenomPairResponse
+				res, err = suite.queryClient.PoolsByDenomPair(gocontext.Background(), &types.QueryPoolsByDenomPairRequest{
+					DenomA:     tc.denomA,
+					DenomB:     tc.denomB,
+					Pagination: tc.pagination,
+				})
+				if res != nil {
+					pools = res.Pools
+				}
+			}
+			if tc.expErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			poolIds := []uint64{}
+			for _, r := range pools {
+				var pool types.CFMMPoolI
+				err = suite.App.InterfaceRegistry().UnpackAny(r, &pool)
+				suite.Require().NoError(err)
+				poolIds = append(poolIds, pool.GetId())
+			}
+			suite.Require().Equal(tc.expPoolIds, poolIds)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestPoolType() {
 	poolIdBalancer := suite.PrepareBalancerPool()
 	poolIdStableswap := suite.PrepareBasicStableswapPool()
@@ -971,3 +1084,58 @@ func (suite *KeeperTestSuite) TestV2QueryStableswapPoolSpotPrice() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryEstimateBestRoutesExactAmountIn() {
+	testcases := []struct {
+		name   string
+		req    *types.QueryBestRoutesExactAmountInRequest
+		expErr bool
+	}{
+		{
+			name: "valid request",
+			req:  &types.QueryBestRoutesExactAmountInRequest{TokenIn: "1000foo", TokenOutDenom: "bar", MaxHops: 2},
+		},
+		{
+			name:   "invalid token in",
+			req:    &types.QueryBestRoutesExactAmountInRequest{TokenIn: "foo", TokenOutDenom: "bar", MaxHops: 2},
+			expErr: true,
+		},
+		{
+			name:   "same denoms",
+			req:    &types.QueryBestRoutesExactAmountInRequest{TokenIn: "1000foo", TokenOutDenom: "foo", MaxHops: 2},
+			expErr: true,
+		},
+		{
+			name:   "zero max hops",
+			req:    &types.QueryBestRoutesExactAmountInRequest{TokenIn: "1000foo", TokenOutDenom: "bar"},
+			expErr: true,
+		},
+		{
+			name:   "too many max hops",
+			req:    &types.QueryBestRoutesExactAmountInRequest{TokenIn: "1000foo", TokenOutDenom: "bar", MaxHops: types.MaxBestRouteHops + 1},
+			expErr: true,
+		},
+		{
+			name:   "limit too large",
+			req:    &types.QueryBestRoutesExactAmountInRequest{TokenIn: "1000foo", TokenOutDenom: "bar", MaxHops: 2, Limit: types.MaxBestRoutes + 1},
+			expErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.PrepareBalancerPool()
+
+			res, err := suite.queryClient.EstimateBestRoutesExactAmountIn(gocontext.Background(), tc.req)
+			if tc.expErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			// a zero limit returns the best route only
+			suite.Require().Len(res.Routes, 1)
+			suite.Require().Equal([]types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "bar"}}, res.Routes[0].Routes)
+		})
+	}
+}
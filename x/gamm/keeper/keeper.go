@@ -22,8 +22,9 @@ func permContains(perms []string, perm string) bool {
 }
 
 type Keeper struct {
-	storeKey sdk.StoreKey
-	cdc      codec.BinaryCodec
+	storeKey     sdk.StoreKey
+	transientKey *sdk.TransientStoreKey
+	cdc          codec.BinaryCodec
 
 	paramSpace paramtypes.Subspace
 	hooks      types.GammHooks
@@ -33,9 +34,18 @@ type Keeper struct {
 	bankKeeper           types.BankKeeper
 	communityPoolKeeper  types.CommunityPoolKeeper
 	poolIncentivesKeeper types.PoolIncentivesKeeper
+	lockupKeeper         types.LockupKeeper
+	wasmKeeper           types.WasmKeeper
+	twapKeeper           types.TwapKeeper
+	downtimeKeeper       types.DowntimeKeeper
+
+	// trippedPoolIds are the pools whose circuit breaker was tripped in this block.
+	// They are kept in memory rather than in a store, so that they outlive the reverted
+	// state of the swap that tripped the breaker, and are paused in EndBlock.
+	trippedPoolIds map[uint64]struct{}
 }
 
-func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, communityPoolKeeper types.CommunityPoolKeeper) Keeper {
+func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, transientKey *sdk.TransientStoreKey, paramSpace paramtypes.Subspace, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, communityPoolKeeper types.CommunityPoolKeeper) Keeper {
 	// Ensure that the module account are set.
 	moduleAddr, perms := accountKeeper.GetModuleAddressAndPermissions(types.ModuleName)
 	if moduleAddr == nil {
@@ -51,13 +61,15 @@ func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramSpace paramtyp
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
 	return Keeper{
-		storeKey:   storeKey,
-		cdc:        cdc,
-		paramSpace: paramSpace,
+		storeKey:     storeKey,
+		transientKey: transientKey,
+		cdc:          cdc,
+		paramSpace:   paramSpace,
 		// keepers
 		accountKeeper:       accountKeeper,
 		bankKeeper:          bankKeeper,
 		communityPoolKeeper: communityPoolKeeper,
+		trippedPoolIds:      map[uint64]struct{}{},
 	}
 }
 
@@ -76,6 +88,22 @@ func (k *Keeper) SetPoolIncentivesKeeper(poolIncentivesKeeper types.PoolIncentiv
 	k.poolIncentivesKeeper = poolIncentivesKeeper
 }
 
+func (k *Keeper) SetLockupKeeper(lockupKeeper types.LockupKeeper) {
+	k.lockupKeeper = lockupKeeper
+}
+
+func (k *Keeper) SetWasmKeeper(wasmKeeper types.WasmKeeper) {
+	k.wasmKeeper = wasmKeeper
+}
+
+func (k *Keeper) SetTwapKeeper(twapKeeper types.TwapKeeper) {
+	k.twapKeeper = twapKeeper
+}
+
+func (k *Keeper) SetDowntimeKeeper(downtimeKeeper types.DowntimeKeeper) {
+	k.downtimeKeeper = downtimeKeeper
+}
+
 // GetParams returns the total set params.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
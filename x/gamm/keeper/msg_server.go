@@ -46,6 +46,38 @@ func (server msgServer) CreateBalancerPool(goCtx context.Context, msg *balancer.
 	return &balancer.MsgCreateBalancerPoolResponse{PoolID: poolId}, err
 }
 
+func (server msgServer) UpdateBalancerPoolWeights(goCtx context.Context, msg *balancer.MsgUpdateBalancerPoolWeights) (*balancer.MsgUpdateBalancerPoolWeightsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.keeper.updateBalancerPoolWeights(ctx, msg.PoolID, msg.Sender, msg.TargetPoolWeights, msg.Duration); err != nil {
+		return nil, err
+	}
+
+	return &balancer.MsgUpdateBalancerPoolWeightsResponse{}, nil
+}
+
+func (server msgServer) AddBalancerPoolAsset(goCtx context.Context, msg *balancer.MsgAddBalancerPoolAsset) (*balancer.MsgAddBalancerPoolAssetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	shareOutAmount, err := server.keeper.addBalancerPoolAsset(ctx, msg.PoolID, msg.Sender, msg.PoolAsset, msg.Duration, msg.PriceDenom, msg.ReferencePoolID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &balancer.MsgAddBalancerPoolAssetResponse{ShareOutAmount: shareOutAmount}, nil
+}
+
+func (server msgServer) RemoveBalancerPoolAsset(goCtx context.Context, msg *balancer.MsgRemoveBalancerPoolAsset) (*balancer.MsgRemoveBalancerPoolAssetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tokenOut, shareInAmount, err := server.keeper.removeBalancerPoolAsset(ctx, msg.PoolID, msg.Sender, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &balancer.MsgRemoveBalancerPoolAssetResponse{TokenOut: tokenOut, ShareInAmount: shareInAmount}, nil
+}
+
 func (server msgServer) CreateStableswapPool(goCtx context.Context, msg *stableswap.MsgCreateStableswapPool) (*stableswap.MsgCreateStableswapPoolResponse, error) {
 	poolId, err := server.CreatePool(goCtx, msg)
 	if err != nil {
@@ -64,6 +96,16 @@ func (server msgServer) StableSwapAdjustScalingFactors(goCtx context.Context, ms
 	return &stableswap.MsgStableSwapAdjustScalingFactorsResponse{}, nil
 }
 
+func (server msgServer) StableSwapSetScalingFactorRateProvider(goCtx context.Context, msg *stableswap.MsgStableSwapSetScalingFactorRateProvider) (*stableswap.MsgStableSwapSetScalingFactorRateProviderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.keeper.setStableSwapScalingFactorRateProvider(ctx, msg.PoolID, msg.Sender, msg.RateProvider); err != nil {
+		return nil, err
+	}
+
+	return &stableswap.MsgStableSwapSetScalingFactorRateProviderResponse{}, nil
+}
+
 // CreatePool attempts to create a pool returning the newly created pool ID or an error upon failure.
 // The pool creation fee is used to fund the community pool.
 // It will create a dedicated module account for the pool and sends the initial liquidity to the created module account.
@@ -184,6 +226,31 @@ func (server msgServer) SwapExactAmountIn(goCtx context.Context, msg *types.MsgS
 	return &types.MsgSwapExactAmountInResponse{TokenOutAmount: tokenOutAmount}, nil
 }
 
+func (server msgServer) SplitRouteSwapExactAmountIn(goCtx context.Context, msg *types.MsgSplitRouteSwapExactAmountIn) (*types.MsgSplitRouteSwapExactAmountInResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenOutAmount, err := server.keeper.SplitRouteSwapExactAmountIn(ctx, sender, msg.Routes, msg.TokenInDenom, msg.TokenOutMinAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Swap and split route swapped events are handled elsewhere
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgSplitRouteSwapExactAmountInResponse{TokenOutAmount: tokenOutAmount}, nil
+}
+
 func (server msgServer) SwapExactAmountOut(goCtx context.Context, msg *types.MsgSwapExactAmountOut) (*types.MsgSwapExactAmountOutResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"strconv"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -61,7 +64,7 @@ func (k Keeper) MultihopSwapExactAmountIn(
 			return sdk.Int{}, poolErr
 		}
 
-		swapFee := pool.GetSwapFee(ctx)
+		swapFee := k.GetSwapFee(ctx, pool)
 
 		// If we determined the route is an osmo multi-hop and both routes are incentivized,
 		// we modify the swap fee accordingly.
@@ -111,7 +114,7 @@ func (k Keeper) MultihopEstimateOutGivenExactAmountIn(
 			return sdk.Int{}, poolErr
 		}
 
-		swapFee := pool.GetSwapFee(ctx)
+		swapFee := k.GetSwapFee(ctx, pool)
 
 		// If we determined the route is an osmo multi-hop and both routes are incentivized,
 		// we modify the swap fee accordingly.
@@ -204,7 +207,7 @@ func (k Keeper) MultihopSwapExactAmountOut(
 			return sdk.Int{}, poolErr
 		}
 
-		swapFee := pool.GetSwapFee(ctx)
+		swapFee := k.GetSwapFee(ctx, pool)
 		if isMultiHopRouted {
 			swapFee = routeSwapFee.Mul((swapFee.Quo(sumOfSwapFees)))
 		}
@@ -263,6 +266,77 @@ func (k Keeper) MultihopEstimateInGivenExactAmountOut(
 	return insExpected[0], nil
 }
 
+// SplitRouteSwapExactAmountIn swaps the token in through each of the routes with MultihopSwapExactAmountIn,
+// and succeeds when the total token out amount of the routes is at least tokenOutMinAmount.
+// Each route emits a split route swapped event besides the swap events of its pools.
+func (k Keeper) SplitRouteSwapExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapAmountInSplitRoute,
+	tokenInDenom string,
+	tokenOutMinAmount sdk.Int,
+) (tokenOutAmount sdk.Int, err error) {
+	if err := types.SwapAmountInSplitRoutes(routes).Validate(); err != nil {
+		return sdk.Int{}, err
+	}
+
+	tokenOutAmount = sdk.ZeroInt()
+	for _, route := range routes {
+		tokenIn := sdk.NewCoin(tokenInDenom, route.TokenInAmount)
+		// The minimum is enforced on the total token out amount, so every route only needs a positive output.
+		routeTokenOutAmount, err := k.MultihopSwapExactAmountIn(ctx, sender, route.Pools, tokenIn, sdk.OneInt())
+		if err != nil {
+			return sdk.Int{}, err
+		}
+
+		emitSplitRouteSwappedEvent(ctx, sender, route, tokenIn, sdk.NewCoin(route.TokenOutDenom(), routeTokenOutAmount))
+		tokenOutAmount = tokenOutAmount.Add(routeTokenOutAmount)
+	}
+
+	if tokenOutAmount.LT(tokenOutMinAmount) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrLimitMinAmount, "%s token is lesser than min amount", routes[0].TokenOutDenom())
+	}
+	return tokenOutAmount, nil
+}
+
+// SplitRouteEstimateOutGivenExactAmountIn estimates the total token out amount of a split route swap.
+// Since split routes don't share pools, the routes are estimated independently of each other.
+func (k Keeper) SplitRouteEstimateOutGivenExactAmountIn(
+	ctx sdk.Context,
+	routes []types.SwapAmountInSplitRoute,
+	tokenInDenom string,
+) (tokenOutAmount sdk.Int, err error) {
+	if err := types.SwapAmountInSplitRoutes(routes).Validate(); err != nil {
+		return sdk.Int{}, err
+	}
+
+	tokenOutAmount = sdk.ZeroInt()
+	for _, route := range routes {
+		routeTokenOutAmount, err := k.MultihopEstimateOutGivenExactAmountIn(ctx, route.Pools, sdk.NewCoin(tokenInDenom, route.TokenInAmount))
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		tokenOutAmount = tokenOutAmount.Add(routeTokenOutAmount)
+	}
+	return tokenOutAmount, nil
+}
+
+func emitSplitRouteSwappedEvent(ctx sdk.Context, sender sdk.AccAddress, route types.SwapAmountInSplitRoute, tokenIn, tokenOut sdk.Coin) {
+	poolIds := make([]string, 0, len(route.Pools))
+	for _, pool := range route.Pools {
+		poolIds = append(poolIds, strconv.FormatUint(pool.PoolId, 10))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtSplitRouteSwapped,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyPoolIds, strings.Join(poolIds, ",")),
+		sdk.NewAttribute(types.AttributeKeyTokensIn, tokenIn.String()),
+		sdk.NewAttribute(types.AttributeKeyTokensOut, tokenOut.String()),
+	))
+}
+
 func (k Keeper) isOsmoRoutedMultihop(ctx sdk.Context, route types.MultihopRoute, inDenom, outDenom string) (isRouted bool) {
 	if route.Length() != 2 {
 		return false
@@ -295,7 +369,7 @@ func (k Keeper) getOsmoRoutedMultihopTotalSwapFee(ctx sdk.Context, route types.M
 		if poolErr != nil {
 			return sdk.Dec{}, sdk.Dec{}, poolErr
 		}
-		swapFee := pool.GetSwapFee(ctx)
+		swapFee := k.GetSwapFee(ctx, pool)
 		additiveSwapFee = additiveSwapFee.Add(swapFee)
 		maxSwapFee = sdk.MaxDec(maxSwapFee, swapFee)
 	}
@@ -323,7 +397,7 @@ func (k Keeper) createMultihopExpectedSwapOuts(
 			return nil, err
 		}
 
-		tokenIn, err := pool.CalcInAmtGivenOut(ctx, sdk.NewCoins(tokenOut), route.TokenInDenom, pool.GetSwapFee(ctx))
+		tokenIn, err := pool.CalcInAmtGivenOut(ctx, sdk.NewCoins(tokenOut), route.TokenInDenom, k.GetSwapFee(ctx, pool))
 		if err != nil {
 			return nil, err
 		}
@@ -351,7 +425,7 @@ func (k Keeper) createOsmoMultihopExpectedSwapOuts(
 			return nil, err
 		}
 
-		swapFee := pool.GetSwapFee(ctx)
+		swapFee := k.GetSwapFee(ctx, pool)
 		tokenIn, err := pool.CalcInAmtGivenOut(ctx, sdk.NewCoins(tokenOut), route.TokenInDenom, cumulativeRouteSwapFee.Mul((swapFee.Quo(sumOfSwapFees))))
 		if err != nil {
 			return nil, err
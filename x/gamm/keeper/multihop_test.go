@@ -831,6 +831,68 @@ func (suite *KeeperTestSuite) TestEstimateMultihopSwapExactAmountOut() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSplitRouteSwapExactAmountIn() {
+	routes := []types.SwapAmountInSplitRoute{{
+		Pools:         []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "bar"}},
+		TokenInAmount: sdk.NewInt(600_000),
+	}, {
+		Pools:         []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: "uosmo"}, {PoolId: 3, TokenOutDenom: "bar"}},
+		TokenInAmount: sdk.NewInt(400_000),
+	}}
+
+	testcases := []struct {
+		name                  string
+		tokenOutMinAmountDiff int64
+		expErr                error
+	}{
+		{
+			name:                  "total token out amount equal to the min amount",
+			tokenOutMinAmountDiff: 0,
+		},
+		{
+			name:                  "total token out amount below the min amount",
+			tokenOutMinAmountDiff: 1,
+			expErr:                types.ErrLimitMinAmount,
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.PrepareBalancerPoolWithCoins(fooCoin, barCoin)
+			suite.PrepareBalancerPoolWithCoins(fooCoin, uosmoCoin)
+			suite.PrepareBalancerPoolWithCoins(uosmoCoin, barCoin)
+			sender := suite.TestAccs[1]
+			suite.FundAcc(sender, sdk.NewCoins(sdk.NewInt64Coin("foo", 1_000_000)))
+
+			// the estimate is the sum of the estimates of the routes
+			expTokenOutAmount := sdk.ZeroInt()
+			for _, route := range routes {
+				routeTokenOutAmount, err := suite.App.GAMMKeeper.MultihopEstimateOutGivenExactAmountIn(suite.Ctx, route.Pools, sdk.NewCoin("foo", route.TokenInAmount))
+				suite.Require().NoError(err)
+				expTokenOutAmount = expTokenOutAmount.Add(routeTokenOutAmount)
+			}
+			estimate, err := suite.App.GAMMKeeper.SplitRouteEstimateOutGivenExactAmountIn(suite.Ctx, routes, "foo")
+			suite.Require().NoError(err)
+			suite.Require().Equal(expTokenOutAmount, estimate)
+
+			ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+			tokenOutMinAmount := estimate.AddRaw(tc.tokenOutMinAmountDiff)
+			tokenOutAmount, err := suite.App.GAMMKeeper.SplitRouteSwapExactAmountIn(ctx, sender, routes, "foo", tokenOutMinAmount)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// the swap matches its estimate
+			suite.Require().Equal(estimate, tokenOutAmount)
+			suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("bar", tokenOutAmount)), suite.App.BankKeeper.GetAllBalances(ctx, sender))
+			suite.AssertEventEmitted(ctx, types.TypeEvtSplitRouteSwapped, len(routes))
+		})
+	}
+}
+
 func (s *KeeperTestSuite) updatePoolSwapFee(ctx sdk.Context, poolId uint64, adjustedPoolSwapFee sdk.Dec) error {
 	pool, err := s.App.GAMMKeeper.GetPoolAndPoke(ctx, poolId)
 	if err != nil {
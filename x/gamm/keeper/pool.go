@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	gogotypes "github.com/gogo/protobuf/types"
 
@@ -12,6 +14,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/balancer"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
 	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 )
 
@@ -48,7 +51,7 @@ func (k Keeper) GetPoolAndPoke(ctx sdk.Context, poolId uint64) (types.CFMMPoolI,
 	return pool, nil
 }
 
-// Get pool and check if the pool is active, i.e. allowed to be swapped against.
+// Get pool and check if the pool is active, i.e. allowed to be swapped against or joined.
 func (k Keeper) getPoolForSwap(ctx sdk.Context, poolId uint64) (types.CFMMPoolI, error) {
 	pool, err := k.GetPoolAndPoke(ctx, poolId)
 	if err != nil {
@@ -56,7 +59,7 @@ func (k Keeper) getPoolForSwap(ctx sdk.Context, poolId uint64) (types.CFMMPoolI,
 	}
 
 	if !pool.IsActive(ctx) {
-		return &balancer.Pool{}, sdkerrors.Wrapf(types.ErrPoolLocked, "swap on inactive pool")
+		return &balancer.Pool{}, sdkerrors.Wrapf(types.ErrPoolLocked, "swap or join on inactive pool")
 	}
 	return pool, nil
 }
@@ -97,6 +100,7 @@ func (k Keeper) setPool(ctx sdk.Context, pool swaproutertypes.PoolI) error {
 	poolKey := types.GetKeyPrefixPools(pool.GetId())
 	store.Set(poolKey, bz)
 
+	k.trackChangedPool(ctx, pool.GetId())
 	return nil
 }
 
@@ -286,6 +290,158 @@ func (k Keeper) setStableSwapScalingFactors(ctx sdk.Context, poolId uint64, scal
 	return k.setPool(ctx, stableswapPool)
 }
 
+// updateBalancerPoolWeights starts a smooth weight change of the balancer pool from its current weights
+// to the target weights over the duration.
+// errors if the pool does not exist, is not a balancer pool, the sender is not the future pool governor,
+// or the target weights are invalid for the pool.
+func (k Keeper) updateBalancerPoolWeights(ctx sdk.Context, poolId uint64, sender string, targetPoolWeights []balancer.PoolAsset, duration time.Duration) error {
+	balancerPool, _, err := k.getGovernedBalancerPool(ctx, poolId, sender)
+	if err != nil {
+		return err
+	}
+	if err := balancerPool.UpdateWeights(targetPoolWeights, duration, ctx.BlockTime()); err != nil {
+		return err
+	}
+
+	return k.setPool(ctx, balancerPool)
+}
+
+// addBalancerPoolAsset adds the asset to the balancer pool, depositing its token from the sender at the weight
+// that prices it at its twap in priceDenom in the reference pool, and mints the sender the pool shares the
+// deposit is worth. The asset is then phased in to its weight over the duration.
+// errors if the pool does not exist, is not a balancer pool, the sender is not the future pool governor,
+// there is no twap of the asset in the reference pool, or the asset can not be added to the pool.
+func (k Keeper) addBalancerPoolAsset(ctx sdk.Context, poolId uint64, sender string, asset balancer.PoolAsset, duration time.Duration, priceDenom string, referencePoolId uint64) (sdk.Int, error) {
+	balancerPool, senderAddr, err := k.getGovernedBalancerPool(ctx, poolId, sender)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	price, err := k.getAddedPoolAssetPrice(ctx, referencePoolId, asset.Token.Denom, priceDenom)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	numShares, err := balancerPool.AddPoolAsset(asset, priceDenom, price, duration, ctx.BlockTime())
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	if err := k.applyJoinPoolStateChange(ctx, balancerPool, senderAddr, numShares, sdk.NewCoins(asset.Token)); err != nil {
+		return sdk.Int{}, err
+	}
+	k.setPoolDenom(ctx, asset.Token.Denom, poolId)
+
+	k.hooks.AfterPoolAssetsChanged(ctx, senderAddr, poolId)
+	return numShares, nil
+}
+
+// getAddedPoolAssetPrice returns the arithmetic twap of the added denom in the price denom over the
+// AddedPoolAssetTwapWindow in the reference pool. errors if there is no twap over the whole window.
+func (k Keeper) getAddedPoolAssetPrice(ctx sdk.Context, referencePoolId uint64, denom, priceDenom string) (sdk.Dec, error) {
+	if k.twapKeeper == nil {
+		return sdk.Dec{}, fmt.Errorf("no twap keeper to price %s in %s", denom, priceDenom)
+	}
+	startTime := ctx.BlockTime().Add(-balancer.AddedPoolAssetTwapWindow)
+	price, err := k.twapKeeper.GetArithmeticTwapToNow(ctx, referencePoolId, denom, priceDenom, startTime)
+	if err != nil {
+		return sdk.Dec{}, sdkerrors.Wrapf(err, "no twap of %s in %s over %s in reference pool %d", denom, priceDenom, balancer.AddedPoolAssetTwapWindow, referencePoolId)
+	}
+	return price, nil
+}
+
+// removeBalancerPoolAsset removes the phased out asset with the denom from the balancer pool, sending its
+// remaining balance to the sender and burning the pool shares the balance is worth from the sender.
+// errors if the pool does not exist, is not a balancer pool, the sender is not the future pool governor,
+// the asset can not be removed from the pool, or the sender does not have enough pool shares.
+func (k Keeper) removeBalancerPoolAsset(ctx sdk.Context, poolId uint64, sender string, denom string) (sdk.Coin, sdk.Int, error) {
+	balancerPool, senderAddr, err := k.getGovernedBalancerPool(ctx, poolId, sender)
+	if err != nil {
+		return sdk.Coin{}, sdk.Int{}, err
+	}
+	tokenOut, numShares, err := balancerPool.RemovePoolAsset(denom)
+	if err != nil {
+		return sdk.Coin{}, sdk.Int{}, err
+	}
+	if err := k.applyExitPoolStateChange(ctx, balancerPool, senderAddr, numShares, sdk.NewCoins(tokenOut)); err != nil {
+		return sdk.Coin{}, sdk.Int{}, err
+	}
+	k.deletePoolDenom(ctx, denom, poolId)
+
+	k.hooks.AfterPoolAssetsChanged(ctx, senderAddr, poolId)
+	return tokenOut, numShares, nil
+}
+
+// getGovernedBalancerPool returns the balancer pool and the sender address, after validating that
+// the sender can act as the future pool governor of the pool.
+func (k Keeper) getGovernedBalancerPool(ctx sdk.Context, poolId uint64, sender string) (*balancer.Pool, sdk.AccAddress, error) {
+	senderAddr, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return nil, nil, err
+	}
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return nil, nil, err
+	}
+	balancerPool, ok := pool.(*balancer.Pool)
+	if !ok {
+		return nil, nil, fmt.Errorf("pool id %d is not of type balancer pool", poolId)
+	}
+	if err := k.validatePoolGovernor(ctx, poolId, balancerPool.FuturePoolGovernor, sender); err != nil {
+		return nil, nil, err
+	}
+	return balancerPool, senderAddr, nil
+}
+
+// validatePoolGovernor returns an error if the sender can not act as the future pool governor.
+// The governor is either an address, which must be the sender, or lock-duration governance in the
+// form "[denom,]duration", where denom defaults to the pool's share denom. In the latter case the
+// duration must be at least balancer.MinPoolGovernorLockDuration, and the sender must hold the majority
+// of the denom locked for at least the duration in locks that are not unlocking, so that its locks
+// can not be withdrawn before its weight changes have completed.
+// A pool without a governor can not be governed.
+func (k Keeper) validatePoolGovernor(ctx sdk.Context, poolId uint64, governor string, sender string) error {
+	if governor == "" {
+		return sdkerrors.Wrapf(types.ErrNotPoolGovernor, "pool %d has no future pool governor", poolId)
+	}
+	if _, err := sdk.AccAddressFromBech32(governor); err == nil {
+		if governor != sender {
+			return sdkerrors.Wrapf(types.ErrNotPoolGovernor, "sender %s is not the governor of pool %d", sender, poolId)
+		}
+		return nil
+	}
+
+	denom := types.GetPoolShareDenom(poolId)
+	durationStr := governor
+	if splits := strings.Split(governor, ","); len(splits) == 2 {
+		denom, durationStr = splits[0], splits[1]
+	}
+	duration, err := time.ParseDuration(durationStr)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrNotPoolGovernor, "invalid future governor: %s", governor)
+	}
+	if duration < balancer.MinPoolGovernorLockDuration {
+		return sdkerrors.Wrapf(types.ErrNotPoolGovernor,
+			"lock duration of future governor %s is shorter than the minimum of %s", governor, balancer.MinPoolGovernorLockDuration)
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return err
+	}
+	senderLocked := sdk.ZeroInt()
+	for _, lock := range k.lockupKeeper.GetAccountLockedLongerDurationDenomNotUnlockingOnly(ctx, senderAddr, denom, duration) {
+		senderLocked = senderLocked.Add(lock.Coins.AmountOf(denom))
+	}
+	totalLocked := k.lockupKeeper.GetPeriodLocksAccumulation(ctx, lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByDuration,
+		Denom:         denom,
+		Duration:      duration,
+	})
+	if !senderLocked.MulRaw(2).GT(totalLocked) {
+		return sdkerrors.Wrapf(types.ErrNotPoolGovernor,
+			"sender %s holds %s of %s %s locked for at least %s, which is not a majority", sender, senderLocked, totalLocked, denom, duration)
+	}
+	return nil
+}
+
 // convertToCFMMPool converts PoolI to CFMMPoolI by casting the input.
 // Returns the pool of the CFMMPoolI or error if the given pool does not implement
 // CFMMPoolI.
@@ -182,6 +182,7 @@ func (k Keeper) CreatePool(ctx sdk.Context, msg swaproutertypes.CreatePoolMsg) (
 	if err := k.setPool(ctx, pool); err != nil {
 		return 0, err
 	}
+	k.setPoolDenoms(ctx, pool)
 
 	k.hooks.AfterPoolCreated(ctx, sender, pool.GetId())
 	k.RecordTotalLiquidityIncrease(ctx, initialPoolLiquidity)
@@ -212,7 +213,7 @@ func (k Keeper) JoinPoolNoSwap(
 		}
 	}()
 	// all pools handled within this method are pointer references, `JoinPool` directly updates the pools
-	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	pool, err := k.getPoolForSwap(ctx, poolId)
 	if err != nil {
 		return nil, sdk.ZeroInt(), err
 	}
@@ -236,7 +237,7 @@ func (k Keeper) JoinPoolNoSwap(
 		}
 	}
 
-	sharesOut, err = pool.JoinPoolNoSwap(ctx, neededLpLiquidity, pool.GetSwapFee(ctx))
+	sharesOut, err = pool.JoinPoolNoSwap(ctx, neededLpLiquidity, k.GetSwapFee(ctx, pool))
 	if err != nil {
 		return nil, sdk.ZeroInt(), err
 	}
@@ -305,7 +306,7 @@ func (k Keeper) JoinSwapExactAmountIn(
 		return sdk.Int{}, err
 	}
 
-	sharesOut, err = pool.JoinPool(ctx, tokensIn, pool.GetSwapFee(ctx))
+	sharesOut, err = pool.JoinPool(ctx, tokensIn, k.GetSwapFee(ctx, pool))
 	switch {
 	case err != nil:
 		return sdk.ZeroInt(), err
@@ -354,7 +355,7 @@ func (k Keeper) JoinSwapShareAmountOut(
 		return sdk.Int{}, fmt.Errorf("pool with id %d does not support this kind of join", poolId)
 	}
 
-	tokenInAmount, err = extendedPool.CalcTokenInShareAmountOut(ctx, tokenInDenom, shareOutAmount, pool.GetSwapFee(ctx))
+	tokenInAmount, err = extendedPool.CalcTokenInShareAmountOut(ctx, tokenInDenom, shareOutAmount, k.GetSwapFee(ctx, pool))
 	if err != nil {
 		return sdk.Int{}, err
 	}
@@ -463,7 +464,7 @@ func (k Keeper) ExitSwapExactAmountOut(
 		return sdk.Int{}, fmt.Errorf("pool with id %d does not support this kind of exit", poolId)
 	}
 
-	shareInAmount, err = extendedPool.ExitSwapExactAmountOut(ctx, tokenOut, shareInMaxAmount)
+	shareInAmount, err = extendedPool.ExitSwapExactAmountOut(ctx, tokenOut, shareInMaxAmount, k.GetSwapFee(ctx, pool))
 	if err != nil {
 		return sdk.Int{}, err
 	}
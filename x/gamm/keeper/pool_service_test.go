@@ -271,9 +271,9 @@ func (suite *KeeperTestSuite) TestPoolCreationFee() {
 		bankKeeper := suite.App.BankKeeper
 
 		// set pool creation fee
-		gammKeeper.SetParams(suite.Ctx, types.Params{
-			PoolCreationFee: test.poolCreationFee,
-		})
+		params := types.DefaultParams()
+		params.PoolCreationFee = test.poolCreationFee
+		gammKeeper.SetParams(suite.Ctx, params)
 
 		// fund sender test account
 		sender, err := sdk.AccAddressFromBech32(test.msg.Sender)
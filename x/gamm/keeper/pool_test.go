@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/golang/mock/gomock"
 
+	"github.com/osmosis-labs/osmosis/v13/app/apptesting"
 	"github.com/osmosis-labs/osmosis/v13/tests/mocks"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/keeper"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/balancer"
@@ -528,3 +529,540 @@ func (suite *KeeperTestSuite) TestSetStableSwapScalingFactors() {
 	}
 
 }
+
+func (suite *KeeperTestSuite) TestUpdateBalancerPoolWeights() {
+	lockDuration := balancer.MinPoolGovernorLockDuration
+	targetWeights := []balancertypes.PoolAsset{
+		{Weight: sdk.NewInt(300), Token: sdk.NewInt64Coin("bar", 0)},
+		{Weight: sdk.NewInt(100), Token: sdk.NewInt64Coin("foo", 0)},
+	}
+
+	testcases := []struct {
+		name          string
+		governor      string
+		isAddrGov     bool
+		senderIndex   int
+		targetWeights []balancertypes.PoolAsset
+		duration      time.Duration
+		stableswap    bool
+		expError      error
+	}{
+		{
+			name:          "address governor",
+			isAddrGov:     true,
+			senderIndex:   0,
+			targetWeights: targetWeights,
+		},
+		{
+			name:          "Error: sender is not the address governor",
+			isAddrGov:     true,
+			senderIndex:   1,
+			targetWeights: targetWeights,
+			expError:      types.ErrNotPoolGovernor,
+		},
+		{
+			name:          "Error: pool without governor",
+			governor:      "",
+			senderIndex:   0,
+			targetWeights: targetWeights,
+			expError:      types.ErrNotPoolGovernor,
+		},
+		{
+			name:          "lock governor, sender holds majority of locked shares",
+			governor:      "48h",
+			senderIndex:   0,
+			targetWeights: targetWeights,
+		},
+		{
+			name:          "lock governor with denom, sender holds majority of locked denom",
+			governor:      "foo,48h",
+			senderIndex:   0,
+			targetWeights: targetWeights,
+		},
+		{
+			name:          "Error: lock governor with denom, sender holds minority of locked denom",
+			governor:      "foo,48h",
+			senderIndex:   1,
+			targetWeights: targetWeights,
+			expError:      types.ErrNotPoolGovernor,
+		},
+		{
+			name:          "Error: lock governor with denom, sender locks are too short",
+			governor:      "foo,48h",
+			senderIndex:   2,
+			targetWeights: targetWeights,
+			expError:      types.ErrNotPoolGovernor,
+		},
+		{
+			name:          "Error: lock governor with denom, sender locks are unlocking",
+			governor:      "bar,48h",
+			senderIndex:   3,
+			targetWeights: targetWeights,
+			expError:      types.ErrNotPoolGovernor,
+		},
+		{
+			name:          "Error: lock governor duration is shorter than the minimum",
+			governor:      "foo,24h",
+			senderIndex:   0,
+			targetWeights: targetWeights,
+			expError:      types.ErrNotPoolGovernor,
+		},
+		{
+			name:          "Error: weight change is too short",
+			isAddrGov:     true,
+			senderIndex:   0,
+			targetWeights: targetWeights,
+			duration:      time.Nanosecond,
+			expError:      types.ErrWeightChangeTooShort,
+		},
+		{
+			name:          "Error: invalid target weights",
+			isAddrGov:     true,
+			senderIndex:   0,
+			targetWeights: targetWeights[:1],
+			expError:      types.ErrPoolParamsInvalidNumDenoms,
+		},
+		{
+			name:          "Error: not a balancer pool",
+			isAddrGov:     true,
+			senderIndex:   0,
+			targetWeights: targetWeights,
+			stableswap:    true,
+			expError:      fmt.Errorf("pool id 1 is not of type balancer pool"),
+		},
+	}
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.TestAccs = append(suite.TestAccs, apptesting.CreateRandomAccounts(1)...)
+			suite.fundAllAccountsWith(defaultAcctFunds)
+			governor := tc.governor
+			if tc.isAddrGov {
+				governor = suite.TestAccs[0].String()
+			}
+			duration := tc.duration
+			if duration == 0 {
+				duration = balancer.MinGovernedWeightChangeDuration
+			}
+			var poolId uint64
+			var err error
+			if tc.stableswap {
+				poolId = suite.prepareCustomStableswapPool(defaultAcctFundsStableSwap, defaultPoolParamsStableSwap, defaultPoolAssetsStableSwap, []uint64{1, 1})
+			} else {
+				poolId, err = suite.App.GAMMKeeper.CreatePool(suite.Ctx, balancer.NewMsgCreateBalancerPool(suite.TestAccs[0], defaultPoolParams, defaultPoolAssets, governor))
+				suite.Require().NoError(err)
+			}
+
+			// account 0 holds the majority of locked shares and foo, account 2 only has short locks,
+			// and account 3 holds all locked bar, but its lock is unlocking.
+			suite.LockTokens(suite.TestAccs[0], sdk.NewCoins(sdk.NewInt64Coin(types.GetPoolShareDenom(poolId), 1)), lockDuration)
+			suite.LockTokens(suite.TestAccs[0], sdk.NewCoins(sdk.NewInt64Coin("foo", 60)), lockDuration)
+			suite.LockTokens(suite.TestAccs[1], sdk.NewCoins(sdk.NewInt64Coin("foo", 40)), lockDuration)
+			suite.LockTokens(suite.TestAccs[2], sdk.NewCoins(sdk.NewInt64Coin("foo", 1000)), time.Hour)
+			unlockingLockId := suite.LockTokens(suite.TestAccs[3], sdk.NewCoins(sdk.NewInt64Coin("bar", 1000)), lockDuration)
+			suite.Require().NoError(suite.App.LockupKeeper.BeginUnlock(suite.Ctx, unlockingLockId, nil))
+
+			pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			initialPoolAssets := pool.GetTotalPoolLiquidity(suite.Ctx)
+
+			err = suite.App.GAMMKeeper.UpdateBalancerPoolWeights(suite.Ctx, poolId, suite.TestAccs[tc.senderIndex].String(), tc.targetWeights, duration)
+			if tc.expError != nil {
+				suite.Require().Error(err)
+				suite.Require().ErrorContains(err, tc.expError.Error())
+				return
+			}
+			suite.Require().NoError(err)
+
+			// the weights change from the current weights after the governed weight change delay.
+			pool, err = suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			initialBarWeight, err := pool.(*balancer.Pool).GetTokenWeight("bar")
+			suite.Require().NoError(err)
+			params := pool.(*balancer.Pool).PoolParams.SmoothWeightChangeParams
+			suite.Require().NotNil(params)
+			suite.Require().Equal(suite.Ctx.BlockTime().Add(balancer.GovernedWeightChangeDelay).Unix(), params.StartTime.Unix())
+			suite.Require().Equal(duration, params.Duration)
+
+			// the weights do not change during the delay.
+			suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(balancer.GovernedWeightChangeDelay))
+			pool, err = suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			weight, err := pool.(*balancer.Pool).GetTokenWeight("bar")
+			suite.Require().NoError(err)
+			suite.Require().Equal(initialBarWeight, weight)
+			suite.Require().Equal(initialPoolAssets, pool.GetTotalPoolLiquidity(suite.Ctx))
+
+			suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(duration + time.Second))
+			pool, err = suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			weight, err = pool.(*balancer.Pool).GetTokenWeight("bar")
+			suite.Require().NoError(err)
+			suite.Require().Equal(sdk.NewInt(300*balancer.GuaranteedWeightPrecision), weight)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAddBalancerPoolAsset() {
+	bazAsset := balancertypes.PoolAsset{Weight: sdk.NewInt(200), Token: sdk.NewInt64Coin("baz", 5000)}
+
+	testcases := []struct {
+		name            string
+		isAddrGov       bool
+		senderIndex     int
+		asset           balancertypes.PoolAsset
+		stableswap      bool
+		priceDenom      string
+		referencePoolId uint64
+		twapWindow      time.Duration
+		expError        error
+	}{
+		{
+			name:        "add asset",
+			isAddrGov:   true,
+			senderIndex: 0,
+			asset:       bazAsset,
+		},
+		{
+			name:        "Error: sender is not the governor",
+			isAddrGov:   true,
+			senderIndex: 1,
+			asset:       bazAsset,
+			expError:    types.ErrNotPoolGovernor,
+		},
+		{
+			name:        "Error: pool without governor",
+			senderIndex: 0,
+			asset:       bazAsset,
+			expError:    types.ErrNotPoolGovernor,
+		},
+		{
+			name:        "Error: asset already in pool",
+			isAddrGov:   true,
+			senderIndex: 0,
+			asset:       balancertypes.PoolAsset{Weight: sdk.NewInt(100), Token: sdk.NewInt64Coin("foo", 5000)},
+			priceDenom:  "bar",
+			expError:    fmt.Errorf("repeating pool assets not allowed, found foo"),
+		},
+		{
+			name:        "Error: sender has insufficient funds",
+			isAddrGov:   true,
+			senderIndex: 0,
+			asset:       balancertypes.PoolAsset{Weight: sdk.NewInt(100), Token: sdk.NewInt64Coin("baz", 20000000)},
+			expError:    fmt.Errorf("insufficient funds"),
+		},
+		{
+			name:        "Error: not a balancer pool",
+			isAddrGov:   true,
+			senderIndex: 0,
+			asset:       bazAsset,
+			stableswap:  true,
+			expError:    fmt.Errorf("pool id 1 is not of type balancer pool"),
+		},
+		{
+			name:            "Error: reference pool does not exist",
+			isAddrGov:       true,
+			senderIndex:     0,
+			asset:           bazAsset,
+			referencePoolId: 3,
+			expError:        fmt.Errorf("no twap of baz in foo"),
+		},
+		{
+			name:        "Error: no twap over the whole window",
+			isAddrGov:   true,
+			senderIndex: 0,
+			asset:       bazAsset,
+			twapWindow:  balancer.AddedPoolAssetTwapWindow - time.Hour,
+			expError:    fmt.Errorf("no twap of baz in foo"),
+		},
+	}
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.fundAllAccountsWith(defaultAcctFunds)
+			governor := ""
+			if tc.isAddrGov {
+				governor = suite.TestAccs[0].String()
+			}
+			var poolId uint64
+			var err error
+			if tc.stableswap {
+				poolId = suite.prepareCustomStableswapPool(defaultAcctFundsStableSwap, defaultPoolParamsStableSwap, defaultPoolAssetsStableSwap, []uint64{1, 1})
+			} else {
+				poolId, err = suite.App.GAMMKeeper.CreatePool(suite.Ctx, balancer.NewMsgCreateBalancerPool(suite.TestAccs[0], defaultPoolParams, defaultPoolAssets, governor))
+				suite.Require().NoError(err)
+			}
+
+			// baz trades at 2 foo, and foo at 1 bar, in the reference pool over the twap window.
+			referencePoolId := suite.prepareReferencePool(sdk.NewInt64Coin("baz", 10000), sdk.NewInt64Coin("foo", 20000), sdk.NewInt64Coin("bar", 20000))
+			if tc.referencePoolId != 0 {
+				referencePoolId = tc.referencePoolId
+			}
+			priceDenom := tc.priceDenom
+			if priceDenom == "" {
+				priceDenom = "foo"
+			}
+			twapWindow := tc.twapWindow
+			if twapWindow == 0 {
+				twapWindow = balancer.AddedPoolAssetTwapWindow
+			}
+			suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(twapWindow))
+
+			sender := suite.TestAccs[tc.senderIndex]
+			shareDenom := types.GetPoolShareDenom(poolId)
+			sharesBefore := suite.App.BankKeeper.GetBalance(suite.Ctx, sender, shareDenom).Amount
+			spotPriceBefore, _ := suite.App.GAMMKeeper.CalculateSpotPrice(suite.Ctx, poolId, "foo", "bar")
+
+			shareOutAmount, err := suite.App.GAMMKeeper.AddBalancerPoolAsset(suite.Ctx, poolId, sender.String(), tc.asset, balancer.MinGovernedWeightChangeDuration, priceDenom, referencePoolId)
+			if tc.expError != nil {
+				suite.Require().Error(err)
+				suite.Require().ErrorContains(err, tc.expError.Error())
+				return
+			}
+			suite.Require().NoError(err)
+
+			// the deposit is worth as much as the foo in the pool, i.e. half of the pool's value before the deposit,
+			// so the governor gets half of the shares.
+			suite.Require().Equal(types.InitPoolSharesSupply.QuoRaw(2), shareOutAmount)
+			sharesAfter := suite.App.BankKeeper.GetBalance(suite.Ctx, sender, shareDenom).Amount
+			suite.Require().Equal(sharesBefore.Add(shareOutAmount), sharesAfter)
+
+			pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(types.InitPoolSharesSupply.Add(shareOutAmount), pool.GetTotalShares())
+			suite.Require().Equal(sdk.NewCoins(defaultFooAsset.Token, defaultBarAsset.Token, tc.asset.Token), pool.GetTotalPoolLiquidity(suite.Ctx))
+			spotPriceAfter, err := suite.App.GAMMKeeper.CalculateSpotPrice(suite.Ctx, poolId, "foo", "bar")
+			suite.Require().NoError(err)
+			suite.Require().Equal(spotPriceBefore, spotPriceAfter)
+			addedSpotPrice, err := suite.App.GAMMKeeper.CalculateSpotPrice(suite.Ctx, poolId, "foo", "baz")
+			suite.Require().NoError(err)
+			suite.Require().Equal(sdk.NewDec(2), addedSpotPrice)
+
+			// the new asset is added at the weight of foo, and phased in to its weight after the governed weight change delay.
+			weight, err := pool.(*balancer.Pool).GetTokenWeight("baz")
+			suite.Require().NoError(err)
+			suite.Require().Equal(defaultFooAsset.Weight.MulRaw(balancer.GuaranteedWeightPrecision), weight)
+			params := pool.(*balancer.Pool).PoolParams.SmoothWeightChangeParams
+			suite.Require().NotNil(params)
+			suite.Require().Equal(suite.Ctx.BlockTime().Add(balancer.GovernedWeightChangeDelay), params.StartTime)
+
+			suite.Ctx = suite.Ctx.WithBlockTime(params.StartTime.Add(params.Duration + time.Second))
+			pool, err = suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			weight, err = pool.(*balancer.Pool).GetTokenWeight("baz")
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.asset.Weight.MulRaw(balancer.GuaranteedWeightPrecision), weight)
+			spotPriceAfter, err = suite.App.GAMMKeeper.CalculateSpotPrice(suite.Ctx, poolId, "foo", "bar")
+			suite.Require().NoError(err)
+			suite.Require().Equal(spotPriceBefore, spotPriceAfter)
+
+			// twap records are created for the new denom pairs.
+			_, err = suite.App.TwapKeeper.GetBeginBlockAccumulatorRecord(suite.Ctx, poolId, "bar", "baz")
+			suite.Require().NoError(err)
+
+			// the pool is indexed by the new denom.
+			suite.Require().Equal([]uint64{poolId, referencePoolId}, suite.App.GAMMKeeper.GetPoolIdsByDenom(suite.Ctx, "baz"))
+
+			_, broken := keeper.AllInvariants(*suite.App.GAMMKeeper, suite.App.BankKeeper)(suite.Ctx)
+			suite.Require().False(broken)
+		})
+	}
+}
+
+// TestSwapAfterAddBalancerPoolAsset tests that the added asset is priced at its twap in the reference pool,
+// so that a swap right after it was added pays about that price, instead of draining the deposit.
+func (suite *KeeperTestSuite) TestSwapAfterAddBalancerPoolAsset() {
+	suite.SetupTest()
+	suite.fundAllAccountsWith(defaultAcctFunds)
+	governor, trader := suite.TestAccs[0], suite.TestAccs[1]
+	poolId, err := suite.App.GAMMKeeper.CreatePool(suite.Ctx, balancer.NewMsgCreateBalancerPool(governor, defaultPoolParams, defaultPoolAssets, governor.String()))
+	suite.Require().NoError(err)
+	referencePoolId := suite.prepareReferencePool(sdk.NewInt64Coin("baz", 10000), sdk.NewInt64Coin("foo", 20000))
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(balancer.AddedPoolAssetTwapWindow))
+	twap, err := suite.App.TwapKeeper.GetArithmeticTwapToNow(suite.Ctx, referencePoolId, "baz", "foo", suite.Ctx.BlockTime().Add(-balancer.AddedPoolAssetTwapWindow))
+	suite.Require().NoError(err)
+
+	asset := balancertypes.PoolAsset{Weight: sdk.NewInt(200), Token: sdk.NewInt64Coin("baz", 5000)}
+	_, err = suite.App.GAMMKeeper.AddBalancerPoolAsset(suite.Ctx, poolId, governor.String(), asset, balancer.MinGovernedWeightChangeDuration, "foo", referencePoolId)
+	suite.Require().NoError(err)
+	spotPrice, err := suite.App.GAMMKeeper.CalculateSpotPrice(suite.Ctx, poolId, "foo", "baz")
+	suite.Require().NoError(err)
+	suite.Require().Equal(twap, spotPrice)
+
+	// buying baz with 1% of the pool's foo yields less baz than the foo is worth at the twap.
+	tokenIn := sdk.NewInt64Coin("foo", 100)
+	tokenOutAmount, err := suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, trader, poolId, tokenIn, "baz", sdk.ZeroInt())
+	suite.Require().NoError(err)
+	suite.Require().True(tokenOutAmount.IsPositive())
+	suite.Require().True(tokenOutAmount.ToDec().LTE(tokenIn.Amount.ToDec().Quo(twap)), "swap got %s baz for %s", tokenOutAmount, tokenIn)
+
+	// the swap only moves the spot price of baz by about 2%, as it would in any pool of these balances and weights.
+	spotPriceAfterSwap, err := suite.App.GAMMKeeper.CalculateSpotPrice(suite.Ctx, poolId, "foo", "baz")
+	suite.Require().NoError(err)
+	suite.Require().True(spotPriceAfterSwap.GT(spotPrice))
+	suite.Require().True(spotPriceAfterSwap.LT(spotPrice.Mul(sdk.MustNewDecFromStr("1.03"))), "spot price moved from %s to %s", spotPrice, spotPriceAfterSwap)
+}
+
+// TestAddNearWorthlessBalancerPoolAsset tests that a governor can not take the assets of the other LPs
+// by adding a near worthless asset, which is priced at its twap in the reference pool.
+func (suite *KeeperTestSuite) TestAddNearWorthlessBalancerPoolAsset() {
+	suite.SetupTest()
+	suite.fundAllAccountsWith(defaultAcctFunds)
+	governor, lp := suite.TestAccs[0], suite.TestAccs[1]
+	poolId, err := suite.App.GAMMKeeper.CreatePool(suite.Ctx, balancer.NewMsgCreateBalancerPool(governor, defaultPoolParams, defaultPoolAssets, governor.String()))
+	suite.Require().NoError(err)
+
+	// another LP joins the pool with as many shares as the governor.
+	_, _, err = suite.App.GAMMKeeper.JoinPoolNoSwap(suite.Ctx, lp, poolId, types.InitPoolSharesSupply, sdk.NewCoins(defaultFooAsset.Token, defaultBarAsset.Token))
+	suite.Require().NoError(err)
+	governorShares := types.InitPoolSharesSupply
+	pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	cfmmPool := pool.(types.CFMMPoolI)
+	governorExitCoins, err := cfmmPool.CalcExitPoolCoinsFromShares(suite.Ctx, governorShares, cfmmPool.GetExitFee(suite.Ctx))
+	suite.Require().NoError(err)
+
+	// the worthless token trades at a millionth of a foo.
+	worthless := sdk.NewInt64Coin("worthless", 1000)
+	suite.FundAcc(governor, sdk.NewCoins(sdk.NewInt64Coin(worthless.Denom, 10000000000)))
+	asset := balancertypes.PoolAsset{Weight: balancer.MaxUserSpecifiedWeight.SubRaw(1), Token: worthless}
+
+	// it can not be added before it has a twap over the whole window.
+	referencePoolId := suite.prepareReferencePool(sdk.NewInt64Coin(worthless.Denom, 1000000000), sdk.NewInt64Coin("foo", 1000))
+	_, err = suite.App.GAMMKeeper.AddBalancerPoolAsset(suite.Ctx, poolId, governor.String(), asset, balancer.MinGovernedWeightChangeDuration, "foo", referencePoolId)
+	suite.Require().Error(err)
+
+	// once it has, the deposit is worth too little to be given a weight.
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(balancer.AddedPoolAssetTwapWindow))
+	_, err = suite.App.GAMMKeeper.AddBalancerPoolAsset(suite.Ctx, poolId, governor.String(), asset, balancer.MinGovernedWeightChangeDuration, "foo", referencePoolId)
+	suite.Require().ErrorIs(err, types.ErrNotPositiveWeight)
+
+	// exiting the pool returns the same share of foo and bar as before.
+	exitCoins, err := suite.App.GAMMKeeper.ExitPool(suite.Ctx, governor, poolId, governorShares, sdk.Coins{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(governorExitCoins.AmountOf("foo"), exitCoins.AmountOf("foo"))
+	suite.Require().Equal(governorExitCoins.AmountOf("bar"), exitCoins.AmountOf("bar"))
+
+	_, broken := keeper.AllInvariants(*suite.App.GAMMKeeper, suite.App.BankKeeper)(suite.Ctx)
+	suite.Require().False(broken)
+}
+
+// prepareReferencePool creates an ungoverned balancer pool of the coins at equal weights,
+// whose twaps can price an asset added to a governed pool.
+func (suite *KeeperTestSuite) prepareReferencePool(coins ...sdk.Coin) uint64 {
+	poolAssets := []balancertypes.PoolAsset{}
+	for _, coin := range coins {
+		poolAssets = append(poolAssets, balancertypes.PoolAsset{Weight: sdk.NewInt(100), Token: coin})
+	}
+	suite.FundAcc(suite.TestAccs[2], sdk.NewCoins(coins...))
+	poolId, err := suite.App.GAMMKeeper.CreatePool(suite.Ctx, balancer.NewMsgCreateBalancerPool(suite.TestAccs[2], defaultPoolParams, poolAssets, defaultFutureGovernor))
+	suite.Require().NoError(err)
+	return poolId
+}
+
+func (suite *KeeperTestSuite) TestRemoveBalancerPoolAsset() {
+	weightChangeEnd := balancer.GovernedWeightChangeDelay + balancer.MinGovernedWeightChangeDuration
+	testcases := []struct {
+		name        string
+		senderIndex int
+		denom       string
+		elapsed     time.Duration
+		expError    error
+	}{
+		{
+			name:        "remove phased out asset",
+			senderIndex: 0,
+			denom:       "baz",
+			elapsed:     weightChangeEnd + time.Hour,
+		},
+		{
+			name:        "Error: sender is not the governor",
+			senderIndex: 1,
+			denom:       "baz",
+			elapsed:     weightChangeEnd + time.Hour,
+			expError:    types.ErrNotPoolGovernor,
+		},
+		{
+			name:        "Error: weights are still changing",
+			senderIndex: 0,
+			denom:       "baz",
+			elapsed:     weightChangeEnd - balancer.MinGovernedWeightChangeDuration/2,
+			expError:    types.ErrPoolWeightsChanging,
+		},
+		{
+			name:        "Error: asset not phased out",
+			senderIndex: 0,
+			denom:       "foo",
+			elapsed:     weightChangeEnd + time.Hour,
+			expError:    types.ErrPoolAssetNotPhasedOut,
+		},
+		{
+			name:        "Error: asset not in pool",
+			senderIndex: 0,
+			denom:       "qux",
+			elapsed:     weightChangeEnd + time.Hour,
+			expError:    types.ErrDenomNotFoundInPool,
+		},
+	}
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.fundAllAccountsWith(defaultAcctFunds)
+			bazAsset := balancertypes.PoolAsset{Weight: sdk.NewInt(100), Token: sdk.NewInt64Coin("baz", 5000)}
+			poolAssets := []balancertypes.PoolAsset{defaultFooAsset, defaultBarAsset, bazAsset}
+			poolId, err := suite.App.GAMMKeeper.CreatePool(suite.Ctx, balancer.NewMsgCreateBalancerPool(suite.TestAccs[0], defaultPoolParams, poolAssets, suite.TestAccs[0].String()))
+			suite.Require().NoError(err)
+
+			// phase out baz over the minimum weight change duration.
+			phaseOutWeights := []balancertypes.PoolAsset{
+				{Weight: sdk.NewInt(100), Token: sdk.NewInt64Coin("foo", 0)},
+				{Weight: sdk.NewInt(100), Token: sdk.NewInt64Coin("bar", 0)},
+				{Weight: sdk.NewInt(1), Token: sdk.NewInt64Coin("baz", 0)},
+			}
+			err = suite.App.GAMMKeeper.UpdateBalancerPoolWeights(suite.Ctx, poolId, suite.TestAccs[0].String(), phaseOutWeights, balancer.MinGovernedWeightChangeDuration)
+			suite.Require().NoError(err)
+			suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(tc.elapsed))
+
+			sender := suite.TestAccs[tc.senderIndex]
+			shareDenom := types.GetPoolShareDenom(poolId)
+			balancesBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+			spotPriceBefore, err := suite.App.GAMMKeeper.CalculateSpotPrice(suite.Ctx, poolId, "foo", "bar")
+			suite.Require().NoError(err)
+
+			tokenOut, shareInAmount, err := suite.App.GAMMKeeper.RemoveBalancerPoolAsset(suite.Ctx, poolId, sender.String(), tc.denom)
+			if tc.expError != nil {
+				suite.Require().ErrorIs(err, tc.expError)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// the removed asset has 1 / 201 of the pool's weight, so that many shares are burned, rounded up.
+			suite.Require().Equal(bazAsset.Token, tokenOut)
+			suite.Require().Equal(types.InitPoolSharesSupply.QuoRaw(201).AddRaw(1), shareInAmount)
+			balancesAfter := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+			suite.Require().Equal(balancesBefore.AmountOf("baz").Add(tokenOut.Amount), balancesAfter.AmountOf("baz"))
+			suite.Require().Equal(balancesBefore.AmountOf(shareDenom).Sub(shareInAmount), balancesAfter.AmountOf(shareDenom))
+
+			pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(types.InitPoolSharesSupply.Sub(shareInAmount), pool.GetTotalShares())
+			suite.Require().Equal(sdk.NewCoins(defaultFooAsset.Token, defaultBarAsset.Token), pool.GetTotalPoolLiquidity(suite.Ctx))
+			spotPriceAfter, err := suite.App.GAMMKeeper.CalculateSpotPrice(suite.Ctx, poolId, "foo", "bar")
+			suite.Require().NoError(err)
+			suite.Require().Equal(spotPriceBefore, spotPriceAfter)
+
+			// the most recent twap records of the removed denom pairs are deleted.
+			_, err = suite.App.TwapKeeper.GetBeginBlockAccumulatorRecord(suite.Ctx, poolId, "bar", "baz")
+			suite.Require().Error(err)
+			_, err = suite.App.TwapKeeper.GetBeginBlockAccumulatorRecord(suite.Ctx, poolId, "bar", "foo")
+			suite.Require().NoError(err)
+
+			// the pool is no longer indexed by the removed denom.
+			suite.Require().Empty(suite.App.GAMMKeeper.GetPoolIdsByDenom(suite.Ctx, "baz"))
+			suite.Require().Equal([]uint64{poolId}, suite.App.GAMMKeeper.GetPoolIdsByDenom(suite.Ctx, "foo"))
+
+			_, broken := keeper.AllInvariants(*suite.App.GAMMKeeper, suite.App.BankKeeper)(suite.Ctx)
+			suite.Require().False(broken)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+)
+
+// setPoolDenoms indexes the pool by each of the denoms it contains.
+func (k Keeper) setPoolDenoms(ctx sdk.Context, pool swaproutertypes.PoolI) {
+	for _, coin := range pool.GetTotalPoolLiquidity(ctx) {
+		k.setPoolDenom(ctx, coin.Denom, pool.GetId())
+	}
+}
+
+// setPoolDenom indexes the pool by the denom.
+func (k Keeper) setPoolDenom(ctx sdk.Context, denom string, poolId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetKeyDenomPool(denom, poolId), []byte{})
+}
+
+// deletePoolDenom removes the pool from the index of the denom.
+func (k Keeper) deletePoolDenom(ctx sdk.Context, denom string, poolId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetKeyDenomPool(denom, poolId))
+}
+
+// hasPoolDenom returns true if the pool is indexed by the denom.
+func (k Keeper) hasPoolDenom(ctx sdk.Context, denom string, poolId uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetKeyDenomPool(denom, poolId))
+}
+
+// GetPoolIdsByDenom returns the ids of the pools containing the denom, in ascending order.
+func (k Keeper) GetPoolIdsByDenom(ctx sdk.Context, denom string) []uint64 {
+	poolIds := []uint64{}
+	k.iteratePoolIdsByDenom(ctx, denom, func(poolId uint64) (stop bool) {
+		poolIds = append(poolIds, poolId)
+		return false
+	})
+	return poolIds
+}
+
+// iteratePoolIdsByDenom calls cb with the ids of the pools containing the denom, in ascending order,
+// until cb returns true.
+func (k Keeper) iteratePoolIdsByDenom(ctx sdk.Context, denom string, cb func(poolId uint64) (stop bool)) {
+	iter := k.iterator(ctx, types.GetKeyPrefixDenomPools(denom))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(sdk.BigEndianToUint64(iter.Key()[len(iter.Key())-8:])) {
+			return
+		}
+	}
+}
+
+// GetPoolIdsByDenomPair returns the ids of the pools containing both denoms, in ascending order.
+func (k Keeper) GetPoolIdsByDenomPair(ctx sdk.Context, denomA, denomB string) []uint64 {
+	poolIds := []uint64{}
+	for _, poolId := range k.GetPoolIdsByDenom(ctx, denomA) {
+		if k.hasPoolDenom(ctx, denomB, poolId) {
+			poolIds = append(poolIds, poolId)
+		}
+	}
+	return poolIds
+}
+
+// IndexPoolsByDenom indexes all pools by the denoms they contain.
+// It is used to index the pools created before the index was introduced.
+func (k Keeper) IndexPoolsByDenom(ctx sdk.Context) error {
+	pools, err := k.GetPoolsAndPoke(ctx)
+	if err != nil {
+		return err
+	}
+	for _, pool := range pools {
+		k.setPoolDenoms(ctx, pool)
+	}
+	return nil
+}
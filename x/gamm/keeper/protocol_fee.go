@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/osmosis-labs/osmosis/v13/osmoutils"
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+)
+
+// getProtocolFeeShare returns the fraction of swap fees taken as protocol fees.
+func (k Keeper) getProtocolFeeShare(ctx sdk.Context) (protocolFeeShare sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyProtocolFeeShare, &protocolFeeShare)
+	return protocolFeeShare
+}
+
+// getLPSwapFee returns the swap fee left for liquidity providers when the protocol fee,
+// tokenIn * swapFee * protocolFeeShare, is taken out of the token in before swapping.
+// It keeps the amount swapped after fees, and so the trader's amounts, unchanged:
+// tokenIn * (1 - swapFee) = (tokenIn - tokenIn * swapFee * protocolFeeShare) * (1 - lpSwapFee).
+func getLPSwapFee(swapFee, protocolFeeShare sdk.Dec) sdk.Dec {
+	if !protocolFeeShare.IsPositive() {
+		return swapFee
+	}
+	return swapFee.Mul(sdk.OneDec().Sub(protocolFeeShare)).Quo(sdk.OneDec().Sub(swapFee.Mul(protocolFeeShare)))
+}
+
+// chargeProtocolFee sends the protocol fee from the swap sender to the protocol fee collector.
+func (k Keeper) chargeProtocolFee(ctx sdk.Context, sender sdk.AccAddress, protocolFee sdk.Coin) error {
+	if !protocolFee.IsPositive() {
+		return nil
+	}
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ProtocolFeeCollectorName, sdk.Coins{protocolFee})
+}
+
+// distributeProtocolFees pays out the protocol fees collected at the end of every protocol fee epoch.
+// Failures are logged, and the protocol fees are paid out at the end of the next epoch instead.
+func (k Keeper) distributeProtocolFees(ctx sdk.Context, epochIdentifier string) {
+	params := k.GetParams(ctx)
+	if epochIdentifier != params.ProtocolFeeEpochIdentifier {
+		return
+	}
+
+	err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+		return k.distributeProtocolFeesTo(cacheCtx, params.ProtocolFeeRecipient)
+	})
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("failed to distribute protocol fees: %s", err))
+	}
+}
+
+// distributeProtocolFeesTo sends the balance of the protocol fee collector to the recipient,
+// or to the community pool if the recipient is empty.
+func (k Keeper) distributeProtocolFeesTo(ctx sdk.Context, recipient string) error {
+	collectorAddr := authtypes.NewModuleAddress(types.ProtocolFeeCollectorName)
+	protocolFees := k.bankKeeper.GetAllBalances(ctx, collectorAddr)
+	if protocolFees.IsZero() {
+		return nil
+	}
+
+	if recipient == "" {
+		if err := k.communityPoolKeeper.FundCommunityPool(ctx, protocolFees, collectorAddr); err != nil {
+			return err
+		}
+	} else {
+		recipientAddr, err := sdk.AccAddressFromBech32(recipient)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ProtocolFeeCollectorName, recipientAddr, protocolFees); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtProtocolFeesDistributed,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+		sdk.NewAttribute(types.AttributeKeyAmount, protocolFees.String()),
+	))
+	return nil
+}
@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+)
+
+var protocolFeeCollectorAddr = authtypes.NewModuleAddress(types.ProtocolFeeCollectorName)
+
+func (suite *KeeperTestSuite) setProtocolFeeParams(protocolFeeShare sdk.Dec, recipient string) {
+	params := suite.App.GAMMKeeper.GetParams(suite.Ctx)
+	params.ProtocolFeeShare = protocolFeeShare
+	params.ProtocolFeeRecipient = recipient
+	suite.App.GAMMKeeper.SetParams(suite.Ctx, params)
+}
+
+func (suite *KeeperTestSuite) TestProtocolFeeSwap() {
+	protocolFeeShare := sdk.NewDecWithPrec(5, 1)
+
+	testcases := []struct {
+		name    string
+		exactIn bool
+	}{
+		{
+			name:    "swap exact amount in",
+			exactIn: true,
+		},
+		{
+			name:    "swap exact amount out",
+			exactIn: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			// swap swaps foo for bar from a new pool, and returns the traded amounts,
+			// the fees collected by the protocol and the pool liquidity afterwards.
+			swap := func(protocolFeeShare sdk.Dec) (tokenIn, tokenOut sdk.Int, protocolFees, poolLiquidity sdk.Coins) {
+				suite.SetupTest()
+				suite.setProtocolFeeParams(protocolFeeShare, "")
+				poolId := suite.prepareCustomBalancerPool(defaultAcctFunds, defaultPoolAssets, defaultPoolParams)
+
+				var err error
+				if tc.exactIn {
+					tokenIn = sdk.NewInt(1000)
+					tokenOut, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], poolId, sdk.NewCoin("foo", tokenIn), "bar", sdk.OneInt())
+				} else {
+					tokenOut = sdk.NewInt(1000)
+					tokenIn, err = suite.App.GAMMKeeper.SwapExactAmountOut(suite.Ctx, suite.TestAccs[0], poolId, "foo", sdk.NewInt(2000), sdk.NewCoin("bar", tokenOut))
+				}
+				suite.Require().NoError(err)
+
+				pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+				suite.Require().NoError(err)
+				poolLiquidity = pool.GetTotalPoolLiquidity(suite.Ctx)
+				// the pool holds exactly its liquidity
+				suite.Require().Equal(poolLiquidity, suite.App.BankKeeper.GetAllBalances(suite.Ctx, pool.GetAddress()))
+
+				return tokenIn, tokenOut, suite.App.BankKeeper.GetAllBalances(suite.Ctx, protocolFeeCollectorAddr), poolLiquidity
+			}
+
+			tokenIn, tokenOut, protocolFees, poolLiquidity := swap(sdk.ZeroDec())
+			suite.Require().True(protocolFees.Empty())
+
+			tokenInWithFee, tokenOutWithFee, protocolFees, poolLiquidityWithFee := swap(protocolFeeShare)
+
+			// the trader's amounts are unchanged by the protocol fee
+			suite.Require().Equal(tokenIn, tokenInWithFee)
+			suite.Require().Equal(tokenOut, tokenOutWithFee)
+
+			// the protocol fee is the protocol's share of the swap fee, taken out of the pool's share
+			expProtocolFee := defaultSwapFee.Mul(protocolFeeShare).MulInt(tokenIn)
+			suite.Require().Len(protocolFees, 1)
+			suite.Require().Equal("foo", protocolFees[0].Denom)
+			suite.Require().True(expProtocolFee.Sub(protocolFees[0].Amount.ToDec()).Abs().LTE(sdk.OneDec()),
+				"expected protocol fee %s, got %s", expProtocolFee, protocolFees[0].Amount)
+			suite.Require().Equal(poolLiquidity.Sub(protocolFees), poolLiquidityWithFee)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDistributeProtocolFees() {
+	protocolFees := sdk.NewCoins(sdk.NewInt64Coin("foo", 100), sdk.NewInt64Coin("bar", 50))
+
+	testcases := []struct {
+		name            string
+		toRecipient     bool
+		epochIdentifier string
+		expDistributed  bool
+	}{
+		{
+			name:            "distributed to the community pool",
+			epochIdentifier: "day",
+			expDistributed:  true,
+		},
+		{
+			name:            "distributed to the recipient",
+			toRecipient:     true,
+			epochIdentifier: "day",
+			expDistributed:  true,
+		},
+		{
+			name:            "not distributed at the end of other epochs",
+			epochIdentifier: "week",
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			recipient := ""
+			if tc.toRecipient {
+				recipient = suite.TestAccs[1].String()
+			}
+			suite.setProtocolFeeParams(sdk.NewDecWithPrec(5, 1), recipient)
+			suite.FundModuleAcc(types.ProtocolFeeCollectorName, protocolFees)
+			recipientBalanceBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, suite.TestAccs[1])
+			communityPoolBefore := suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx)
+
+			err := suite.App.GAMMKeeper.EpochHooks().AfterEpochEnd(suite.Ctx, tc.epochIdentifier, 1)
+			suite.Require().NoError(err)
+
+			collectorBalance := suite.App.BankKeeper.GetAllBalances(suite.Ctx, protocolFeeCollectorAddr)
+			recipientBalance := suite.App.BankKeeper.GetAllBalances(suite.Ctx, suite.TestAccs[1])
+			communityPool := suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx)
+			if !tc.expDistributed {
+				suite.Require().Equal(protocolFees, collectorBalance)
+				suite.Require().Equal(communityPoolBefore, communityPool)
+				return
+			}
+
+			suite.Require().True(collectorBalance.Empty())
+			if tc.toRecipient {
+				suite.Require().Equal(recipientBalanceBefore.Add(protocolFees...), recipientBalance)
+				suite.Require().Equal(communityPoolBefore, communityPool)
+			} else {
+				suite.Require().Equal(recipientBalanceBefore, recipientBalance)
+				suite.Require().Equal(communityPoolBefore.Add(sdk.NewDecCoinsFromCoins(protocolFees...)...), communityPool)
+			}
+		})
+	}
+}
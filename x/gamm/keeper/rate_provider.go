@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v13/osmoutils"
+	epochstypes "github.com/osmosis-labs/osmosis/v13/x/epochs/types"
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/stableswap"
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+)
+
+var _ epochstypes.EpochHooks = &epochhook{}
+
+type epochhook struct {
+	k *Keeper
+}
+
+// EpochHooks returns the gamm epoch hooks, which adjust the scaling factors of stableswap pools
+// by their rate providers, and pay out the protocol fees, at the end of their epochs.
+func (k *Keeper) EpochHooks() epochstypes.EpochHooks {
+	return &epochhook{k}
+}
+
+func (hook *epochhook) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	hook.k.updateScalingFactorsFromRateProviders(ctx, epochIdentifier)
+	hook.k.distributeProtocolFees(ctx, epochIdentifier)
+	return nil
+}
+
+func (hook *epochhook) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	return nil
+}
+
+// setStableSwapScalingFactorRateProvider sets the rate provider of the stableswap pool, or removes it if nil.
+// errors if the pool does not exist, is not a stableswap pool, the sender is not the scaling factor controller,
+// or the rate provider is invalid.
+func (k Keeper) setStableSwapScalingFactorRateProvider(ctx sdk.Context, poolId uint64, sender string, rateProvider *stableswap.ScalingFactorRateProvider) error {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	stableswapPool, ok := pool.(*stableswap.Pool)
+	if !ok {
+		return fmt.Errorf("pool id %d is not of type stableswap pool", poolId)
+	}
+	if err := stableswapPool.SetScalingFactorRateProvider(rateProvider, sender); err != nil {
+		return err
+	}
+	k.setRateProviderPool(ctx, stableswapPool)
+
+	return k.setPool(ctx, stableswapPool)
+}
+
+// setRateProviderPool indexes the pool if it is a stableswap pool with a scaling factor rate provider,
+// and removes it from the index otherwise.
+func (k Keeper) setRateProviderPool(ctx sdk.Context, pool swaproutertypes.PoolI) {
+	store := ctx.KVStore(k.storeKey)
+	if stableswapPool, ok := pool.(*stableswap.Pool); ok && stableswapPool.ScalingFactorRateProvider != nil {
+		store.Set(types.GetKeyRateProviderPool(pool.GetId()), []byte{})
+		return
+	}
+	store.Delete(types.GetKeyRateProviderPool(pool.GetId()))
+}
+
+// getRateProviderPoolIds returns the ids of the stableswap pools with a scaling factor rate provider,
+// in ascending order.
+func (k Keeper) getRateProviderPoolIds(ctx sdk.Context) []uint64 {
+	iter := k.iterator(ctx, types.KeyPrefixRateProviderPools)
+	defer iter.Close()
+
+	poolIds := []uint64{}
+	for ; iter.Valid(); iter.Next() {
+		poolIds = append(poolIds, sdk.BigEndianToUint64(iter.Key()[len(types.KeyPrefixRateProviderPools):]))
+	}
+	return poolIds
+}
+
+// updateScalingFactorsFromRateProviders adjusts the scaling factors of the indexed stableswap pools whose rate
+// provider is queried at the end of the epoch. A pool whose rate provider fails keeps its scaling factors, and
+// the error is logged.
+func (k Keeper) updateScalingFactorsFromRateProviders(ctx sdk.Context, epochIdentifier string) {
+	for _, poolId := range k.getRateProviderPoolIds(ctx) {
+		pool, err := k.GetPoolAndPoke(ctx, poolId)
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to get pool %d for updating scaling factors: %s", poolId, err))
+			continue
+		}
+		stableswapPool, ok := pool.(*stableswap.Pool)
+		if !ok || stableswapPool.ScalingFactorRateProvider == nil ||
+			stableswapPool.ScalingFactorRateProvider.EpochIdentifier != epochIdentifier {
+			continue
+		}
+
+		err = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			return k.updateScalingFactorsFromRateProvider(cacheCtx, stableswapPool)
+		})
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to update scaling factors of pool %d from its rate provider: %s", stableswapPool.Id, err))
+		}
+	}
+}
+
+// updateScalingFactorsFromRateProvider queries the redemption rates of the stableswap pool's assets from its
+// rate provider, and moves the scaling factors towards them.
+func (k Keeper) updateScalingFactorsFromRateProvider(ctx sdk.Context, pool *stableswap.Pool) error {
+	rates, err := k.queryRedemptionRates(ctx, pool)
+	if err != nil {
+		return err
+	}
+	if err := pool.UpdateScalingFactorsFromRates(rates); err != nil {
+		return err
+	}
+
+	return k.setPool(ctx, pool)
+}
+
+// queryRedemptionRates queries the redemption rates of the stableswap pool's assets from its rate provider
+// contract, within the RateProviderQueryGasLimit.
+func (k Keeper) queryRedemptionRates(ctx sdk.Context, pool *stableswap.Pool) ([]sdk.Dec, error) {
+	if k.wasmKeeper == nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidRateProvider, "wasm keeper is not set")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(pool.ScalingFactorRateProvider.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	denoms := make([]string, 0, pool.PoolLiquidity.Len())
+	for _, coin := range pool.PoolLiquidity {
+		denoms = append(denoms, coin.Denom)
+	}
+	req, err := json.Marshal(stableswap.RateProviderQueryMsg{
+		RedemptionRates: &stableswap.RedemptionRatesQuery{PoolId: pool.Id, Denoms: denoms},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	queryCtx := ctx.WithGasMeter(sdk.NewGasMeter(types.RateProviderQueryGasLimit))
+	bz, err := k.wasmKeeper.QuerySmart(queryCtx, contractAddr, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var res stableswap.RedemptionRatesResponse
+	if err := json.Unmarshal(bz, &res); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidRedemptionRates, err.Error())
+	}
+	return res.Rates, nil
+}
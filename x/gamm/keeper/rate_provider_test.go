@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/stableswap"
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+)
+
+var rateProviderContractAddr = sdk.AccAddress([]byte("rateProviderContract"))
+
+// mockWasmKeeper responds to every rate provider query with the configured rates, or error.
+type mockWasmKeeper struct {
+	rates []sdk.Dec
+	err   error
+}
+
+func (m mockWasmKeeper) QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	var query stableswap.RateProviderQueryMsg
+	if err := json.Unmarshal(req, &query); err != nil || query.RedemptionRates == nil {
+		return nil, errors.New("unexpected query")
+	}
+	return json.Marshal(stableswap.RedemptionRatesResponse{Rates: m.rates})
+}
+
+func (suite *KeeperTestSuite) prepareRateProviderStableswapPool(controller sdk.AccAddress, scalingFactors []uint64) *stableswap.Pool {
+	poolId := suite.prepareCustomStableswapPool(
+		defaultAcctFunds,
+		stableswap.PoolParams{
+			SwapFee: defaultSwapFee,
+			ExitFee: defaultExitFee,
+		},
+		sdk.NewCoins(sdk.NewCoin(defaultAcctFunds[0].Denom, defaultAcctFunds[0].Amount.QuoRaw(2)), sdk.NewCoin(defaultAcctFunds[1].Denom, defaultAcctFunds[1].Amount.QuoRaw(2))),
+		scalingFactors,
+	)
+	pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	stableswapPool := pool.(*stableswap.Pool)
+	stableswapPool.ScalingFactorController = controller.String()
+	suite.Require().NoError(suite.App.GAMMKeeper.SetPool(suite.Ctx, stableswapPool))
+	return stableswapPool
+}
+
+func (suite *KeeperTestSuite) TestSetStableSwapScalingFactorRateProvider() {
+	rateProvider := &stableswap.ScalingFactorRateProvider{
+		ContractAddress:   rateProviderContractAddr.String(),
+		EpochIdentifier:   "day",
+		MaxChangePerEpoch: sdk.NewDecWithPrec(1, 2),
+	}
+
+	testcases := []struct {
+		name             string
+		isStableSwapPool bool
+		notController    bool
+		rateProvider     *stableswap.ScalingFactorRateProvider
+		expError         error
+	}{
+		{
+			name:             "set rate provider",
+			isStableSwapPool: true,
+			rateProvider:     rateProvider,
+		},
+		{
+			name:             "remove rate provider",
+			isStableSwapPool: true,
+		},
+		{
+			name:         "error: pool is not a stableswap pool",
+			rateProvider: rateProvider,
+			expError:     errors.New("pool id 1 is not of type stableswap pool"),
+		},
+		{
+			name:             "error: sender is not the scaling factor controller",
+			isStableSwapPool: true,
+			notController:    true,
+			rateProvider:     rateProvider,
+			expError:         types.ErrNotScalingFactorGovernor,
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			controller, sender := suite.TestAccs[0], suite.TestAccs[0]
+			if tc.notController {
+				sender = suite.TestAccs[1]
+			}
+
+			var poolId uint64
+			if tc.isStableSwapPool {
+				poolId = suite.prepareRateProviderStableswapPool(controller, []uint64{1, 1}).Id
+				// the pool already has a rate provider, so that removing it is observable
+				err := suite.App.GAMMKeeper.SetStableSwapScalingFactorRateProvider(suite.Ctx, poolId, controller.String(), rateProvider)
+				suite.Require().NoError(err)
+			} else {
+				poolId = suite.prepareCustomBalancerPool(defaultAcctFunds, defaultPoolAssets, defaultPoolParams)
+			}
+
+			err := suite.App.GAMMKeeper.SetStableSwapScalingFactorRateProvider(suite.Ctx, poolId, sender.String(), tc.rateProvider)
+			if tc.expError != nil {
+				suite.Require().EqualError(err, tc.expError.Error())
+				return
+			}
+			suite.Require().NoError(err)
+
+			pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.rateProvider, pool.(*stableswap.Pool).ScalingFactorRateProvider)
+
+			// only the pools with a rate provider are indexed
+			expPoolIds := []uint64{}
+			if tc.rateProvider != nil {
+				expPoolIds = []uint64{poolId}
+			}
+			suite.Require().Equal(expPoolIds, suite.App.GAMMKeeper.GetRateProviderPoolIds(suite.Ctx))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateScalingFactorsFromRateProviders() {
+	testcases := []struct {
+		name              string
+		epochIdentifier   string
+		wasmKeeper        types.WasmKeeper
+		expScalingFactors []uint64
+	}{
+		{
+			name:              "scaling factors move towards the rates",
+			epochIdentifier:   "day",
+			wasmKeeper:        mockWasmKeeper{rates: []sdk.Dec{sdk.MustNewDecFromStr("1.05"), sdk.OneDec()}},
+			expScalingFactors: []uint64{952, 1000},
+		},
+		{
+			name:              "different epoch identifier",
+			epochIdentifier:   "week",
+			wasmKeeper:        mockWasmKeeper{rates: []sdk.Dec{sdk.MustNewDecFromStr("1.05"), sdk.OneDec()}},
+			expScalingFactors: []uint64{1000, 1000},
+		},
+		{
+			name:              "query error keeps scaling factors",
+			epochIdentifier:   "day",
+			wasmKeeper:        mockWasmKeeper{err: errors.New("contract error")},
+			expScalingFactors: []uint64{1000, 1000},
+		},
+		{
+			name:              "invalid rates keep scaling factors",
+			epochIdentifier:   "day",
+			wasmKeeper:        mockWasmKeeper{rates: []sdk.Dec{sdk.OneDec()}},
+			expScalingFactors: []uint64{1000, 1000},
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			controller := suite.TestAccs[0]
+			pool := suite.prepareRateProviderStableswapPool(controller, []uint64{1000, 1000})
+			err := suite.App.GAMMKeeper.SetStableSwapScalingFactorRateProvider(suite.Ctx, pool.Id, controller.String(), &stableswap.ScalingFactorRateProvider{
+				ContractAddress:   rateProviderContractAddr.String(),
+				EpochIdentifier:   "day",
+				MaxChangePerEpoch: sdk.NewDecWithPrec(1, 1),
+			})
+			suite.Require().NoError(err)
+			// a balancer pool is skipped
+			suite.prepareCustomBalancerPool(defaultAcctFunds, defaultPoolAssets, defaultPoolParams)
+
+			suite.App.GAMMKeeper.SetWasmKeeper(tc.wasmKeeper)
+			suite.App.GAMMKeeper.UpdateScalingFactorsFromRateProviders(suite.Ctx, tc.epochIdentifier)
+
+			updatedPool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, pool.Id)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expScalingFactors, updatedPool.(*stableswap.Pool).GetScalingFactors())
+		})
+	}
+}
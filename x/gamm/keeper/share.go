@@ -8,8 +8,15 @@ import (
 	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 )
 
-func (k Keeper) applyJoinPoolStateChange(ctx sdk.Context, pool swaproutertypes.PoolI, joiner sdk.AccAddress, numShares sdk.Int, joinCoins sdk.Coins) error {
-	err := k.bankKeeper.SendCoins(ctx, joiner, pool.GetAddress(), joinCoins)
+// applyJoinPoolStateChange moves the join coins into the pool, mints the shares to the joiner
+// and stores the pool. It returns an error if the join trips the circuit breaker.
+func (k Keeper) applyJoinPoolStateChange(ctx sdk.Context, pool types.CFMMPoolI, joiner sdk.AccAddress, numShares sdk.Int, joinCoins sdk.Coins) error {
+	err := k.checkCircuitBreakerAllPairs(ctx, pool)
+	if err != nil {
+		return err
+	}
+
+	err = k.bankKeeper.SendCoins(ctx, joiner, pool.GetAddress(), joinCoins)
 	if err != nil {
 		return err
 	}
@@ -30,8 +37,15 @@ func (k Keeper) applyJoinPoolStateChange(ctx sdk.Context, pool swaproutertypes.P
 	return nil
 }
 
-func (k Keeper) applyExitPoolStateChange(ctx sdk.Context, pool swaproutertypes.PoolI, exiter sdk.AccAddress, numShares sdk.Int, exitCoins sdk.Coins) error {
-	err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), exiter, exitCoins)
+// applyExitPoolStateChange moves the exit coins to the exiter, burns its shares and stores the pool.
+// It returns an error if the exit trips the circuit breaker.
+func (k Keeper) applyExitPoolStateChange(ctx sdk.Context, pool types.CFMMPoolI, exiter sdk.AccAddress, numShares sdk.Int, exitCoins sdk.Coins) error {
+	err := k.checkCircuitBreakerAllPairs(ctx, pool)
+	if err != nil {
+		return err
+	}
+
+	err = k.bankKeeper.SendCoins(ctx, pool.GetAddress(), exiter, exitCoins)
 	if err != nil {
 		return err
 	}
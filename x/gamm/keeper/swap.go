@@ -29,7 +29,7 @@ func (k Keeper) SwapExactAmountIn(
 		return sdk.Int{}, err
 	}
 
-	swapFee := pool.GetSwapFee(ctx)
+	swapFee := k.GetSwapFee(ctx, pool)
 	return k.swapExactAmountIn(ctx, sender, pool, tokenIn, tokenOutDenom, tokenOutMinAmount, swapFee)
 }
 
@@ -49,7 +49,6 @@ func (k Keeper) swapExactAmountIn(
 	if tokenIn.Denom == tokenOutDenom {
 		return sdk.Int{}, errors.New("cannot trade same denomination in and out")
 	}
-	tokensIn := sdk.Coins{tokenIn}
 
 	defer func() {
 		if r := recover(); r != nil {
@@ -58,9 +57,16 @@ func (k Keeper) swapExactAmountIn(
 		}
 	}()
 
+	// The protocol's share of the swap fee is taken out of the token in, and the rest of the
+	// token in is swapped against the pool with the liquidity providers' share of the swap fee.
+	protocolFeeShare := k.getProtocolFeeShare(ctx)
+	protocolFee := sdk.Coin{Denom: tokenIn.Denom, Amount: swapFee.Mul(protocolFeeShare).MulInt(tokenIn.Amount).TruncateInt()}
+	poolTokenIn := sdk.Coin{Denom: tokenIn.Denom, Amount: tokenIn.Amount.Sub(protocolFee.Amount)}
+	tokensIn := sdk.Coins{poolTokenIn}
+
 	// Executes the swap in the pool and stores the output. Updates pool assets but
 	// does not actually transfer any tokens to or from the pool.
-	tokenOutCoin, err := pool.SwapOutAmtGivenIn(ctx, tokensIn, tokenOutDenom, swapFee)
+	tokenOutCoin, err := pool.SwapOutAmtGivenIn(ctx, tokensIn, tokenOutDenom, getLPSwapFee(swapFee, protocolFeeShare))
 	if err != nil {
 		return sdk.Int{}, err
 	}
@@ -77,7 +83,10 @@ func (k Keeper) swapExactAmountIn(
 
 	// Settles balances between the tx sender and the pool to match the swap that was executed earlier.
 	// Also emits swap event and updates related liquidity metrics
-	if err := k.updatePoolForSwap(ctx, pool, sender, tokenIn, tokenOutCoin); err != nil {
+	if err := k.updatePoolForSwap(ctx, pool, sender, poolTokenIn, tokenOutCoin, swapFee); err != nil {
+		return sdk.Int{}, err
+	}
+	if err := k.chargeProtocolFee(ctx, sender, protocolFee); err != nil {
 		return sdk.Int{}, err
 	}
 
@@ -96,7 +105,7 @@ func (k Keeper) SwapExactAmountOut(
 	if err != nil {
 		return sdk.Int{}, err
 	}
-	swapFee := pool.GetSwapFee(ctx)
+	swapFee := k.GetSwapFee(ctx, pool)
 	return k.swapExactAmountOut(ctx, sender, pool, tokenInDenom, tokenInMaxAmount, tokenOut, swapFee)
 }
 
@@ -130,41 +139,64 @@ func (k Keeper) swapExactAmountOut(
 			"can't get more tokens out than there are tokens in the pool")
 	}
 
-	tokenIn, err := pool.SwapInAmtGivenOut(ctx, sdk.Coins{tokenOut}, tokenInDenom, swapFee)
+	// The protocol's share of the swap fee is the difference between the token in charged with the
+	// full swap fee, and the token in the pool requires with the liquidity providers' share of it.
+	protocolFee := sdk.Coin{Denom: tokenInDenom, Amount: sdk.ZeroInt()}
+	protocolFeeShare := k.getProtocolFeeShare(ctx)
+	if protocolFeeShare.IsPositive() {
+		totalTokenIn, err := pool.CalcInAmtGivenOut(ctx, sdk.Coins{tokenOut}, tokenInDenom, swapFee)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		protocolFee = totalTokenIn
+	}
+
+	tokenIn, err := pool.SwapInAmtGivenOut(ctx, sdk.Coins{tokenOut}, tokenInDenom, getLPSwapFee(swapFee, protocolFeeShare))
 	if err != nil {
 		return sdk.Int{}, err
 	}
-	tokenInAmount = tokenIn.Amount
+	protocolFee.Amount = sdk.MaxInt(protocolFee.Amount.Sub(tokenIn.Amount), sdk.ZeroInt())
+	tokenInAmount = tokenIn.Amount.Add(protocolFee.Amount)
 
 	if tokenInAmount.LTE(sdk.ZeroInt()) {
 		return sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount is zero or negative")
 	}
 
 	if tokenInAmount.GT(tokenInMaxAmount) {
-		return sdk.Int{}, sdkerrors.Wrapf(types.ErrLimitMaxAmount, "Swap requires %s, which is greater than the amount %s", tokenIn, tokenInMaxAmount)
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrLimitMaxAmount, "Swap requires %s, which is greater than the amount %s", tokenIn.Add(protocolFee), tokenInMaxAmount)
 	}
 
-	err = k.updatePoolForSwap(ctx, pool, sender, tokenIn, tokenOut)
+	err = k.updatePoolForSwap(ctx, pool, sender, tokenIn, tokenOut, swapFee)
 	if err != nil {
 		return sdk.Int{}, err
 	}
+	if err := k.chargeProtocolFee(ctx, sender, protocolFee); err != nil {
+		return sdk.Int{}, err
+	}
 	return tokenInAmount, nil
 }
 
-// updatePoolForSwap takes a pool, sender, and tokenIn, tokenOut amounts
+// updatePoolForSwap takes a pool, sender, tokenIn, tokenOut amounts and the swap fee charged
 // It then updates the pool's balances to the new reserve amounts, and
 // sends the in tokens from the sender to the pool, and the out tokens from the pool to the sender.
+// It returns an error if the swap trips the circuit breaker.
 func (k Keeper) updatePoolForSwap(
 	ctx sdk.Context,
 	pool types.CFMMPoolI,
 	sender sdk.AccAddress,
 	tokenIn sdk.Coin,
 	tokenOut sdk.Coin,
+	swapFee sdk.Dec,
 ) error {
 	tokensIn := sdk.Coins{tokenIn}
 	tokensOut := sdk.Coins{tokenOut}
 
-	err := k.setPool(ctx, pool)
+	err := k.checkCircuitBreaker(ctx, pool, tokenIn.Denom, tokenOut.Denom)
+	if err != nil {
+		return err
+	}
+
+	err = k.setPool(ctx, pool)
 	if err != nil {
 		return err
 	}
@@ -184,7 +216,7 @@ func (k Keeper) updatePoolForSwap(
 	}
 
 	events.EmitSwapEvent(ctx, sender, pool.GetId(), tokensIn, tokensOut)
-	k.hooks.AfterSwap(ctx, sender, pool.GetId(), tokensIn, tokensOut)
+	k.hooks.AfterSwap(ctx, sender, pool.GetId(), tokensIn, tokensOut, swapFee)
 	k.RecordTotalLiquidityIncrease(ctx, tokensIn)
 	k.RecordTotalLiquidityDecrease(ctx, tokensOut)
 
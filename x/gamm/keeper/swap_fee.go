@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+)
+
+// GetSwapFee returns the swap fee to charge on the given pool. Pools without
+// dynamic swap fee params charge their static swap fee. Otherwise, the swap fee is
+// the static swap fee, plus the volatility surcharge, plus the downtime surcharge
+// while the chain recovers from downtime, clamped to the min and max swap fee.
+//
+// The computation does at most one twap query and one downtime query,
+// so its gas cost is bounded regardless of the pool's size.
+func (k Keeper) GetSwapFee(ctx sdk.Context, pool types.CFMMPoolI) sdk.Dec {
+	swapFee := pool.GetSwapFee(ctx)
+
+	dynamicPool, ok := pool.(types.DynamicSwapFeePoolExtension)
+	if !ok {
+		return swapFee
+	}
+	params := dynamicPool.GetDynamicSwapFeeParams()
+	if params == nil {
+		return swapFee
+	}
+
+	if params.VolatilityMultiplier.IsPositive() {
+		swapFee = swapFee.Add(params.VolatilityMultiplier.Mul(k.getVolatility(ctx, pool, *params)))
+	}
+
+	if params.DowntimeSurcharge.IsPositive() && k.downtimeKeeper != nil {
+		recovered, err := k.downtimeKeeper.RecoveredSinceDowntimeOfLength(ctx, params.Downtime, params.DowntimeRecovery)
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to check downtime recovery for pool %d: %s", pool.GetId(), err))
+		} else if !recovered {
+			swapFee = swapFee.Add(params.DowntimeSurcharge)
+		}
+	}
+
+	if swapFee.LT(params.MinSwapFee) {
+		return params.MinSwapFee
+	}
+	if swapFee.GT(params.MaxSwapFee) {
+		return params.MaxSwapFee
+	}
+	return swapFee
+}
+
+// getVolatility returns the relative deviation of the pool's spot price from its
+// arithmetic twap over the volatility window. It is measured on the pool's first
+// two assets, in denom order, so that it does not depend on the swap direction.
+// It is zero if there is no twap for the whole window.
+func (k Keeper) getVolatility(ctx sdk.Context, pool types.CFMMPoolI, params types.DynamicSwapFeeParams) sdk.Dec {
+	liquidity := pool.GetTotalPoolLiquidity(ctx)
+	if len(liquidity) < 2 {
+		return sdk.ZeroDec()
+	}
+
+	deviation, found, err := k.spotPriceTwapDeviation(ctx, pool, liquidity[0].Denom, liquidity[1].Denom, params.VolatilityWindow)
+	if err != nil || !found {
+		return sdk.ZeroDec()
+	}
+	return deviation
+}
@@ -0,0 +1,153 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+)
+
+// mockDowntimeKeeper reports the chain as recovered from downtime or not, or errors.
+type mockDowntimeKeeper struct {
+	recovered bool
+	err       error
+}
+
+func (m mockDowntimeKeeper) RecoveredSinceDowntimeOfLength(ctx sdk.Context, downtime, recovery time.Duration) (bool, error) {
+	return m.recovered, m.err
+}
+
+func (suite *KeeperTestSuite) TestGetSwapFee() {
+	minSwapFee, maxSwapFee := sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(1, 1)
+	downtimeSurcharge := sdk.NewDecWithPrec(2, 2)
+
+	testcases := []struct {
+		name                 string
+		dynamicSwapFee       bool
+		volatilityMultiplier sdk.Dec
+		swap                 bool
+		downtimeKeeper       types.DowntimeKeeper
+		// expSwapFee is computed from the volatility if nil.
+		expSwapFee *sdk.Dec
+	}{
+		{
+			name:       "static swap fee",
+			swap:       true,
+			expSwapFee: &defaultSwapFee,
+		},
+		{
+			name:                 "no volatility",
+			dynamicSwapFee:       true,
+			volatilityMultiplier: sdk.OneDec(),
+			downtimeKeeper:       mockDowntimeKeeper{recovered: true},
+			expSwapFee:           &defaultSwapFee,
+		},
+		{
+			name:                 "volatility surcharge",
+			dynamicSwapFee:       true,
+			volatilityMultiplier: sdk.NewDecWithPrec(1, 1),
+			swap:                 true,
+			downtimeKeeper:       mockDowntimeKeeper{recovered: true},
+		},
+		{
+			name:                 "volatility surcharge capped at max swap fee",
+			dynamicSwapFee:       true,
+			volatilityMultiplier: sdk.NewDec(10),
+			swap:                 true,
+			downtimeKeeper:       mockDowntimeKeeper{recovered: true},
+			expSwapFee:           &maxSwapFee,
+		},
+		{
+			name:                 "downtime surcharge while recovering",
+			dynamicSwapFee:       true,
+			volatilityMultiplier: sdk.ZeroDec(),
+			downtimeKeeper:       mockDowntimeKeeper{recovered: false},
+			expSwapFee:           decPtr(defaultSwapFee.Add(downtimeSurcharge)),
+		},
+		{
+			name:                 "downtime error is ignored",
+			dynamicSwapFee:       true,
+			volatilityMultiplier: sdk.ZeroDec(),
+			downtimeKeeper:       mockDowntimeKeeper{err: errors.New("downtime error")},
+			expSwapFee:           &defaultSwapFee,
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			poolParams := defaultPoolParams
+			if tc.dynamicSwapFee {
+				poolParams.DynamicSwapFeeParams = &types.DynamicSwapFeeParams{
+					MinSwapFee:           minSwapFee,
+					MaxSwapFee:           maxSwapFee,
+					VolatilityWindow:     time.Hour,
+					VolatilityMultiplier: tc.volatilityMultiplier,
+					Downtime:             10 * time.Minute,
+					DowntimeRecovery:     time.Hour,
+					DowntimeSurcharge:    downtimeSurcharge,
+				}
+			}
+			poolId := suite.prepareCustomBalancerPool(defaultAcctFunds, defaultPoolAssets, poolParams)
+			suite.App.GAMMKeeper.SetDowntimeKeeper(tc.downtimeKeeper)
+			suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(2 * time.Hour))
+
+			pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			twap, err := pool.SpotPrice(suite.Ctx, "bar", "foo")
+			suite.Require().NoError(err)
+
+			if tc.swap {
+				_, err := suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], poolId, sdk.NewInt64Coin("foo", 1000), "bar", sdk.OneInt())
+				suite.Require().NoError(err)
+			}
+
+			pool, err = suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			swapFee := suite.App.GAMMKeeper.GetSwapFee(suite.Ctx, pool)
+
+			if tc.expSwapFee != nil {
+				suite.Require().Equal(*tc.expSwapFee, swapFee)
+				return
+			}
+			// the twap over the volatility window is the spot price before the swap
+			spotPrice, err := pool.SpotPrice(suite.Ctx, "bar", "foo")
+			suite.Require().NoError(err)
+			volatility := spotPrice.Sub(twap).Abs().Quo(twap)
+			suite.Require().True(volatility.IsPositive())
+			suite.Require().Equal(defaultSwapFee.Add(tc.volatilityMultiplier.Mul(volatility)), swapFee)
+		})
+	}
+}
+
+// TestExitSwapExactAmountOutDynamicSwapFee tests that single asset exits charge the dynamic swap fee.
+func (suite *KeeperTestSuite) TestExitSwapExactAmountOutDynamicSwapFee() {
+	suite.SetupTest()
+	poolParams := defaultPoolParams
+	poolParams.DynamicSwapFeeParams = &types.DynamicSwapFeeParams{
+		MinSwapFee:           sdk.ZeroDec(),
+		MaxSwapFee:           sdk.NewDecWithPrec(1, 1),
+		VolatilityMultiplier: sdk.ZeroDec(),
+		Downtime:             10 * time.Minute,
+		DowntimeRecovery:     time.Hour,
+		DowntimeSurcharge:    sdk.NewDecWithPrec(5, 2),
+	}
+	poolId := suite.prepareCustomBalancerPool(defaultAcctFunds, defaultPoolAssets, poolParams)
+
+	exitSharesIn := func(recovered bool) sdk.Int {
+		suite.App.GAMMKeeper.SetDowntimeKeeper(mockDowntimeKeeper{recovered: recovered})
+		cacheCtx, _ := suite.Ctx.CacheContext()
+		sharesIn, err := suite.App.GAMMKeeper.ExitSwapExactAmountOut(cacheCtx, suite.TestAccs[0], poolId, sdk.NewInt64Coin("foo", 100), types.InitPoolSharesSupply)
+		suite.Require().NoError(err)
+		return sharesIn
+	}
+
+	// the downtime surcharge makes the exit burn more shares.
+	suite.Require().True(exitSharesIn(false).GT(exitSharesIn(true)))
+}
+
+func decPtr(d sdk.Dec) *sdk.Dec {
+	return &d
+}
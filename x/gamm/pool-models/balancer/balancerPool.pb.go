@@ -12,12 +12,13 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	types1 "github.com/cosmos/cosmos-sdk/types"
+	types2 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/x/auth/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	types1 "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -137,6 +138,9 @@ type PoolParams struct {
 	SwapFee                  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee" yaml:"swap_fee"`
 	ExitFee                  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exit_fee,json=exitFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exit_fee" yaml:"exit_fee"`
 	SmoothWeightChangeParams *SmoothWeightChangeParams              `protobuf:"bytes,3,opt,name=smooth_weight_change_params,json=smoothWeightChangeParams,proto3" json:"smooth_weight_change_params,omitempty" yaml:"smooth_weight_change_params"`
+	// dynamic_swap_fee_params optionally makes the swap fee depend on recent
+	// volatility and chain downtime. If unset, swap_fee is used as is.
+	DynamicSwapFeeParams *types1.DynamicSwapFeeParams `protobuf:"bytes,4,opt,name=dynamic_swap_fee_params,json=dynamicSwapFeeParams,proto3" json:"dynamic_swap_fee_params,omitempty" yaml:"dynamic_swap_fee_params"`
 }
 
 func (m *PoolParams) Reset()         { *m = PoolParams{} }
@@ -179,6 +183,13 @@ func (m *PoolParams) GetSmoothWeightChangeParams() *SmoothWeightChangeParams {
 	return nil
 }
 
+func (m *PoolParams) GetDynamicSwapFeeParams() *types1.DynamicSwapFeeParams {
+	if m != nil {
+		return m.DynamicSwapFeeParams
+	}
+	return nil
+}
+
 // Pool asset is an internal struct that combines the amount of the
 // token in the pool, and its balancer weight.
 // This is an awkward packaging of data,
@@ -186,7 +197,7 @@ func (m *PoolParams) GetSmoothWeightChangeParams() *SmoothWeightChangeParams {
 type PoolAsset struct {
 	// Coins we are talking about,
 	// the denomination must be unique amongst all PoolAssets for this pool.
-	Token types1.Coin `protobuf:"bytes,1,opt,name=token,proto3" json:"token" yaml:"token"`
+	Token types2.Coin `protobuf:"bytes,1,opt,name=token,proto3" json:"token" yaml:"token"`
 	// Weight that is not normalized. This weight must be less than 2^50
 	Weight github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"weight" yaml:"weight"`
 }
@@ -224,11 +235,11 @@ func (m *PoolAsset) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PoolAsset proto.InternalMessageInfo
 
-func (m *PoolAsset) GetToken() types1.Coin {
+func (m *PoolAsset) GetToken() types2.Coin {
 	if m != nil {
 		return m.Token
 	}
-	return types1.Coin{}
+	return types2.Coin{}
 }
 
 type Pool struct {
@@ -246,12 +257,15 @@ type Pool struct {
 	// TODO: Further improve these docs
 	FuturePoolGovernor string `protobuf:"bytes,4,opt,name=future_pool_governor,json=futurePoolGovernor,proto3" json:"future_pool_governor,omitempty" yaml:"future_pool_governor"`
 	// sum of all LP tokens sent out
-	TotalShares types1.Coin `protobuf:"bytes,5,opt,name=total_shares,json=totalShares,proto3" json:"total_shares" yaml:"total_shares"`
+	TotalShares types2.Coin `protobuf:"bytes,5,opt,name=total_shares,json=totalShares,proto3" json:"total_shares" yaml:"total_shares"`
 	// These are assumed to be sorted by denomiation.
 	// They contain the pool asset and the information about the weight
 	PoolAssets []PoolAsset `protobuf:"bytes,6,rep,name=pool_assets,json=poolAssets,proto3" json:"pool_assets" yaml:"pool_assets"`
 	// sum of all non-normalized pool weights
 	TotalWeight github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=total_weight,json=totalWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_weight" yaml:"total_weight"`
+	// paused is true if swaps and joins against the pool are paused, by
+	// governance or by the circuit breaker.
+	Paused bool `protobuf:"varint,8,opt,name=paused,proto3" json:"paused,omitempty" yaml:"paused"`
 }
 
 func (m *Pool) Reset()      { *m = Pool{} }
@@ -298,59 +312,64 @@ func init() {
 }
 
 var fileDescriptor_7e991f749f68c2a4 = []byte{
-	// 831 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4f, 0x4f, 0xe3, 0x46,
-	0x14, 0x8f, 0x93, 0x40, 0x96, 0xc9, 0x76, 0x2b, 0x66, 0x73, 0x30, 0x41, 0x8d, 0xd1, 0x54, 0xaa,
-	0x56, 0xd5, 0xc6, 0x56, 0x76, 0x7b, 0xda, 0xcb, 0x6a, 0x03, 0xb4, 0xe2, 0x46, 0x4d, 0x25, 0x4a,
-	0x85, 0x64, 0x4d, 0x92, 0x89, 0x6d, 0x61, 0x7b, 0x2c, 0xcf, 0x24, 0xc0, 0x37, 0xe8, 0x91, 0x23,
-	0xbd, 0x71, 0xef, 0xb5, 0xfd, 0x0e, 0xa8, 0xbd, 0x70, 0xac, 0x7a, 0x70, 0x2b, 0xe8, 0xa9, 0xc7,
-	0x7c, 0x82, 0x6a, 0xfe, 0x38, 0x09, 0x34, 0x51, 0x41, 0x3d, 0x65, 0xde, 0x9b, 0xf7, 0x7e, 0xef,
-	0xf7, 0xde, 0xfb, 0x8d, 0x03, 0xbe, 0xa0, 0x2c, 0xa6, 0x2c, 0x64, 0x8e, 0x8f, 0xe3, 0xd8, 0x49,
-	0x29, 0x8d, 0xda, 0x31, 0x1d, 0x90, 0x88, 0x39, 0x3d, 0x1c, 0xe1, 0xa4, 0x4f, 0xb2, 0xe9, 0x61,
-	0x9f, 0xd2, 0xc8, 0x4e, 0x33, 0xca, 0x29, 0x6c, 0xe8, 0x2c, 0x5b, 0x64, 0xd9, 0xe3, 0x4e, 0x8f,
-	0x70, 0xdc, 0x69, 0x6e, 0xf4, 0xa5, 0xdb, 0x93, 0x31, 0x8e, 0x32, 0x54, 0x42, 0xb3, 0xe1, 0x53,
-	0x9f, 0x2a, 0xbf, 0x38, 0x69, 0x6f, 0xcb, 0xa7, 0xd4, 0x8f, 0x88, 0x23, 0xad, 0xde, 0x68, 0xe8,
-	0x0c, 0x46, 0x19, 0xe6, 0x21, 0x4d, 0xf4, 0xbd, 0xf5, 0xf0, 0x9e, 0x87, 0x31, 0x61, 0x1c, 0xc7,
-	0x69, 0x01, 0xa0, 0x8a, 0x38, 0x78, 0xc4, 0x03, 0x47, 0xd3, 0x90, 0xc6, 0x83, 0xfb, 0x1e, 0x66,
-	0x64, 0x7a, 0xdf, 0xa7, 0xa1, 0x2e, 0x80, 0x7e, 0xad, 0x00, 0xf3, 0x20, 0xa6, 0x94, 0x07, 0x87,
-	0x24, 0xf4, 0x03, 0xbe, 0x1d, 0xe0, 0xc4, 0x27, 0xfb, 0x38, 0xc3, 0x31, 0x83, 0xdf, 0x02, 0xc0,
-	0x38, 0xce, 0xb8, 0x27, 0xaa, 0x9a, 0xc6, 0x96, 0xf1, 0xaa, 0xfe, 0xa6, 0x69, 0x2b, 0x4a, 0x76,
-	0x41, 0xc9, 0xfe, 0xa6, 0xa0, 0xd4, 0xfd, 0xe4, 0x3a, 0xb7, 0x4a, 0x93, 0xdc, 0x5a, 0x3f, 0xc7,
-	0x71, 0xf4, 0x0e, 0xcd, 0x72, 0xd1, 0xc5, 0x1f, 0x96, 0xe1, 0xae, 0x49, 0x87, 0x08, 0x87, 0x01,
-	0x78, 0x56, 0x74, 0x6a, 0x96, 0x25, 0xee, 0xc6, 0xbf, 0x70, 0x77, 0x74, 0x40, 0xb7, 0x23, 0x60,
-	0xff, 0xce, 0x2d, 0x58, 0xa4, 0xbc, 0xa6, 0x71, 0xc8, 0x49, 0x9c, 0xf2, 0xf3, 0x49, 0x6e, 0x7d,
-	0xac, 0x8a, 0x15, 0x77, 0xe8, 0x52, 0x94, 0x9a, 0xa2, 0xc3, 0x31, 0x68, 0x84, 0x49, 0xc8, 0x43,
-	0x1c, 0x79, 0x62, 0xb7, 0xde, 0xa9, 0x6c, 0x93, 0x99, 0x95, 0xad, 0xca, 0xab, 0xfa, 0x1b, 0xcb,
-	0x5e, 0xb4, 0x47, 0x5b, 0x2c, 0xfa, 0x03, 0x63, 0x84, 0x77, 0x3f, 0xd5, 0x2d, 0x6d, 0xaa, 0x2a,
-	0x8b, 0xa0, 0x90, 0x0b, 0xb5, 0x5b, 0xa4, 0xa9, 0x31, 0x32, 0xc8, 0xc0, 0x4b, 0x8e, 0x33, 0x9f,
-	0xf0, 0xfb, 0x65, 0xab, 0x8f, 0x2b, 0x8b, 0x74, 0xd9, 0xa6, 0x2a, 0xbb, 0x00, 0x09, 0xb9, 0xeb,
-	0xca, 0x3b, 0x57, 0x14, 0xfd, 0x55, 0x06, 0x40, 0xd8, 0x7a, 0x7f, 0xc7, 0xe0, 0x19, 0x3b, 0xc5,
-	0xa9, 0x37, 0x24, 0x6a, 0x7b, 0x6b, 0xdd, 0x0f, 0x02, 0xf7, 0xf7, 0xdc, 0xfa, 0xcc, 0x0f, 0x79,
-	0x30, 0xea, 0xd9, 0x7d, 0x1a, 0x6b, 0x99, 0xea, 0x9f, 0x36, 0x1b, 0x9c, 0x38, 0xfc, 0x3c, 0x25,
-	0xcc, 0xde, 0x21, 0xfd, 0xd9, 0x78, 0x0b, 0x1c, 0xe4, 0xd6, 0xc4, 0xf1, 0x4b, 0x42, 0x04, 0x3a,
-	0x39, 0x0b, 0xb9, 0x44, 0x2f, 0xff, 0x3f, 0xf4, 0x02, 0x07, 0xb9, 0x35, 0x71, 0x14, 0xe8, 0x3f,
-	0x18, 0x60, 0x93, 0x49, 0x61, 0xea, 0x8e, 0xbd, 0xbe, 0x94, 0xa6, 0x97, 0xca, 0xde, 0xcc, 0x8a,
-	0x54, 0x8d, 0xbd, 0x78, 0x90, 0xcb, 0x14, 0xdd, 0xfd, 0xfc, 0x3a, 0xb7, 0x8c, 0x49, 0x6e, 0x21,
-	0xdd, 0xd5, 0xf2, 0x02, 0xc8, 0x35, 0xd9, 0x12, 0x14, 0xf4, 0xa3, 0x01, 0xd6, 0xa6, 0xbb, 0x82,
-	0xbb, 0x60, 0x85, 0xd3, 0x13, 0x92, 0xe8, 0x07, 0xb2, 0x61, 0xeb, 0x77, 0x2f, 0x9e, 0xdc, 0x94,
-	0xd1, 0x36, 0x0d, 0x93, 0x6e, 0x43, 0x6f, 0xf5, 0xb9, 0xde, 0xaa, 0xc8, 0x42, 0xae, 0xca, 0x86,
-	0x87, 0x60, 0x55, 0xf1, 0xd0, 0xc3, 0x7c, 0xff, 0x84, 0x61, 0xee, 0x25, 0x7c, 0x92, 0x5b, 0x1f,
-	0x29, 0x58, 0x85, 0x82, 0x5c, 0x0d, 0x87, 0x7e, 0xae, 0x82, 0xaa, 0x60, 0x0b, 0x5f, 0x83, 0x1a,
-	0x1e, 0x0c, 0x32, 0xc2, 0x98, 0x56, 0x03, 0x9c, 0xe4, 0xd6, 0x0b, 0x95, 0xa4, 0x2f, 0x90, 0x5b,
-	0x84, 0xc0, 0x17, 0xa0, 0x1c, 0x0e, 0x24, 0x97, 0xaa, 0x5b, 0x0e, 0x07, 0x70, 0x08, 0xea, 0x52,
-	0x7f, 0xf7, 0xe6, 0xbf, 0xb5, 0x5c, 0xc8, 0x7a, 0xe2, 0x0f, 0x1e, 0x50, 0xf1, 0x29, 0xf5, 0xe6,
-	0xb0, 0x90, 0x0b, 0xd2, 0x99, 0x68, 0xbf, 0x06, 0x8d, 0xe1, 0x88, 0x8f, 0x32, 0xa2, 0x42, 0x7c,
-	0x3a, 0x26, 0x59, 0x42, 0x33, 0xb3, 0x2a, 0x29, 0x5b, 0x33, 0xa8, 0x45, 0x51, 0xc8, 0x85, 0xca,
-	0x2d, 0x18, 0x7c, 0xa5, 0x9d, 0xf0, 0x08, 0x3c, 0xe7, 0x94, 0xe3, 0xc8, 0x63, 0x01, 0xce, 0x08,
-	0x33, 0x57, 0xfe, 0x6b, 0x51, 0x9b, 0x9a, 0xf4, 0xcb, 0x62, 0x51, 0xb3, 0x64, 0xe4, 0xd6, 0xa5,
-	0x79, 0x20, 0x2d, 0x78, 0xac, 0xa7, 0x82, 0x85, 0x14, 0x98, 0xb9, 0xfa, 0xb8, 0xe7, 0xdd, 0xd4,
-	0xf8, 0x50, 0xe1, 0xcf, 0x21, 0xe8, 0x59, 0xc8, 0x30, 0x06, 0x83, 0x82, 0xb8, 0x56, 0x46, 0x4d,
-	0xce, 0x60, 0xf7, 0xc9, 0xca, 0xb8, 0xd7, 0x47, 0xa1, 0x0f, 0xd5, 0x87, 0x92, 0xf7, 0xbb, 0xf5,
-	0xef, 0xaf, 0xac, 0xd2, 0xe5, 0x95, 0x55, 0xfa, 0xe5, 0xa7, 0xf6, 0x8a, 0x20, 0xba, 0xd7, 0x3d,
-	0xba, 0xbe, 0x6d, 0x19, 0x37, 0xb7, 0x2d, 0xe3, 0xcf, 0xdb, 0x96, 0x71, 0x71, 0xd7, 0x2a, 0xdd,
-	0xdc, 0xb5, 0x4a, 0xbf, 0xdd, 0xb5, 0x4a, 0xdf, 0xbd, 0x9f, 0x2b, 0xac, 0x3b, 0x6d, 0x47, 0xb8,
-	0xc7, 0x0a, 0xc3, 0x19, 0x77, 0xde, 0x3a, 0x67, 0xcb, 0xff, 0x50, 0x7b, 0xab, 0xf2, 0x23, 0xff,
-	0xf6, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9d, 0xba, 0x2b, 0xb9, 0x7c, 0x07, 0x00, 0x00,
+	// 906 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x16, 0x2d, 0xc5, 0x3f, 0xeb, 0x34, 0x85, 0x37, 0x02, 0x4a, 0xcb, 0xa8, 0x68, 0x6c, 0x80,
+	0xc0, 0x0d, 0x62, 0x12, 0x4e, 0x7a, 0xca, 0x25, 0x08, 0xe3, 0xb4, 0xc8, 0x2d, 0xa5, 0x0b, 0xa4,
+	0x29, 0x02, 0x10, 0x2b, 0x71, 0x4d, 0x11, 0x21, 0xb9, 0x04, 0x77, 0xe5, 0xc4, 0x6f, 0xd0, 0xde,
+	0x72, 0x4c, 0x6f, 0xb9, 0xf7, 0xda, 0x87, 0x30, 0xda, 0x4b, 0x8e, 0x45, 0x0f, 0x6c, 0x60, 0xdf,
+	0x7a, 0x2a, 0xf4, 0x04, 0xc5, 0xee, 0xce, 0x4a, 0xb6, 0x2b, 0xa1, 0x09, 0x7a, 0x32, 0x77, 0x76,
+	0xe6, 0x9b, 0x6f, 0x66, 0xbe, 0x59, 0x0b, 0x7d, 0xc9, 0x45, 0xc1, 0x45, 0x26, 0x82, 0x94, 0x16,
+	0x45, 0x50, 0x71, 0x9e, 0xef, 0x16, 0x3c, 0x61, 0xb9, 0x08, 0x06, 0x34, 0xa7, 0xe5, 0x90, 0xd5,
+	0xd3, 0x8f, 0x27, 0x9c, 0xe7, 0x7e, 0x55, 0x73, 0xc9, 0x71, 0x17, 0xa2, 0x7c, 0x15, 0xe5, 0x1f,
+	0xed, 0x0d, 0x98, 0xa4, 0x7b, 0xbd, 0xcd, 0xa1, 0x36, 0xc7, 0xda, 0x27, 0x30, 0x07, 0x13, 0xd0,
+	0xeb, 0xa6, 0x3c, 0xe5, 0xc6, 0xae, 0xbe, 0xc0, 0xda, 0x4f, 0x39, 0x4f, 0x73, 0x16, 0xe8, 0xd3,
+	0x60, 0x7c, 0x18, 0x24, 0xe3, 0x9a, 0xca, 0x8c, 0x97, 0x70, 0xef, 0x5d, 0xbe, 0x97, 0x59, 0xc1,
+	0x84, 0xa4, 0x45, 0x65, 0x01, 0x4c, 0x92, 0x80, 0x8e, 0xe5, 0x28, 0x00, 0x1a, 0xfa, 0x70, 0xe9,
+	0x7e, 0x40, 0x05, 0x9b, 0xde, 0x0f, 0x79, 0x66, 0x13, 0xdc, 0xb8, 0x50, 0xbd, 0x75, 0x10, 0x2f,
+	0x69, 0x15, 0x1f, 0x32, 0x66, 0x9c, 0xc8, 0x6f, 0x6d, 0xe4, 0x1e, 0x14, 0x9c, 0xcb, 0xd1, 0x53,
+	0x96, 0xa5, 0x23, 0xf9, 0x70, 0x44, 0xcb, 0x94, 0x3d, 0xa1, 0x35, 0x2d, 0x04, 0xfe, 0x0e, 0x21,
+	0x21, 0x69, 0x2d, 0x63, 0x45, 0xcd, 0x75, 0xb6, 0x9d, 0x9d, 0xf5, 0x3b, 0x3d, 0xdf, 0xf0, 0xf6,
+	0x2d, 0x6f, 0xff, 0x5b, 0xcb, 0x3b, 0xfc, 0xfc, 0xa4, 0xf1, 0x5a, 0x93, 0xc6, 0xdb, 0x38, 0xa6,
+	0x45, 0x7e, 0x8f, 0xcc, 0x62, 0xc9, 0xeb, 0x3f, 0x3d, 0x27, 0x5a, 0xd3, 0x06, 0xe5, 0x8e, 0x47,
+	0x68, 0xd5, 0xb6, 0xc3, 0x5d, 0xd2, 0xb8, 0x9b, 0xff, 0xc2, 0xdd, 0x07, 0x87, 0x70, 0x4f, 0xc1,
+	0xfe, 0xd5, 0x78, 0xd8, 0x86, 0xdc, 0xe6, 0x45, 0x26, 0x59, 0x51, 0xc9, 0xe3, 0x49, 0xe3, 0x7d,
+	0x6a, 0x92, 0xd9, 0x3b, 0xf2, 0x46, 0xa5, 0x9a, 0xa2, 0xe3, 0x23, 0xd4, 0xcd, 0xca, 0x4c, 0x66,
+	0x34, 0x8f, 0x95, 0x00, 0xe2, 0x97, 0xba, 0x4c, 0xe1, 0xb6, 0xb7, 0xdb, 0x3b, 0xeb, 0x77, 0x3c,
+	0x7f, 0xde, 0xb0, 0x7d, 0xa5, 0x86, 0x07, 0x42, 0x30, 0x19, 0xde, 0x80, 0x92, 0xb6, 0x4c, 0x96,
+	0x79, 0x50, 0x24, 0xc2, 0x60, 0x56, 0x61, 0xa6, 0x8d, 0x02, 0x0b, 0x74, 0x5d, 0xd2, 0x3a, 0x65,
+	0xf2, 0x62, 0xda, 0xce, 0x87, 0xa5, 0x25, 0x90, 0xb6, 0x67, 0xd2, 0xce, 0x41, 0x22, 0xd1, 0x86,
+	0xb1, 0x9e, 0x4b, 0x4a, 0xfe, 0x6e, 0x23, 0xa4, 0xce, 0x30, 0xbf, 0xe7, 0x68, 0xd5, 0x8e, 0x5b,
+	0x4f, 0x6f, 0x2d, 0x7c, 0xa0, 0x70, 0xff, 0x68, 0xbc, 0x9b, 0x69, 0x26, 0x47, 0xe3, 0x81, 0x3f,
+	0xe4, 0x05, 0x68, 0x19, 0xfe, 0xec, 0x8a, 0xe4, 0x45, 0x20, 0x8f, 0x2b, 0x26, 0xfc, 0x7d, 0x36,
+	0x9c, 0xb5, 0xd7, 0xe2, 0x90, 0x68, 0x45, 0x7d, 0x7e, 0xc5, 0x98, 0x42, 0x67, 0xaf, 0x32, 0xa9,
+	0xd1, 0x97, 0xfe, 0x1f, 0xba, 0xc5, 0x21, 0xd1, 0x8a, 0xfa, 0x54, 0xe8, 0x3f, 0x39, 0x68, 0x4b,
+	0x68, 0x61, 0x42, 0xc5, 0xf1, 0x50, 0x4b, 0x33, 0xae, 0x74, 0x6d, 0x6e, 0x5b, 0xab, 0xc6, 0x9f,
+	0xdf, 0xc8, 0x45, 0x8a, 0x0e, 0x6f, 0x9d, 0x34, 0x9e, 0x33, 0x69, 0x3c, 0x02, 0x55, 0x2d, 0x4e,
+	0x40, 0x22, 0x57, 0x2c, 0xda, 0x8b, 0x1f, 0x1d, 0xf4, 0x59, 0x72, 0x5c, 0xd2, 0x22, 0x1b, 0xc6,
+	0xb6, 0x31, 0x96, 0x57, 0x47, 0xf3, 0xba, 0x35, 0x9f, 0xd7, 0xbe, 0x09, 0x3a, 0x30, 0x1d, 0x04,
+	0x4e, 0x37, 0x81, 0x53, 0x1f, 0x84, 0x3c, 0x1f, 0x98, 0x44, 0xdd, 0x64, 0x4e, 0x34, 0xf9, 0xd9,
+	0x41, 0x6b, 0x53, 0xdd, 0xe0, 0x47, 0xe8, 0x8a, 0xe4, 0x2f, 0x58, 0x09, 0xcb, 0xba, 0xe9, 0xc3,
+	0x43, 0xa5, 0xde, 0x88, 0x29, 0x8b, 0x87, 0x3c, 0x2b, 0xc3, 0x2e, 0x28, 0xec, 0x2a, 0x28, 0x4c,
+	0x45, 0x91, 0xc8, 0x44, 0xe3, 0xa7, 0x68, 0xd9, 0xf4, 0x04, 0x06, 0x7b, 0xff, 0x23, 0x06, 0xfb,
+	0xb8, 0x94, 0x93, 0xc6, 0xfb, 0xc4, 0xc0, 0x1a, 0x14, 0x12, 0x01, 0x1c, 0x79, 0xdf, 0x41, 0x1d,
+	0xc5, 0x16, 0xdf, 0x46, 0x2b, 0x34, 0x49, 0x6a, 0x26, 0x04, 0x28, 0x13, 0x4f, 0x1a, 0xef, 0x9a,
+	0x09, 0x82, 0x0b, 0x12, 0x59, 0x17, 0x7c, 0x0d, 0x2d, 0x65, 0x89, 0xe6, 0xd2, 0x89, 0x96, 0xb2,
+	0x04, 0x1f, 0xa2, 0x75, 0xbd, 0x0b, 0x17, 0xb4, 0xb0, 0xbd, 0x78, 0xa9, 0xa0, 0xd3, 0x97, 0x96,
+	0xd9, 0xbe, 0xfd, 0xf1, 0x39, 0x2c, 0x12, 0xa1, 0x6a, 0xb6, 0x40, 0xdf, 0xa0, 0xee, 0xe1, 0x58,
+	0x8e, 0x6b, 0x66, 0x5c, 0x52, 0x7e, 0xc4, 0xea, 0x92, 0xd7, 0x7a, 0xc8, 0x6b, 0xa1, 0x37, 0x83,
+	0x9a, 0xe7, 0x45, 0x22, 0x6c, 0xcc, 0x8a, 0xc1, 0xd7, 0x60, 0xc4, 0xcf, 0xd0, 0x55, 0xc9, 0x25,
+	0xcd, 0x63, 0x31, 0xa2, 0x35, 0x13, 0xee, 0x95, 0xff, 0x1a, 0xd4, 0x16, 0x90, 0xbe, 0x6e, 0x07,
+	0x35, 0x0b, 0x26, 0xd1, 0xba, 0x3e, 0x1e, 0xe8, 0x13, 0x7e, 0x0e, 0x5d, 0xa1, 0x4a, 0x0a, 0xc2,
+	0x5d, 0xfe, 0xb0, 0xa7, 0xa6, 0x07, 0xf8, 0xd8, 0xe0, 0x9f, 0x43, 0x80, 0x5e, 0x68, 0x37, 0x81,
+	0x47, 0x96, 0x38, 0x28, 0x63, 0x45, 0xf7, 0xe0, 0xd1, 0x47, 0x2b, 0xe3, 0x42, 0x1d, 0x56, 0x1f,
+	0xa6, 0x0e, 0xb3, 0x6a, 0xf8, 0x0b, 0xb4, 0x5c, 0xd1, 0xb1, 0x60, 0x89, 0xbb, 0xba, 0xed, 0xec,
+	0xac, 0x86, 0x1b, 0x33, 0x3d, 0x19, 0x3b, 0x89, 0xc0, 0xe1, 0xde, 0xc6, 0x0f, 0x6f, 0xbd, 0xd6,
+	0x9b, 0xb7, 0x5e, 0xeb, 0xd7, 0x5f, 0x76, 0xaf, 0xa8, 0x9a, 0x1e, 0x87, 0xcf, 0x4e, 0x4e, 0xfb,
+	0xce, 0xbb, 0xd3, 0xbe, 0xf3, 0xfe, 0xb4, 0xef, 0xbc, 0x3e, 0xeb, 0xb7, 0xde, 0x9d, 0xf5, 0x5b,
+	0xbf, 0x9f, 0xf5, 0x5b, 0xdf, 0xdf, 0x3f, 0xc7, 0x11, 0x9a, 0xb2, 0x9b, 0xd3, 0x81, 0xb0, 0x87,
+	0xe0, 0x68, 0xef, 0x6e, 0xf0, 0x6a, 0xf1, 0x8f, 0x85, 0xc1, 0xb2, 0xfe, 0xdf, 0x74, 0xf7, 0x9f,
+	0x01, 0x00, 0x68, 0xff, 0xdf, 0x9f, 0x58, 0x08, 0x00, 0x00,
 }
 
 func (m *SmoothWeightChangeParams) Marshal() (dAtA []byte, err error) {
@@ -440,6 +459,18 @@ func (m *PoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DynamicSwapFeeParams != nil {
+		{
+			size, err := m.DynamicSwapFeeParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBalancerPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.SmoothWeightChangeParams != nil {
 		{
 			size, err := m.SmoothWeightChangeParams.MarshalToSizedBuffer(dAtA[:i])
@@ -538,6 +569,16 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	{
 		size := m.TotalWeight.Size()
 		i -= size
@@ -654,6 +695,10 @@ func (m *PoolParams) Size() (n int) {
 		l = m.SmoothWeightChangeParams.Size()
 		n += 1 + l + sovBalancerPool(uint64(l))
 	}
+	if m.DynamicSwapFeeParams != nil {
+		l = m.DynamicSwapFeeParams.Size()
+		n += 1 + l + sovBalancerPool(uint64(l))
+	}
 	return n
 }
 
@@ -699,6 +744,9 @@ func (m *Pool) Size() (n int) {
 	}
 	l = m.TotalWeight.Size()
 	n += 1 + l + sovBalancerPool(uint64(l))
+	if m.Paused {
+		n += 2
+	}
 	return n
 }
 
@@ -1025,6 +1073,42 @@ func (m *PoolParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicSwapFeeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DynamicSwapFeeParams == nil {
+				m.DynamicSwapFeeParams = &types1.DynamicSwapFeeParams{}
+			}
+			if err := m.DynamicSwapFeeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBalancerPool(dAtA[iNdEx:])
@@ -1409,6 +1493,26 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBalancerPool(dAtA[iNdEx:])
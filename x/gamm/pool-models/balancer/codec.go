@@ -16,6 +16,9 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&Pool{}, "osmosis/gamm/BalancerPool", nil)
 	cdc.RegisterConcrete(&MsgCreateBalancerPool{}, "osmosis/gamm/create-balancer-pool", nil)
+	cdc.RegisterConcrete(&MsgUpdateBalancerPoolWeights{}, "osmosis/gamm/update-balancer-pool-weights", nil)
+	cdc.RegisterConcrete(&MsgAddBalancerPoolAsset{}, "osmosis/gamm/add-balancer-pool-asset", nil)
+	cdc.RegisterConcrete(&MsgRemoveBalancerPoolAsset{}, "osmosis/gamm/remove-balancer-pool-asset", nil)
 	cdc.RegisterConcrete(&PoolParams{}, "osmosis/gamm/BalancerPoolParams", nil)
 }
 
@@ -33,6 +36,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgCreateBalancerPool{},
+		&MsgUpdateBalancerPoolWeights{},
+		&MsgAddBalancerPoolAsset{},
+		&MsgRemoveBalancerPoolAsset{},
 	)
 	registry.RegisterImplementations(
 		(*proto.Message)(nil),
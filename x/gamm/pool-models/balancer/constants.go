@@ -1,6 +1,8 @@
 package balancer
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -17,6 +19,21 @@ var (
 	//
 	// This is done so that smooth weight changes have enough precision to actually be smooth.
 	GuaranteedWeightPrecision int64 = 1 << 30
+	// A pool asset can only be removed from the pool once it has been phased out,
+	// i.e. its weight is at most MaxRemovedPoolAssetWeightRatio of the pool's total weight.
+	// This bounds the pool shares the remaining balance is worth.
+	MaxRemovedPoolAssetWeightRatio = sdk.NewDecWithPrec(1, 2)
+	// Weight changes started by a pool's future governor only start GovernedWeightChangeDelay after
+	// they are submitted, and last at least MinGovernedWeightChangeDuration.
+	// This gives LPs time to exit the pool before the governor can move the spot prices.
+	GovernedWeightChangeDelay       = 24 * time.Hour
+	MinGovernedWeightChangeDuration = 24 * time.Hour
+	// A lock-duration future governor must require locks of at least MinPoolGovernorLockDuration,
+	// so that the shares of the governing locker stay locked until its weight change has completed.
+	MinPoolGovernorLockDuration = GovernedWeightChangeDelay + MinGovernedWeightChangeDuration
+	// A pool asset added to the pool is priced at its arithmetic twap over AddedPoolAssetTwapWindow in a reference pool,
+	// so that the governor can not mint itself shares by moving the price of the asset for a block.
+	AddedPoolAssetTwapWindow = 24 * time.Hour
 
 	PoolTypeName string = "Balancer"
 )
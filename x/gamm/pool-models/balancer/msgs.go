@@ -1,6 +1,8 @@
 package balancer
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -9,12 +11,18 @@ import (
 )
 
 const (
-	TypeMsgCreateBalancerPool = "create_balancer_pool"
+	TypeMsgCreateBalancerPool        = "create_balancer_pool"
+	TypeMsgUpdateBalancerPoolWeights = "update_balancer_pool_weights"
+	TypeMsgAddBalancerPoolAsset      = "add_balancer_pool_asset"
+	TypeMsgRemoveBalancerPoolAsset   = "remove_balancer_pool_asset"
 )
 
 var (
 	_ sdk.Msg                       = &MsgCreateBalancerPool{}
 	_ swaproutertypes.CreatePoolMsg = &MsgCreateBalancerPool{}
+	_ sdk.Msg                       = &MsgUpdateBalancerPoolWeights{}
+	_ sdk.Msg                       = &MsgAddBalancerPoolAsset{}
+	_ sdk.Msg                       = &MsgRemoveBalancerPoolAsset{}
 )
 
 func NewMsgCreateBalancerPool(
@@ -103,3 +111,162 @@ func (msg MsgCreateBalancerPool) CreatePool(ctx sdk.Context, poolID uint64) (swa
 func (msg MsgCreateBalancerPool) GetPoolType() swaproutertypes.PoolType {
 	return swaproutertypes.Balancer
 }
+
+func NewMsgUpdateBalancerPoolWeights(
+	sender sdk.AccAddress,
+	poolID uint64,
+	targetPoolWeights []PoolAsset,
+	duration time.Duration,
+) MsgUpdateBalancerPoolWeights {
+	return MsgUpdateBalancerPoolWeights{
+		Sender:            sender.String(),
+		PoolID:            poolID,
+		TargetPoolWeights: targetPoolWeights,
+		Duration:          duration,
+	}
+}
+
+func (msg MsgUpdateBalancerPoolWeights) Route() string { return types.RouterKey }
+func (msg MsgUpdateBalancerPoolWeights) Type() string  { return TypeMsgUpdateBalancerPoolWeights }
+func (msg MsgUpdateBalancerPoolWeights) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	// the token amounts of the target weights are ignored, so only the denoms and weights are validated.
+	if len(msg.TargetPoolWeights) < 2 {
+		return types.ErrTooFewPoolAssets
+	}
+	if len(msg.TargetPoolWeights) > 8 {
+		return sdkerrors.Wrapf(types.ErrTooManyPoolAssets, "%d", len(msg.TargetPoolWeights))
+	}
+	denomExistsMap := map[string]bool{}
+	for _, weight := range msg.TargetPoolWeights {
+		if err := ValidateUserSpecifiedWeight(weight.Weight); err != nil {
+			return err
+		}
+		if err := sdk.ValidateDenom(weight.Token.Denom); err != nil {
+			return err
+		}
+		if denomExistsMap[weight.Token.Denom] {
+			return sdkerrors.Wrapf(types.ErrPoolParamsInvalidDenom, "target weight of %s already exists", weight.Token.Denom)
+		}
+		denomExistsMap[weight.Token.Denom] = true
+	}
+
+	if msg.Duration < MinGovernedWeightChangeDuration {
+		return sdkerrors.Wrapf(types.ErrWeightChangeTooShort, "duration must be at least %s, was %s", MinGovernedWeightChangeDuration, msg.Duration)
+	}
+
+	return nil
+}
+
+func (msg MsgUpdateBalancerPoolWeights) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateBalancerPoolWeights) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func NewMsgAddBalancerPoolAsset(
+	sender sdk.AccAddress,
+	poolID uint64,
+	poolAsset PoolAsset,
+	duration time.Duration,
+	priceDenom string,
+	referencePoolID uint64,
+) MsgAddBalancerPoolAsset {
+	return MsgAddBalancerPoolAsset{
+		Sender:          sender.String(),
+		PoolID:          poolID,
+		PoolAsset:       poolAsset,
+		Duration:        duration,
+		PriceDenom:      priceDenom,
+		ReferencePoolID: referencePoolID,
+	}
+}
+
+func (msg MsgAddBalancerPoolAsset) Route() string { return types.RouterKey }
+func (msg MsgAddBalancerPoolAsset) Type() string  { return TypeMsgAddBalancerPoolAsset }
+func (msg MsgAddBalancerPoolAsset) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if err := ValidateUserSpecifiedWeight(msg.PoolAsset.Weight); err != nil {
+		return err
+	}
+	if !msg.PoolAsset.Token.IsValid() || !msg.PoolAsset.Token.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.PoolAsset.Token.String())
+	}
+
+	if msg.Duration < MinGovernedWeightChangeDuration {
+		return sdkerrors.Wrapf(types.ErrWeightChangeTooShort, "duration must be at least %s, was %s", MinGovernedWeightChangeDuration, msg.Duration)
+	}
+
+	if err := sdk.ValidateDenom(msg.PriceDenom); err != nil {
+		return sdkerrors.Wrap(types.ErrPoolParamsInvalidDenom, err.Error())
+	}
+	if msg.PriceDenom == msg.PoolAsset.Token.Denom {
+		return sdkerrors.Wrapf(types.ErrPoolParamsInvalidDenom, "price denom must differ from the added denom %s", msg.PriceDenom)
+	}
+	if msg.ReferencePoolID == msg.PoolID {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "reference pool must differ from pool %d", msg.PoolID)
+	}
+
+	return nil
+}
+
+func (msg MsgAddBalancerPoolAsset) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgAddBalancerPoolAsset) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func NewMsgRemoveBalancerPoolAsset(
+	sender sdk.AccAddress,
+	poolID uint64,
+	denom string,
+) MsgRemoveBalancerPoolAsset {
+	return MsgRemoveBalancerPoolAsset{
+		Sender: sender.String(),
+		PoolID: poolID,
+		Denom:  denom,
+	}
+}
+
+func (msg MsgRemoveBalancerPoolAsset) Route() string { return types.RouterKey }
+func (msg MsgRemoveBalancerPoolAsset) Type() string  { return TypeMsgRemoveBalancerPoolAsset }
+func (msg MsgRemoveBalancerPoolAsset) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return sdk.ValidateDenom(msg.Denom)
+}
+
+func (msg MsgRemoveBalancerPoolAsset) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRemoveBalancerPoolAsset) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
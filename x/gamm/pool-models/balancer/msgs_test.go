@@ -2,6 +2,7 @@ package balancer_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -240,3 +241,267 @@ func TestMsgCreateBalancerPool(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgUpdateBalancerPoolWeights(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	createMsg := func(after func(msg balancer.MsgUpdateBalancerPoolWeights) balancer.MsgUpdateBalancerPoolWeights) balancer.MsgUpdateBalancerPoolWeights {
+		msg := balancer.NewMsgUpdateBalancerPoolWeights(addr1, 1, []balancer.PoolAsset{
+			{Weight: sdk.NewInt(100), Token: sdk.NewCoin("test", sdk.ZeroInt())},
+			{Weight: sdk.NewInt(200), Token: sdk.NewCoin("test2", sdk.ZeroInt())},
+		}, balancer.MinGovernedWeightChangeDuration)
+		return after(msg)
+	}
+
+	defaultMsg := createMsg(func(msg balancer.MsgUpdateBalancerPoolWeights) balancer.MsgUpdateBalancerPoolWeights {
+		return msg
+	})
+	require.Equal(t, defaultMsg.Route(), types.RouterKey)
+	require.Equal(t, defaultMsg.Type(), "update_balancer_pool_weights")
+	signers := defaultMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0], addr1)
+
+	tests := []struct {
+		name       string
+		msg        balancer.MsgUpdateBalancerPoolWeights
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        defaultMsg,
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg balancer.MsgUpdateBalancerPoolWeights) balancer.MsgUpdateBalancerPoolWeights {
+				msg.Sender = "invalid"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "too few weights",
+			msg: createMsg(func(msg balancer.MsgUpdateBalancerPoolWeights) balancer.MsgUpdateBalancerPoolWeights {
+				msg.TargetPoolWeights = msg.TargetPoolWeights[:1]
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "duplicate denom",
+			msg: createMsg(func(msg balancer.MsgUpdateBalancerPoolWeights) balancer.MsgUpdateBalancerPoolWeights {
+				msg.TargetPoolWeights[1].Token.Denom = "test"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: createMsg(func(msg balancer.MsgUpdateBalancerPoolWeights) balancer.MsgUpdateBalancerPoolWeights {
+				msg.TargetPoolWeights[1].Token.Denom = "1"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero weight",
+			msg: createMsg(func(msg balancer.MsgUpdateBalancerPoolWeights) balancer.MsgUpdateBalancerPoolWeights {
+				msg.TargetPoolWeights[0].Weight = sdk.ZeroInt()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "too large of a weight",
+			msg: createMsg(func(msg balancer.MsgUpdateBalancerPoolWeights) balancer.MsgUpdateBalancerPoolWeights {
+				msg.TargetPoolWeights[0].Weight = sdk.NewInt(1 << 21)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero duration",
+			msg: createMsg(func(msg balancer.MsgUpdateBalancerPoolWeights) balancer.MsgUpdateBalancerPoolWeights {
+				msg.Duration = 0
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "duration shorter than the minimum",
+			msg: createMsg(func(msg balancer.MsgUpdateBalancerPoolWeights) balancer.MsgUpdateBalancerPoolWeights {
+				msg.Duration = balancer.MinGovernedWeightChangeDuration - time.Second
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgAddBalancerPoolAsset(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	createMsg := func(after func(msg balancer.MsgAddBalancerPoolAsset) balancer.MsgAddBalancerPoolAsset) balancer.MsgAddBalancerPoolAsset {
+		msg := balancer.NewMsgAddBalancerPoolAsset(addr1, 1, balancer.PoolAsset{
+			Weight: sdk.NewInt(100), Token: sdk.NewInt64Coin("test", 100),
+		}, balancer.MinGovernedWeightChangeDuration, "uosmo", 2)
+		return after(msg)
+	}
+
+	defaultMsg := createMsg(func(msg balancer.MsgAddBalancerPoolAsset) balancer.MsgAddBalancerPoolAsset {
+		return msg
+	})
+	require.Equal(t, defaultMsg.Route(), types.RouterKey)
+	require.Equal(t, defaultMsg.Type(), "add_balancer_pool_asset")
+	signers := defaultMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0], addr1)
+
+	tests := []struct {
+		name       string
+		msg        balancer.MsgAddBalancerPoolAsset
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        defaultMsg,
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg balancer.MsgAddBalancerPoolAsset) balancer.MsgAddBalancerPoolAsset {
+				msg.Sender = "invalid"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: createMsg(func(msg balancer.MsgAddBalancerPoolAsset) balancer.MsgAddBalancerPoolAsset {
+				msg.PoolAsset.Token.Denom = "1"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero amount",
+			msg: createMsg(func(msg balancer.MsgAddBalancerPoolAsset) balancer.MsgAddBalancerPoolAsset {
+				msg.PoolAsset.Token.Amount = sdk.ZeroInt()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero weight",
+			msg: createMsg(func(msg balancer.MsgAddBalancerPoolAsset) balancer.MsgAddBalancerPoolAsset {
+				msg.PoolAsset.Weight = sdk.ZeroInt()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "too large of a weight",
+			msg: createMsg(func(msg balancer.MsgAddBalancerPoolAsset) balancer.MsgAddBalancerPoolAsset {
+				msg.PoolAsset.Weight = sdk.NewInt(1 << 21)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "duration shorter than the minimum",
+			msg: createMsg(func(msg balancer.MsgAddBalancerPoolAsset) balancer.MsgAddBalancerPoolAsset {
+				msg.Duration = balancer.MinGovernedWeightChangeDuration - time.Second
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid price denom",
+			msg: createMsg(func(msg balancer.MsgAddBalancerPoolAsset) balancer.MsgAddBalancerPoolAsset {
+				msg.PriceDenom = "1"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "price denom is the added denom",
+			msg: createMsg(func(msg balancer.MsgAddBalancerPoolAsset) balancer.MsgAddBalancerPoolAsset {
+				msg.PriceDenom = msg.PoolAsset.Token.Denom
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "reference pool is the pool",
+			msg: createMsg(func(msg balancer.MsgAddBalancerPoolAsset) balancer.MsgAddBalancerPoolAsset {
+				msg.ReferencePoolID = msg.PoolID
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgRemoveBalancerPoolAsset(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	defaultMsg := balancer.NewMsgRemoveBalancerPoolAsset(addr1, 1, "test")
+	require.Equal(t, defaultMsg.Route(), types.RouterKey)
+	require.Equal(t, defaultMsg.Type(), "remove_balancer_pool_asset")
+	signers := defaultMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0], addr1)
+
+	tests := []struct {
+		name       string
+		msg        balancer.MsgRemoveBalancerPoolAsset
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        defaultMsg,
+			expectPass: true,
+		},
+		{
+			name:       "invalid sender",
+			msg:        balancer.MsgRemoveBalancerPoolAsset{Sender: "invalid", PoolID: 1, Denom: "test"},
+			expectPass: false,
+		},
+		{
+			name:       "invalid denom",
+			msg:        balancer.NewMsgRemoveBalancerPoolAsset(addr1, 1, "1"),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
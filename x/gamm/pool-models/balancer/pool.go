@@ -369,11 +369,8 @@ func (p Pool) GetAllPoolAssets() []PoolAsset {
 // This does not affect the asset balances.
 // If any of the above are not satisfied, this will panic.
 // (As all input to this should be generated from the state machine)
-// TODO: (post-launch) If newWeights includes a new denomination,
-// add the balance as well to the pool's internal measurements.
-// TODO: (post-launch) If newWeights excludes an existing denomination,
-// remove the weight from the pool, and figure out something to do
-// with any remaining coin.
+// Denominations are added and removed with AddPoolAsset and RemovePoolAsset,
+// which can not be called while the weights are changing.
 func (p *Pool) updateAllWeights(newWeights []PoolAsset) {
 	if len(p.PoolAssets) != len(newWeights) {
 		panic("updateAllWeights called with invalid input, len(newWeights) != len(existingWeights)")
@@ -395,6 +392,156 @@ func (p *Pool) updateAllWeights(newWeights []PoolAsset) {
 	p.TotalWeight = totalWeight
 }
 
+// UpdateWeights starts a smooth weight change from the pool's current weights to the target weights,
+// over the given duration starting GovernedWeightChangeDelay after the block time. Any ongoing weight change
+// is first applied up to the block time and then replaced, so the weights stay where they currently are
+// until the delay has passed, and then change continuously to the target weights.
+// The token amounts of the target weights are ignored.
+// Errors if the duration is shorter than MinGovernedWeightChangeDuration.
+func (p *Pool) UpdateWeights(targetPoolWeights []PoolAsset, duration time.Duration, blockTime time.Time) error {
+	if duration < MinGovernedWeightChangeDuration {
+		return sdkerrors.Wrapf(types.ErrWeightChangeTooShort, "duration must be at least %s, was %s", MinGovernedWeightChangeDuration, duration)
+	}
+	p.PokePool(blockTime)
+
+	// copy the target weights, as setting them sorts and scales them in place.
+	targetWeights := make([]PoolAsset, len(targetPoolWeights))
+	copy(targetWeights, targetPoolWeights)
+
+	// copy the pool params, so that only the smooth weight change params are replaced.
+	params := p.PoolParams
+	params.SmoothWeightChangeParams = &SmoothWeightChangeParams{
+		StartTime:         blockTime.Add(GovernedWeightChangeDelay),
+		Duration:          duration,
+		TargetPoolWeights: targetWeights,
+	}
+	if err := params.Validate(p.PoolAssets); err != nil {
+		return err
+	}
+
+	return p.setInitialPoolParams(params, p.GetAllPoolAssets(), blockTime)
+}
+
+// AddPoolAsset adds a new asset to the pool, with the asset's token as its initial balance, at the initial weight
+// that prices one unit of the asset at price units of priceDenom, an asset already in the pool.
+// The initial weight is the deposit's share of the pool's value, so that many pool shares are added to keep
+// the value of a share unchanged. The weights of the existing assets, and so the spot prices between them,
+// are unchanged. If the user specified weight, scaled by GuaranteedWeightPrecision, differs from the initial
+// weight, the asset is phased in to it through a smooth weight change over the given duration, which starts
+// GovernedWeightChangeDelay after the block time like UpdateWeights. Returns the number of added shares.
+// Errors if the pool weights are changing, the pool is full, the asset already exists or is invalid,
+// the price denom is not in the pool, the initial weight is out of the weight bounds,
+// or the duration is shorter than MinGovernedWeightChangeDuration.
+func (p *Pool) AddPoolAsset(asset PoolAsset, priceDenom string, price sdk.Dec, duration time.Duration, blockTime time.Time) (numShares sdk.Int, err error) {
+	if duration < MinGovernedWeightChangeDuration {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrWeightChangeTooShort, "duration must be at least %s, was %s", MinGovernedWeightChangeDuration, duration)
+	}
+	if p.PoolParams.SmoothWeightChangeParams != nil {
+		return sdk.Int{}, types.ErrPoolWeightsChanging
+	}
+	if len(p.PoolAssets) >= swaproutertypes.MaxPoolAssets {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrTooManyPoolAssets, "%d", len(p.PoolAssets)+1)
+	}
+	if _, _, err := p.getPoolAssetAndIndex(asset.Token.Denom); err == nil {
+		return sdk.Int{}, fmt.Errorf(formatRepeatingPoolAssetsNotAllowedErrFormat, asset.Token.Denom)
+	}
+	if !asset.Token.IsValid() || !asset.Token.IsPositive() {
+		return sdk.Int{}, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, asset.Token.String())
+	}
+	if err := ValidateUserSpecifiedWeight(asset.Weight); err != nil {
+		return sdk.Int{}, err
+	}
+	initialWeight, err := p.priceNeutralWeight(asset.Token, priceDenom, price)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	numShares = p.TotalShares.Amount.Mul(initialWeight).Quo(p.TotalWeight)
+	if !numShares.IsPositive() {
+		return sdk.Int{}, fmt.Errorf("shares amount must be positive, was %s", numShares)
+	}
+
+	// the existing assets keep their weights, and the new asset goes from its initial weight to its weight.
+	targetWeight := asset.Weight.MulRaw(GuaranteedWeightPrecision)
+	targetWeights := append(poolAssetWeights(p.PoolAssets), PoolAsset{
+		Weight: targetWeight,
+		Token:  sdk.NewCoin(asset.Token.Denom, sdk.ZeroInt()),
+	})
+	sortPoolAssetsByDenom(targetWeights)
+
+	asset.Weight = initialWeight
+	p.PoolAssets = append(p.PoolAssets, asset)
+	sortPoolAssetsByDenom(p.PoolAssets)
+	p.TotalWeight = p.TotalWeight.Add(asset.Weight)
+	p.AddTotalShares(numShares)
+	if !initialWeight.Equal(targetWeight) {
+		p.PoolParams.SmoothWeightChangeParams = &SmoothWeightChangeParams{
+			StartTime:          blockTime.Add(GovernedWeightChangeDelay),
+			Duration:           duration,
+			InitialPoolWeights: poolAssetWeights(p.PoolAssets),
+			TargetPoolWeights:  targetWeights,
+		}
+	}
+	return numShares, nil
+}
+
+// priceNeutralWeight returns the weight at which the token, as the balance of a new pool asset, has a spot price
+// of price units of priceDenom, i.e. weight = price * balance * priceDenomWeight / priceDenomBalance, truncated.
+// Errors if priceDenom is not in the pool, or the weight is not in [GuaranteedWeightPrecision,
+// MaxUserSpecifiedWeight * GuaranteedWeightPrecision), so that the spot price keeps its precision.
+func (p Pool) priceNeutralWeight(token sdk.Coin, priceDenom string, price sdk.Dec) (sdk.Int, error) {
+	if !price.IsPositive() {
+		return sdk.Int{}, fmt.Errorf("price must be positive, was %s", price)
+	}
+	_, priceAsset, err := p.getPoolAssetAndIndex(priceDenom)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	weight := price.MulInt(token.Amount).MulInt(priceAsset.Weight).QuoInt(priceAsset.Token.Amount).TruncateInt()
+	if weight.LT(sdk.NewInt(GuaranteedWeightPrecision)) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrNotPositiveWeight,
+			"deposit of %s at price %s %s has weight %s, must be at least %d", token, price, priceDenom, weight, GuaranteedWeightPrecision)
+	}
+	if weight.GTE(MaxUserSpecifiedWeight.MulRaw(GuaranteedWeightPrecision)) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrWeightTooLarge,
+			"deposit of %s at price %s %s has weight %s", token, price, priceDenom, weight)
+	}
+	return weight, nil
+}
+
+// RemovePoolAsset removes a phased out asset from the pool, whose weight is at most
+// MaxRemovedPoolAssetWeightRatio of the pool's total weight. The spot prices between the remaining
+// assets are unchanged. The remaining balance of the asset is worth weight / totalWeight of the pool's
+// value, so that many pool shares, rounded up, are removed to keep the value of a share unchanged.
+// Returns the remaining balance of the asset and the number of removed shares.
+// Errors if the pool weights are changing, the pool would be left with too few assets,
+// the asset does not exist or is not phased out.
+func (p *Pool) RemovePoolAsset(denom string) (tokenOut sdk.Coin, numShares sdk.Int, err error) {
+	if p.PoolParams.SmoothWeightChangeParams != nil {
+		return sdk.Coin{}, sdk.Int{}, types.ErrPoolWeightsChanging
+	}
+	if len(p.PoolAssets) <= swaproutertypes.MinPoolAssets {
+		return sdk.Coin{}, sdk.Int{}, types.ErrTooFewPoolAssets
+	}
+	index, asset, err := p.getPoolAssetAndIndex(denom)
+	if err != nil {
+		return sdk.Coin{}, sdk.Int{}, err
+	}
+	weightRatio := asset.Weight.ToDec().QuoInt(p.TotalWeight)
+	if weightRatio.GT(MaxRemovedPoolAssetWeightRatio) {
+		return sdk.Coin{}, sdk.Int{}, sdkerrors.Wrapf(types.ErrPoolAssetNotPhasedOut,
+			"weight ratio of %s is %s, must be at most %s", denom, weightRatio, MaxRemovedPoolAssetWeightRatio)
+	}
+
+	numShares = p.TotalShares.Amount.ToDec().MulInt(asset.Weight).QuoInt(p.TotalWeight).Ceil().TruncateInt()
+
+	p.PoolAssets = append(p.PoolAssets[:index], p.PoolAssets[index+1:]...)
+	p.TotalWeight = p.TotalWeight.Sub(asset.Weight)
+	p.SubTotalShares(numShares)
+	return asset.Token, numShares, nil
+}
+
 // PokePool checks to see if the pool's token weights need to be updated, and
 // if so, does so. Currently doesn't do anything outside out LBPs.
 func (p *Pool) PokePool(blockTime time.Time) {
@@ -425,9 +572,6 @@ func (p *Pool) PokePool(blockTime time.Time) {
 		// case 2: start_time < t <= start_time + duration:
 
 		// Update weights to be the target weights.
-		//
-		// TODO: When we add support for adding new assets via this method, ensure
-		// the new asset has some token sent with it.
 		p.updateAllWeights(params.TargetPoolWeights)
 
 		// we've finished updating the weights, so reset the following fields
@@ -478,8 +622,20 @@ func (p Pool) NumAssets() int {
 	return len(p.PoolAssets)
 }
 
+// GetDynamicSwapFeeParams returns the pool's dynamic swap fee params, if any.
+func (p Pool) GetDynamicSwapFeeParams() *types.DynamicSwapFeeParams {
+	return p.PoolParams.DynamicSwapFeeParams
+}
+
+// IsActive returns false if the pool has been paused by governance or by the
+// circuit breaker.
 func (p Pool) IsActive(ctx sdk.Context) bool {
-	return true
+	return !p.Paused
+}
+
+// SetPaused pauses or resumes swaps and joins on the pool.
+func (p *Pool) SetPaused(paused bool) {
+	p.Paused = paused
 }
 
 // CalcOutAmtGivenIn calculates tokens to be swapped out given the provided
@@ -904,6 +1060,7 @@ func (p *Pool) JoinPoolTokenInMaxShareAmountOut(
 	ctx sdk.Context,
 	tokenInDenom string,
 	shareOutAmount sdk.Int,
+	swapFee sdk.Dec,
 ) (tokenInAmount sdk.Int, err error) {
 	_, poolAssetIn, err := p.getPoolAssetAndIndex(tokenInDenom)
 	if err != nil {
@@ -917,7 +1074,7 @@ func (p *Pool) JoinPoolTokenInMaxShareAmountOut(
 		normalizedWeight,
 		p.GetTotalShares().ToDec(),
 		shareOutAmount.ToDec(),
-		p.GetSwapFee(ctx),
+		swapFee,
 	).TruncateInt()
 
 	if !tokenInAmount.IsPositive() {
@@ -937,6 +1094,7 @@ func (p *Pool) ExitSwapExactAmountOut(
 	ctx sdk.Context,
 	tokenOut sdk.Coin,
 	shareInMaxAmount sdk.Int,
+	swapFee sdk.Dec,
 ) (shareInAmount sdk.Int, err error) {
 	_, poolAssetOut, err := p.getPoolAssetAndIndex(tokenOut.Denom)
 	if err != nil {
@@ -948,7 +1106,7 @@ func (p *Pool) ExitSwapExactAmountOut(
 		poolAssetOut.Weight.ToDec().Quo(p.TotalWeight.ToDec()),
 		p.GetTotalShares().ToDec(),
 		tokenOut.Amount.ToDec(),
-		p.GetSwapFee(ctx),
+		swapFee,
 		p.GetExitFee(ctx),
 	).TruncateInt()
 
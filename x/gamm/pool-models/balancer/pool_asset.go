@@ -95,6 +95,19 @@ func validateUserSpecifiedPoolAssets(assets []PoolAsset) error {
 	return nil
 }
 
+// poolAssetWeights returns the weights of the provided pool assets, with zero token amounts,
+// as used by SmoothWeightChangeParams.
+func poolAssetWeights(assets []PoolAsset) []PoolAsset {
+	weights := make([]PoolAsset, len(assets))
+	for i, asset := range assets {
+		weights[i] = PoolAsset{
+			Weight: asset.Weight,
+			Token:  sdk.NewCoin(asset.Token.Denom, sdk.ZeroInt()),
+		}
+	}
+	return weights
+}
+
 // poolAssetsCoins returns all the coins corresponding to a slice of pool assets.
 func poolAssetsCoins(assets []PoolAsset) sdk.Coins {
 	coins := sdk.Coins{}
@@ -33,6 +33,12 @@ func (params PoolParams) Validate(poolWeights []PoolAsset) error {
 		return types.ErrTooMuchSwapFee
 	}
 
+	if params.DynamicSwapFeeParams != nil {
+		if err := params.DynamicSwapFeeParams.Validate(params.SwapFee); err != nil {
+			return err
+		}
+	}
+
 	if params.SmoothWeightChangeParams != nil {
 		targetWeights := params.SmoothWeightChangeParams.TargetPoolWeights
 		// Ensure it has the right number of weights
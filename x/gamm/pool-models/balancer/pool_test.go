@@ -7,6 +7,7 @@ import (
 	time "time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 
 	"github.com/osmosis-labs/osmosis/v13/app/apptesting/osmoassert"
@@ -1188,6 +1189,366 @@ func TestBalancerPoolPokeTokenWeights(t *testing.T) {
 	}
 }
 
+func TestBalancerPoolUpdateWeights(t *testing.T) {
+	startTime := time.Unix(1618703511, 0)
+	duration := balancer.MinGovernedWeightChangeDuration
+	delay := balancer.GovernedWeightChangeDelay
+	precision := balancer.GuaranteedWeightPrecision
+	weights := func(asset1, asset2 int64) []balancer.PoolAsset {
+		return []balancer.PoolAsset{
+			{Weight: sdk.NewInt(asset1), Token: sdk.NewInt64Coin("asset1", 0)},
+			{Weight: sdk.NewInt(asset2), Token: sdk.NewInt64Coin("asset2", 0)},
+		}
+	}
+	requireWeights := func(pool balancer.Pool, expected ...int64) {
+		totalWeight := sdk.ZeroInt()
+		for i, asset := range pool.GetAllPoolAssets() {
+			require.Equal(t, sdk.NewInt(expected[i]), asset.Weight, "asset %d", i)
+			totalWeight = totalWeight.Add(asset.Weight)
+		}
+		require.Equal(t, totalWeight, pool.GetTotalWeight())
+	}
+	newPool := func(params *balancer.SmoothWeightChangeParams) balancer.Pool {
+		pool, err := balancer.NewBalancerPool(defaultPoolId, balancer.PoolParams{
+			SwapFee:                  defaultSwapFee,
+			ExitFee:                  defaultExitFee,
+			SmoothWeightChangeParams: params,
+		}, []balancer.PoolAsset{
+			{Weight: sdk.NewInt(1), Token: sdk.NewInt64Coin("asset1", 10000)},
+			{Weight: sdk.NewInt(1), Token: sdk.NewInt64Coin("asset2", 10000)},
+		}, defaultFutureGovernor, startTime)
+		require.NoError(t, err)
+		return pool
+	}
+
+	t.Run("without ongoing weight change", func(t *testing.T) {
+		pool := newPool(nil)
+		err := pool.UpdateWeights(weights(1, 2), duration, startTime)
+		require.NoError(t, err)
+		require.Equal(t, defaultSwapFee, pool.GetSwapFee(sdk.Context{}))
+		changeStartTime := startTime.Add(delay)
+		require.Equal(t, changeStartTime, pool.PoolParams.SmoothWeightChangeParams.StartTime)
+
+		// the weights do not change until the delay has passed.
+		pool.PokePool(changeStartTime)
+		requireWeights(pool, precision, precision)
+
+		pool.PokePool(changeStartTime.Add(duration / 2))
+		requireWeights(pool, precision, 3*precision/2)
+
+		pool.PokePool(changeStartTime.Add(duration + time.Second))
+		requireWeights(pool, precision, 2*precision)
+		require.Nil(t, pool.PoolParams.SmoothWeightChangeParams)
+	})
+
+	t.Run("during ongoing weight change", func(t *testing.T) {
+		// 1:1 changing to 1:3, so that halfway through the weights are 1:2
+		pool := newPool(&balancer.SmoothWeightChangeParams{
+			StartTime:         startTime,
+			Duration:          duration,
+			TargetPoolWeights: weights(1, 3),
+		})
+		midChangeTime := startTime.Add(duration / 2)
+
+		// the new change starts from the mid-change weights of 1:2, which are kept during the delay.
+		err := pool.UpdateWeights(weights(2, 2), duration, midChangeTime)
+		require.NoError(t, err)
+		requireWeights(pool, precision, 2*precision)
+		require.Equal(t, weights(precision, 2*precision), pool.PoolParams.SmoothWeightChangeParams.InitialPoolWeights)
+		changeStartTime := midChangeTime.Add(delay)
+		require.Equal(t, changeStartTime, pool.PoolParams.SmoothWeightChangeParams.StartTime)
+
+		pool.PokePool(changeStartTime)
+		requireWeights(pool, precision, 2*precision)
+
+		pool.PokePool(changeStartTime.Add(duration / 2))
+		requireWeights(pool, 3*precision/2, 2*precision)
+
+		pool.PokePool(changeStartTime.Add(duration + time.Second))
+		requireWeights(pool, 2*precision, 2*precision)
+		require.Nil(t, pool.PoolParams.SmoothWeightChangeParams)
+	})
+
+	t.Run("dynamic swap fee params are kept", func(t *testing.T) {
+		pool := newPool(nil)
+		dynamicSwapFeeParams := &types.DynamicSwapFeeParams{
+			MinSwapFee:           sdk.ZeroDec(),
+			MaxSwapFee:           sdk.NewDecWithPrec(5, 2),
+			VolatilityWindow:     time.Hour,
+			VolatilityMultiplier: sdk.NewDecWithPrec(5, 2),
+			DowntimeSurcharge:    sdk.ZeroDec(),
+		}
+		pool.PoolParams.DynamicSwapFeeParams = dynamicSwapFeeParams
+		err := pool.UpdateWeights(weights(1, 2), duration, startTime)
+		require.NoError(t, err)
+		require.Equal(t, dynamicSwapFeeParams, pool.GetDynamicSwapFeeParams())
+		require.Equal(t, defaultExitFee, pool.GetExitFee(sdk.Context{}))
+	})
+
+	t.Run("target weights are not modified", func(t *testing.T) {
+		pool := newPool(nil)
+		targetWeights := []balancer.PoolAsset{
+			{Weight: sdk.NewInt(2), Token: sdk.NewInt64Coin("asset2", 0)},
+			{Weight: sdk.NewInt(1), Token: sdk.NewInt64Coin("asset1", 0)},
+		}
+		err := pool.UpdateWeights(targetWeights, duration, startTime)
+		require.NoError(t, err)
+		require.Equal(t, "asset2", targetWeights[0].Token.Denom)
+		require.Equal(t, sdk.NewInt(2), targetWeights[0].Weight)
+	})
+
+	errorCases := map[string]struct {
+		targetWeights []balancer.PoolAsset
+		duration      time.Duration
+		expectedErr   error
+	}{
+		"wrong number of weights": {
+			targetWeights: weights(1, 2)[:1],
+			duration:      duration,
+			expectedErr:   types.ErrPoolParamsInvalidNumDenoms,
+		},
+		"denom not in pool": {
+			targetWeights: []balancer.PoolAsset{
+				{Weight: sdk.NewInt(1), Token: sdk.NewInt64Coin("asset1", 0)},
+				{Weight: sdk.NewInt(1), Token: sdk.NewInt64Coin("asset3", 0)},
+			},
+			duration:    duration,
+			expectedErr: types.ErrPoolParamsInvalidDenom,
+		},
+		"zero weight": {
+			targetWeights: weights(1, 0),
+			duration:      duration,
+			expectedErr:   types.ErrNotPositiveWeight,
+		},
+		"zero duration": {
+			targetWeights: weights(1, 2),
+			duration:      0,
+			expectedErr:   types.ErrWeightChangeTooShort,
+		},
+		"duration shorter than the minimum": {
+			targetWeights: weights(1, 2),
+			duration:      duration - time.Second,
+			expectedErr:   types.ErrWeightChangeTooShort,
+		},
+	}
+	for name, tc := range errorCases {
+		t.Run(name, func(t *testing.T) {
+			pool := newPool(nil)
+			err := pool.UpdateWeights(tc.targetWeights, tc.duration, startTime)
+			require.Error(t, err)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			}
+			requireWeights(pool, precision, precision)
+			require.Nil(t, pool.PoolParams.SmoothWeightChangeParams)
+		})
+	}
+}
+
+func TestBalancerPoolAddRemovePoolAsset(t *testing.T) {
+	precision := balancer.GuaranteedWeightPrecision
+	initShares := types.InitPoolSharesSupply
+	newPool := func(assets ...balancer.PoolAsset) balancer.Pool {
+		pool, err := balancer.NewBalancerPool(defaultPoolId, defaultBalancerPoolParams, assets, defaultFutureGovernor, defaultCurBlockTime)
+		require.NoError(t, err)
+		return pool
+	}
+	asset := func(denom string, weight int64, amount int64) balancer.PoolAsset {
+		return balancer.PoolAsset{Weight: sdk.NewInt(weight), Token: sdk.NewInt64Coin(denom, amount)}
+	}
+	requireSpotPrice := func(pool balancer.Pool, expected sdk.Dec) {
+		spotPrice, err := pool.SpotPrice(sdk.Context{}, "asset1", "asset2")
+		require.NoError(t, err)
+		require.Equal(t, expected, spotPrice)
+	}
+
+	t.Run("add asset", func(t *testing.T) {
+		pool := newPool(asset("asset1", 1, 10000), asset("asset2", 1, 20000))
+		spotPrice, err := pool.SpotPrice(sdk.Context{}, "asset1", "asset2")
+		require.NoError(t, err)
+
+		// at 2 asset1 per asset0, the deposit is worth as much as the asset1 balance, i.e. half of the pool's value
+		// before the deposit. So it is added at the weight of asset1, and half of the shares are added.
+		numShares, err := pool.AddPoolAsset(asset("asset0", 2, 5000), "asset1", sdk.NewDec(2), balancer.MinGovernedWeightChangeDuration, defaultCurBlockTime)
+		require.NoError(t, err)
+		require.Equal(t, initShares.QuoRaw(2), numShares)
+		require.Equal(t, initShares.Add(numShares), pool.GetTotalShares())
+		require.Equal(t, sdk.NewInt(3*precision), pool.GetTotalWeight())
+		require.Equal(t, []string{"asset0", "asset1", "asset2"}, osmoutils.CoinsDenoms(pool.GetTotalPoolLiquidity(sdk.Context{})))
+		requireSpotPrice(pool, spotPrice)
+		// the spot price of (asset1, asset0) is the number of asset1 per asset0.
+		addedSpotPrice, err := pool.SpotPrice(sdk.Context{}, "asset1", "asset0")
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDec(2), addedSpotPrice)
+
+		// the new asset is phased in to its weight once the delay has passed.
+		params := pool.PoolParams.SmoothWeightChangeParams
+		require.NotNil(t, params)
+		require.Equal(t, defaultCurBlockTime.Add(balancer.GovernedWeightChangeDelay), params.StartTime)
+		require.Equal(t, balancer.MinGovernedWeightChangeDuration, params.Duration)
+		require.Equal(t, []balancer.PoolAsset{
+			asset("asset0", precision, 0), asset("asset1", precision, 0), asset("asset2", precision, 0),
+		}, params.InitialPoolWeights)
+		require.Equal(t, []balancer.PoolAsset{
+			asset("asset0", 2*precision, 0), asset("asset1", precision, 0), asset("asset2", precision, 0),
+		}, params.TargetPoolWeights)
+	})
+
+	t.Run("add asset at its weight", func(t *testing.T) {
+		pool := newPool(asset("asset1", 1, 10000), asset("asset2", 1, 20000))
+
+		// the price neutral weight is the asset's weight, so there is no weight change.
+		numShares, err := pool.AddPoolAsset(asset("asset0", 1, 5000), "asset1", sdk.NewDec(2), balancer.MinGovernedWeightChangeDuration, defaultCurBlockTime)
+		require.NoError(t, err)
+		require.Equal(t, initShares.QuoRaw(2), numShares)
+		require.Nil(t, pool.PoolParams.SmoothWeightChangeParams)
+	})
+
+	t.Run("remove asset", func(t *testing.T) {
+		pool := newPool(asset("asset1", 100, 10000), asset("asset2", 100, 20000), asset("asset3", 1, 5000))
+		spotPrice, err := pool.SpotPrice(sdk.Context{}, "asset1", "asset2")
+		require.NoError(t, err)
+
+		// the removed asset has 1 / 201 of the pool's weight, so that many shares are removed, rounded up.
+		tokenOut, numShares, err := pool.RemovePoolAsset("asset3")
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewInt64Coin("asset3", 5000), tokenOut)
+		require.Equal(t, initShares.QuoRaw(201).AddRaw(1), numShares)
+		require.Equal(t, initShares.Sub(numShares), pool.GetTotalShares())
+		require.Equal(t, sdk.NewInt(200*precision), pool.GetTotalWeight())
+		require.Equal(t, []string{"asset1", "asset2"}, osmoutils.CoinsDenoms(pool.GetTotalPoolLiquidity(sdk.Context{})))
+		requireSpotPrice(pool, spotPrice)
+	})
+
+	weightChange := &balancer.SmoothWeightChangeParams{
+		Duration: balancer.MinGovernedWeightChangeDuration,
+		TargetPoolWeights: []balancer.PoolAsset{
+			asset("asset1", 100, 0), asset("asset2", 100, 0), asset("asset3", 2, 0),
+		},
+	}
+	addErrorCases := map[string]struct {
+		pool        balancer.Pool
+		asset       balancer.PoolAsset
+		price       sdk.Dec
+		expectedErr error
+	}{
+		"price denom not in pool": {
+			pool:        newPool(asset("asset2", 1, 10000), asset("asset4", 1, 10000)),
+			asset:       asset("asset3", 1, 10000),
+			expectedErr: types.ErrDenomNotFoundInPool,
+		},
+		"deposit too small for its price": {
+			pool:        newPool(asset("asset1", 1, 10000), asset("asset2", 1, 10000)),
+			asset:       asset("asset3", 1, 1),
+			expectedErr: types.ErrNotPositiveWeight,
+		},
+		"deposit too large for its price": {
+			pool:        newPool(asset("asset1", 1, 10000), asset("asset2", 1, 10000)),
+			asset:       asset("asset3", 1, 10000),
+			price:       sdk.NewDec(1 << 20),
+			expectedErr: types.ErrWeightTooLarge,
+		},
+		"zero price": {
+			pool:        newPool(asset("asset1", 1, 10000), asset("asset2", 1, 10000)),
+			asset:       asset("asset3", 1, 10000),
+			price:       sdk.ZeroDec(),
+			expectedErr: fmt.Errorf("price must be positive, was %s", sdk.ZeroDec()),
+		},
+		"asset already in pool": {
+			pool:        newPool(asset("asset1", 1, 10000), asset("asset2", 1, 10000)),
+			asset:       asset("asset1", 1, 10000),
+			expectedErr: fmt.Errorf("repeating pool assets not allowed, found asset1"),
+		},
+		"zero amount": {
+			pool:        newPool(asset("asset1", 1, 10000), asset("asset2", 1, 10000)),
+			asset:       asset("asset3", 1, 0),
+			expectedErr: sdkerrors.ErrInvalidCoins,
+		},
+		"zero weight": {
+			pool:        newPool(asset("asset1", 1, 10000), asset("asset2", 1, 10000)),
+			asset:       asset("asset3", 0, 10000),
+			expectedErr: types.ErrNotPositiveWeight,
+		},
+		"pool is full": {
+			pool: newPool(asset("asset1", 1, 1), asset("asset2", 1, 1), asset("asset3", 1, 1), asset("asset4", 1, 1),
+				asset("asset5", 1, 1), asset("asset6", 1, 1), asset("asset7", 1, 1), asset("asset8", 1, 1)),
+			asset:       asset("asset9", 1, 10000),
+			expectedErr: types.ErrTooManyPoolAssets,
+		},
+		"weights are changing": {
+			pool: func() balancer.Pool {
+				pool := newPool(asset("asset1", 100, 10000), asset("asset2", 100, 10000), asset("asset3", 1, 10000))
+				require.NoError(t, pool.UpdateWeights(weightChange.TargetPoolWeights, weightChange.Duration, defaultCurBlockTime))
+				return pool
+			}(),
+			asset:       asset("asset4", 1, 10000),
+			expectedErr: types.ErrPoolWeightsChanging,
+		},
+	}
+	t.Run("add: duration shorter than the minimum", func(t *testing.T) {
+		pool := newPool(asset("asset1", 1, 10000), asset("asset2", 1, 10000))
+		_, err := pool.AddPoolAsset(asset("asset3", 1, 10000), "asset1", sdk.OneDec(), balancer.MinGovernedWeightChangeDuration-time.Second, defaultCurBlockTime)
+		require.ErrorIs(t, err, types.ErrWeightChangeTooShort)
+		require.Len(t, pool.PoolAssets, 2)
+		require.Nil(t, pool.PoolParams.SmoothWeightChangeParams)
+	})
+	for name, tc := range addErrorCases {
+		t.Run("add: "+name, func(t *testing.T) {
+			pool := tc.pool
+			totalWeight := pool.GetTotalWeight()
+			price := tc.price
+			if price.IsNil() {
+				price = sdk.OneDec()
+			}
+			_, err := pool.AddPoolAsset(tc.asset, "asset1", price, balancer.MinGovernedWeightChangeDuration, defaultCurBlockTime)
+			require.ErrorContains(t, err, tc.expectedErr.Error())
+			require.Equal(t, initShares, pool.GetTotalShares())
+			require.Equal(t, totalWeight, pool.GetTotalWeight())
+		})
+	}
+
+	removeErrorCases := map[string]struct {
+		pool        balancer.Pool
+		denom       string
+		expectedErr error
+	}{
+		"asset not phased out": {
+			pool:        newPool(asset("asset1", 100, 10000), asset("asset2", 100, 10000), asset("asset3", 3, 10000)),
+			denom:       "asset3",
+			expectedErr: types.ErrPoolAssetNotPhasedOut,
+		},
+		"asset not in pool": {
+			pool:        newPool(asset("asset1", 100, 10000), asset("asset2", 100, 10000), asset("asset3", 1, 10000)),
+			denom:       "asset4",
+			expectedErr: types.ErrDenomNotFoundInPool,
+		},
+		"too few assets": {
+			pool:        newPool(asset("asset1", 1000, 10000), asset("asset2", 1, 10000)),
+			denom:       "asset2",
+			expectedErr: types.ErrTooFewPoolAssets,
+		},
+		"weights are changing": {
+			pool: func() balancer.Pool {
+				pool := newPool(asset("asset1", 100, 10000), asset("asset2", 100, 10000), asset("asset3", 1, 10000))
+				require.NoError(t, pool.UpdateWeights(weightChange.TargetPoolWeights, weightChange.Duration, defaultCurBlockTime))
+				return pool
+			}(),
+			denom:       "asset3",
+			expectedErr: types.ErrPoolWeightsChanging,
+		},
+	}
+	for name, tc := range removeErrorCases {
+		t.Run("remove: "+name, func(t *testing.T) {
+			pool := tc.pool
+			numAssets := pool.NumAssets()
+			_, _, err := pool.RemovePoolAsset(tc.denom)
+			require.ErrorIs(t, err, tc.expectedErr)
+			require.Equal(t, initShares, pool.GetTotalShares())
+			require.Equal(t, numAssets, pool.NumAssets())
+		})
+	}
+}
+
 // This test (currently trivially) checks to make sure that `IsActive` returns true for balancer pools.
 // This is mainly to make sure that if IsActive is ever used as an emergency switch, it is not accidentally left off for any (or all) pools.
 func TestIsActive(t *testing.T) {
@@ -6,21 +6,27 @@ package balancer
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -142,9 +148,366 @@ func (m *MsgCreateBalancerPoolResponse) GetPoolID() uint64 {
 	return 0
 }
 
+// ===================== MsgUpdateBalancerPoolWeights
+// Sender must be the pool's future_pool_governor in order for the tx to
+// succeed. Starts a smooth weight change from the pool's current weights to
+// the target weights over the duration, replacing any ongoing weight change.
+// The change starts a fixed delay after the message is executed, and the
+// duration must be at least a minimum weight change duration.
+type MsgUpdateBalancerPoolWeights struct {
+	Sender            string        `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID            uint64        `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	TargetPoolWeights []PoolAsset   `protobuf:"bytes,3,rep,name=target_pool_weights,json=targetPoolWeights,proto3" json:"target_pool_weights" yaml:"target_pool_weights"`
+	Duration          time.Duration `protobuf:"bytes,4,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
+}
+
+func (m *MsgUpdateBalancerPoolWeights) Reset()         { *m = MsgUpdateBalancerPoolWeights{} }
+func (m *MsgUpdateBalancerPoolWeights) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateBalancerPoolWeights) ProtoMessage()    {}
+func (*MsgUpdateBalancerPoolWeights) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0647ee155de97433, []int{2}
+}
+func (m *MsgUpdateBalancerPoolWeights) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateBalancerPoolWeights) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBalancerPoolWeights.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateBalancerPoolWeights) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBalancerPoolWeights.Merge(m, src)
+}
+func (m *MsgUpdateBalancerPoolWeights) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateBalancerPoolWeights) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBalancerPoolWeights.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateBalancerPoolWeights proto.InternalMessageInfo
+
+func (m *MsgUpdateBalancerPoolWeights) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgUpdateBalancerPoolWeights) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+func (m *MsgUpdateBalancerPoolWeights) GetTargetPoolWeights() []PoolAsset {
+	if m != nil {
+		return m.TargetPoolWeights
+	}
+	return nil
+}
+
+func (m *MsgUpdateBalancerPoolWeights) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+type MsgUpdateBalancerPoolWeightsResponse struct {
+}
+
+func (m *MsgUpdateBalancerPoolWeightsResponse) Reset()         { *m = MsgUpdateBalancerPoolWeightsResponse{} }
+func (m *MsgUpdateBalancerPoolWeightsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateBalancerPoolWeightsResponse) ProtoMessage()    {}
+func (*MsgUpdateBalancerPoolWeightsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0647ee155de97433, []int{3}
+}
+func (m *MsgUpdateBalancerPoolWeightsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateBalancerPoolWeightsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBalancerPoolWeightsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateBalancerPoolWeightsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBalancerPoolWeightsResponse.Merge(m, src)
+}
+func (m *MsgUpdateBalancerPoolWeightsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateBalancerPoolWeightsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBalancerPoolWeightsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateBalancerPoolWeightsResponse proto.InternalMessageInfo
+
+// ===================== MsgAddBalancerPoolAsset
+// Sender must be the pool's future_pool_governor in order for the tx to
+// succeed. Deposits the pool asset's token into the pool at the weight that
+// prices it at its arithmetic twap in price_denom, an asset already in the
+// pool, over the AddedPoolAssetTwapWindow in the reference pool, and mints
+// the sender the pool shares the deposit is worth at that price. The spot
+// prices between the existing assets are unchanged. If the pool asset's
+// weight differs from this initial weight, the asset is then phased in to its
+// weight over the duration, like MsgUpdateBalancerPoolWeights.
+type MsgAddBalancerPoolAsset struct {
+	Sender          string        `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID          uint64        `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	PoolAsset       PoolAsset     `protobuf:"bytes,3,opt,name=pool_asset,json=poolAsset,proto3" json:"pool_asset" yaml:"pool_asset"`
+	Duration        time.Duration `protobuf:"bytes,4,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
+	PriceDenom      string        `protobuf:"bytes,5,opt,name=price_denom,json=priceDenom,proto3" json:"price_denom,omitempty" yaml:"price_denom"`
+	ReferencePoolID uint64        `protobuf:"varint,6,opt,name=reference_pool_id,json=referencePoolId,proto3" json:"reference_pool_id,omitempty" yaml:"reference_pool_id"`
+}
+
+func (m *MsgAddBalancerPoolAsset) Reset()         { *m = MsgAddBalancerPoolAsset{} }
+func (m *MsgAddBalancerPoolAsset) String() string { return proto.CompactTextString(m) }
+func (*MsgAddBalancerPoolAsset) ProtoMessage()    {}
+func (*MsgAddBalancerPoolAsset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0647ee155de97433, []int{4}
+}
+func (m *MsgAddBalancerPoolAsset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddBalancerPoolAsset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddBalancerPoolAsset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddBalancerPoolAsset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddBalancerPoolAsset.Merge(m, src)
+}
+func (m *MsgAddBalancerPoolAsset) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddBalancerPoolAsset) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddBalancerPoolAsset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddBalancerPoolAsset proto.InternalMessageInfo
+
+func (m *MsgAddBalancerPoolAsset) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgAddBalancerPoolAsset) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+func (m *MsgAddBalancerPoolAsset) GetPoolAsset() PoolAsset {
+	if m != nil {
+		return m.PoolAsset
+	}
+	return PoolAsset{}
+}
+
+func (m *MsgAddBalancerPoolAsset) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *MsgAddBalancerPoolAsset) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
+	}
+	return ""
+}
+
+func (m *MsgAddBalancerPoolAsset) GetReferencePoolID() uint64 {
+	if m != nil {
+		return m.ReferencePoolID
+	}
+	return 0
+}
+
+type MsgAddBalancerPoolAssetResponse struct {
+	ShareOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=share_out_amount,json=shareOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"share_out_amount" yaml:"share_out_amount"`
+}
+
+func (m *MsgAddBalancerPoolAssetResponse) Reset()         { *m = MsgAddBalancerPoolAssetResponse{} }
+func (m *MsgAddBalancerPoolAssetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddBalancerPoolAssetResponse) ProtoMessage()    {}
+func (*MsgAddBalancerPoolAssetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0647ee155de97433, []int{5}
+}
+func (m *MsgAddBalancerPoolAssetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddBalancerPoolAssetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddBalancerPoolAssetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddBalancerPoolAssetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddBalancerPoolAssetResponse.Merge(m, src)
+}
+func (m *MsgAddBalancerPoolAssetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddBalancerPoolAssetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddBalancerPoolAssetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddBalancerPoolAssetResponse proto.InternalMessageInfo
+
+// ===================== MsgRemoveBalancerPoolAsset
+// Sender must be the pool's future_pool_governor in order for the tx to
+// succeed. Removes a phased out asset, whose weight has been brought close to
+// zero through MsgUpdateBalancerPoolWeights, from the pool. The sender
+// receives the remaining balance of the asset in exchange for the pool
+// shares it is worth.
+type MsgRemoveBalancerPoolAsset struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Denom  string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *MsgRemoveBalancerPoolAsset) Reset()         { *m = MsgRemoveBalancerPoolAsset{} }
+func (m *MsgRemoveBalancerPoolAsset) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveBalancerPoolAsset) ProtoMessage()    {}
+func (*MsgRemoveBalancerPoolAsset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0647ee155de97433, []int{6}
+}
+func (m *MsgRemoveBalancerPoolAsset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveBalancerPoolAsset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveBalancerPoolAsset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveBalancerPoolAsset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveBalancerPoolAsset.Merge(m, src)
+}
+func (m *MsgRemoveBalancerPoolAsset) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveBalancerPoolAsset) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveBalancerPoolAsset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveBalancerPoolAsset proto.InternalMessageInfo
+
+func (m *MsgRemoveBalancerPoolAsset) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRemoveBalancerPoolAsset) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+func (m *MsgRemoveBalancerPoolAsset) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type MsgRemoveBalancerPoolAssetResponse struct {
+	TokenOut      types1.Coin                            `protobuf:"bytes,1,opt,name=token_out,json=tokenOut,proto3" json:"token_out" yaml:"token_out"`
+	ShareInAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=share_in_amount,json=shareInAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"share_in_amount" yaml:"share_in_amount"`
+}
+
+func (m *MsgRemoveBalancerPoolAssetResponse) Reset()         { *m = MsgRemoveBalancerPoolAssetResponse{} }
+func (m *MsgRemoveBalancerPoolAssetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveBalancerPoolAssetResponse) ProtoMessage()    {}
+func (*MsgRemoveBalancerPoolAssetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0647ee155de97433, []int{7}
+}
+func (m *MsgRemoveBalancerPoolAssetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveBalancerPoolAssetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveBalancerPoolAssetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveBalancerPoolAssetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveBalancerPoolAssetResponse.Merge(m, src)
+}
+func (m *MsgRemoveBalancerPoolAssetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveBalancerPoolAssetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveBalancerPoolAssetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveBalancerPoolAssetResponse proto.InternalMessageInfo
+
+func (m *MsgRemoveBalancerPoolAssetResponse) GetTokenOut() types1.Coin {
+	if m != nil {
+		return m.TokenOut
+	}
+	return types1.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*MsgCreateBalancerPool)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgCreateBalancerPool")
 	proto.RegisterType((*MsgCreateBalancerPoolResponse)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgCreateBalancerPoolResponse")
+	proto.RegisterType((*MsgUpdateBalancerPoolWeights)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgUpdateBalancerPoolWeights")
+	proto.RegisterType((*MsgUpdateBalancerPoolWeightsResponse)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgUpdateBalancerPoolWeightsResponse")
+	proto.RegisterType((*MsgAddBalancerPoolAsset)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgAddBalancerPoolAsset")
+	proto.RegisterType((*MsgAddBalancerPoolAssetResponse)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgAddBalancerPoolAssetResponse")
+	proto.RegisterType((*MsgRemoveBalancerPoolAsset)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgRemoveBalancerPoolAsset")
+	proto.RegisterType((*MsgRemoveBalancerPoolAssetResponse)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgRemoveBalancerPoolAssetResponse")
 }
 
 func init() {
@@ -152,34 +515,65 @@ func init() {
 }
 
 var fileDescriptor_0647ee155de97433 = []byte{
-	// 427 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
-	0x18, 0x80, 0xeb, 0xb5, 0x0a, 0xc2, 0x15, 0x07, 0xac, 0x81, 0xa2, 0x22, 0x92, 0x28, 0x5c, 0xc2,
-	0xa1, 0xb6, 0xda, 0x71, 0xe2, 0x32, 0x11, 0x26, 0xa6, 0x1d, 0x26, 0x8d, 0xdc, 0xc6, 0x65, 0x72,
-	0x16, 0x13, 0x2a, 0x25, 0x71, 0x64, 0xbb, 0x55, 0x79, 0x0b, 0x9e, 0x80, 0x23, 0xcf, 0xc0, 0x23,
-	0xec, 0xb8, 0x23, 0xa7, 0x08, 0xa5, 0x6f, 0xd0, 0x27, 0x40, 0xb6, 0x13, 0x34, 0xa4, 0x4c, 0x20,
-	0x71, 0x73, 0x7e, 0x7f, 0xff, 0xf7, 0xff, 0xbf, 0xf3, 0xc3, 0x39, 0x97, 0x25, 0x97, 0x2b, 0x49,
-	0x72, 0x5a, 0x96, 0xa4, 0xe6, 0xbc, 0x98, 0x97, 0x3c, 0x63, 0x85, 0x24, 0x29, 0x2d, 0x68, 0x75,
-	0xcd, 0x04, 0x51, 0x5b, 0xa2, 0xb6, 0xb8, 0x16, 0x5c, 0x71, 0x14, 0x75, 0x38, 0xd6, 0x38, 0xd6,
-	0xb8, 0xa5, 0x71, 0x4f, 0xe3, 0xcd, 0x22, 0x65, 0x8a, 0x2e, 0x66, 0x87, 0x39, 0xcf, 0xb9, 0x49,
-	0x22, 0xfa, 0x64, 0xf3, 0x67, 0xaf, 0xfe, 0x5e, 0xae, 0x3f, 0x5c, 0x70, 0x5e, 0xd8, 0xac, 0xf0,
-	0xfb, 0x01, 0x7c, 0x72, 0x2e, 0xf3, 0xb7, 0x82, 0x51, 0xc5, 0xe2, 0x3b, 0xf7, 0xe8, 0x25, 0x74,
-	0x24, 0xab, 0x32, 0x26, 0x5c, 0x10, 0x80, 0xe8, 0x61, 0xfc, 0x78, 0xdf, 0xf8, 0x8f, 0x3e, 0xd3,
-	0xb2, 0x78, 0x1d, 0xda, 0x78, 0x98, 0x74, 0x00, 0xba, 0x84, 0x53, 0x5d, 0xef, 0xaa, 0xa6, 0x82,
-	0x96, 0xd2, 0x3d, 0x08, 0x40, 0x34, 0x5d, 0x06, 0xf8, 0x8f, 0x81, 0xba, 0xe6, 0xb1, 0x76, 0x5f,
-	0x18, 0x2e, 0x7e, 0xba, 0x6f, 0x7c, 0x64, 0x8d, 0x77, 0xd2, 0xc3, 0x04, 0xd6, 0xbf, 0x19, 0xf4,
-	0xae, 0x53, 0x53, 0x29, 0x99, 0x92, 0xee, 0x38, 0x18, 0x47, 0xd3, 0xa5, 0x7f, 0xbf, 0xfa, 0x8d,
-	0xe6, 0xe2, 0xc9, 0x4d, 0xe3, 0x8f, 0xac, 0xc7, 0x04, 0x24, 0x7a, 0x0f, 0x0f, 0x3f, 0xae, 0xd5,
-	0x5a, 0xb0, 0x2b, 0xa3, 0xcb, 0xf9, 0x86, 0x89, 0x8a, 0x0b, 0x77, 0x62, 0x66, 0xf3, 0xf7, 0x8d,
-	0xff, 0xcc, 0x76, 0x32, 0x44, 0x85, 0x09, 0xb2, 0x61, 0x5d, 0xe1, 0xb4, 0x0f, 0x9e, 0xc0, 0xe7,
-	0x83, 0x2f, 0x97, 0x30, 0x59, 0xf3, 0x4a, 0x32, 0xf4, 0x02, 0x3e, 0x30, 0x9a, 0x55, 0x66, 0x9e,
-	0x70, 0x12, 0xc3, 0xb6, 0xf1, 0x1d, 0x8d, 0x9c, 0x9d, 0x24, 0x8e, 0xbe, 0x3a, 0xcb, 0x96, 0xdf,
-	0x00, 0x1c, 0x9f, 0xcb, 0x1c, 0x7d, 0x05, 0x10, 0x0d, 0xfc, 0x85, 0x63, 0xfc, 0xaf, 0x6b, 0x81,
-	0x07, 0x9b, 0x99, 0x9d, 0xfe, 0xa7, 0xa0, 0x9f, 0x26, 0xbe, 0xbc, 0x69, 0x3d, 0x70, 0xdb, 0x7a,
-	0xe0, 0x67, 0xeb, 0x81, 0x2f, 0x3b, 0x6f, 0x74, 0xbb, 0xf3, 0x46, 0x3f, 0x76, 0xde, 0xe8, 0xc3,
-	0x71, 0xbe, 0x52, 0x9f, 0xd6, 0x29, 0xbe, 0xe6, 0x25, 0xe9, 0x8a, 0xcd, 0x0b, 0x9a, 0xca, 0xfe,
-	0x83, 0x6c, 0x16, 0x47, 0x64, 0x7b, 0xff, 0x5e, 0xa6, 0x8e, 0xd9, 0xc5, 0xa3, 0x5f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x09, 0x14, 0x1c, 0x57, 0x32, 0x03, 0x00, 0x00,
+	// 913 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0xef, 0x24, 0x6d, 0xd8, 0x4e, 0x58, 0xda, 0x9a, 0xb2, 0x75, 0xb3, 0x10, 0x47, 0x03, 0xaa,
+	0xca, 0xa1, 0x1e, 0xb5, 0x8b, 0x84, 0xc4, 0x65, 0xd5, 0x6c, 0xb4, 0x4b, 0x24, 0xc2, 0x16, 0x4b,
+	0x08, 0x15, 0x0e, 0x91, 0x13, 0x4f, 0x5d, 0x6b, 0x63, 0x8f, 0xe5, 0x19, 0x97, 0xee, 0xb7, 0xe0,
+	0x80, 0x10, 0x27, 0x4e, 0x5c, 0xe1, 0xb2, 0x07, 0xf8, 0x08, 0x7b, 0xdc, 0x23, 0xe2, 0x60, 0x50,
+	0x7a, 0xe1, 0x9c, 0x4f, 0x80, 0xe6, 0x8f, 0xbd, 0xde, 0x25, 0x66, 0x1b, 0x6d, 0x7b, 0x8a, 0xfd,
+	0xe6, 0xf7, 0x7e, 0xef, 0xbd, 0xdf, 0x7b, 0x6f, 0x1c, 0xb8, 0x47, 0x59, 0x48, 0x59, 0xc0, 0xb0,
+	0xef, 0x86, 0x21, 0x8e, 0x29, 0x9d, 0xec, 0x85, 0xd4, 0x23, 0x13, 0x86, 0x47, 0xee, 0xc4, 0x8d,
+	0xc6, 0x24, 0xc1, 0xfc, 0x1c, 0xf3, 0x73, 0x3b, 0x4e, 0x28, 0xa7, 0xc6, 0xae, 0x86, 0xdb, 0x02,
+	0x6e, 0x0b, 0xb8, 0x42, 0xdb, 0x39, 0xda, 0x3e, 0xdb, 0x1f, 0x11, 0xee, 0xee, 0xb7, 0x36, 0x7d,
+	0xea, 0x53, 0xe9, 0x84, 0xc5, 0x93, 0xf2, 0x6f, 0xb5, 0x7d, 0x4a, 0xfd, 0x09, 0xc1, 0xf2, 0x6d,
+	0x94, 0x9e, 0x60, 0x2f, 0x4d, 0x5c, 0x1e, 0xd0, 0x28, 0x3f, 0x1f, 0xcb, 0x00, 0x78, 0xe4, 0x32,
+	0x82, 0x35, 0x15, 0x1e, 0xd3, 0x20, 0x3f, 0xff, 0xe8, 0xd5, 0xe9, 0xe6, 0x0f, 0x47, 0x94, 0x4e,
+	0x94, 0x17, 0xfa, 0xbd, 0x06, 0xdf, 0x19, 0x30, 0xff, 0x5e, 0x42, 0x5c, 0x4e, 0xba, 0xa5, 0x73,
+	0xe3, 0x43, 0xd8, 0x60, 0x24, 0xf2, 0x48, 0x62, 0x82, 0x0e, 0xd8, 0x5d, 0xed, 0x6e, 0xcc, 0x32,
+	0xeb, 0xe6, 0x63, 0x37, 0x9c, 0x7c, 0x82, 0x94, 0x1d, 0x39, 0x1a, 0x60, 0x1c, 0xc3, 0xa6, 0x88,
+	0x37, 0x8c, 0xdd, 0xc4, 0x0d, 0x99, 0x59, 0xeb, 0x80, 0xdd, 0xe6, 0x41, 0xc7, 0x7e, 0x41, 0x10,
+	0x9d, 0xb1, 0x2d, 0xb8, 0x8f, 0x24, 0xae, 0x7b, 0x6b, 0x96, 0x59, 0x86, 0x62, 0x2c, 0xb9, 0x23,
+	0x07, 0xc6, 0x05, 0xc6, 0xb8, 0xaf, 0xa9, 0x5d, 0xc6, 0x08, 0x67, 0x66, 0xbd, 0x53, 0xdf, 0x6d,
+	0x1e, 0x58, 0xd5, 0xd4, 0x87, 0x02, 0xd7, 0x5d, 0x7e, 0x9a, 0x59, 0x4b, 0x8a, 0x47, 0x1a, 0x98,
+	0xf1, 0x05, 0xdc, 0x3c, 0x49, 0x79, 0x9a, 0x90, 0xa1, 0xa4, 0xf3, 0xe9, 0x19, 0x49, 0x22, 0x9a,
+	0x98, 0xcb, 0xb2, 0x36, 0x6b, 0x96, 0x59, 0xb7, 0x55, 0x26, 0xf3, 0x50, 0xc8, 0x31, 0x94, 0x59,
+	0x44, 0x78, 0x90, 0x1b, 0x7b, 0xf0, 0xbd, 0xb9, 0xca, 0x39, 0x84, 0xc5, 0x34, 0x62, 0xc4, 0x78,
+	0x1f, 0xbe, 0x21, 0x69, 0x02, 0x4f, 0x4a, 0xb8, 0xdc, 0x85, 0xd3, 0xcc, 0x6a, 0x08, 0x48, 0xbf,
+	0xe7, 0x34, 0xc4, 0x51, 0xdf, 0x43, 0xbf, 0xd5, 0xe0, 0xbb, 0x03, 0xe6, 0x7f, 0x19, 0x7b, 0x2f,
+	0xd1, 0x7c, 0x45, 0x02, 0xff, 0x94, 0xb3, 0x45, 0xfa, 0x50, 0x0a, 0x58, 0xab, 0x0a, 0x68, 0x30,
+	0xf8, 0x36, 0x77, 0x13, 0x9f, 0x70, 0x55, 0xe3, 0xb7, 0x2a, 0xcc, 0x65, 0x95, 0x45, 0x42, 0xd9,
+	0x59, 0x66, 0xb5, 0x54, 0x06, 0x73, 0x98, 0x90, 0xb3, 0xa1, 0xac, 0xe5, 0x22, 0x1c, 0x78, 0x23,
+	0x1f, 0x67, 0x29, 0x79, 0xf3, 0x60, 0xdb, 0x56, 0xf3, 0x6e, 0xe7, 0xf3, 0x6e, 0xf7, 0x34, 0xa0,
+	0x7b, 0x5b, 0xc7, 0x58, 0x53, 0x31, 0x72, 0x47, 0xf4, 0xe3, 0x5f, 0x16, 0x70, 0x0a, 0x1e, 0xb4,
+	0x03, 0x3f, 0xf8, 0x3f, 0xe1, 0xf2, 0x36, 0xa0, 0x5f, 0xeb, 0x70, 0x6b, 0xc0, 0xfc, 0x43, 0xcf,
+	0x2b, 0xa3, 0x64, 0x39, 0x57, 0x2e, 0xee, 0x31, 0x84, 0xcf, 0xc7, 0xd5, 0xac, 0x77, 0xc0, 0x65,
+	0x34, 0xdd, 0xd6, 0xf5, 0x6e, 0x94, 0x76, 0x41, 0x12, 0x20, 0x67, 0xb5, 0x18, 0xe1, 0xeb, 0x90,
+	0xd0, 0xf8, 0x18, 0x36, 0xe3, 0x24, 0x18, 0x93, 0xa1, 0x47, 0x22, 0x1a, 0x9a, 0x2b, 0x52, 0x83,
+	0xf2, 0x5a, 0x3e, 0x3f, 0x14, 0x6b, 0x29, 0xde, 0x7a, 0xe2, 0xc5, 0xf8, 0x06, 0x6e, 0x24, 0xe4,
+	0x84, 0x24, 0x24, 0x1a, 0xeb, 0x5d, 0x09, 0x3c, 0xb3, 0x21, 0x65, 0xc1, 0xd3, 0xcc, 0x5a, 0x73,
+	0xf2, 0x43, 0xa5, 0xcf, 0x2c, 0xb3, 0x4c, 0xc5, 0xf8, 0x1f, 0x2f, 0xe4, 0xac, 0x25, 0x2f, 0x80,
+	0x3d, 0xf4, 0x03, 0x80, 0x56, 0x45, 0xc3, 0x8a, 0xdd, 0x62, 0x70, 0x9d, 0x9d, 0xba, 0x09, 0x19,
+	0xd2, 0x94, 0x0f, 0xdd, 0x90, 0xa6, 0x11, 0xd7, 0x2d, 0xec, 0x8b, 0xd2, 0xff, 0xcc, 0xac, 0x1d,
+	0x3f, 0xe0, 0xa7, 0xe9, 0xc8, 0x1e, 0xd3, 0x10, 0xeb, 0xab, 0x53, 0xfd, 0xec, 0x31, 0xef, 0x11,
+	0xe6, 0x8f, 0x63, 0xc2, 0xec, 0x7e, 0xc4, 0x67, 0x99, 0xb5, 0xa5, 0x1b, 0xfe, 0x12, 0x1f, 0x72,
+	0xde, 0x92, 0xa6, 0x87, 0x29, 0x3f, 0x54, 0x86, 0xef, 0x01, 0x6c, 0x0d, 0x98, 0xef, 0x90, 0x90,
+	0x9e, 0x91, 0xeb, 0x1f, 0xa6, 0x1d, 0xb8, 0xa2, 0xfa, 0x52, 0x97, 0x74, 0xeb, 0xb3, 0xcc, 0x7a,
+	0x53, 0xf7, 0x53, 0x75, 0x44, 0x1d, 0xa3, 0x7f, 0x00, 0x44, 0xd5, 0x69, 0x15, 0x92, 0x1d, 0xc1,
+	0x55, 0x4e, 0x1f, 0x91, 0x48, 0x94, 0x68, 0x02, 0x3d, 0x41, 0x4a, 0x12, 0x5b, 0x7c, 0x54, 0x8a,
+	0xc9, 0xbc, 0x47, 0x83, 0xa8, 0x6b, 0xea, 0x09, 0x5a, 0xd7, 0x8b, 0x9e, 0x7b, 0x22, 0xe7, 0x86,
+	0x7c, 0x7e, 0x98, 0x72, 0x23, 0x86, 0x6b, 0x4a, 0xb4, 0x20, 0xca, 0x7b, 0x50, 0x93, 0xa9, 0x7e,
+	0xba, 0x70, 0x0f, 0x6e, 0x95, 0x7b, 0x50, 0xd0, 0x21, 0xe7, 0xa6, 0xb4, 0xf4, 0x23, 0xd5, 0x81,
+	0x83, 0x27, 0x2b, 0xb0, 0x3e, 0x60, 0xbe, 0xf1, 0x13, 0x80, 0xc6, 0x9c, 0x6f, 0xd6, 0x5d, 0xfb,
+	0xb2, 0x1f, 0x61, 0x7b, 0xee, 0xd5, 0xdd, 0x7a, 0xf0, 0x9a, 0x04, 0x85, 0xd8, 0x4f, 0x00, 0xdc,
+	0xae, 0xbe, 0xd3, 0xef, 0x2f, 0x14, 0xa6, 0x92, 0xa7, 0xf5, 0xf9, 0xd5, 0xf0, 0x14, 0x59, 0xff,
+	0x0c, 0xe0, 0xe6, 0xdc, 0x7b, 0xf2, 0x70, 0xa1, 0x40, 0xf3, 0x28, 0x5a, 0xfd, 0xd7, 0xa6, 0x28,
+	0xd2, 0xfc, 0x05, 0xc0, 0xad, 0xaa, 0x25, 0xec, 0x2d, 0x14, 0xa6, 0x82, 0xa5, 0xf5, 0xd9, 0x55,
+	0xb0, 0xe4, 0xf9, 0x76, 0x8f, 0x9f, 0x4e, 0xdb, 0xe0, 0xd9, 0xb4, 0x0d, 0xfe, 0x9e, 0xb6, 0xc1,
+	0x77, 0x17, 0xed, 0xa5, 0x67, 0x17, 0xed, 0xa5, 0x3f, 0x2e, 0xda, 0x4b, 0x5f, 0xdf, 0x2d, 0x2d,
+	0x88, 0x8e, 0xb8, 0x37, 0x71, 0x47, 0x2c, 0x7f, 0xc1, 0x67, 0xfb, 0x77, 0xf0, 0x79, 0xf5, 0x5f,
+	0xba, 0x51, 0x43, 0xde, 0xfd, 0x77, 0xfe, 0x1d, 0x00, 0xc9, 0xfd, 0x48, 0x8c, 0xad, 0x0a, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -195,6 +589,9 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
 	CreateBalancerPool(ctx context.Context, in *MsgCreateBalancerPool, opts ...grpc.CallOption) (*MsgCreateBalancerPoolResponse, error)
+	UpdateBalancerPoolWeights(ctx context.Context, in *MsgUpdateBalancerPoolWeights, opts ...grpc.CallOption) (*MsgUpdateBalancerPoolWeightsResponse, error)
+	AddBalancerPoolAsset(ctx context.Context, in *MsgAddBalancerPoolAsset, opts ...grpc.CallOption) (*MsgAddBalancerPoolAssetResponse, error)
+	RemoveBalancerPoolAsset(ctx context.Context, in *MsgRemoveBalancerPoolAsset, opts ...grpc.CallOption) (*MsgRemoveBalancerPoolAssetResponse, error)
 }
 
 type msgClient struct {
@@ -214,9 +611,39 @@ func (c *msgClient) CreateBalancerPool(ctx context.Context, in *MsgCreateBalance
 	return out, nil
 }
 
+func (c *msgClient) UpdateBalancerPoolWeights(ctx context.Context, in *MsgUpdateBalancerPoolWeights, opts ...grpc.CallOption) (*MsgUpdateBalancerPoolWeightsResponse, error) {
+	out := new(MsgUpdateBalancerPoolWeightsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.balancer.v1beta1.Msg/UpdateBalancerPoolWeights", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddBalancerPoolAsset(ctx context.Context, in *MsgAddBalancerPoolAsset, opts ...grpc.CallOption) (*MsgAddBalancerPoolAssetResponse, error) {
+	out := new(MsgAddBalancerPoolAssetResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.balancer.v1beta1.Msg/AddBalancerPoolAsset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveBalancerPoolAsset(ctx context.Context, in *MsgRemoveBalancerPoolAsset, opts ...grpc.CallOption) (*MsgRemoveBalancerPoolAssetResponse, error) {
+	out := new(MsgRemoveBalancerPoolAssetResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.balancer.v1beta1.Msg/RemoveBalancerPoolAsset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateBalancerPool(context.Context, *MsgCreateBalancerPool) (*MsgCreateBalancerPoolResponse, error)
+	UpdateBalancerPoolWeights(context.Context, *MsgUpdateBalancerPoolWeights) (*MsgUpdateBalancerPoolWeightsResponse, error)
+	AddBalancerPoolAsset(context.Context, *MsgAddBalancerPoolAsset) (*MsgAddBalancerPoolAssetResponse, error)
+	RemoveBalancerPoolAsset(context.Context, *MsgRemoveBalancerPoolAsset) (*MsgRemoveBalancerPoolAssetResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -226,6 +653,15 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) CreateBalancerPool(ctx context.Context, req *MsgCreateBalancerPool) (*MsgCreateBalancerPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBalancerPool not implemented")
 }
+func (*UnimplementedMsgServer) UpdateBalancerPoolWeights(ctx context.Context, req *MsgUpdateBalancerPoolWeights) (*MsgUpdateBalancerPoolWeightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBalancerPoolWeights not implemented")
+}
+func (*UnimplementedMsgServer) AddBalancerPoolAsset(ctx context.Context, req *MsgAddBalancerPoolAsset) (*MsgAddBalancerPoolAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBalancerPoolAsset not implemented")
+}
+func (*UnimplementedMsgServer) RemoveBalancerPoolAsset(ctx context.Context, req *MsgRemoveBalancerPoolAsset) (*MsgRemoveBalancerPoolAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBalancerPoolAsset not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -249,36 +685,102 @@ func _Msg_CreateBalancerPool_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.gamm.poolmodels.balancer.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateBalancerPool",
-			Handler:    _Msg_CreateBalancerPool_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "osmosis/gamm/pool-models/balancer/tx/tx.proto",
-}
-
-func (m *MsgCreateBalancerPool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateBalancerPoolWeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBalancerPoolWeights)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCreateBalancerPool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBalancerPoolWeights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.balancer.v1beta1.Msg/UpdateBalancerPoolWeights",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBalancerPoolWeights(ctx, req.(*MsgUpdateBalancerPoolWeights))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateBalancerPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
+func _Msg_AddBalancerPoolAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddBalancerPoolAsset)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddBalancerPoolAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.balancer.v1beta1.Msg/AddBalancerPoolAsset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddBalancerPoolAsset(ctx, req.(*MsgAddBalancerPoolAsset))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveBalancerPoolAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveBalancerPoolAsset)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveBalancerPoolAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.balancer.v1beta1.Msg/RemoveBalancerPoolAsset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveBalancerPoolAsset(ctx, req.(*MsgRemoveBalancerPoolAsset))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.gamm.poolmodels.balancer.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateBalancerPool",
+			Handler:    _Msg_CreateBalancerPool_Handler,
+		},
+		{
+			MethodName: "UpdateBalancerPoolWeights",
+			Handler:    _Msg_UpdateBalancerPoolWeights_Handler,
+		},
+		{
+			MethodName: "AddBalancerPoolAsset",
+			Handler:    _Msg_AddBalancerPoolAsset_Handler,
+		},
+		{
+			MethodName: "RemoveBalancerPoolAsset",
+			Handler:    _Msg_RemoveBalancerPoolAsset_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/gamm/pool-models/balancer/tx/tx.proto",
+}
+
+func (m *MsgCreateBalancerPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateBalancerPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateBalancerPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
@@ -353,6 +855,269 @@ func (m *MsgCreateBalancerPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateBalancerPoolWeights) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBalancerPoolWeights) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBalancerPoolWeights) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if len(m.TargetPoolWeights) > 0 {
+		for iNdEx := len(m.TargetPoolWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TargetPoolWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateBalancerPoolWeightsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBalancerPoolWeightsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBalancerPoolWeightsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddBalancerPoolAsset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddBalancerPoolAsset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddBalancerPoolAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReferencePoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReferencePoolID))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.PoolAsset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddBalancerPoolAssetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddBalancerPoolAssetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddBalancerPoolAssetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ShareOutAmount.Size()
+		i -= size
+		if _, err := m.ShareOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveBalancerPoolAsset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveBalancerPoolAsset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveBalancerPoolAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveBalancerPoolAssetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveBalancerPoolAssetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveBalancerPoolAssetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ShareInAmount.Size()
+		i -= size
+		if _, err := m.ShareInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -384,32 +1149,825 @@ func (m *MsgCreateBalancerPool) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	l = len(m.FuturePoolGovernor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
+	l = len(m.FuturePoolGovernor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateBalancerPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	return n
+}
+
+func (m *MsgUpdateBalancerPoolWeights) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	if len(m.TargetPoolWeights) > 0 {
+		for _, e := range m.TargetPoolWeights {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateBalancerPoolWeightsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddBalancerPoolAsset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	l = m.PoolAsset.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ReferencePoolID != 0 {
+		n += 1 + sovTx(uint64(m.ReferencePoolID))
+	}
+	return n
+}
+
+func (m *MsgAddBalancerPoolAssetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ShareOutAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRemoveBalancerPoolAsset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveBalancerPoolAssetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ShareInAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateBalancerPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBalancerPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBalancerPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PoolParams == nil {
+				m.PoolParams = &PoolParams{}
+			}
+			if err := m.PoolParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolAssets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolAssets = append(m.PoolAssets, PoolAsset{})
+			if err := m.PoolAssets[len(m.PoolAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FuturePoolGovernor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FuturePoolGovernor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateBalancerPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBalancerPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBalancerPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateBalancerPoolWeights) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateBalancerPoolWeights: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateBalancerPoolWeights: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPoolWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetPoolWeights = append(m.TargetPoolWeights, PoolAsset{})
+			if err := m.TargetPoolWeights[len(m.TargetPoolWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateBalancerPoolWeightsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateBalancerPoolWeightsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateBalancerPoolWeightsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddBalancerPoolAsset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddBalancerPoolAsset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddBalancerPoolAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferencePoolID", wireType)
+			}
+			m.ReferencePoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReferencePoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgCreateBalancerPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolID != 0 {
-		n += 1 + sovTx(uint64(m.PoolID))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateBalancerPool) Unmarshal(dAtA []byte) error {
+func (m *MsgAddBalancerPoolAssetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -432,15 +1990,15 @@ func (m *MsgCreateBalancerPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateBalancerPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddBalancerPoolAssetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateBalancerPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddBalancerPoolAssetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -468,13 +2026,65 @@ func (m *MsgCreateBalancerPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveBalancerPoolAsset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveBalancerPoolAsset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveBalancerPoolAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -484,33 +2094,29 @@ func (m *MsgCreateBalancerPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PoolParams == nil {
-				m.PoolParams = &PoolParams{}
-			}
-			if err := m.PoolParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolAssets", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
 			}
-			var msglen int
+			m.PoolID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -520,29 +2126,14 @@ func (m *MsgCreateBalancerPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PoolID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PoolAssets = append(m.PoolAssets, PoolAsset{})
-			if err := m.PoolAssets[len(m.PoolAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FuturePoolGovernor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -570,7 +2161,7 @@ func (m *MsgCreateBalancerPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FuturePoolGovernor = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -593,7 +2184,7 @@ func (m *MsgCreateBalancerPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateBalancerPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveBalancerPoolAssetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -616,17 +2207,17 @@ func (m *MsgCreateBalancerPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateBalancerPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveBalancerPoolAssetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateBalancerPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveBalancerPoolAssetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
 			}
-			m.PoolID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -636,11 +2227,59 @@ func (m *MsgCreateBalancerPoolResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareInAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
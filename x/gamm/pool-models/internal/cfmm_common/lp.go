@@ -128,8 +128,8 @@ func BinarySearchSingleAssetJoin(
 	tokenIn sdk.Coin,
 	poolWithAddedLiquidityAndShares func(newLiquidity sdk.Coin, newShares sdk.Int) types.CFMMPoolI,
 ) (numLPShares sdk.Int, err error) {
-	// use dummy context
-	ctx := sdk.Context{}
+	// use dummy context, whose gas meter discards the gas of the estimation swaps
+	ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
 	// should be guaranteed to converge if above 256 since sdk.Int has 256 bits
 	maxIterations := 300
 	// upperbound of number of LP shares = existingShares * tokenIn.Amount / pool.totalLiquidity.AmountOf(tokenIn.Denom)
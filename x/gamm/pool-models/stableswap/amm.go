@@ -75,12 +75,18 @@ func cfmmConstantMulti(xReserve, yReserve, u, v osmomath.BigDec) osmomath.BigDec
 // So we solve the following expression for `a`:
 // xy(x^2 + y^2 + w) = (x - a)(y + b)((x - a)^2 + (y + b)^2 + w)
 // with w set to 0 for 2 asset pools
-func solveCfmm(xReserve, yReserve osmomath.BigDec, remReserves []osmomath.BigDec, yIn osmomath.BigDec) osmomath.BigDec {
+// It also returns the number of solver iterations used, which swaps pay gas for.
+func solveCfmm(xReserve, yReserve osmomath.BigDec, remReserves []osmomath.BigDec, yIn osmomath.BigDec) (osmomath.BigDec, int) {
 	wSumSquares := osmomath.ZeroDec()
 	for _, assetReserve := range remReserves {
 		wSumSquares = wSumSquares.Add(assetReserve.Mul(assetReserve))
 	}
-	return solveCFMMBinarySearchMulti(xReserve, yReserve, wSumSquares, yIn)
+	return solveCFMMNewtonMultiWithIterations(xReserve, yReserve, wSumSquares, yIn)
+}
+
+// consumeSolverGas consumes StableswapGasFeePerSolverIteration gas per iteration of the CFMM solver in a swap.
+func consumeSolverGas(ctx sdk.Context, iterations int) {
+	ctx.GasMeter().ConsumeGas(uint64(iterations)*types.StableswapGasFeePerSolverIteration, "stableswap swap computation")
 }
 
 // solidly CFMM is xy(x^2 + y^2) = k
@@ -314,6 +320,13 @@ func deriveUpperLowerXFinalReserveBounds(xReserve, yReserve, wSumSquares, yFinal
 
 // solveCFMMBinarySearch searches the correct dx using binary search over constant K.
 func solveCFMMBinarySearchMulti(xReserve, yReserve, wSumSquares, yIn osmomath.BigDec) osmomath.BigDec {
+	xOut, _ := solveCFMMBinarySearchMultiWithIterations(xReserve, yReserve, wSumSquares, yIn)
+	return xOut
+}
+
+// solveCFMMBinarySearchMultiWithIterations is solveCFMMBinarySearchMulti, also returning the number of
+// evaluations of K used by the binary search.
+func solveCFMMBinarySearchMultiWithIterations(xReserve, yReserve, wSumSquares, yIn osmomath.BigDec) (osmomath.BigDec, int) {
 	if !xReserve.IsPositive() || !yReserve.IsPositive() || wSumSquares.IsNegative() {
 		panic("invalid input: reserves and input must be positive")
 	} else if yIn.Abs().GTE(yReserve) {
@@ -323,7 +336,12 @@ func solveCFMMBinarySearchMulti(xReserve, yReserve, wSumSquares, yIn osmomath.Bi
 	yFinal := yReserve.Add(yIn)
 	xLowEst, xHighEst := deriveUpperLowerXFinalReserveBounds(xReserve, yReserve, wSumSquares, yFinal)
 	targetK := targetKCalculator(xReserve, yReserve, wSumSquares, yFinal)
-	iterKCalc := iterKCalculator(xReserve, wSumSquares, yFinal)
+	kCalc := iterKCalculator(xReserve, wSumSquares, yFinal)
+	iterations := 0
+	iterKCalc := func(xEst osmomath.BigDec) (osmomath.BigDec, error) {
+		iterations++
+		return kCalc(xEst)
+	}
 	maxIterations := 256
 
 	// we use a geometric error tolerance that guarantees approximately 10^-12 precision on outputs
@@ -354,9 +372,71 @@ func solveCFMMBinarySearchMulti(xReserve, yReserve, wSumSquares, yIn osmomath.Bi
 	if xOut.Abs().GTE(xReserve) {
 		panic("invalid output: greater than full pool reserves")
 	}
+	return xOut, iterations
+}
+
+var (
+	// newtonMaxIterations bounds the iterations of solveCFMMNewtonMulti.
+	// Swaps within the pool's reserves converge in under 10 iterations, see TestSolveCFMMNewtonMultiErrorBounds.
+	newtonMaxIterations = 256
+	// newtonMultiplicativeTolerance is the size of the final Newton step relative to x', at which we stop iterating.
+	newtonMultiplicativeTolerance = osmomath.NewDecWithPrec(1, 24)
+)
+
+// solveCFMMNewtonMulti solves the multi-asset CFMM for the amount of x out, given the amount of y in,
+// using Newton's method on f(x') = x' y' (x'^2 + y'^2 + w) - k, where y' = y + yIn and k = xy(x^2 + y^2 + w).
+// This works for any number of assets, as all reserves other than x and y are folded into w.
+//
+// f is increasing and convex for x' > 0, so starting from an upperbound of x' every Newton step stays above
+// the root and converges to it monotonically, quadratically once close. Hence x' is always rounded up,
+// which rounds x_out down for yIn > 0 and rounds |x_out| up for yIn < 0, both in favor of the pool.
+// By convexity, the error of x' after a step is lower than the step itself, so stopping once a step is within
+// newtonMultiplicativeTolerance of x' bounds the relative error of x' by the same tolerance.
+func solveCFMMNewtonMulti(xReserve, yReserve, wSumSquares, yIn osmomath.BigDec) osmomath.BigDec {
+	xOut, _ := solveCFMMNewtonMultiWithIterations(xReserve, yReserve, wSumSquares, yIn)
 	return xOut
 }
 
+// solveCFMMNewtonMultiWithIterations is solveCFMMNewtonMulti, also returning the number of Newton iterations used.
+func solveCFMMNewtonMultiWithIterations(xReserve, yReserve, wSumSquares, yIn osmomath.BigDec) (osmomath.BigDec, int) {
+	if !xReserve.IsPositive() || !yReserve.IsPositive() || wSumSquares.IsNegative() {
+		panic("invalid input: reserves and input must be positive")
+	} else if yIn.Abs().GTE(yReserve) {
+		panic("cannot input more than pool reserves")
+	}
+
+	yFinal := yReserve.Add(yIn)
+	k := cfmmConstantMultiNoV(xReserve, yReserve, wSumSquares)
+	// x' = xReserve for yIn >= 0, and the upperbound is a linear extrapolation of k in x' for yIn < 0
+	_, xFinal := deriveUpperLowerXFinalReserveBounds(xReserve, yReserve, wSumSquares, yFinal)
+
+	// f(x') = x' y' (x'^2 + y'^2 + w) - k
+	// f'(x') = y' (3 x'^2 + y'^2 + w)
+	yFinal2PlusW := yFinal.Mul(yFinal).Add(wSumSquares)
+	for i := 1; i <= newtonMaxIterations; i++ {
+		xFinal2 := xFinal.Mul(xFinal)
+		f := xFinal.Mul(yFinal).Mul(xFinal2.Add(yFinal2PlusW)).Sub(k)
+		fPrime := yFinal.Mul(xFinal2.MulInt64(3).Add(yFinal2PlusW))
+
+		// truncate the step, so that rounding never moves x' below the root
+		step := f.QuoTruncate(fPrime)
+		xFinal = xFinal.Sub(step)
+
+		if step.LTE(xFinal.Mul(newtonMultiplicativeTolerance)) {
+			xOut := xReserve.Sub(xFinal)
+			// We check the absolute value of the output against the xReserve amount to ensure that:
+			// 1. Swaps cannot more than double the input token's pool supply
+			// 2. Swaps cannot output more than the output token's pool supply
+			if xOut.Abs().GTE(xReserve) {
+				panic("invalid output: greater than full pool reserves")
+			}
+			return xOut, i
+		}
+	}
+
+	panic("newton's method did not converge")
+}
+
 func (p Pool) spotPrice(baseDenom, quoteDenom string) (spotPrice sdk.Dec, err error) {
 	// Define f_{y -> x}(a) as the function that outputs the amount of tokens X you'd get by
 	// trading "a" units of Y against the pool, assuming 0 swap fee, at the current liquidity.
@@ -374,7 +454,7 @@ func (p Pool) spotPrice(baseDenom, quoteDenom string) (spotPrice sdk.Dec, err er
 	a := sdk.OneInt()
 
 	// We swap quoteDenom and baseDenom intentionally, due to the odd issue needed for balancer v1 query compat
-	res, err := p.calcOutAmtGivenIn(sdk.NewCoin(quoteDenom, a), baseDenom, sdk.ZeroDec())
+	res, _, err := p.calcOutAmtGivenIn(sdk.NewCoin(quoteDenom, a), baseDenom, sdk.ZeroDec())
 	// fmt.Println("spot price res", res)
 	return res, err
 }
@@ -383,52 +463,54 @@ func oneMinus(swapFee sdk.Dec) osmomath.BigDec {
 	return osmomath.BigDecFromSDKDec(sdk.OneDec().Sub(swapFee))
 }
 
-// calcOutAmtGivenIn calculate amount of specified denom to output from a pool in sdk.Dec given the input `tokenIn`
-func (p Pool) calcOutAmtGivenIn(tokenIn sdk.Coin, tokenOutDenom string, swapFee sdk.Dec) (sdk.Dec, error) {
+// calcOutAmtGivenIn calculate amount of specified denom to output from a pool in sdk.Dec given the input `tokenIn`,
+// and the number of solver iterations used
+func (p Pool) calcOutAmtGivenIn(tokenIn sdk.Coin, tokenOutDenom string, swapFee sdk.Dec) (sdk.Dec, int, error) {
 	// round liquidity down, and round token in down
 	reserves, err := p.scaledSortedPoolReserves(tokenIn.Denom, tokenOutDenom, osmomath.RoundDown)
 	if err != nil {
-		return sdk.Dec{}, err
+		return sdk.Dec{}, 0, err
 	}
 	tokenInSupply, tokenOutSupply, remReserves := reserves[0], reserves[1], reserves[2:]
 	tokenInDec, err := p.scaleCoin(tokenIn, osmomath.RoundDown)
 	if err != nil {
-		return sdk.Dec{}, err
+		return sdk.Dec{}, 0, err
 	}
 
 	// amm input = tokenIn * (1 - swap fee)
 	ammIn := tokenInDec.Mul(oneMinus(swapFee))
 	// We are solving for the amount of token out, hence x = tokenOutSupply, y = tokenInSupply
 	// fmt.Printf("outSupply %s, inSupply %s, remReservs %s, ammIn %s\n ", tokenOutSupply, tokenInSupply, remReserves, ammIn)
-	cfmmOut := solveCfmm(tokenOutSupply, tokenInSupply, remReserves, ammIn)
+	cfmmOut, iterations := solveCfmm(tokenOutSupply, tokenInSupply, remReserves, ammIn)
 	// fmt.Println("cfmmout ", cfmmOut)
 	outAmt := p.getDescaledPoolAmt(tokenOutDenom, cfmmOut)
-	return outAmt, nil
+	return outAmt, iterations, nil
 }
 
-// calcInAmtGivenOut calculates exact input amount given the desired output and return as a decimal
-func (p *Pool) calcInAmtGivenOut(tokenOut sdk.Coin, tokenInDenom string, swapFee sdk.Dec) (sdk.Dec, error) {
+// calcInAmtGivenOut calculates exact input amount given the desired output and return as a decimal,
+// and the number of solver iterations used
+func (p *Pool) calcInAmtGivenOut(tokenOut sdk.Coin, tokenInDenom string, swapFee sdk.Dec) (sdk.Dec, int, error) {
 	// round liquidity down, and round token out up
 	reserves, err := p.scaledSortedPoolReserves(tokenInDenom, tokenOut.Denom, osmomath.RoundDown)
 	if err != nil {
-		return sdk.Dec{}, err
+		return sdk.Dec{}, 0, err
 	}
 	tokenInSupply, tokenOutSupply, remReserves := reserves[0], reserves[1], reserves[2:]
 	tokenOutAmount, err := p.scaleCoin(tokenOut, osmomath.RoundUp)
 	if err != nil {
-		return sdk.Dec{}, err
+		return sdk.Dec{}, 0, err
 	}
 
 	// We are solving for the amount of token in, cfmm(x,y) = cfmm(x + x_in, y - y_out)
 	// x = tokenInSupply, y = tokenOutSupply, yIn = -tokenOutAmount
-	cfmmIn := solveCfmm(tokenInSupply, tokenOutSupply, remReserves, tokenOutAmount.Neg())
+	cfmmIn, iterations := solveCfmm(tokenInSupply, tokenOutSupply, remReserves, tokenOutAmount.Neg())
 	// returned cfmmIn is negative, representing we need to add this many tokens to pool.
 	// We invert that negative here.
 	cfmmIn = cfmmIn.Neg()
 	// divide by (1 - swapfee) to force a corresponding increase in input asset
 	inAmt := cfmmIn.QuoRoundUp(oneMinus(swapFee))
 	inCoinAmt := p.getDescaledPoolAmt(tokenInDenom, inAmt)
-	return inCoinAmt, nil
+	return inCoinAmt, iterations, nil
 }
 
 // calcSingleAssetJoinShares calculates the number of LP shares that
@@ -1,12 +1,17 @@
 package stableswap
 
 import (
+	"fmt"
 	"math/rand"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/osmosis-labs/osmosis/v13/osmomath"
 )
 
+var benchmarkNumAssets = []int{2, 3, 4, 8}
+
 func BenchmarkCFMM(b *testing.B) {
 	// Uses solveCfmm
 	for i := 0; i < b.N; i++ {
@@ -20,17 +25,94 @@ func BenchmarkBinarySearchMultiAsset(b *testing.B) {
 	}
 }
 
-func runCalcCFMM(solve func(osmomath.BigDec, osmomath.BigDec, []osmomath.BigDec, osmomath.BigDec) osmomath.BigDec) {
+func BenchmarkNewtonMultiAsset(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		runCalcMultiAsset(solveCFMMNewtonMulti)
+	}
+}
+
+// BenchmarkSolversNAssets compares the solvers on swaps in pools of 2, 3, 4 and 8 assets,
+// reporting the Newton iterations per swap.
+func BenchmarkSolversNAssets(b *testing.B) {
+	for _, numAssets := range benchmarkNumAssets {
+		b.Run(fmt.Sprintf("newton/%d assets", numAssets), func(b *testing.B) {
+			totalIterations := 0
+			for i := 0; i < b.N; i++ {
+				xReserve, yReserve, wSumSquares, yIn := randNAssetSwap(numAssets)
+				_, iterations := solveCFMMNewtonMultiWithIterations(xReserve, yReserve, wSumSquares, yIn)
+				totalIterations += iterations
+			}
+			b.ReportMetric(float64(totalIterations)/float64(b.N), "iterations/op")
+		})
+		b.Run(fmt.Sprintf("binary search/%d assets", numAssets), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				solveCFMMBinarySearchMulti(randNAssetSwap(numAssets))
+			}
+		})
+	}
+}
+
+// BenchmarkSolversGasPerSwap compares the gas a swap consumes for the computation of the Newton solver
+// and of the previous binary search solver, in pools of 2, 3, 4 and 8 assets, reporting the gas per swap.
+func BenchmarkSolversGasPerSwap(b *testing.B) {
+	solvers := []struct {
+		name  string
+		solve func(xReserve, yReserve, wSumSquares, yIn osmomath.BigDec) (osmomath.BigDec, int)
+	}{
+		{name: "newton", solve: solveCFMMNewtonMultiWithIterations},
+		{name: "binary search", solve: solveCFMMBinarySearchMultiWithIterations},
+	}
+	for _, numAssets := range benchmarkNumAssets {
+		for _, solver := range solvers {
+			b.Run(fmt.Sprintf("%s/%d assets", solver.name, numAssets), func(b *testing.B) {
+				ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
+				for i := 0; i < b.N; i++ {
+					_, iterations := solver.solve(randNAssetSwap(numAssets))
+					consumeSolverGas(ctx, iterations)
+				}
+				b.ReportMetric(float64(ctx.GasMeter().GasConsumed())/float64(b.N), "gas/op")
+			})
+		}
+	}
+}
+
+// BenchmarkSwapOutAmtGivenInNAssets benchmarks a full swap, including scaling, in pools of 2, 3, 4 and 8 assets.
+func BenchmarkSwapOutAmtGivenInNAssets(b *testing.B) {
+	for _, numAssets := range benchmarkNumAssets {
+		b.Run(fmt.Sprintf("%d assets", numAssets), func(b *testing.B) {
+			liquidity := sdk.NewCoins()
+			for i := 0; i < numAssets; i++ {
+				liquidity = liquidity.Add(sdk.NewInt64Coin(fmt.Sprintf("asset/%d", i), rand.Int63n(1_000_000_000_000)+1_000_000_000_000))
+			}
+			scalingFactors := make([]uint64, numAssets)
+			for i := range scalingFactors {
+				scalingFactors[i] = 1
+			}
+			pool := poolStructFromAssets(liquidity, scalingFactors)
+			tokenIn := sdk.NewCoins(sdk.NewInt64Coin(liquidity[0].Denom, rand.Int63n(1_000_000_000)+1))
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_, err := pool.CalcOutAmtGivenIn(sdk.Context{}, tokenIn, liquidity[numAssets-1].Denom, defaultSwapFee)
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func runCalcCFMM(solve func(osmomath.BigDec, osmomath.BigDec, []osmomath.BigDec, osmomath.BigDec) (osmomath.BigDec, int)) {
 	xReserve := osmomath.NewBigDec(rand.Int63n(100000) + 50000)
 	yReserve := osmomath.NewBigDec(rand.Int63n(100000) + 50000)
-	yIn := osmomath.NewBigDec(rand.Int63n(100000))
+	yIn := osmomath.NewBigDec(rand.Int63n(50000))
 	solve(xReserve, yReserve, []osmomath.BigDec{}, yIn)
 }
 
 func runCalcTwoAsset(solve func(osmomath.BigDec, osmomath.BigDec, osmomath.BigDec) osmomath.BigDec) {
 	xReserve := osmomath.NewBigDec(rand.Int63n(100000) + 50000)
 	yReserve := osmomath.NewBigDec(rand.Int63n(100000) + 50000)
-	yIn := osmomath.NewBigDec(rand.Int63n(100000))
+	yIn := osmomath.NewBigDec(rand.Int63n(50000))
 	solve(xReserve, yReserve, yIn)
 }
 
@@ -40,6 +122,18 @@ func runCalcMultiAsset(solve func(osmomath.BigDec, osmomath.BigDec, osmomath.Big
 	mReserve := osmomath.NewBigDec(rand.Int63n(100000) + 50000)
 	nReserve := osmomath.NewBigDec(rand.Int63n(100000) + 50000)
 	w := mReserve.Mul(mReserve).Add(nReserve.Mul(nReserve))
-	yIn := osmomath.NewBigDec(rand.Int63n(100000))
+	yIn := osmomath.NewBigDec(rand.Int63n(50000))
 	solve(xReserve, yReserve, w, yIn)
 }
+
+func randNAssetSwap(numAssets int) (xReserve, yReserve, wSumSquares, yIn osmomath.BigDec) {
+	xReserve = osmomath.NewBigDec(rand.Int63n(100000) + 50000)
+	yReserve = osmomath.NewBigDec(rand.Int63n(100000) + 50000)
+	wSumSquares = osmomath.ZeroDec()
+	for i := 2; i < numAssets; i++ {
+		reserve := osmomath.NewBigDec(rand.Int63n(100000) + 50000)
+		wSumSquares = wSumSquares.Add(reserve.Mul(reserve))
+	}
+	yIn = osmomath.NewBigDec(rand.Int63n(50000))
+	return xReserve, yReserve, wSumSquares, yIn
+}
@@ -421,7 +421,7 @@ func TestCFMMInvariantTwoAssets(t *testing.T) {
 
 				// using two-asset cfmm
 				k0 := cfmmConstant(test.xReserve, test.yReserve)
-				xOut := solveCfmm(test.xReserve, test.yReserve, test.remReserves, test.yIn)
+				xOut, _ := solveCfmm(test.xReserve, test.yReserve, test.remReserves, test.yIn)
 
 				k1 := cfmmConstant(test.xReserve.Sub(xOut), test.yReserve.Add(test.yIn))
 				osmomath.DecApproxEq(t, k0, k1, kErrTolerance)
@@ -468,7 +468,7 @@ func TestCFMMInvariantMultiAssets(t *testing.T) {
 
 				// using multi-asset cfmm
 				k2 := cfmmConstantMulti(test.xReserve, test.yReserve, uReserve, wSumSquares)
-				xOut2 := solveCfmm(test.xReserve, test.yReserve, test.remReserves, test.yIn)
+				xOut2, _ := solveCfmm(test.xReserve, test.yReserve, test.remReserves, test.yIn)
 				k3 := cfmmConstantMulti(test.xReserve.Sub(xOut2), test.yReserve.Add(test.yIn), uReserve, wSumSquares)
 				osmomath.DecApproxEq(t, k2, k3, kErrTolerance)
 			}
@@ -524,6 +524,76 @@ func TestCFMMInvariantMultiAssetsBinarySearch(t *testing.T) {
 	}
 }
 
+// TestSolveCFMMNewtonMultiErrorBounds checks the error bounds of the Newton solver against the binary search solver
+// on random pools of 2 to 8 assets, for swaps both into and out of the pool:
+// - x' is within newtonMultiplicativeTolerance of the root, so it agrees with the binary search up to the
+// binary search's tolerance of 10^-12 on x'.
+// - x' is rounded up, so the CFMM constant never decreases after the swap.
+// - the iteration count stays far below newtonMaxIterations.
+func TestSolveCFMMNewtonMultiErrorBounds(t *testing.T) {
+	binarySearchTolerance := osmomath.NewDecWithPrec(1, 12)
+	maxExpectedIterations := 10
+	r := rand.New(rand.NewSource(1))
+
+	randReserve := func() osmomath.BigDec {
+		// reserves between 10^6 and 10^18
+		return osmomath.NewBigDec(r.Int63n(1_000_000_000_000) + 1).MulInt64(r.Int63n(1_000_000) + 1_000_000)
+	}
+
+	for _, numAssets := range []int{2, 3, 4, 8} {
+		for _, isOut := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%d assets, out given in: %t", numAssets, !isOut), func(t *testing.T) {
+				for i := 0; i < 100; i++ {
+					xReserve, yReserve := randReserve(), randReserve()
+					remReserves := make([]osmomath.BigDec, numAssets-2)
+					for j := range remReserves {
+						remReserves[j] = randReserve()
+					}
+					wSumSquares := calcWSumSquares(remReserves)
+
+					// swap up to 1/3 of the y reserves in, or out, so that the output stays below the x reserves
+					yIn := yReserve.MulInt64(r.Int63n(1_000_000) + 1).QuoInt64(3_000_000)
+					if isOut {
+						yIn = yIn.Neg()
+					}
+					yFinal := yReserve.Add(yIn)
+
+					var xOut osmomath.BigDec
+					var iterations int
+					sut := func() {
+						xOut, iterations = solveCFMMNewtonMultiWithIterations(xReserve, yReserve, wSumSquares, yIn)
+					}
+					if isOut && xReserve.LT(yReserve) {
+						// taking out y from a pool with a smaller x reserve may require more than the x reserves in,
+						// in which case the solver panics
+						didPanic := func() (didPanic bool) {
+							defer func() { didPanic = recover() != nil }()
+							sut()
+							return false
+						}()
+						if didPanic {
+							continue
+						}
+					} else {
+						sut()
+					}
+
+					xOutBinarySearch := solveCFMMBinarySearchMulti(xReserve, yReserve, wSumSquares, yIn)
+					xFinal := xReserve.Sub(xOut)
+					require.True(t, approxDecEqual(xOut, xOutBinarySearch, xFinal.Mul(binarySearchTolerance)),
+						"newton: %s, binary search: %s", xOut, xOutBinarySearch)
+
+					k0 := cfmmConstantMultiNoV(xReserve, yReserve, wSumSquares)
+					k1 := cfmmConstantMultiNoV(xFinal, yFinal, wSumSquares)
+					require.True(t, k1.GTE(k0), "k decreased from %s to %s", k0, k1)
+
+					require.LessOrEqual(t, iterations, maxExpectedIterations)
+				}
+			})
+		}
+	}
+}
+
 func (suite *StableSwapTestSuite) Test_StableSwap_CalculateAmountOutAndIn_InverseRelationship() {
 	type testcase struct {
 		denomOut       string
@@ -759,7 +829,7 @@ func (suite *StableSwapTestSuite) Test_StableSwap_Slippage_LiquidityRelation() {
 			createPoolFn := func(ctx sdk.Context, liq sdk.Coins) types.CFMMPoolI {
 				return createTestPool(suite.T(), liq, sdk.MustNewDecFromStr(swapFee), sdk.ZeroDec(), tc.scalingFactors)
 			}
-			ctx := sdk.Context{}
+			ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
 			test_helpers.TestSlippageRelationWithLiquidityIncrease(name, suite.T(), ctx, createPoolFn, tc.initialLiquidity)
 		}
 	}
@@ -868,7 +938,7 @@ func TestCalcSingleAssetJoinShares(t *testing.T) {
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			ctx := sdk.Context{}
+			ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
 			p := poolStructFromAssets(tc.poolAssets, tc.scalingFactors)
 
 			shares, err := p.calcSingleAssetJoinShares(tc.tokenIn, tc.swapFee)
@@ -987,7 +1057,7 @@ func TestJoinPoolSharesInternal(t *testing.T) {
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			ctx := sdk.Context{}
+			ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
 			p := poolStructFromAssets(tc.poolAssets, tc.scalingFactors)
 
 			shares, joinedLiquidity, err := p.joinPoolSharesInternal(ctx, tc.tokensIn, tc.swapFee)
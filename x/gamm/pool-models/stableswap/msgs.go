@@ -11,6 +11,8 @@ import (
 const (
 	TypeMsgCreateStableswapPool           = "create_stableswap_pool"
 	TypeMsgStableSwapAdjustScalingFactors = "stable_swap_adjust_scaling_factors"
+
+	TypeMsgStableSwapSetScalingFactorRateProvider = "stable_swap_set_scaling_factor_rate_provider"
 )
 
 var (
@@ -168,3 +170,47 @@ func (msg MsgStableSwapAdjustScalingFactors) GetSigners() []sdk.AccAddress {
 
 	return []sdk.AccAddress{scalingFactorGovernor}
 }
+
+var _ sdk.Msg = &MsgStableSwapSetScalingFactorRateProvider{}
+
+func NewMsgStableSwapSetScalingFactorRateProvider(
+	sender string,
+	poolID uint64,
+	rateProvider *ScalingFactorRateProvider,
+) MsgStableSwapSetScalingFactorRateProvider {
+	return MsgStableSwapSetScalingFactorRateProvider{
+		Sender:       sender,
+		PoolID:       poolID,
+		RateProvider: rateProvider,
+	}
+}
+
+func (msg MsgStableSwapSetScalingFactorRateProvider) Route() string { return types.RouterKey }
+func (msg MsgStableSwapSetScalingFactorRateProvider) Type() string {
+	return TypeMsgStableSwapSetScalingFactorRateProvider
+}
+
+func (msg MsgStableSwapSetScalingFactorRateProvider) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.RateProvider != nil {
+		return msg.RateProvider.Validate()
+	}
+	return nil
+}
+
+func (msg MsgStableSwapSetScalingFactorRateProvider) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgStableSwapSetScalingFactorRateProvider) GetSigners() []sdk.AccAddress {
+	scalingFactorGovernor, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{scalingFactorGovernor}
+}
@@ -296,3 +296,68 @@ func TestMsgCreateStableswapPoolValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgStableSwapSetScalingFactorRateProviderValidateBasic(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	contractAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	invalidAddr := sdk.AccAddress("invalid")
+
+	validRateProvider := &stableswap.ScalingFactorRateProvider{
+		ContractAddress:   contractAddr.String(),
+		EpochIdentifier:   "day",
+		MaxChangePerEpoch: sdk.NewDecWithPrec(1, 2),
+	}
+	defaultMsg := stableswap.NewMsgStableSwapSetScalingFactorRateProvider(addr1.String(), 1, validRateProvider)
+
+	require.Equal(t, defaultMsg.Route(), types.RouterKey)
+	require.Equal(t, defaultMsg.Type(), "stable_swap_set_scaling_factor_rate_provider")
+	signers := defaultMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        stableswap.MsgStableSwapSetScalingFactorRateProvider
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        defaultMsg,
+			expectPass: true,
+		},
+		{
+			name:       "remove rate provider",
+			msg:        stableswap.NewMsgStableSwapSetScalingFactorRateProvider(addr1.String(), 1, nil),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg:  stableswap.NewMsgStableSwapSetScalingFactorRateProvider(invalidAddr.String(), 1, validRateProvider),
+		},
+		{
+			name: "invalid contract address",
+			msg: stableswap.NewMsgStableSwapSetScalingFactorRateProvider(addr1.String(), 1, &stableswap.ScalingFactorRateProvider{
+				ContractAddress:   invalidAddr.String(),
+				EpochIdentifier:   "day",
+				MaxChangePerEpoch: sdk.NewDecWithPrec(1, 2),
+			}),
+		},
+		{
+			name: "negative max change per epoch",
+			msg: stableswap.NewMsgStableSwapSetScalingFactorRateProvider(addr1.String(), 1, &stableswap.ScalingFactorRateProvider{
+				ContractAddress:   contractAddr.String(),
+				EpochIdentifier:   "day",
+				MaxChangePerEpoch: sdk.NewDecWithPrec(-1, 2),
+			}),
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
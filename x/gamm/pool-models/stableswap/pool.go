@@ -108,8 +108,20 @@ func (p Pool) GetExitFee(ctx sdk.Context) sdk.Dec {
 	return p.PoolParams.ExitFee
 }
 
+// GetDynamicSwapFeeParams returns the pool's dynamic swap fee params, if any.
+func (p Pool) GetDynamicSwapFeeParams() *types.DynamicSwapFeeParams {
+	return p.PoolParams.DynamicSwapFeeParams
+}
+
+// IsActive returns false if the pool has been paused by governance or by the
+// circuit breaker.
 func (p Pool) IsActive(ctx sdk.Context) bool {
-	return true
+	return !p.Paused
+}
+
+// SetPaused pauses or resumes swaps and joins on the pool.
+func (p *Pool) SetPaused(paused bool) {
+	p.Paused = paused
 }
 
 // Returns the coins in the pool owned by all LP shareholders
@@ -259,21 +271,27 @@ func (p *Pool) updatePoolForJoin(tokensIn sdk.Coins, newShares sdk.Int) {
 // TODO: These should all get moved to amm.go
 // CalcOutAmtGivenIn calculates expected output amount given input token
 func (p Pool) CalcOutAmtGivenIn(ctx sdk.Context, tokenIn sdk.Coins, tokenOutDenom string, swapFee sdk.Dec) (tokenOut sdk.Coin, err error) {
+	tokenOut, _, err = p.calcOutCoinGivenIn(tokenIn, tokenOutDenom, swapFee)
+	return tokenOut, err
+}
+
+// calcOutCoinGivenIn calculates expected output amount given input token, and the number of solver iterations used
+func (p Pool) calcOutCoinGivenIn(tokenIn sdk.Coins, tokenOutDenom string, swapFee sdk.Dec) (tokenOut sdk.Coin, iterations int, err error) {
 	if tokenIn.Len() != 1 {
-		return sdk.Coin{}, errors.New("stableswap CalcOutAmtGivenIn: tokenIn is of wrong length")
+		return sdk.Coin{}, 0, errors.New("stableswap CalcOutAmtGivenIn: tokenIn is of wrong length")
 	}
-	outAmtDec, err := p.calcOutAmtGivenIn(tokenIn[0], tokenOutDenom, swapFee)
+	outAmtDec, iterations, err := p.calcOutAmtGivenIn(tokenIn[0], tokenOutDenom, swapFee)
 	if err != nil {
-		return sdk.Coin{}, err
+		return sdk.Coin{}, 0, err
 	}
 
 	// we ignore the decimal component, as token out amount must round down
 	tokenOutAmt := outAmtDec.TruncateInt()
 	if !tokenOutAmt.IsPositive() {
-		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox,
+		return sdk.Coin{}, 0, sdkerrors.Wrapf(types.ErrInvalidMathApprox,
 			fmt.Sprintf("token amount must be positive, got %v", tokenOutAmt))
 	}
-	return sdk.NewCoin(tokenOutDenom, tokenOutAmt), nil
+	return sdk.NewCoin(tokenOutDenom, tokenOutAmt), iterations, nil
 }
 
 // SwapOutAmtGivenIn executes a swap given a desired input amount
@@ -282,10 +300,11 @@ func (p *Pool) SwapOutAmtGivenIn(ctx sdk.Context, tokenIn sdk.Coins, tokenOutDen
 		return sdk.Coin{}, err
 	}
 
-	tokenOut, err = p.CalcOutAmtGivenIn(ctx, tokenIn, tokenOutDenom, swapFee)
+	tokenOut, iterations, err := p.calcOutCoinGivenIn(tokenIn, tokenOutDenom, swapFee)
 	if err != nil {
 		return sdk.Coin{}, err
 	}
+	consumeSolverGas(ctx, iterations)
 
 	p.updatePoolLiquidityForSwap(tokenIn, sdk.NewCoins(tokenOut))
 
@@ -294,13 +313,19 @@ func (p *Pool) SwapOutAmtGivenIn(ctx sdk.Context, tokenIn sdk.Coins, tokenOutDen
 
 // CalcInAmtGivenOut calculates input amount needed to receive given output
 func (p Pool) CalcInAmtGivenOut(ctx sdk.Context, tokenOut sdk.Coins, tokenInDenom string, swapFee sdk.Dec) (tokenIn sdk.Coin, err error) {
+	tokenIn, _, err = p.calcInCoinGivenOut(tokenOut, tokenInDenom, swapFee)
+	return tokenIn, err
+}
+
+// calcInCoinGivenOut calculates input amount needed to receive given output, and the number of solver iterations used
+func (p Pool) calcInCoinGivenOut(tokenOut sdk.Coins, tokenInDenom string, swapFee sdk.Dec) (tokenIn sdk.Coin, iterations int, err error) {
 	if tokenOut.Len() != 1 {
-		return sdk.Coin{}, errors.New("stableswap CalcInAmtGivenOut: tokenOut is of wrong length")
+		return sdk.Coin{}, 0, errors.New("stableswap CalcInAmtGivenOut: tokenOut is of wrong length")
 	}
 
-	amt, err := p.calcInAmtGivenOut(tokenOut[0], tokenInDenom, swapFee)
+	amt, iterations, err := p.calcInAmtGivenOut(tokenOut[0], tokenInDenom, swapFee)
 	if err != nil {
-		return sdk.Coin{}, err
+		return sdk.Coin{}, 0, err
 	}
 
 	// We round up tokenInAmt, as this is whats charged for the swap, for the precise amount out.
@@ -308,14 +333,14 @@ func (p Pool) CalcInAmtGivenOut(ctx sdk.Context, tokenOut sdk.Coins, tokenInDeno
 	tokenInAmt := amt.Ceil().TruncateInt()
 
 	if !tokenInAmt.IsPositive() {
-		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount must be positive")
+		return sdk.Coin{}, 0, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount must be positive")
 	}
-	return sdk.NewCoin(tokenInDenom, tokenInAmt), nil
+	return sdk.NewCoin(tokenInDenom, tokenInAmt), iterations, nil
 }
 
 // SwapInAmtGivenOut executes a swap given a desired output amount
 func (p *Pool) SwapInAmtGivenOut(ctx sdk.Context, tokenOut sdk.Coins, tokenInDenom string, swapFee sdk.Dec) (tokenIn sdk.Coin, err error) {
-	tokenIn, err = p.CalcInAmtGivenOut(ctx, tokenOut, tokenInDenom, swapFee)
+	tokenIn, iterations, err := p.calcInCoinGivenOut(tokenOut, tokenInDenom, swapFee)
 	if err != nil {
 		return sdk.Coin{}, err
 	}
@@ -323,6 +348,7 @@ func (p *Pool) SwapInAmtGivenOut(ctx sdk.Context, tokenOut sdk.Coins, tokenInDen
 	if err = validatePoolLiquidity(p.PoolLiquidity.Add(tokenIn), p.ScalingFactors); err != nil {
 		return sdk.Coin{}, err
 	}
+	consumeSolverGas(ctx, iterations)
 
 	p.updatePoolLiquidityForSwap(sdk.NewCoins(tokenIn), tokenOut)
 
@@ -22,5 +22,11 @@ func (params PoolParams) Validate() error {
 	if params.SwapFee.GTE(sdk.OneDec()) {
 		return types.ErrTooMuchSwapFee
 	}
+
+	if params.DynamicSwapFeeParams != nil {
+		if err := params.DynamicSwapFeeParams.Validate(params.SwapFee); err != nil {
+			return err
+		}
+	}
 	return nil
 }
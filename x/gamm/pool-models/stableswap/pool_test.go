@@ -663,7 +663,7 @@ func TestCalcJoinPoolNoSwapShares(t *testing.T) {
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			ctx := sdk.Context{}
+			ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
 			pool := poolStructFromAssets(test.poolAssets, test.scalingFactors)
 			numShare, tokensJoined, err := pool.CalcJoinPoolNoSwapShares(ctx, test.tokensIn, pool.GetSwapFee(ctx))
 
@@ -754,13 +754,17 @@ func TestSwapOutAmtGivenIn(t *testing.T) {
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			ctx := sdk.Context{}
+			ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
 			p := poolStructFromAssets(tc.poolAssets, tc.scalingFactors)
 
 			tokenOut, err := p.SwapOutAmtGivenIn(ctx, tc.tokenIn, tc.expectedTokenOut.Denom, tc.swapFee)
 			osmoassert.ConditionalError(t, tc.expError, err)
 			if !tc.expError {
 				require.Equal(t, tc.expectedTokenOut.Amount, tokenOut.Amount)
+				// the swap pays gas for every iteration of the solver
+				gasConsumed := ctx.GasMeter().GasConsumed()
+				require.Positive(t, gasConsumed)
+				require.Zero(t, gasConsumed%types.StableswapGasFeePerSolverIteration)
 				require.True(t, p.PoolLiquidity.IsAllGTE(tc.expectedPoolLiquidity),
 					"p.PoolLiquidity.IsAllGTE(tc.expectedPoolLiquidity) failed. Pool liq %v, expected %v",
 					p.PoolLiquidity, tc.expectedPoolLiquidity)
@@ -822,13 +826,17 @@ func TestSwapInAmtGivenOut(t *testing.T) {
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			ctx := sdk.Context{}
+			ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
 			p := poolStructFromAssets(tc.poolAssets, tc.scalingFactors)
 
 			tokenIn, err := p.SwapInAmtGivenOut(ctx, tc.tokenOut, tc.expectedTokenIn.Denom, tc.swapFee)
 			if !tc.expError {
 				require.True(t, tokenIn.Amount.GTE(tc.expectedTokenIn.Amount))
 				require.True(t, p.PoolLiquidity.IsAllGTE(tc.expectedPoolLiquidity))
+				// the swap pays gas for every iteration of the solver
+				gasConsumed := ctx.GasMeter().GasConsumed()
+				require.Positive(t, gasConsumed)
+				require.Zero(t, gasConsumed%types.StableswapGasFeePerSolverIteration)
 			}
 			osmoassert.ConditionalError(t, tc.expError, err)
 		})
@@ -938,7 +946,7 @@ func TestInverseJoinPoolExitPool(t *testing.T) {
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			ctx := sdk.Context{}
+			ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
 			p := poolStructFromAssets(tc.poolAssets, tc.scalingFactors)
 
 			// only for single asset join case
@@ -1029,7 +1037,7 @@ func TestExitPool(t *testing.T) {
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			ctx := sdk.Context{}
+			ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
 			p := poolStructFromAssets(tc.initialPoolLiquidity, tc.scalingFactors)
 			tokenOut, err := p.ExitPool(ctx, tc.sharesIn, defaultExitFee)
 
@@ -1174,7 +1182,7 @@ func TestSetScalingFactors(t *testing.T) {
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			ctx := sdk.Context{}
+			ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
 			pool := poolStructFromAssets(tc.poolAssets, tc.scalingFactors)
 			pool.ScalingFactorController = addr.String()
 			err := pool.SetScalingFactors(ctx, tc.scalingFactors, tc.sender)
@@ -1326,7 +1334,7 @@ func TestStableswapSpotPrice(t *testing.T) {
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			ctx := sdk.Context{}
+			ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
 			p := poolStructFromAssets(tc.poolAssets, tc.scalingFactors)
 			spotPrice, err := p.SpotPrice(ctx, tc.baseDenom, tc.quoteDenom)
 
@@ -1337,7 +1345,7 @@ func TestStableswapSpotPrice(t *testing.T) {
 				if (tc.expectedPrice != sdk.Dec{}) {
 					expectedSpotPrice = tc.expectedPrice
 				} else {
-					expectedSpotPrice, err = p.calcOutAmtGivenIn(sdk.NewInt64Coin(tc.quoteDenom, 1), tc.baseDenom, sdk.ZeroDec())
+					expectedSpotPrice, _, err = p.calcOutAmtGivenIn(sdk.NewInt64Coin(tc.quoteDenom, 1), tc.baseDenom, sdk.ZeroDec())
 					require.NoError(t, err)
 				}
 
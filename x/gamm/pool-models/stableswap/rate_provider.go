@@ -0,0 +1,119 @@
+package stableswap
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	epochstypes "github.com/osmosis-labs/osmosis/v13/x/epochs/types"
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+)
+
+// RateProviderQueryMsg is the query sent to a scaling factor rate provider contract.
+type RateProviderQueryMsg struct {
+	RedemptionRates *RedemptionRatesQuery `json:"redemption_rates,omitempty"`
+}
+
+// RedemptionRatesQuery asks the rate provider for the redemption rates of the pool's denoms.
+type RedemptionRatesQuery struct {
+	PoolId uint64   `json:"pool_id"`
+	Denoms []string `json:"denoms"`
+}
+
+// RedemptionRatesResponse is the rate provider's response to a RedemptionRatesQuery.
+// It holds the value of one unit of each denom in a common unit, in the order of the queried denoms.
+type RedemptionRatesResponse struct {
+	Rates []sdk.Dec `json:"rates"`
+}
+
+// Validate returns an error if the rate provider is invalid.
+func (rp ScalingFactorRateProvider) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(rp.ContractAddress); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidRateProvider, "invalid contract address (%s)", err)
+	}
+	if err := epochstypes.ValidateEpochIdentifierString(rp.EpochIdentifier); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidRateProvider, err.Error())
+	}
+	if rp.MaxChangePerEpoch.IsNil() || !rp.MaxChangePerEpoch.IsPositive() || rp.MaxChangePerEpoch.GTE(sdk.OneDec()) {
+		return sdkerrors.Wrapf(types.ErrInvalidRateProvider, "max change per epoch must be in (0, 1), was %s", rp.MaxChangePerEpoch)
+	}
+	return nil
+}
+
+// SetScalingFactorRateProvider sets the rate provider the pool's scaling factors are adjusted by,
+// or removes it if nil. Errors if the sender is not the scaling factor controller or the rate provider is invalid.
+func (p *Pool) SetScalingFactorRateProvider(rateProvider *ScalingFactorRateProvider, sender string) error {
+	if sender != p.ScalingFactorController {
+		return types.ErrNotScalingFactorGovernor
+	}
+	if rateProvider != nil {
+		if err := rateProvider.Validate(); err != nil {
+			return err
+		}
+	}
+
+	p.ScalingFactorRateProvider = rateProvider
+	return nil
+}
+
+// UpdateScalingFactorsFromRates moves the pool's scaling factors towards the redemption rates of its assets,
+// given in the order of the pool liquidity. Assets are worth the same after scaling when scaling factor * rate
+// is equal for all of them, so the target scaling factors are inversely proportional to the rates. They are
+// normalized so that the asset with the lowest rate gets the largest current scaling factor, which keeps the
+// precision of the scaling factors.
+// Each scaling factor changes by at most the rate provider's max change per epoch, so a pool tracks a large
+// rate change over multiple epochs. Scaling factors should be large enough for this change to be representable.
+func (p *Pool) UpdateScalingFactorsFromRates(rates []sdk.Dec) error {
+	if p.ScalingFactorRateProvider == nil {
+		return sdkerrors.Wrapf(types.ErrInvalidRateProvider, "pool %d has no scaling factor rate provider", p.Id)
+	}
+	if len(rates) != p.PoolLiquidity.Len() {
+		return sdkerrors.Wrapf(types.ErrInvalidRedemptionRates, "expected %d rates, got %d", p.PoolLiquidity.Len(), len(rates))
+	}
+
+	minRate := sdk.Dec{}
+	for _, rate := range rates {
+		if rate.IsNil() || !rate.IsPositive() {
+			return sdkerrors.Wrapf(types.ErrInvalidRedemptionRates, "rates must be positive, got %s", rates)
+		}
+		if minRate.IsNil() || rate.LT(minRate) {
+			minRate = rate
+		}
+	}
+	maxScalingFactor := uint64(0)
+	for _, scalingFactor := range p.ScalingFactors {
+		if scalingFactor > maxScalingFactor {
+			maxScalingFactor = scalingFactor
+		}
+	}
+
+	maxChange := p.ScalingFactorRateProvider.MaxChangePerEpoch
+	newScalingFactors := make([]uint64, len(rates))
+	for i, rate := range rates {
+		current := sdk.NewDecFromInt(sdk.NewIntFromUint64(p.ScalingFactors[i]))
+		target := sdk.NewDecFromInt(sdk.NewIntFromUint64(maxScalingFactor)).Mul(minRate.Quo(rate))
+
+		lowerBound := current.Mul(sdk.OneDec().Sub(maxChange))
+		upperBound := current.Mul(sdk.OneDec().Add(maxChange))
+		if target.LT(lowerBound) {
+			target = lowerBound
+		} else if target.GT(upperBound) {
+			target = upperBound
+		}
+
+		scalingFactor := target.TruncateInt()
+		if !scalingFactor.IsUint64() {
+			return types.ErrInvalidScalingFactors
+		}
+		newScalingFactors[i] = scalingFactor.Uint64()
+	}
+
+	if err := validateScalingFactors(newScalingFactors, p.PoolLiquidity.Len()); err != nil {
+		return err
+	}
+	if err := validatePoolLiquidity(p.PoolLiquidity, newScalingFactors); err != nil {
+		return err
+	}
+
+	p.ScalingFactors = newScalingFactors
+	return nil
+}
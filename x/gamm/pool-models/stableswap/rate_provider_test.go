@@ -0,0 +1,201 @@
+package stableswap
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+)
+
+func TestScalingFactorRateProviderValidate(t *testing.T) {
+	contractAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+
+	tests := map[string]struct {
+		rateProvider ScalingFactorRateProvider
+		expectPass   bool
+	}{
+		"valid": {
+			rateProvider: ScalingFactorRateProvider{ContractAddress: contractAddr, EpochIdentifier: "day", MaxChangePerEpoch: sdk.NewDecWithPrec(1, 2)},
+			expectPass:   true,
+		},
+		"invalid contract address": {
+			rateProvider: ScalingFactorRateProvider{ContractAddress: "contract", EpochIdentifier: "day", MaxChangePerEpoch: sdk.NewDecWithPrec(1, 2)},
+		},
+		"empty epoch identifier": {
+			rateProvider: ScalingFactorRateProvider{ContractAddress: contractAddr, EpochIdentifier: "", MaxChangePerEpoch: sdk.NewDecWithPrec(1, 2)},
+		},
+		"nil max change per epoch": {
+			rateProvider: ScalingFactorRateProvider{ContractAddress: contractAddr, EpochIdentifier: "day"},
+		},
+		"zero max change per epoch": {
+			rateProvider: ScalingFactorRateProvider{ContractAddress: contractAddr, EpochIdentifier: "day", MaxChangePerEpoch: sdk.ZeroDec()},
+		},
+		"max change per epoch of one": {
+			rateProvider: ScalingFactorRateProvider{ContractAddress: contractAddr, EpochIdentifier: "day", MaxChangePerEpoch: sdk.OneDec()},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.rateProvider.Validate()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidRateProvider)
+			}
+		})
+	}
+}
+
+func TestSetScalingFactorRateProvider(t *testing.T) {
+	controller := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	nonController := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	contractAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	validRateProvider := &ScalingFactorRateProvider{ContractAddress: contractAddr, EpochIdentifier: "day", MaxChangePerEpoch: sdk.NewDecWithPrec(1, 2)}
+
+	tests := map[string]struct {
+		rateProvider *ScalingFactorRateProvider
+		sender       string
+		expError     error
+	}{
+		"set rate provider": {
+			rateProvider: validRateProvider,
+			sender:       controller,
+		},
+		"remove rate provider": {
+			rateProvider: nil,
+			sender:       controller,
+		},
+		"sender is not scaling factor controller": {
+			rateProvider: validRateProvider,
+			sender:       nonController,
+			expError:     types.ErrNotScalingFactorGovernor,
+		},
+		"invalid rate provider": {
+			rateProvider: &ScalingFactorRateProvider{ContractAddress: contractAddr, EpochIdentifier: "day", MaxChangePerEpoch: sdk.ZeroDec()},
+			sender:       controller,
+			expError:     types.ErrInvalidRateProvider,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool := poolStructFromAssets(twoEvenStablePoolAssets, defaultTwoAssetScalingFactors)
+			pool.ScalingFactorController = controller
+			pool.ScalingFactorRateProvider = validRateProvider
+
+			err := pool.SetScalingFactorRateProvider(tc.rateProvider, tc.sender)
+			if tc.expError != nil {
+				require.ErrorIs(t, err, tc.expError)
+				require.Equal(t, validRateProvider, pool.ScalingFactorRateProvider)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.rateProvider, pool.ScalingFactorRateProvider)
+		})
+	}
+}
+
+func TestUpdateScalingFactorsFromRates(t *testing.T) {
+	contractAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+
+	tests := map[string]struct {
+		poolAssets        sdk.Coins
+		scalingFactors    []uint64
+		noRateProvider    bool
+		maxChangePerEpoch sdk.Dec
+		rates             []sdk.Dec
+		expScalingFactors []uint64
+		expError          error
+	}{
+		"equal rates keep equal scaling factors": {
+			poolAssets:        twoEvenStablePoolAssets,
+			scalingFactors:    []uint64{1000, 1000},
+			maxChangePerEpoch: sdk.NewDecWithPrec(1, 1),
+			rates:             []sdk.Dec{sdk.OneDec(), sdk.OneDec()},
+			expScalingFactors: []uint64{1000, 1000},
+		},
+		"rate change within max change reaches target": {
+			poolAssets:        twoEvenStablePoolAssets,
+			scalingFactors:    []uint64{1000, 1000},
+			maxChangePerEpoch: sdk.NewDecWithPrec(1, 1),
+			// bar is worth 1.05 foo, so one unit of bar is scaled by 1000 / 1.05
+			rates:             []sdk.Dec{sdk.MustNewDecFromStr("1.05"), sdk.OneDec()},
+			expScalingFactors: []uint64{952, 1000},
+		},
+		"rate change above max change is clamped": {
+			poolAssets:        twoEvenStablePoolAssets,
+			scalingFactors:    []uint64{1000, 1000},
+			maxChangePerEpoch: sdk.NewDecWithPrec(1, 2),
+			rates:             []sdk.Dec{sdk.NewDec(2), sdk.OneDec()},
+			expScalingFactors: []uint64{990, 1000},
+		},
+		"clamped increase towards a falling rate": {
+			poolAssets:        twoEvenStablePoolAssets,
+			scalingFactors:    []uint64{500, 1000},
+			maxChangePerEpoch: sdk.NewDecWithPrec(1, 2),
+			rates:             []sdk.Dec{sdk.OneDec(), sdk.OneDec()},
+			expScalingFactors: []uint64{505, 1000},
+		},
+		"three assets": {
+			poolAssets:        threeEvenStablePoolAssets,
+			scalingFactors:    []uint64{1000, 1000, 1000},
+			maxChangePerEpoch: sdk.NewDecWithPrec(5, 1),
+			rates:             []sdk.Dec{sdk.OneDec(), sdk.MustNewDecFromStr("1.25"), sdk.NewDec(2)},
+			expScalingFactors: []uint64{1000, 800, 500},
+		},
+		"no rate provider": {
+			poolAssets:     twoEvenStablePoolAssets,
+			scalingFactors: []uint64{1000, 1000},
+			noRateProvider: true,
+			rates:          []sdk.Dec{sdk.OneDec(), sdk.OneDec()},
+			expError:       types.ErrInvalidRateProvider,
+		},
+		"wrong number of rates": {
+			poolAssets:        twoEvenStablePoolAssets,
+			scalingFactors:    []uint64{1000, 1000},
+			maxChangePerEpoch: sdk.NewDecWithPrec(1, 1),
+			rates:             []sdk.Dec{sdk.OneDec()},
+			expError:          types.ErrInvalidRedemptionRates,
+		},
+		"zero rate": {
+			poolAssets:        twoEvenStablePoolAssets,
+			scalingFactors:    []uint64{1000, 1000},
+			maxChangePerEpoch: sdk.NewDecWithPrec(1, 1),
+			rates:             []sdk.Dec{sdk.ZeroDec(), sdk.OneDec()},
+			expError:          types.ErrInvalidRedemptionRates,
+		},
+		"scaling factor truncated to zero": {
+			poolAssets:        twoEvenStablePoolAssets,
+			scalingFactors:    []uint64{1, 1},
+			maxChangePerEpoch: sdk.NewDecWithPrec(1, 1),
+			rates:             []sdk.Dec{sdk.NewDec(2), sdk.OneDec()},
+			expError:          types.ErrInvalidScalingFactors,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool := poolStructFromAssets(tc.poolAssets, tc.scalingFactors)
+			if !tc.noRateProvider {
+				pool.ScalingFactorRateProvider = &ScalingFactorRateProvider{
+					ContractAddress:   contractAddr,
+					EpochIdentifier:   "day",
+					MaxChangePerEpoch: tc.maxChangePerEpoch,
+				}
+			}
+
+			err := pool.UpdateScalingFactorsFromRates(tc.rates)
+			if tc.expError != nil {
+				require.ErrorIs(t, err, tc.expError)
+				require.Equal(t, applyScalingFactorMultiplier(tc.scalingFactors), pool.ScalingFactors)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, applyScalingFactorMultiplier(tc.expScalingFactors), pool.ScalingFactors)
+		})
+	}
+}
@@ -7,11 +7,12 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/x/auth/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
+	types "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -35,6 +36,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type PoolParams struct {
 	SwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee" yaml:"swap_fee"`
 	ExitFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exit_fee,json=exitFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exit_fee" yaml:"exit_fee"`
+	// dynamic_swap_fee_params optionally makes the swap fee depend on recent
+	// volatility and chain downtime. If unset, swap_fee is used as is.
+	DynamicSwapFeeParams *types.DynamicSwapFeeParams `protobuf:"bytes,3,opt,name=dynamic_swap_fee_params,json=dynamicSwapFeeParams,proto3" json:"dynamic_swap_fee_params,omitempty" yaml:"dynamic_swap_fee_params"`
 }
 
 func (m *PoolParams) Reset()         { *m = PoolParams{} }
@@ -70,6 +74,13 @@ func (m *PoolParams) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PoolParams proto.InternalMessageInfo
 
+func (m *PoolParams) GetDynamicSwapFeeParams() *types.DynamicSwapFeeParams {
+	if m != nil {
+		return m.DynamicSwapFeeParams
+	}
+	return nil
+}
+
 // Pool is the stableswap Pool struct
 type Pool struct {
 	Address    string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
@@ -85,13 +96,19 @@ type Pool struct {
 	// would need to be locked up to count in governance. 0w means no lockup.
 	FuturePoolGovernor string `protobuf:"bytes,4,opt,name=future_pool_governor,json=futurePoolGovernor,proto3" json:"future_pool_governor,omitempty" yaml:"future_pool_governor"`
 	// sum of all LP shares
-	TotalShares types.Coin `protobuf:"bytes,5,opt,name=total_shares,json=totalShares,proto3" json:"total_shares" yaml:"total_shares"`
+	TotalShares types1.Coin `protobuf:"bytes,5,opt,name=total_shares,json=totalShares,proto3" json:"total_shares" yaml:"total_shares"`
 	// assets in the pool
 	PoolLiquidity github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=pool_liquidity,json=poolLiquidity,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_liquidity"`
 	// for calculation amognst assets with different precisions
 	ScalingFactors []uint64 `protobuf:"varint,7,rep,packed,name=scaling_factors,json=scalingFactors,proto3" json:"scaling_factors,omitempty" yaml:"stableswap_scaling_factors"`
 	// scaling_factor_controller is the address can adjust pool scaling factors
 	ScalingFactorController string `protobuf:"bytes,8,opt,name=scaling_factor_controller,json=scalingFactorController,proto3" json:"scaling_factor_controller,omitempty" yaml:"scaling_factor_controller"`
+	// scaling_factor_rate_provider, if set, is queried at the end of every
+	// epoch for the redemption rates the scaling factors are adjusted towards
+	ScalingFactorRateProvider *ScalingFactorRateProvider `protobuf:"bytes,9,opt,name=scaling_factor_rate_provider,json=scalingFactorRateProvider,proto3" json:"scaling_factor_rate_provider,omitempty" yaml:"scaling_factor_rate_provider"`
+	// paused is true if swaps and joins against the pool are paused, by
+	// governance or by the circuit breaker.
+	Paused bool `protobuf:"varint,10,opt,name=paused,proto3" json:"paused,omitempty" yaml:"paused"`
 }
 
 func (m *Pool) Reset()      { *m = Pool{} }
@@ -126,9 +143,71 @@ func (m *Pool) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Pool proto.InternalMessageInfo
 
+// ScalingFactorRateProvider is an on-chain source of redemption rates for the
+// assets of a stableswap pool, such as liquid staking derivatives.
+type ScalingFactorRateProvider struct {
+	// contract_address is the CosmWasm contract queried for the redemption
+	// rates
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty" yaml:"contract_address"`
+	// epoch_identifier is the epoch at the end of which the contract is
+	// queried
+	EpochIdentifier string `protobuf:"bytes,2,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty" yaml:"epoch_identifier"`
+	// max_change_per_epoch is the largest relative change of any scaling factor
+	// in a single epoch
+	MaxChangePerEpoch github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_change_per_epoch,json=maxChangePerEpoch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_change_per_epoch" yaml:"max_change_per_epoch"`
+}
+
+func (m *ScalingFactorRateProvider) Reset()         { *m = ScalingFactorRateProvider{} }
+func (m *ScalingFactorRateProvider) String() string { return proto.CompactTextString(m) }
+func (*ScalingFactorRateProvider) ProtoMessage()    {}
+func (*ScalingFactorRateProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae0f054436f9999a, []int{2}
+}
+func (m *ScalingFactorRateProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScalingFactorRateProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScalingFactorRateProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScalingFactorRateProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScalingFactorRateProvider.Merge(m, src)
+}
+func (m *ScalingFactorRateProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScalingFactorRateProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScalingFactorRateProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScalingFactorRateProvider proto.InternalMessageInfo
+
+func (m *ScalingFactorRateProvider) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *ScalingFactorRateProvider) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*PoolParams)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.PoolParams")
 	proto.RegisterType((*Pool)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.Pool")
+	proto.RegisterType((*ScalingFactorRateProvider)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.ScalingFactorRateProvider")
 }
 
 func init() {
@@ -136,48 +215,62 @@ func init() {
 }
 
 var fileDescriptor_ae0f054436f9999a = []byte{
-	// 642 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0xdb, 0xb4, 0x29, 0x5b, 0x48, 0x85, 0xa9, 0x84, 0xdb, 0x0a, 0x6f, 0xb0, 0x28, 0x8a,
-	0x10, 0xb1, 0x29, 0x95, 0x90, 0xe8, 0xad, 0x29, 0x2a, 0x42, 0x42, 0xa8, 0x98, 0x13, 0x3f, 0x52,
-	0x58, 0xdb, 0x1b, 0x77, 0x85, 0x9d, 0x35, 0xbb, 0xeb, 0xd2, 0x5e, 0x38, 0x73, 0xe4, 0xc8, 0xb1,
-	0x67, 0xb8, 0xf2, 0x10, 0x15, 0xa7, 0x1e, 0x11, 0x07, 0x83, 0xda, 0x37, 0xc8, 0x13, 0xa0, 0x5d,
-	0xaf, 0xfb, 0x13, 0xa0, 0xaa, 0xc4, 0x29, 0x3b, 0x33, 0xdf, 0x7c, 0x33, 0xdf, 0xcc, 0xc4, 0xe0,
-	0x3e, 0xe5, 0x29, 0xe5, 0x84, 0x7b, 0x31, 0x4a, 0x53, 0x2f, 0xa3, 0x34, 0xe9, 0xa4, 0x34, 0xc2,
-	0x09, 0xf7, 0xb8, 0x40, 0x41, 0x82, 0xf9, 0x3b, 0x94, 0x9d, 0x78, 0xf6, 0x24, 0xc2, 0xcd, 0x18,
-	0x15, 0xd4, 0xbc, 0xa5, 0x53, 0x5d, 0x99, 0xea, 0xca, 0x40, 0x99, 0xe9, 0x1e, 0xc3, 0xdd, 0xad,
-	0xa5, 0x00, 0x0b, 0xb4, 0x34, 0x3f, 0x17, 0x2a, 0x70, 0x4f, 0x65, 0x7a, 0xa5, 0x51, 0xd2, 0xcc,
-	0xcf, 0xc6, 0x34, 0xa6, 0xa5, 0x5f, 0xbe, 0xb4, 0xd7, 0x8e, 0x29, 0x8d, 0x13, 0xec, 0x29, 0x2b,
-	0xc8, 0xfb, 0x5e, 0x94, 0x33, 0x24, 0x08, 0x1d, 0xe8, 0x38, 0x1c, 0x8d, 0x0b, 0x92, 0x62, 0x2e,
-	0x50, 0x9a, 0x55, 0x04, 0x65, 0x11, 0x0f, 0xe5, 0x62, 0xd3, 0xd3, 0x6d, 0x28, 0x63, 0x24, 0x1e,
-	0x20, 0x8e, 0x8f, 0xe2, 0x21, 0x25, 0xba, 0x80, 0xb3, 0x67, 0x00, 0xb0, 0x41, 0x69, 0xb2, 0x81,
-	0x18, 0x4a, 0xb9, 0xf9, 0x0a, 0x4c, 0x29, 0xfd, 0x7d, 0x8c, 0x2d, 0xa3, 0x65, 0xb4, 0x2f, 0x74,
-	0x57, 0xf7, 0x0a, 0x58, 0xfb, 0x51, 0xc0, 0x9b, 0x31, 0x11, 0x9b, 0x79, 0xe0, 0x86, 0x34, 0xd5,
-	0xc2, 0xf4, 0x4f, 0x87, 0x47, 0x6f, 0x3c, 0xb1, 0x93, 0x61, 0xee, 0x3e, 0xc0, 0xe1, 0xb0, 0x80,
-	0x33, 0x3b, 0x28, 0x4d, 0x56, 0x9c, 0x8a, 0xc7, 0xf1, 0x1b, 0xf2, 0xb9, 0x8e, 0xb1, 0x64, 0xc7,
-	0xdb, 0x44, 0x28, 0xf6, 0xb1, 0xff, 0x63, 0xaf, 0x78, 0x1c, 0xbf, 0x21, 0x9f, 0xeb, 0x18, 0x3b,
-	0x5f, 0x26, 0x40, 0x5d, 0x4a, 0x31, 0x6f, 0x83, 0x06, 0x8a, 0x22, 0x86, 0x39, 0xd7, 0x1a, 0xcc,
-	0x61, 0x01, 0x9b, 0x65, 0x9e, 0x0e, 0x38, 0x7e, 0x05, 0x31, 0x9b, 0x60, 0x8c, 0x44, 0xaa, 0x9d,
-	0xba, 0x3f, 0x46, 0x22, 0xf3, 0x3d, 0x98, 0x96, 0x4b, 0xee, 0x65, 0x6a, 0x22, 0xd6, 0x78, 0xcb,
-	0x68, 0x4f, 0xdf, 0xbd, 0xe7, 0x9e, 0xff, 0x0a, 0xdc, 0xe3, 0x79, 0x76, 0x17, 0xa5, 0xbe, 0x61,
-	0x01, 0xaf, 0xe9, 0x99, 0x9c, 0xbe, 0x30, 0x5d, 0xc3, 0xf1, 0x41, 0x76, 0xbc, 0x82, 0xa7, 0x60,
-	0xb6, 0x9f, 0x8b, 0x9c, 0xe1, 0x12, 0x12, 0xd3, 0x2d, 0xcc, 0x06, 0x94, 0x59, 0x75, 0x25, 0x05,
-	0x0e, 0x0b, 0xb8, 0x50, 0x92, 0xfd, 0x0d, 0xe5, 0xf8, 0x66, 0xe9, 0x96, 0x3d, 0x3c, 0xd4, 0x4e,
-	0xf3, 0x39, 0xb8, 0x28, 0xa8, 0x40, 0x49, 0x8f, 0x6f, 0x22, 0x86, 0xb9, 0x35, 0xa1, 0x34, 0xcd,
-	0xb9, 0xfa, 0x40, 0xe5, 0x6d, 0x1c, 0x35, 0xbf, 0x46, 0xc9, 0xa0, 0xbb, 0xa0, 0xdb, 0xbe, 0x52,
-	0x56, 0x3a, 0x99, 0xec, 0xf8, 0xd3, 0xca, 0x7c, 0xa6, 0x2c, 0x93, 0x81, 0xa6, 0x6a, 0x20, 0x21,
-	0x6f, 0x73, 0x12, 0x11, 0xb1, 0x63, 0x4d, 0xb6, 0xc6, 0xcf, 0x26, 0xbf, 0x23, 0xc9, 0x3f, 0xff,
-	0x84, 0xed, 0x73, 0xec, 0x5c, 0x26, 0x70, 0xff, 0x92, 0x2c, 0xf1, 0xb8, 0xaa, 0x60, 0x3e, 0x01,
-	0x33, 0x3c, 0x44, 0x09, 0x19, 0xc4, 0xbd, 0x3e, 0x0a, 0x05, 0x65, 0xdc, 0x6a, 0xb4, 0xc6, 0xdb,
-	0xf5, 0xee, 0xe2, 0xb0, 0x80, 0xd7, 0xff, 0x98, 0xf4, 0x08, 0xd6, 0xf1, 0x9b, 0xda, 0xb3, 0x5e,
-	0x3a, 0xcc, 0xd7, 0x60, 0xee, 0x34, 0xa6, 0x17, 0xd2, 0x81, 0x60, 0x34, 0x49, 0x30, 0xb3, 0xa6,
-	0xd4, 0xd8, 0x6f, 0x0c, 0x0b, 0xd8, 0xd2, 0xcc, 0xff, 0x82, 0x3a, 0xfe, 0xd5, 0x53, 0xc4, 0x6b,
-	0x47, 0x91, 0x95, 0xcb, 0x1f, 0x76, 0x61, 0xed, 0xd3, 0x2e, 0xac, 0x7d, 0xfb, 0xda, 0x99, 0x90,
-	0xab, 0x79, 0xd4, 0x7d, 0xb9, 0x77, 0x60, 0x1b, 0xfb, 0x07, 0xb6, 0xf1, 0xeb, 0xc0, 0x36, 0x3e,
-	0x1e, 0xda, 0xb5, 0xfd, 0x43, 0xbb, 0xf6, 0xfd, 0xd0, 0xae, 0xbd, 0x58, 0x3d, 0x31, 0x17, 0x7d,
-	0x75, 0x9d, 0x04, 0x05, 0xbc, 0x32, 0xbc, 0xad, 0xa5, 0x65, 0x6f, 0xfb, 0xac, 0x2f, 0x59, 0x30,
-	0xa9, 0xfe, 0xdc, 0xcb, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xeb, 0xf7, 0x52, 0xb8, 0xf7, 0x04,
-	0x00, 0x00,
+	// 876 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4d, 0x6f, 0xdc, 0x44,
+	0x18, 0x5e, 0x6f, 0xd2, 0x6c, 0x32, 0xa1, 0x49, 0x63, 0x22, 0xc5, 0xdb, 0xc0, 0xce, 0xe2, 0xd2,
+	0xb2, 0x54, 0xc4, 0x26, 0x54, 0x42, 0xa2, 0xb7, 0x38, 0x6d, 0x50, 0x25, 0x40, 0xc1, 0x39, 0xf1,
+	0x21, 0x99, 0x59, 0x7b, 0xd6, 0x3b, 0xc2, 0xde, 0x31, 0x33, 0xb3, 0x21, 0xb9, 0xf4, 0x5c, 0x6e,
+	0x1c, 0x39, 0xf6, 0x0c, 0x07, 0x2e, 0xfc, 0x88, 0x88, 0x53, 0x0f, 0x1c, 0x10, 0x07, 0x83, 0x92,
+	0x7f, 0xe0, 0x5f, 0x80, 0xe6, 0xc3, 0x9b, 0xec, 0x92, 0x54, 0x8d, 0x38, 0xed, 0xcc, 0xf3, 0x3e,
+	0xef, 0xf3, 0x7e, 0xcc, 0xfb, 0xae, 0xc1, 0x47, 0x94, 0xe7, 0x94, 0x13, 0xee, 0xa7, 0x28, 0xcf,
+	0xfd, 0x82, 0xd2, 0x6c, 0x2b, 0xa7, 0x09, 0xce, 0xb8, 0xcf, 0x05, 0xea, 0x67, 0x98, 0x7f, 0x8f,
+	0x8a, 0x0b, 0xc7, 0x48, 0x32, 0xbc, 0x82, 0x51, 0x41, 0xed, 0xfb, 0xc6, 0xd5, 0x93, 0xae, 0x9e,
+	0x34, 0x68, 0x4f, 0xef, 0x9c, 0xee, 0x1d, 0x6e, 0xf7, 0xb1, 0x40, 0xdb, 0xb7, 0xdb, 0xb1, 0x22,
+	0x47, 0xca, 0xd3, 0xd7, 0x17, 0x2d, 0x73, 0x7b, 0x3d, 0xa5, 0x29, 0xd5, 0xb8, 0x3c, 0x19, 0xb4,
+	0x93, 0x52, 0x9a, 0x66, 0xd8, 0x57, 0xb7, 0xfe, 0x78, 0xe0, 0x27, 0x63, 0x86, 0x04, 0xa1, 0x23,
+	0x63, 0x87, 0xb3, 0x76, 0x41, 0x72, 0xcc, 0x05, 0xca, 0x8b, 0x5a, 0x40, 0x07, 0xf1, 0xd1, 0x58,
+	0x0c, 0x7d, 0x93, 0x86, 0xba, 0xcc, 0xd8, 0xfb, 0x88, 0xe3, 0x89, 0x3d, 0xa6, 0xa4, 0x0e, 0x70,
+	0x67, 0xaa, 0x31, 0x35, 0x41, 0xf5, 0x60, 0x80, 0xb1, 0x26, 0xb9, 0x7f, 0x34, 0x01, 0xd8, 0xa7,
+	0x34, 0xdb, 0x47, 0x0c, 0xe5, 0xdc, 0xfe, 0x1a, 0x2c, 0xd6, 0x04, 0xc7, 0xea, 0x5a, 0xbd, 0xa5,
+	0x60, 0xe7, 0xa4, 0x84, 0x8d, 0xbf, 0x4a, 0x78, 0x2f, 0x25, 0x62, 0x38, 0xee, 0x7b, 0x31, 0xcd,
+	0x4d, 0xf5, 0xe6, 0x67, 0x8b, 0x27, 0xdf, 0xfa, 0xe2, 0xb8, 0xc0, 0xdc, 0x7b, 0x84, 0xe3, 0xaa,
+	0x84, 0xab, 0xc7, 0x28, 0xcf, 0x1e, 0xba, 0xb5, 0x8e, 0x1b, 0xb6, 0xe4, 0x71, 0x0f, 0x63, 0xa9,
+	0x8e, 0x8f, 0x88, 0x50, 0xea, 0xcd, 0xff, 0xa7, 0x5e, 0xeb, 0xb8, 0x61, 0x4b, 0x1e, 0xa5, 0xfa,
+	0x0f, 0x16, 0xd8, 0x48, 0x8e, 0x47, 0x28, 0x27, 0x71, 0x54, 0x07, 0x8f, 0x0a, 0x55, 0x97, 0x33,
+	0xd7, 0xb5, 0x7a, 0xcb, 0x1f, 0xdc, 0xf7, 0xa6, 0x1e, 0xdc, 0xb4, 0xc4, 0x7b, 0xa4, 0x9d, 0x0e,
+	0x74, 0x96, 0xba, 0x13, 0xc1, 0xbd, 0x93, 0x12, 0x5a, 0x55, 0x09, 0x3b, 0x3a, 0xde, 0x15, 0xc2,
+	0x6e, 0xb8, 0x9e, 0x5c, 0xe2, 0xed, 0x3e, 0x6b, 0x81, 0x79, 0xd9, 0x56, 0xfb, 0x3d, 0xd0, 0x42,
+	0x49, 0xc2, 0x30, 0xe7, 0xa6, 0x9f, 0x76, 0x55, 0xc2, 0x15, 0xad, 0x69, 0x0c, 0x6e, 0x58, 0x53,
+	0xec, 0x15, 0xd0, 0x24, 0x89, 0x6a, 0xcd, 0x7c, 0xd8, 0x24, 0x89, 0xfd, 0x14, 0x2c, 0xcb, 0xa9,
+	0x9c, 0xae, 0xe2, 0x43, 0xef, 0xd5, 0xc7, 0xd6, 0x3b, 0x7f, 0xdb, 0xe0, 0xae, 0xec, 0x75, 0x55,
+	0xc2, 0x37, 0xcd, 0xfb, 0x4c, 0xaf, 0xc4, 0xa4, 0x20, 0x50, 0x9c, 0x8f, 0xc3, 0xe7, 0x60, 0x7d,
+	0x30, 0x16, 0x63, 0x86, 0x35, 0x25, 0xa5, 0x87, 0x98, 0x8d, 0x28, 0x73, 0xe6, 0x55, 0x29, 0xb0,
+	0x2a, 0xe1, 0xa6, 0x16, 0xbb, 0x8c, 0xe5, 0x86, 0xb6, 0x86, 0x65, 0x0e, 0x1f, 0x1b, 0xd0, 0xfe,
+	0x02, 0xbc, 0x26, 0xa8, 0x40, 0x59, 0xc4, 0x87, 0x88, 0x61, 0xee, 0xdc, 0x50, 0x35, 0xb5, 0x3d,
+	0xb3, 0x51, 0x72, 0x98, 0x27, 0xc9, 0xef, 0x52, 0x32, 0x0a, 0x36, 0x4d, 0xda, 0xaf, 0xeb, 0x48,
+	0x17, 0x9d, 0xdd, 0x70, 0x59, 0x5d, 0x0f, 0xd4, 0xcd, 0x66, 0x60, 0x45, 0x25, 0x90, 0x91, 0xef,
+	0xc6, 0x24, 0x21, 0xe2, 0xd8, 0x59, 0xe8, 0xce, 0xbd, 0x5c, 0xfc, 0x7d, 0x29, 0xfe, 0xf3, 0xdf,
+	0xb0, 0xf7, 0x0a, 0xf3, 0x27, 0x1d, 0x78, 0x78, 0x53, 0x86, 0xf8, 0xa4, 0x8e, 0x60, 0x7f, 0x06,
+	0x56, 0x79, 0x8c, 0x32, 0x32, 0x4a, 0xa3, 0x01, 0x8a, 0x05, 0x65, 0xdc, 0x69, 0x75, 0xe7, 0x7a,
+	0xf3, 0xc1, 0xdd, 0xaa, 0x84, 0x6f, 0xfd, 0xa7, 0xd3, 0x33, 0x5c, 0x37, 0x5c, 0x31, 0xc8, 0x9e,
+	0x06, 0xec, 0x6f, 0x40, 0x7b, 0x9a, 0x13, 0xc5, 0x74, 0x24, 0x18, 0xcd, 0x32, 0xcc, 0x9c, 0x45,
+	0xd5, 0xf6, 0xb7, 0xab, 0x12, 0x76, 0x8d, 0xf2, 0x55, 0x54, 0x37, 0xdc, 0x98, 0x12, 0xde, 0x9d,
+	0x58, 0xec, 0x5f, 0x2d, 0xf0, 0xc6, 0x8c, 0x1f, 0x43, 0x02, 0xcb, 0x3f, 0xb6, 0x43, 0x92, 0x60,
+	0xe6, 0x2c, 0xa9, 0x17, 0x79, 0x7c, 0x9d, 0x29, 0x3b, 0xb8, 0x18, 0x2b, 0x44, 0x02, 0xef, 0x1b,
+	0xb1, 0xe0, 0x9d, 0xaa, 0x84, 0x77, 0x2e, 0x4d, 0x76, 0x2a, 0xa8, 0x1b, 0xb6, 0xf9, 0x55, 0x1a,
+	0xf6, 0xbb, 0x60, 0xa1, 0x40, 0x63, 0x8e, 0x13, 0x07, 0x74, 0xad, 0xde, 0x62, 0xb0, 0x56, 0x95,
+	0xf0, 0xa6, 0xd6, 0xd4, 0xb8, 0x1b, 0x1a, 0xc2, 0xc3, 0xb5, 0x67, 0xcf, 0x61, 0xe3, 0xa7, 0xe7,
+	0xb0, 0xf1, 0xfb, 0x6f, 0x5b, 0x37, 0xe4, 0xdc, 0x3d, 0x71, 0x7f, 0x69, 0x82, 0xf6, 0x95, 0xf9,
+	0xd9, 0x7b, 0xe0, 0x96, 0xea, 0x1a, 0x8a, 0x45, 0x34, 0xbd, 0xa8, 0x9b, 0x55, 0x09, 0x37, 0x74,
+	0x94, 0x59, 0x86, 0x1b, 0xae, 0xd6, 0xd0, 0x8e, 0xd9, 0xdc, 0x3d, 0x70, 0x0b, 0x17, 0x34, 0x1e,
+	0x46, 0x24, 0xc1, 0x23, 0x41, 0x06, 0x04, 0x33, 0xa7, 0x39, 0xab, 0x33, 0xcb, 0x70, 0xc3, 0x55,
+	0x05, 0x3d, 0x99, 0x20, 0xf6, 0x53, 0xb0, 0x9e, 0xa3, 0xa3, 0x28, 0x1e, 0xa2, 0x51, 0x8a, 0xa3,
+	0x02, 0xb3, 0x48, 0x31, 0xd4, 0xea, 0x2f, 0x05, 0x9f, 0x5e, 0xfb, 0xef, 0xd2, 0xec, 0xe7, 0x65,
+	0x9a, 0x6e, 0xb8, 0x96, 0xa3, 0xa3, 0x5d, 0x85, 0xee, 0x63, 0xf6, 0x58, 0x62, 0xc1, 0x57, 0x27,
+	0xa7, 0x1d, 0xeb, 0xc5, 0x69, 0xc7, 0xfa, 0xe7, 0xb4, 0x63, 0xfd, 0x78, 0xd6, 0x69, 0xbc, 0x38,
+	0xeb, 0x34, 0xfe, 0x3c, 0xeb, 0x34, 0xbe, 0xdc, 0xb9, 0x10, 0xd3, 0x8c, 0xc6, 0x56, 0x86, 0xfa,
+	0xbc, 0xbe, 0xf8, 0x87, 0xdb, 0x0f, 0xfc, 0xa3, 0x97, 0x7d, 0x85, 0xfb, 0x0b, 0xea, 0x9b, 0xf3,
+	0xe0, 0xdf, 0x01, 0x00, 0x03, 0xad, 0x68, 0xaa, 0xb3, 0x07, 0x00, 0x00,
 }
 
 func (m *PoolParams) Marshal() (dAtA []byte, err error) {
@@ -200,6 +293,18 @@ func (m *PoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DynamicSwapFeeParams != nil {
+		{
+			size, err := m.DynamicSwapFeeParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size := m.ExitFee.Size()
 		i -= size
@@ -243,6 +348,28 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ScalingFactorRateProvider != nil {
+		{
+			size, err := m.ScalingFactorRateProvider.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.ScalingFactorController) > 0 {
 		i -= len(m.ScalingFactorController)
 		copy(dAtA[i:], m.ScalingFactorController)
@@ -251,20 +378,20 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 	}
 	if len(m.ScalingFactors) > 0 {
-		dAtA2 := make([]byte, len(m.ScalingFactors)*10)
-		var j1 int
+		dAtA4 := make([]byte, len(m.ScalingFactors)*10)
+		var j3 int
 		for _, num := range m.ScalingFactors {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j3++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintStableswapPool(dAtA, i, uint64(j1))
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintStableswapPool(dAtA, i, uint64(j3))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -324,6 +451,53 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ScalingFactorRateProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScalingFactorRateProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScalingFactorRateProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxChangePerEpoch.Size()
+		i -= size
+		if _, err := m.MaxChangePerEpoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintStableswapPool(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintStableswapPool(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStableswapPool(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStableswapPool(v)
 	base := offset
@@ -345,6 +519,10 @@ func (m *PoolParams) Size() (n int) {
 	n += 1 + l + sovStableswapPool(uint64(l))
 	l = m.ExitFee.Size()
 	n += 1 + l + sovStableswapPool(uint64(l))
+	if m.DynamicSwapFeeParams != nil {
+		l = m.DynamicSwapFeeParams.Size()
+		n += 1 + l + sovStableswapPool(uint64(l))
+	}
 	return n
 }
 
@@ -386,6 +564,32 @@ func (m *Pool) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStableswapPool(uint64(l))
 	}
+	if m.ScalingFactorRateProvider != nil {
+		l = m.ScalingFactorRateProvider.Size()
+		n += 1 + l + sovStableswapPool(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *ScalingFactorRateProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovStableswapPool(uint64(l))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovStableswapPool(uint64(l))
+	}
+	l = m.MaxChangePerEpoch.Size()
+	n += 1 + l + sovStableswapPool(uint64(l))
 	return n
 }
 
@@ -492,6 +696,42 @@ func (m *PoolParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicSwapFeeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DynamicSwapFeeParams == nil {
+				m.DynamicSwapFeeParams = &types.DynamicSwapFeeParams{}
+			}
+			if err := m.DynamicSwapFeeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStableswapPool(dAtA[iNdEx:])
@@ -720,7 +960,7 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolLiquidity = append(m.PoolLiquidity, types.Coin{})
+			m.PoolLiquidity = append(m.PoolLiquidity, types1.Coin{})
 			if err := m.PoolLiquidity[len(m.PoolLiquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -833,6 +1073,210 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			}
 			m.ScalingFactorController = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactorRateProvider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScalingFactorRateProvider == nil {
+				m.ScalingFactorRateProvider = &ScalingFactorRateProvider{}
+			}
+			if err := m.ScalingFactorRateProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStableswapPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScalingFactorRateProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStableswapPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScalingFactorRateProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScalingFactorRateProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangePerEpoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangePerEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStableswapPool(dAtA[iNdEx:])
@@ -262,11 +262,120 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgStableSwapAdjustScalingFactorsResponse proto.InternalMessageInfo
 
+// Sender must be the pool's scaling_factor_governor in order for the tx to
+// succeed. Sets the rate provider the scaling factors are adjusted by at the
+// end of every epoch, or removes it if unset.
+type MsgStableSwapSetScalingFactorRateProvider struct {
+	Sender       string                     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID       uint64                     `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	RateProvider *ScalingFactorRateProvider `protobuf:"bytes,3,opt,name=rate_provider,json=rateProvider,proto3" json:"rate_provider,omitempty" yaml:"rate_provider"`
+}
+
+func (m *MsgStableSwapSetScalingFactorRateProvider) Reset() {
+	*m = MsgStableSwapSetScalingFactorRateProvider{}
+}
+func (m *MsgStableSwapSetScalingFactorRateProvider) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgStableSwapSetScalingFactorRateProvider) ProtoMessage() {}
+func (*MsgStableSwapSetScalingFactorRateProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b7c8a0f24de97c, []int{4}
+}
+func (m *MsgStableSwapSetScalingFactorRateProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapSetScalingFactorRateProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapSetScalingFactorRateProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapSetScalingFactorRateProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapSetScalingFactorRateProvider.Merge(m, src)
+}
+func (m *MsgStableSwapSetScalingFactorRateProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapSetScalingFactorRateProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapSetScalingFactorRateProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapSetScalingFactorRateProvider proto.InternalMessageInfo
+
+func (m *MsgStableSwapSetScalingFactorRateProvider) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgStableSwapSetScalingFactorRateProvider) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+func (m *MsgStableSwapSetScalingFactorRateProvider) GetRateProvider() *ScalingFactorRateProvider {
+	if m != nil {
+		return m.RateProvider
+	}
+	return nil
+}
+
+type MsgStableSwapSetScalingFactorRateProviderResponse struct {
+}
+
+func (m *MsgStableSwapSetScalingFactorRateProviderResponse) Reset() {
+	*m = MsgStableSwapSetScalingFactorRateProviderResponse{}
+}
+func (m *MsgStableSwapSetScalingFactorRateProviderResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgStableSwapSetScalingFactorRateProviderResponse) ProtoMessage() {}
+func (*MsgStableSwapSetScalingFactorRateProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b7c8a0f24de97c, []int{5}
+}
+func (m *MsgStableSwapSetScalingFactorRateProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapSetScalingFactorRateProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapSetScalingFactorRateProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapSetScalingFactorRateProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapSetScalingFactorRateProviderResponse.Merge(m, src)
+}
+func (m *MsgStableSwapSetScalingFactorRateProviderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapSetScalingFactorRateProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapSetScalingFactorRateProviderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapSetScalingFactorRateProviderResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateStableswapPool)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgCreateStableswapPool")
 	proto.RegisterType((*MsgCreateStableswapPoolResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgCreateStableswapPoolResponse")
 	proto.RegisterType((*MsgStableSwapAdjustScalingFactors)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapAdjustScalingFactors")
 	proto.RegisterType((*MsgStableSwapAdjustScalingFactorsResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapAdjustScalingFactorsResponse")
+	proto.RegisterType((*MsgStableSwapSetScalingFactorRateProvider)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapSetScalingFactorRateProvider")
+	proto.RegisterType((*MsgStableSwapSetScalingFactorRateProviderResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapSetScalingFactorRateProviderResponse")
 }
 
 func init() {
@@ -274,46 +383,51 @@ func init() {
 }
 
 var fileDescriptor_46b7c8a0f24de97c = []byte{
-	// 622 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
-	0x14, 0x6f, 0xd6, 0x52, 0x84, 0x27, 0x40, 0x44, 0xd5, 0x96, 0x15, 0x29, 0x29, 0x81, 0x43, 0x07,
-	0x2c, 0x66, 0x9b, 0x84, 0x04, 0xb7, 0xa5, 0x68, 0x68, 0x82, 0x4a, 0x23, 0x13, 0x17, 0x38, 0x14,
-	0x27, 0xf1, 0x82, 0x21, 0x89, 0x43, 0xec, 0x76, 0xeb, 0x91, 0x6f, 0xc0, 0xc7, 0x40, 0x7c, 0x07,
-	0x2e, 0x1c, 0xd0, 0x8e, 0x3b, 0x72, 0x0a, 0xa8, 0xfd, 0x06, 0xbd, 0x71, 0x43, 0x89, 0xd3, 0x7f,
-	0x52, 0xbb, 0xad, 0x68, 0xa7, 0x38, 0xcf, 0xbf, 0xf7, 0xfb, 0xbd, 0xf7, 0x7b, 0xb6, 0xc1, 0x43,
-	0xca, 0x02, 0xca, 0x08, 0x83, 0x1e, 0x0a, 0x02, 0x18, 0x51, 0xea, 0x6f, 0x04, 0xd4, 0xc5, 0x3e,
-	0x83, 0x8c, 0x23, 0xdb, 0xc7, 0xec, 0x08, 0x45, 0x90, 0x1f, 0x1b, 0x51, 0x4c, 0x39, 0x95, 0xef,
-	0xe7, 0x68, 0x23, 0x45, 0x1b, 0x29, 0x5a, 0x80, 0x8d, 0x31, 0xd8, 0xe8, 0x6c, 0xda, 0x98, 0xa3,
-	0xcd, 0xaa, 0xea, 0x64, 0x60, 0x68, 0x23, 0x86, 0x61, 0x1e, 0x84, 0x0e, 0x25, 0xa1, 0xe0, 0xaa,
-	0x56, 0x3c, 0xea, 0xd1, 0x6c, 0x09, 0xd3, 0x55, 0x1e, 0x7d, 0x72, 0x91, 0x7a, 0xc6, 0xcb, 0x56,
-	0x8a, 0x10, 0xa9, 0xfa, 0xf7, 0x12, 0x58, 0x6d, 0x32, 0xaf, 0x11, 0x63, 0xc4, 0xf1, 0xc1, 0x08,
-	0xb2, 0x4f, 0xa9, 0x2f, 0xaf, 0x83, 0x32, 0xc3, 0xa1, 0x8b, 0x63, 0x45, 0xaa, 0x49, 0xf5, 0x6b,
-	0xe6, 0xad, 0x41, 0xa2, 0x5d, 0xef, 0xa2, 0xc0, 0x7f, 0xaa, 0x8b, 0xb8, 0x6e, 0xe5, 0x00, 0x99,
-	0x82, 0xe5, 0x94, 0xb4, 0x15, 0xa1, 0x18, 0x05, 0x4c, 0x59, 0xaa, 0x49, 0xf5, 0xe5, 0xad, 0xc7,
-	0xc6, 0xc5, 0x3b, 0x37, 0x52, 0xc5, 0xfd, 0x2c, 0xdb, 0x5c, 0x19, 0x24, 0x9a, 0x2c, 0x74, 0x26,
-	0x48, 0x75, 0x0b, 0x44, 0x23, 0x8c, 0xfc, 0x59, 0x02, 0x2b, 0x24, 0x24, 0x9c, 0x20, 0x3f, 0x6b,
-	0xa7, 0xe5, 0x93, 0x4f, 0x6d, 0xe2, 0x12, 0xde, 0x55, 0x8a, 0xb5, 0x62, 0x7d, 0x79, 0x6b, 0xcd,
-	0x10, 0x56, 0x1a, 0xa9, 0x95, 0x23, 0x95, 0x06, 0x25, 0xa1, 0xf9, 0xe8, 0x24, 0xd1, 0x0a, 0xdf,
-	0x7e, 0x6b, 0x75, 0x8f, 0xf0, 0xf7, 0x6d, 0xdb, 0x70, 0x68, 0x00, 0x73, 0xdf, 0xc5, 0x67, 0x83,
-	0xb9, 0x1f, 0x21, 0xef, 0x46, 0x98, 0x65, 0x09, 0xcc, 0xaa, 0xe4, 0x52, 0x69, 0x91, 0x2f, 0x87,
-	0x42, 0x72, 0x13, 0xdc, 0x64, 0x0e, 0xf2, 0x49, 0xe8, 0xb5, 0x0e, 0x91, 0xc3, 0x69, 0xcc, 0x94,
-	0x52, 0xad, 0x58, 0x2f, 0x99, 0xf7, 0x06, 0x89, 0x56, 0xcb, 0x8d, 0x1a, 0xbb, 0x3e, 0x8d, 0xd5,
-	0xad, 0x1b, 0x79, 0x60, 0x57, 0xe4, 0xca, 0xaf, 0x40, 0xe5, 0xb0, 0xcd, 0xdb, 0x31, 0x16, 0x0d,
-	0x79, 0xb4, 0x83, 0xe3, 0x90, 0xc6, 0xca, 0x95, 0xcc, 0x7c, 0x6d, 0x90, 0x68, 0xb7, 0x05, 0xe7,
-	0x2c, 0x94, 0x6e, 0xc9, 0x22, 0x9c, 0x96, 0xf8, 0x3c, 0x0f, 0xca, 0xef, 0xc0, 0xda, 0xb4, 0x6a,
-	0xcb, 0xa1, 0x21, 0x8f, 0xa9, 0xef, 0xe3, 0x58, 0x29, 0x67, 0xbc, 0x93, 0xb5, 0xce, 0x83, 0xea,
-	0xd6, 0xea, 0x54, 0xad, 0x8d, 0xf1, 0xce, 0x2e, 0xd0, 0xe6, 0x1c, 0x1f, 0x0b, 0xb3, 0x88, 0x86,
-	0x0c, 0xcb, 0x77, 0xc1, 0xd5, 0xac, 0x54, 0xe2, 0x66, 0xe7, 0xa8, 0x64, 0x82, 0x5e, 0xa2, 0x95,
-	0x53, 0xc8, 0xde, 0x33, 0xab, 0x9c, 0x6e, 0xed, 0xb9, 0xfa, 0x0f, 0x09, 0xdc, 0x69, 0x32, 0x4f,
-	0x50, 0x1c, 0x1c, 0xa1, 0x68, 0xc7, 0xfd, 0xd0, 0x66, 0xfc, 0x60, 0xda, 0xa2, 0x05, 0x4e, 0xe4,
-	0x84, 0xea, 0xd2, 0x3c, 0xd5, 0x59, 0x13, 0x2c, 0xfe, 0xff, 0x04, 0xf5, 0x07, 0x60, 0xfd, 0xdc,
-	0x1e, 0x86, 0xb6, 0x6c, 0xfd, 0x5d, 0x02, 0xc5, 0x26, 0xf3, 0xe4, 0xaf, 0x12, 0xa8, 0xcc, 0xbc,
-	0x7e, 0x8d, 0x45, 0xae, 0xcf, 0x9c, 0x21, 0x54, 0x5f, 0x5c, 0x02, 0xc9, 0x68, 0x92, 0x3f, 0x25,
-	0xa0, 0x9e, 0x33, 0xa1, 0xe6, 0x82, 0x7a, 0x67, 0xd3, 0x55, 0x5f, 0x5f, 0x2a, 0xdd, 0xb0, 0x11,
-	0xf3, 0xed, 0x49, 0x4f, 0x95, 0x4e, 0x7b, 0xaa, 0xf4, 0xa7, 0xa7, 0x4a, 0x5f, 0xfa, 0x6a, 0xe1,
-	0xb4, 0xaf, 0x16, 0x7e, 0xf5, 0xd5, 0xc2, 0x9b, 0x9d, 0x89, 0x37, 0x21, 0x97, 0xde, 0xf0, 0x91,
-	0xcd, 0x86, 0x3f, 0xb0, 0xb3, 0xb9, 0x0d, 0x8f, 0xcf, 0x7a, 0x68, 0xed, 0x72, 0xf6, 0xb2, 0x6e,
-	0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xc6, 0x5b, 0x3f, 0xf5, 0x26, 0x06, 0x00, 0x00,
+	// 703 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x18, 0x8d, 0x9b, 0xdc, 0x5c, 0xdd, 0xe9, 0x2d, 0x08, 0x2b, 0x6a, 0xdd, 0x20, 0xd9, 0xc1, 0x20,
+	0x94, 0x02, 0xb5, 0x49, 0x2b, 0x21, 0xc1, 0xae, 0x0e, 0x14, 0x55, 0x10, 0xa9, 0x38, 0x62, 0x03,
+	0x42, 0x61, 0x12, 0x4f, 0xcd, 0x80, 0xed, 0x31, 0x33, 0x93, 0xb4, 0x5d, 0x82, 0x78, 0x00, 0x1e,
+	0x03, 0xf1, 0x0e, 0x6c, 0x58, 0xa0, 0x2e, 0xbb, 0x44, 0x2c, 0x0c, 0x4a, 0xdf, 0x20, 0x4f, 0x80,
+	0xec, 0x71, 0xfe, 0x50, 0xd3, 0x26, 0x55, 0x59, 0x65, 0xf2, 0xf9, 0xcc, 0x39, 0xe7, 0x3b, 0xfe,
+	0xc6, 0x03, 0x6e, 0x11, 0xe6, 0x13, 0x86, 0x99, 0xe9, 0x42, 0xdf, 0x37, 0x43, 0x42, 0xbc, 0x55,
+	0x9f, 0x38, 0xc8, 0x63, 0x26, 0xe3, 0xb0, 0xe9, 0x21, 0xb6, 0x0b, 0x43, 0x93, 0xef, 0x19, 0x21,
+	0x25, 0x9c, 0xc8, 0x37, 0x52, 0xb4, 0x11, 0xa3, 0x8d, 0x18, 0x2d, 0xc0, 0xc6, 0x10, 0x6c, 0x74,
+	0x2a, 0x4d, 0xc4, 0x61, 0xa5, 0xa8, 0xb6, 0x12, 0xb0, 0xd9, 0x84, 0x0c, 0x99, 0x69, 0xd1, 0x6c,
+	0x11, 0x1c, 0x08, 0xae, 0x62, 0xc1, 0x25, 0x2e, 0x49, 0x96, 0x66, 0xbc, 0x4a, 0xab, 0x77, 0xa7,
+	0xf1, 0x33, 0x5c, 0x36, 0x62, 0x84, 0xd8, 0xaa, 0x7f, 0xc9, 0x81, 0xa5, 0x1a, 0x73, 0xab, 0x14,
+	0x41, 0x8e, 0xea, 0x03, 0xc8, 0x36, 0x21, 0x9e, 0xbc, 0x02, 0xf2, 0x0c, 0x05, 0x0e, 0xa2, 0x8a,
+	0x54, 0x92, 0xca, 0xff, 0x59, 0x97, 0x7a, 0x91, 0xb6, 0xb0, 0x0f, 0x7d, 0xef, 0x9e, 0x2e, 0xea,
+	0xba, 0x9d, 0x02, 0x64, 0x02, 0xe6, 0x63, 0xd2, 0x46, 0x08, 0x29, 0xf4, 0x99, 0x32, 0x57, 0x92,
+	0xca, 0xf3, 0x6b, 0x77, 0x8c, 0xe9, 0x3b, 0x37, 0x62, 0xc5, 0xed, 0x64, 0xb7, 0xb5, 0xd8, 0x8b,
+	0x34, 0x59, 0xe8, 0x8c, 0x90, 0xea, 0x36, 0x08, 0x07, 0x18, 0xf9, 0x9d, 0x04, 0x16, 0x71, 0x80,
+	0x39, 0x86, 0x5e, 0xd2, 0x4e, 0xc3, 0xc3, 0x6f, 0xdb, 0xd8, 0xc1, 0x7c, 0x5f, 0xc9, 0x96, 0xb2,
+	0xe5, 0xf9, 0xb5, 0x65, 0x43, 0x44, 0x69, 0xc4, 0x51, 0x0e, 0x54, 0xaa, 0x04, 0x07, 0xd6, 0xed,
+	0x83, 0x48, 0xcb, 0x7c, 0xfe, 0xa9, 0x95, 0x5d, 0xcc, 0x5f, 0xb5, 0x9b, 0x46, 0x8b, 0xf8, 0x66,
+	0x9a, 0xbb, 0xf8, 0x59, 0x65, 0xce, 0x1b, 0x93, 0xef, 0x87, 0x88, 0x25, 0x1b, 0x98, 0x5d, 0x48,
+	0xa5, 0x62, 0x93, 0x8f, 0xfb, 0x42, 0x72, 0x0d, 0x5c, 0x64, 0x2d, 0xe8, 0xe1, 0xc0, 0x6d, 0xec,
+	0xc0, 0x16, 0x27, 0x94, 0x29, 0xb9, 0x52, 0xb6, 0x9c, 0xb3, 0xae, 0xf5, 0x22, 0xad, 0x94, 0x06,
+	0x35, 0x4c, 0x7d, 0x1c, 0xab, 0xdb, 0x17, 0xd2, 0xc2, 0xa6, 0xd8, 0x2b, 0x3f, 0x01, 0x85, 0x9d,
+	0x36, 0x6f, 0x53, 0x24, 0x1a, 0x72, 0x49, 0x07, 0xd1, 0x80, 0x50, 0xe5, 0x9f, 0x24, 0x7c, 0xad,
+	0x17, 0x69, 0x97, 0x05, 0xe7, 0x71, 0x28, 0xdd, 0x96, 0x45, 0x39, 0xb6, 0xf8, 0x30, 0x2d, 0xca,
+	0x2f, 0xc1, 0xf2, 0xb8, 0x6a, 0xa3, 0x45, 0x02, 0x4e, 0x89, 0xe7, 0x21, 0xaa, 0xe4, 0x13, 0xde,
+	0x51, 0xaf, 0x93, 0xa0, 0xba, 0xbd, 0x34, 0xe6, 0xb5, 0x3a, 0x7c, 0xb2, 0x09, 0xb4, 0x09, 0xe3,
+	0x63, 0x23, 0x16, 0x92, 0x80, 0x21, 0xf9, 0x2a, 0xf8, 0x37, 0xb1, 0x8a, 0x9d, 0x64, 0x8e, 0x72,
+	0x16, 0xe8, 0x46, 0x5a, 0x3e, 0x86, 0x6c, 0xdd, 0xb7, 0xf3, 0xf1, 0xa3, 0x2d, 0x47, 0xff, 0x2a,
+	0x81, 0x2b, 0x35, 0xe6, 0x0a, 0x8a, 0xfa, 0x2e, 0x0c, 0x37, 0x9c, 0xd7, 0x6d, 0xc6, 0xeb, 0xe3,
+	0x11, 0xcd, 0x30, 0x91, 0x23, 0xaa, 0x73, 0x93, 0x54, 0x8f, 0x7b, 0x83, 0xd9, 0xb3, 0xbf, 0x41,
+	0xfd, 0x26, 0x58, 0x39, 0xb5, 0x87, 0x7e, 0x2c, 0xfa, 0xfb, 0xb9, 0x3f, 0xd0, 0x75, 0x34, 0x0e,
+	0xb5, 0x21, 0x47, 0xdb, 0x94, 0x74, 0x70, 0xdc, 0xce, 0x79, 0x77, 0xfe, 0x41, 0x02, 0x0b, 0x14,
+	0x72, 0xd4, 0x08, 0x53, 0x05, 0x25, 0x9b, 0x9c, 0xd9, 0x07, 0xb3, 0x9c, 0xd9, 0x89, 0x76, 0x2d,
+	0xa5, 0x17, 0x69, 0x05, 0x61, 0x6f, 0x4c, 0x45, 0xb7, 0xff, 0xa7, 0x23, 0x38, 0x7d, 0x1d, 0x54,
+	0xa6, 0xce, 0xa0, 0x9f, 0xdc, 0xda, 0x8f, 0x1c, 0xc8, 0xd6, 0x98, 0x2b, 0x7f, 0x92, 0x40, 0xe1,
+	0xd8, 0x0f, 0x57, 0x75, 0x96, 0x26, 0x26, 0x8c, 0x6f, 0xf1, 0xd1, 0x39, 0x90, 0x0c, 0xce, 0xc0,
+	0x37, 0x09, 0xa8, 0xa7, 0xcc, 0x76, 0x6d, 0x46, 0xbd, 0x93, 0xe9, 0x8a, 0x4f, 0xcf, 0x95, 0x6e,
+	0xd0, 0x48, 0x24, 0x81, 0xeb, 0x53, 0x8e, 0xec, 0xd9, 0x1d, 0x9c, 0x44, 0x5b, 0x7c, 0xf1, 0x57,
+	0x68, 0xfb, 0x0d, 0x5a, 0xcf, 0x0f, 0xba, 0xaa, 0x74, 0xd8, 0x55, 0xa5, 0x5f, 0x5d, 0x55, 0xfa,
+	0x78, 0xa4, 0x66, 0x0e, 0x8f, 0xd4, 0xcc, 0xf7, 0x23, 0x35, 0xf3, 0x6c, 0x63, 0xe4, 0xba, 0x48,
+	0x2d, 0xac, 0x7a, 0xb0, 0xc9, 0xfa, 0x7f, 0xcc, 0x4e, 0x65, 0xdd, 0xdc, 0x3b, 0xe9, 0x0e, 0x6e,
+	0xe6, 0x93, 0x4b, 0x77, 0xfd, 0xf7, 0x00, 0x94, 0xc9, 0x4f, 0xa9, 0x41, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -330,6 +444,7 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	CreateStableswapPool(ctx context.Context, in *MsgCreateStableswapPool, opts ...grpc.CallOption) (*MsgCreateStableswapPoolResponse, error)
 	StableSwapAdjustScalingFactors(ctx context.Context, in *MsgStableSwapAdjustScalingFactors, opts ...grpc.CallOption) (*MsgStableSwapAdjustScalingFactorsResponse, error)
+	StableSwapSetScalingFactorRateProvider(ctx context.Context, in *MsgStableSwapSetScalingFactorRateProvider, opts ...grpc.CallOption) (*MsgStableSwapSetScalingFactorRateProviderResponse, error)
 }
 
 type msgClient struct {
@@ -358,10 +473,20 @@ func (c *msgClient) StableSwapAdjustScalingFactors(ctx context.Context, in *MsgS
 	return out, nil
 }
 
+func (c *msgClient) StableSwapSetScalingFactorRateProvider(ctx context.Context, in *MsgStableSwapSetScalingFactorRateProvider, opts ...grpc.CallOption) (*MsgStableSwapSetScalingFactorRateProviderResponse, error) {
+	out := new(MsgStableSwapSetScalingFactorRateProviderResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapSetScalingFactorRateProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateStableswapPool(context.Context, *MsgCreateStableswapPool) (*MsgCreateStableswapPoolResponse, error)
 	StableSwapAdjustScalingFactors(context.Context, *MsgStableSwapAdjustScalingFactors) (*MsgStableSwapAdjustScalingFactorsResponse, error)
+	StableSwapSetScalingFactorRateProvider(context.Context, *MsgStableSwapSetScalingFactorRateProvider) (*MsgStableSwapSetScalingFactorRateProviderResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -374,6 +499,9 @@ func (*UnimplementedMsgServer) CreateStableswapPool(ctx context.Context, req *Ms
 func (*UnimplementedMsgServer) StableSwapAdjustScalingFactors(ctx context.Context, req *MsgStableSwapAdjustScalingFactors) (*MsgStableSwapAdjustScalingFactorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StableSwapAdjustScalingFactors not implemented")
 }
+func (*UnimplementedMsgServer) StableSwapSetScalingFactorRateProvider(ctx context.Context, req *MsgStableSwapSetScalingFactorRateProvider) (*MsgStableSwapSetScalingFactorRateProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StableSwapSetScalingFactorRateProvider not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -415,6 +543,24 @@ func _Msg_StableSwapAdjustScalingFactors_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_StableSwapSetScalingFactorRateProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStableSwapSetScalingFactorRateProvider)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StableSwapSetScalingFactorRateProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapSetScalingFactorRateProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StableSwapSetScalingFactorRateProvider(ctx, req.(*MsgStableSwapSetScalingFactorRateProvider))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.poolmodels.stableswap.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -427,6 +573,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StableSwapAdjustScalingFactors",
 			Handler:    _Msg_StableSwapAdjustScalingFactors_Handler,
 		},
+		{
+			MethodName: "StableSwapSetScalingFactorRateProvider",
+			Handler:    _Msg_StableSwapSetScalingFactorRateProvider_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/pool-models/stableswap/tx.proto",
@@ -624,6 +774,76 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) MarshalToSizedBuffer(dAtA []
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgStableSwapSetScalingFactorRateProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapSetScalingFactorRateProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapSetScalingFactorRateProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RateProvider != nil {
+		{
+			size, err := m.RateProvider.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStableSwapSetScalingFactorRateProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapSetScalingFactorRateProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapSetScalingFactorRateProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -717,6 +937,35 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgStableSwapSetScalingFactorRateProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	if m.RateProvider != nil {
+		l = m.RateProvider.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStableSwapSetScalingFactorRateProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1311,6 +1560,193 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
+func (m *MsgStableSwapSetScalingFactorRateProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapSetScalingFactorRateProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapSetScalingFactorRateProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateProvider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RateProvider == nil {
+				m.RateProvider = &ScalingFactorRateProvider{}
+			}
+			if err := m.RateProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStableSwapSetScalingFactorRateProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapSetScalingFactorRateProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapSetScalingFactorRateProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -12,8 +12,17 @@ const (
 	BalancerGasFeeForSwap = 10_000
 
 	StableswapMinScaledAmtPerAsset = 1
+	// StableswapGasFeePerSolverIteration is the gas consumed per iteration of the CFMM solver in a stableswap swap,
+	// so that the swap's computation is paid for like the fixed BalancerGasFeeForSwap.
+	StableswapGasFeePerSolverIteration = 1_000
 	// We keep this multiplier at 1, but can increase if needed in the unlikely scenario where default scaling factors of 1 cannot accommodate enough assets
 	ScalingFactorMultiplier = 1
+	// RateProviderQueryGasLimit is the gas limit of querying a stableswap pool's scaling factor rate provider contract.
+	RateProviderQueryGasLimit = 1_000_000
+	// MaxBestRouteHops is the maximum number of pools in a route searched by the best routes query.
+	MaxBestRouteHops = 4
+	// MaxBestRoutes is the maximum number of routes returned by the best routes query.
+	MaxBestRoutes = 10
 )
 
 var (
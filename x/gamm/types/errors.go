@@ -54,4 +54,13 @@ var (
 	ErrInvalidScalingFactors      = sdkerrors.Register(ModuleName, 64, "scaling factors cannot be 0 or use more than 63 bits")
 	ErrHitMaxScaledAssets         = sdkerrors.Register(ModuleName, 65, "post-scaled pool assets can not exceed 10^34")
 	ErrHitMinScaledAssets         = sdkerrors.Register(ModuleName, 66, "post-scaled pool assets can not be less than 1")
+	ErrNotPoolGovernor            = sdkerrors.Register(ModuleName, 67, "not future pool governor")
+	ErrPoolWeightsChanging        = sdkerrors.Register(ModuleName, 68, "pool weights are changing")
+	ErrPoolAssetNotPhasedOut      = sdkerrors.Register(ModuleName, 69, "pool asset is not phased out")
+	ErrInvalidRateProvider        = sdkerrors.Register(ModuleName, 70, "invalid scaling factor rate provider")
+	ErrInvalidRedemptionRates     = sdkerrors.Register(ModuleName, 71, "invalid redemption rates")
+	ErrCircuitBreakerTripped      = sdkerrors.Register(ModuleName, 72, "spot price deviates too far from twap")
+	ErrInvalidDynamicSwapFee      = sdkerrors.Register(ModuleName, 73, "invalid dynamic swap fee params")
+	ErrInvalidSplitRoutes         = sdkerrors.Register(ModuleName, 74, "invalid split routes")
+	ErrWeightChangeTooShort       = sdkerrors.Register(ModuleName, 75, "weight change duration is too short")
 )
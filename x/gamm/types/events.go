@@ -5,10 +5,18 @@ const (
 	TypeEvtPoolExited   = "pool_exited"
 	TypeEvtPoolCreated  = "pool_created"
 	TypeEvtTokenSwapped = "token_swapped"
+	TypeEvtPoolPaused   = "pool_paused"
+	TypeEvtPoolResumed  = "pool_resumed"
+
+	TypeEvtProtocolFeesDistributed = "protocol_fees_distributed"
+	TypeEvtSplitRouteSwapped       = "split_route_swapped"
 
 	AttributeValueCategory = ModuleName
 	AttributeKeyPoolId     = "pool_id"
+	AttributeKeyPoolIds    = "pool_ids"
 	AttributeKeySwapFee    = "swap_fee"
 	AttributeKeyTokensIn   = "tokens_in"
 	AttributeKeyTokensOut  = "tokens_out"
+	AttributeKeyRecipient  = "recipient"
+	AttributeKeyAmount     = "amount"
 )
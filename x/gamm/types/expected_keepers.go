@@ -1,9 +1,13 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
 )
 
 // AccountKeeper defines the account contract that must be fulfilled when
@@ -45,3 +49,24 @@ type CommunityPoolKeeper interface {
 type PoolIncentivesKeeper interface {
 	IsPoolIncentivized(ctx sdk.Context, poolId uint64) bool
 }
+
+// LockupKeeper defines the lockup contract needed for pools governed by lock-duration governance.
+type LockupKeeper interface {
+	GetPeriodLocksAccumulation(ctx sdk.Context, query lockuptypes.QueryCondition) sdk.Int
+	GetAccountLockedLongerDurationDenomNotUnlockingOnly(ctx sdk.Context, addr sdk.AccAddress, denom string, duration time.Duration) []lockuptypes.PeriodLock
+}
+
+// WasmKeeper defines the wasm contract needed to query scaling factor rate provider contracts.
+type WasmKeeper interface {
+	QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error)
+}
+
+// DowntimeKeeper defines the expected interface of the downtime detector module.
+type DowntimeKeeper interface {
+	RecoveredSinceDowntimeOfLength(ctx sdk.Context, downtime, recovery time.Duration) (bool, error)
+}
+
+// TwapKeeper defines the twap contract needed for the circuit breaker, and for pricing added balancer pool assets.
+type TwapKeeper interface {
+	GetArithmeticTwapToNow(ctx sdk.Context, poolId uint64, baseAssetDenom string, quoteAssetDenom string, startTime time.Time) (sdk.Dec, error)
+}
@@ -6,20 +6,24 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	types2 "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -30,6 +34,25 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params holds parameters for the incentives module
 type Params struct {
 	PoolCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=pool_creation_fee,json=poolCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_creation_fee" yaml:"pool_creation_fee"`
+	// circuit_breaker_max_spot_price_deviation is the maximum relative deviation
+	// of a pool's spot price from its arithmetic TWAP over the
+	// circuit_breaker_twap_duration. Swaps, joins and exits that would exceed it
+	// are rejected, and the pool is paused at the end of the block, as are pools
+	// that exceed it at the end of a block. Zero disables the circuit breaker.
+	CircuitBreakerMaxSpotPriceDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=circuit_breaker_max_spot_price_deviation,json=circuitBreakerMaxSpotPriceDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"circuit_breaker_max_spot_price_deviation" yaml:"circuit_breaker_max_spot_price_deviation"`
+	CircuitBreakerTwapDuration          time.Duration                          `protobuf:"bytes,3,opt,name=circuit_breaker_twap_duration,json=circuitBreakerTwapDuration,proto3,stdduration" json:"circuit_breaker_twap_duration" yaml:"circuit_breaker_twap_duration"`
+	// protocol_fee_share is the fraction of swap fees taken out of the token in
+	// and sent to the protocol fee collector instead of staying in the pool for
+	// liquidity providers. Zero disables the protocol fee.
+	ProtocolFeeShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=protocol_fee_share,json=protocolFeeShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"protocol_fee_share" yaml:"protocol_fee_share"`
+	// protocol_fee_recipient is the address the protocol fee collector pays out
+	// to at the end of every protocol_fee_epoch_identifier epoch. If empty, the
+	// protocol fees are paid out to the community pool.
+	ProtocolFeeRecipient       string `protobuf:"bytes,5,opt,name=protocol_fee_recipient,json=protocolFeeRecipient,proto3" json:"protocol_fee_recipient,omitempty" yaml:"protocol_fee_recipient"`
+	ProtocolFeeEpochIdentifier string `protobuf:"bytes,6,opt,name=protocol_fee_epoch_identifier,json=protocolFeeEpochIdentifier,proto3" json:"protocol_fee_epoch_identifier,omitempty" yaml:"protocol_fee_epoch_identifier"`
+	// max_route_pools_considered bounds the work of the best routes query: it is
+	// the maximum number of pools the route search extends routes through.
+	MaxRoutePoolsConsidered uint64 `protobuf:"varint,7,opt,name=max_route_pools_considered,json=maxRoutePoolsConsidered,proto3" json:"max_route_pools_considered,omitempty" yaml:"max_route_pools_considered"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -72,9 +95,37 @@ func (m *Params) GetPoolCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *Params) GetCircuitBreakerTwapDuration() time.Duration {
+	if m != nil {
+		return m.CircuitBreakerTwapDuration
+	}
+	return 0
+}
+
+func (m *Params) GetProtocolFeeRecipient() string {
+	if m != nil {
+		return m.ProtocolFeeRecipient
+	}
+	return ""
+}
+
+func (m *Params) GetProtocolFeeEpochIdentifier() string {
+	if m != nil {
+		return m.ProtocolFeeEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetMaxRoutePoolsConsidered() uint64 {
+	if m != nil {
+		return m.MaxRoutePoolsConsidered
+	}
+	return 0
+}
+
 // GenesisState defines the gamm module's genesis state.
 type GenesisState struct {
-	Pools []*types1.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+	Pools []*types2.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
 	// will be renamed to next_pool_id in an upcoming version
 	NextPoolNumber uint64 `protobuf:"varint,2,opt,name=next_pool_number,json=nextPoolNumber,proto3" json:"next_pool_number,omitempty"`
 	Params         Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
@@ -113,7 +164,7 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
-func (m *GenesisState) GetPools() []*types1.Any {
+func (m *GenesisState) GetPools() []*types2.Any {
 	if m != nil {
 		return m.Pools
 	}
@@ -144,33 +195,50 @@ func init() {
 }
 
 var fileDescriptor_5a324eb7f1dd793e = []byte{
-	// 401 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcf, 0xaa, 0xd3, 0x40,
-	0x18, 0xc5, 0x33, 0xde, 0x7b, 0x0b, 0xe6, 0x8a, 0x7f, 0x42, 0x17, 0xb9, 0x17, 0x49, 0x4b, 0x56,
-	0xd9, 0x74, 0xc6, 0xde, 0xe2, 0xa6, 0x3b, 0x53, 0x50, 0x14, 0x91, 0x12, 0x77, 0x6e, 0xc2, 0x24,
-	0x4e, 0x63, 0x30, 0x99, 0x2f, 0x64, 0xa6, 0xa5, 0x79, 0x0b, 0xc1, 0xbd, 0x0f, 0xa0, 0x5b, 0x1f,
-	0xa2, 0xb8, 0xea, 0xd2, 0x55, 0x95, 0xf6, 0x0d, 0x7c, 0x02, 0x99, 0x3f, 0x11, 0x41, 0x57, 0xc9,
-	0x37, 0xdf, 0xef, 0x1c, 0xce, 0x9c, 0xc4, 0x0d, 0x41, 0xd4, 0x20, 0x4a, 0x41, 0x0a, 0x5a, 0xd7,
-	0x64, 0x33, 0xcd, 0x98, 0xa4, 0x53, 0x52, 0x30, 0xce, 0x44, 0x29, 0x70, 0xd3, 0x82, 0x04, 0x6f,
-	0x68, 0x19, 0xac, 0x18, 0x6c, 0x99, 0xeb, 0x61, 0x01, 0x05, 0x68, 0x80, 0xa8, 0x37, 0xc3, 0x5e,
-	0x5f, 0x15, 0x00, 0x45, 0xc5, 0x88, 0x9e, 0xb2, 0xf5, 0x8a, 0x50, 0xde, 0xf5, 0xab, 0x5c, 0xfb,
-	0xa4, 0x46, 0x63, 0x06, 0xbb, 0x0a, 0xcc, 0x44, 0x32, 0x2a, 0xd8, 0x9f, 0x10, 0x39, 0x94, 0xdc,
-	0xec, 0xc3, 0x4f, 0xc8, 0x1d, 0x2c, 0x69, 0x4b, 0x6b, 0xe1, 0x7d, 0x44, 0xee, 0x83, 0x06, 0xa0,
-	0x4a, 0xf3, 0x96, 0x51, 0x59, 0x02, 0x4f, 0x57, 0x8c, 0xf9, 0x68, 0x7c, 0x16, 0x5d, 0xde, 0x5c,
-	0x61, 0xeb, 0xaa, 0x7c, 0xfa, 0xa0, 0x78, 0x01, 0x25, 0x8f, 0x5f, 0xee, 0x0e, 0x23, 0xe7, 0xd7,
-	0x61, 0xe4, 0x77, 0xb4, 0xae, 0xe6, 0xe1, 0x3f, 0x0e, 0xe1, 0xe7, 0x1f, 0xa3, 0xa8, 0x28, 0xe5,
-	0xbb, 0x75, 0x86, 0x73, 0xa8, 0x6d, 0x3c, 0xfb, 0x98, 0x88, 0xb7, 0xef, 0x89, 0xec, 0x1a, 0x26,
-	0xb4, 0x99, 0x48, 0xee, 0x29, 0xfd, 0xc2, 0xca, 0x9f, 0x32, 0x16, 0x7e, 0x41, 0xee, 0x9d, 0x67,
-	0xa6, 0xb4, 0xd7, 0x92, 0x4a, 0xe6, 0x3d, 0x76, 0x2f, 0x14, 0x23, 0x6c, 0xb2, 0x21, 0x36, 0xbd,
-	0xe0, 0xbe, 0x17, 0xfc, 0x84, 0x77, 0xf1, 0xed, 0x6f, 0x5f, 0x27, 0x17, 0x4b, 0x80, 0xea, 0x79,
-	0x62, 0x68, 0x2f, 0x72, 0xef, 0x73, 0xb6, 0x95, 0xa9, 0xce, 0xc7, 0xd7, 0x75, 0xc6, 0x5a, 0xff,
-	0xd6, 0x18, 0x45, 0xe7, 0xc9, 0x5d, 0x75, 0xae, 0xd8, 0x57, 0xfa, 0xd4, 0x9b, 0xbb, 0x83, 0x46,
-	0x37, 0xe2, 0x9f, 0x8d, 0x51, 0x74, 0x79, 0xf3, 0x10, 0xff, 0xef, 0x2b, 0x61, 0xd3, 0x5a, 0x7c,
-	0xae, 0xae, 0x9f, 0x58, 0x45, 0xfc, 0x62, 0x77, 0x0c, 0xd0, 0xfe, 0x18, 0xa0, 0x9f, 0xc7, 0x00,
-	0x7d, 0x38, 0x05, 0xce, 0xfe, 0x14, 0x38, 0xdf, 0x4f, 0x81, 0xf3, 0xe6, 0xd1, 0x5f, 0x15, 0x58,
-	0xbf, 0x49, 0x45, 0x33, 0xd1, 0x0f, 0x64, 0x33, 0x9d, 0x91, 0xad, 0xf9, 0x59, 0x74, 0x21, 0xd9,
-	0x40, 0xdf, 0x68, 0xf6, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x43, 0x18, 0x92, 0x53, 0x49, 0x02, 0x00,
-	0x00,
+	// 684 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0xc7, 0xe3, 0x4b, 0x08, 0xc2, 0xf7, 0xea, 0x5e, 0xae, 0x15, 0xb5, 0x26, 0x2a, 0x71, 0x70,
+	0x3f, 0x94, 0x0d, 0x36, 0x14, 0x75, 0xc3, 0xae, 0x86, 0x52, 0xd1, 0x2f, 0x21, 0x53, 0xa9, 0x52,
+	0x37, 0xa3, 0xb1, 0x73, 0x30, 0x23, 0x62, 0x8f, 0x35, 0x33, 0x81, 0xe4, 0x25, 0xda, 0x4a, 0xdd,
+	0xf4, 0x19, 0xda, 0x6d, 0x17, 0x7d, 0x04, 0xd4, 0x15, 0xcb, 0xaa, 0x8b, 0x50, 0xc1, 0x1b, 0xe4,
+	0x09, 0xaa, 0x19, 0x8f, 0x81, 0x00, 0xad, 0xe8, 0x2a, 0x99, 0x39, 0xbf, 0xf3, 0x3f, 0xc7, 0xe7,
+	0x9c, 0x39, 0xa6, 0x4b, 0x79, 0x4a, 0x39, 0xe1, 0x7e, 0x82, 0xd3, 0xd4, 0xdf, 0x5b, 0x8a, 0x40,
+	0xe0, 0x25, 0x3f, 0x81, 0x0c, 0x38, 0xe1, 0x5e, 0xce, 0xa8, 0xa0, 0x56, 0x5d, 0x33, 0x9e, 0x64,
+	0x3c, 0xcd, 0x34, 0xea, 0x09, 0x4d, 0xa8, 0x02, 0x7c, 0xf9, 0xaf, 0x60, 0x1b, 0xb3, 0x09, 0xa5,
+	0x49, 0x17, 0x7c, 0x75, 0x8a, 0x7a, 0xdb, 0x3e, 0xce, 0x06, 0xda, 0xd4, 0xbc, 0x68, 0xea, 0xf4,
+	0x18, 0x16, 0x84, 0x66, 0xa5, 0x6b, 0xac, 0xe2, 0xa0, 0x42, 0xb3, 0x38, 0x94, 0xae, 0xc5, 0xc9,
+	0x8f, 0x30, 0x87, 0xd3, 0x24, 0x63, 0x4a, 0xb4, 0xab, 0xfb, 0x76, 0xca, 0xac, 0x6d, 0x62, 0x86,
+	0x53, 0x6e, 0xbd, 0x37, 0xcc, 0xff, 0x73, 0x4a, 0xbb, 0x28, 0x66, 0xa0, 0xd4, 0xd1, 0x36, 0x80,
+	0x6d, 0xb4, 0x26, 0xda, 0x7f, 0xdf, 0x9f, 0xf5, 0xb4, 0xaa, 0xd4, 0x29, 0x3f, 0xc4, 0x5b, 0xa5,
+	0x24, 0x0b, 0x9e, 0x1d, 0x0c, 0x9d, 0xca, 0x68, 0xe8, 0xd8, 0x03, 0x9c, 0x76, 0x57, 0xdc, 0x4b,
+	0x0a, 0xee, 0xc7, 0x23, 0xa7, 0x9d, 0x10, 0xb1, 0xd3, 0x8b, 0xbc, 0x98, 0xa6, 0x3a, 0x3d, 0xfd,
+	0xb3, 0xc0, 0x3b, 0xbb, 0xbe, 0x18, 0xe4, 0xc0, 0x95, 0x18, 0x0f, 0xff, 0x93, 0xfe, 0xab, 0xda,
+	0x7d, 0x1d, 0xc0, 0xfa, 0x62, 0x98, 0xed, 0x98, 0xb0, 0xb8, 0x47, 0x04, 0x8a, 0x18, 0xe0, 0x5d,
+	0x60, 0x28, 0xc5, 0x7d, 0xc4, 0x73, 0x2a, 0x50, 0xce, 0x48, 0x0c, 0xa8, 0x03, 0x7b, 0x44, 0xf1,
+	0xf6, 0x5f, 0x2d, 0xa3, 0x3d, 0x1d, 0x60, 0x99, 0xd1, 0xf7, 0xa1, 0x73, 0xef, 0x1a, 0x51, 0xd7,
+	0x20, 0x1e, 0x0d, 0x1d, 0xbf, 0xc8, 0xfd, 0xba, 0x71, 0xdc, 0xf0, 0xb6, 0x46, 0x83, 0x82, 0x7c,
+	0x8e, 0xfb, 0x5b, 0x39, 0x15, 0x9b, 0x12, 0x5b, 0x2b, 0x29, 0xeb, 0x8d, 0x61, 0xce, 0x5d, 0x94,
+	0x14, 0xfb, 0x38, 0x47, 0x65, 0xfb, 0xec, 0x89, 0x96, 0xa1, 0x8a, 0x5b, 0xf4, 0xd7, 0x2b, 0xfb,
+	0xeb, 0xad, 0x69, 0x20, 0x58, 0xd4, 0xc5, 0xbd, 0x73, 0x75, 0x82, 0x63, 0x6a, 0xee, 0x87, 0x23,
+	0xc7, 0x08, 0x1b, 0xe3, 0x99, 0xbd, 0xdc, 0xc7, 0x79, 0xa9, 0x66, 0x0d, 0x4c, 0x4b, 0x85, 0x88,
+	0x69, 0x57, 0x76, 0x06, 0xf1, 0x1d, 0xcc, 0xc0, 0xae, 0xaa, 0xa2, 0x3d, 0xfd, 0xe3, 0xa2, 0xcd,
+	0xea, 0x86, 0x5f, 0x52, 0x74, 0xc3, 0x99, 0xf2, 0x72, 0x1d, 0x60, 0x4b, 0x5e, 0x59, 0xaf, 0xcc,
+	0x1b, 0x63, 0x20, 0x83, 0x98, 0xe4, 0x04, 0x32, 0x61, 0x4f, 0xaa, 0xf0, 0xf3, 0xa3, 0xa1, 0x33,
+	0x77, 0x85, 0xe0, 0x29, 0xe7, 0x86, 0xf5, 0x73, 0xa2, 0x61, 0x79, 0x6d, 0xed, 0x9a, 0x73, 0x63,
+	0x0e, 0x90, 0xd3, 0x78, 0x07, 0x91, 0x0e, 0x64, 0x82, 0x6c, 0x13, 0x60, 0x76, 0x4d, 0xe9, 0xb7,
+	0xcf, 0x8a, 0xf8, 0x5b, 0xdc, 0x0d, 0x1b, 0xe7, 0xc2, 0x3c, 0x92, 0xd6, 0x8d, 0x53, 0xa3, 0x15,
+	0x99, 0x0d, 0x39, 0x13, 0x8c, 0xf6, 0x04, 0x20, 0x39, 0xa9, 0x1c, 0xc5, 0x34, 0xe3, 0xa4, 0x03,
+	0x0c, 0x3a, 0xf6, 0x54, 0xcb, 0x68, 0x57, 0x83, 0xbb, 0xa3, 0xa1, 0x33, 0x5f, 0x44, 0xfa, 0x35,
+	0xeb, 0x86, 0x37, 0x53, 0xdc, 0x0f, 0xa5, 0x6d, 0x53, 0x9a, 0x56, 0xcf, 0x2c, 0x9f, 0x0c, 0xf3,
+	0x9f, 0xc7, 0xc5, 0x16, 0xd9, 0x12, 0x58, 0x80, 0xf5, 0xc0, 0x9c, 0x54, 0xee, 0xfa, 0x29, 0xd6,
+	0x2f, 0x4d, 0xcb, 0xc3, 0x6c, 0x10, 0x4c, 0x7f, 0xfd, 0xbc, 0x30, 0x29, 0xa5, 0x36, 0xc2, 0x82,
+	0xb6, 0xda, 0xe6, 0x4c, 0x06, 0x7d, 0xa1, 0x42, 0xa3, 0xac, 0x97, 0x46, 0xc0, 0xd4, 0xfb, 0xa8,
+	0x86, 0xff, 0xca, 0x7b, 0xc9, 0xbe, 0x50, 0xb7, 0xd6, 0x8a, 0x59, 0xcb, 0xd5, 0x0a, 0xd0, 0xf3,
+	0x78, 0xcb, 0xbb, 0x6a, 0x6d, 0x79, 0xc5, 0x9a, 0x08, 0xaa, 0x72, 0x50, 0x42, 0xed, 0x11, 0x3c,
+	0x39, 0x38, 0x6e, 0x1a, 0x87, 0xc7, 0x4d, 0xe3, 0xc7, 0x71, 0xd3, 0x78, 0x77, 0xd2, 0xac, 0x1c,
+	0x9e, 0x34, 0x2b, 0xdf, 0x4e, 0x9a, 0x95, 0xd7, 0x8b, 0xe7, 0x06, 0x49, 0xeb, 0x2d, 0x74, 0x71,
+	0xc4, 0xcb, 0x83, 0xbf, 0xb7, 0xb4, 0xec, 0xf7, 0x8b, 0xed, 0xa9, 0xc6, 0x2a, 0xaa, 0xa9, 0x2f,
+	0x5a, 0xfe, 0x39, 0x00, 0x21, 0xf2, 0x85, 0xae, 0x5a, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -193,6 +261,53 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxRoutePoolsConsidered != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxRoutePoolsConsidered))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ProtocolFeeEpochIdentifier) > 0 {
+		i -= len(m.ProtocolFeeEpochIdentifier)
+		copy(dAtA[i:], m.ProtocolFeeEpochIdentifier)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ProtocolFeeEpochIdentifier)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ProtocolFeeRecipient) > 0 {
+		i -= len(m.ProtocolFeeRecipient)
+		copy(dAtA[i:], m.ProtocolFeeRecipient)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ProtocolFeeRecipient)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.ProtocolFeeShare.Size()
+		i -= size
+		if _, err := m.ProtocolFeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.CircuitBreakerTwapDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.CircuitBreakerTwapDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CircuitBreakerMaxSpotPriceDeviation.Size()
+		i -= size
+		if _, err := m.CircuitBreakerMaxSpotPriceDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.PoolCreationFee) > 0 {
 		for iNdEx := len(m.PoolCreationFee) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -285,6 +400,23 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.CircuitBreakerMaxSpotPriceDeviation.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.CircuitBreakerTwapDuration)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.ProtocolFeeShare.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.ProtocolFeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ProtocolFeeEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.MaxRoutePoolsConsidered != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxRoutePoolsConsidered))
+	}
 	return n
 }
 
@@ -377,6 +509,190 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerMaxSpotPriceDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CircuitBreakerMaxSpotPriceDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerTwapDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.CircuitBreakerTwapDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtocolFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolFeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolFeeEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRoutePoolsConsidered", wireType)
+			}
+			m.MaxRoutePoolsConsidered = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRoutePoolsConsidered |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -456,7 +772,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, &types1.Any{})
+			m.Pools = append(m.Pools, &types2.Any{})
 			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeSetPoolPaused = "SetPoolPaused"
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSetPoolPaused)
+	govtypes.RegisterProposalTypeCodec(&SetPoolPausedProposal{}, "osmosis/SetPoolPausedProposal")
+}
+
+var _ govtypes.Content = &SetPoolPausedProposal{}
+
+func NewSetPoolPausedProposal(title, description string, poolId uint64, paused bool) SetPoolPausedProposal {
+	return SetPoolPausedProposal{
+		Title:       title,
+		Description: description,
+		PoolId:      poolId,
+		Paused:      paused,
+	}
+}
+
+func (p *SetPoolPausedProposal) GetTitle() string { return p.Title }
+
+func (p *SetPoolPausedProposal) GetDescription() string { return p.Description }
+
+func (p *SetPoolPausedProposal) ProposalRoute() string { return RouterKey }
+
+func (p *SetPoolPausedProposal) ProposalType() string {
+	return ProposalTypeSetPoolPaused
+}
+
+func (p *SetPoolPausedProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if p.PoolId == 0 {
+		return fmt.Errorf("pool id must be positive")
+	}
+	return nil
+}
+
+func (p SetPoolPausedProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Pool Paused Proposal:
+  Title:       %s
+  Description: %s
+  Pool ID:     %d
+  Paused:      %t
+`, p.Title, p.Description, p.PoolId, p.Paused))
+	return b.String()
+}
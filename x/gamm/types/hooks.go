@@ -12,8 +12,11 @@ type GammHooks interface {
 	// AfterExitPool is called after ExitPool, ExitSwapShareAmountIn, and ExitSwapExternAmountOut
 	AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, exitCoins sdk.Coins)
 
-	// AfterSwap is called after SwapExactAmountIn and SwapExactAmountOut
-	AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins)
+	// AfterSwap is called after SwapExactAmountIn and SwapExactAmountOut, with the swap fee charged on the swap
+	AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins, swapFee sdk.Dec)
+
+	// AfterPoolAssetsChanged is called after an asset is added to or removed from a pool
+	AfterPoolAssetsChanged(ctx sdk.Context, sender sdk.AccAddress, poolId uint64)
 }
 
 var _ GammHooks = MultiGammHooks{}
@@ -44,8 +47,14 @@ func (h MultiGammHooks) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, po
 	}
 }
 
-func (h MultiGammHooks) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+func (h MultiGammHooks) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins, swapFee sdk.Dec) {
+	for i := range h {
+		h[i].AfterSwap(ctx, sender, poolId, input, output, swapFee)
+	}
+}
+
+func (h MultiGammHooks) AfterPoolAssetsChanged(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
 	for i := range h {
-		h[i].AfterSwap(ctx, sender, poolId, input, output)
+		h[i].AfterPoolAssetsChanged(ctx, sender, poolId)
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -13,6 +14,13 @@ const (
 
 	StoreKey = ModuleName
 
+	// TransientStoreKey tracks the pools changed in the current block, for the circuit breaker.
+	TransientStoreKey = "transient_" + ModuleName
+
+	// ProtocolFeeCollectorName is the module account collecting the protocol's share of swap fees
+	// until they are paid out at the end of the protocol fee epoch.
+	ProtocolFeeCollectorName = "gamm_protocol_fee_collector"
+
 	RouterKey = ModuleName
 
 	QuerierRoute = ModuleName
@@ -25,6 +33,10 @@ var (
 	KeyPrefixPools = []byte{0x02}
 	// KeyTotalLiquidity defines key to store total liquidity.
 	KeyTotalLiquidity = []byte{0x03}
+	// KeyPrefixDenomPools defines prefix to store the index of pools by the denoms they contain.
+	KeyPrefixDenomPools = []byte{0x04}
+	// KeyPrefixRateProviderPools defines prefix to store the index of stableswap pools with a scaling factor rate provider.
+	KeyPrefixRateProviderPools = []byte{0x05}
 )
 
 func MustGetPoolIdFromShareDenom(denom string) uint64 {
@@ -56,3 +68,19 @@ func GetPoolShareDenom(poolId uint64) string {
 func GetKeyPrefixPools(poolId uint64) []byte {
 	return append(KeyPrefixPools, sdk.Uint64ToBigEndian(poolId)...)
 }
+
+// GetKeyPrefixDenomPools returns the prefix of the index entries of the pools containing the denom.
+// The denom is length prefixed, so that no denom's prefix is the prefix of another denom's.
+func GetKeyPrefixDenomPools(denom string) []byte {
+	return append(KeyPrefixDenomPools, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// GetKeyDenomPool returns the index entry key of the pool containing the denom.
+func GetKeyDenomPool(denom string, poolId uint64) []byte {
+	return append(GetKeyPrefixDenomPools(denom), sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// GetKeyRateProviderPool returns the index entry key of the stableswap pool with a scaling factor rate provider.
+func GetKeyRateProviderPool(poolId uint64) []byte {
+	return append(KeyPrefixRateProviderPools, sdk.Uint64ToBigEndian(poolId)...)
+}
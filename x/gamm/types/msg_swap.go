@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // SwapMsg defines a simple interface for getting the token denoms on a swap message route.
 type SwapMsgRoute interface {
 	TokenInDenom() string
@@ -7,9 +11,15 @@ type SwapMsgRoute interface {
 	TokenDenomsOnPath() []string
 }
 
+// MultiSwapMsgRoute defines an interface for getting the swaps of a message swapping through several routes.
+type MultiSwapMsgRoute interface {
+	GetSwapMsgs() []SwapMsgRoute
+}
+
 var (
-	_ SwapMsgRoute = MsgSwapExactAmountOut{}
-	_ SwapMsgRoute = MsgSwapExactAmountIn{}
+	_ SwapMsgRoute      = MsgSwapExactAmountOut{}
+	_ SwapMsgRoute      = MsgSwapExactAmountIn{}
+	_ MultiSwapMsgRoute = MsgSplitRouteSwapExactAmountIn{}
 )
 
 func (msg MsgSwapExactAmountOut) TokenInDenom() string {
@@ -46,3 +56,17 @@ func (msg MsgSwapExactAmountIn) TokenDenomsOnPath() []string {
 	}
 	return denoms
 }
+
+// GetSwapMsgs returns the swap of each route of the split route swap.
+func (msg MsgSplitRouteSwapExactAmountIn) GetSwapMsgs() []SwapMsgRoute {
+	swapMsgs := make([]SwapMsgRoute, 0, len(msg.Routes))
+	for _, route := range msg.Routes {
+		swapMsgs = append(swapMsgs, MsgSwapExactAmountIn{
+			Sender:            msg.Sender,
+			Routes:            route.Pools,
+			TokenIn:           sdk.Coin{Denom: msg.TokenInDenom, Amount: route.TokenInAmount},
+			TokenOutMinAmount: sdk.OneInt(),
+		})
+	}
+	return swapMsgs
+}
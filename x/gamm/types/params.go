@@ -2,8 +2,10 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	appparams "github.com/osmosis-labs/osmosis/v13/app/params"
+	epochstypes "github.com/osmosis-labs/osmosis/v13/x/epochs/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -11,7 +13,13 @@ import (
 
 // Parameter store keys.
 var (
-	KeyPoolCreationFee = []byte("PoolCreationFee")
+	KeyPoolCreationFee                     = []byte("PoolCreationFee")
+	KeyCircuitBreakerMaxSpotPriceDeviation = []byte("CircuitBreakerMaxSpotPriceDeviation")
+	KeyCircuitBreakerTwapDuration          = []byte("CircuitBreakerTwapDuration")
+	KeyProtocolFeeShare                    = []byte("ProtocolFeeShare")
+	KeyProtocolFeeRecipient                = []byte("ProtocolFeeRecipient")
+	KeyProtocolFeeEpochIdentifier          = []byte("ProtocolFeeEpochIdentifier")
+	KeyMaxRoutePoolsConsidered             = []byte("MaxRoutePoolsConsidered")
 )
 
 // ParamTable for gamm module.
@@ -19,16 +27,30 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(poolCreationFee sdk.Coins) Params {
+func NewParams(poolCreationFee sdk.Coins, circuitBreakerMaxSpotPriceDeviation sdk.Dec, circuitBreakerTwapDuration time.Duration,
+	protocolFeeShare sdk.Dec, protocolFeeRecipient string, protocolFeeEpochIdentifier string, maxRoutePoolsConsidered uint64,
+) Params {
 	return Params{
-		PoolCreationFee: poolCreationFee,
+		PoolCreationFee:                     poolCreationFee,
+		CircuitBreakerMaxSpotPriceDeviation: circuitBreakerMaxSpotPriceDeviation,
+		CircuitBreakerTwapDuration:          circuitBreakerTwapDuration,
+		ProtocolFeeShare:                    protocolFeeShare,
+		ProtocolFeeRecipient:                protocolFeeRecipient,
+		ProtocolFeeEpochIdentifier:          protocolFeeEpochIdentifier,
+		MaxRoutePoolsConsidered:             maxRoutePoolsConsidered,
 	}
 }
 
 // default gamm module parameters.
 func DefaultParams() Params {
 	return Params{
-		PoolCreationFee: sdk.Coins{sdk.NewInt64Coin(appparams.BaseCoinUnit, 1000_000_000)}, // 1000 OSMO
+		PoolCreationFee:                     sdk.Coins{sdk.NewInt64Coin(appparams.BaseCoinUnit, 1000_000_000)}, // 1000 OSMO
+		CircuitBreakerMaxSpotPriceDeviation: sdk.ZeroDec(),                                                     // disabled
+		CircuitBreakerTwapDuration:          10 * time.Minute,
+		ProtocolFeeShare:                    sdk.ZeroDec(), // disabled
+		ProtocolFeeRecipient:                "",            // community pool
+		ProtocolFeeEpochIdentifier:          "day",
+		MaxRoutePoolsConsidered:             100,
 	}
 }
 
@@ -37,6 +59,24 @@ func (p Params) Validate() error {
 	if err := validatePoolCreationFee(p.PoolCreationFee); err != nil {
 		return err
 	}
+	if err := validateCircuitBreakerMaxSpotPriceDeviation(p.CircuitBreakerMaxSpotPriceDeviation); err != nil {
+		return err
+	}
+	if err := validateCircuitBreakerTwapDuration(p.CircuitBreakerTwapDuration); err != nil {
+		return err
+	}
+	if err := validateProtocolFeeShare(p.ProtocolFeeShare); err != nil {
+		return err
+	}
+	if err := validateProtocolFeeRecipient(p.ProtocolFeeRecipient); err != nil {
+		return err
+	}
+	if err := epochstypes.ValidateEpochIdentifierInterface(p.ProtocolFeeEpochIdentifier); err != nil {
+		return err
+	}
+	if err := validateMaxRoutePoolsConsidered(p.MaxRoutePoolsConsidered); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -45,6 +85,12 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyPoolCreationFee, &p.PoolCreationFee, validatePoolCreationFee),
+		paramtypes.NewParamSetPair(KeyCircuitBreakerMaxSpotPriceDeviation, &p.CircuitBreakerMaxSpotPriceDeviation, validateCircuitBreakerMaxSpotPriceDeviation),
+		paramtypes.NewParamSetPair(KeyCircuitBreakerTwapDuration, &p.CircuitBreakerTwapDuration, validateCircuitBreakerTwapDuration),
+		paramtypes.NewParamSetPair(KeyProtocolFeeShare, &p.ProtocolFeeShare, validateProtocolFeeShare),
+		paramtypes.NewParamSetPair(KeyProtocolFeeRecipient, &p.ProtocolFeeRecipient, validateProtocolFeeRecipient),
+		paramtypes.NewParamSetPair(KeyProtocolFeeEpochIdentifier, &p.ProtocolFeeEpochIdentifier, epochstypes.ValidateEpochIdentifierInterface),
+		paramtypes.NewParamSetPair(KeyMaxRoutePoolsConsidered, &p.MaxRoutePoolsConsidered, validateMaxRoutePoolsConsidered),
 	}
 }
 
@@ -60,3 +106,72 @@ func validatePoolCreationFee(i interface{}) error {
 
 	return nil
 }
+
+func validateCircuitBreakerMaxSpotPriceDeviation(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("circuit breaker max spot price deviation must be non-negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateCircuitBreakerTwapDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("circuit breaker twap duration must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateProtocolFeeShare(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GTE(sdk.OneDec()) {
+		return fmt.Errorf("protocol fee share must be in [0, 1): %s", v)
+	}
+
+	return nil
+}
+
+func validateProtocolFeeRecipient(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// an empty recipient pays out the protocol fees to the community pool
+	if v == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(v); err != nil {
+		return fmt.Errorf("invalid protocol fee recipient %s: %w", v, err)
+	}
+
+	return nil
+}
+
+func validateMaxRoutePoolsConsidered(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max route pools considered must be positive")
+	}
+
+	return nil
+}
@@ -76,19 +76,21 @@ type PoolAmountOutExtension interface {
 	) (tokenInAmount sdk.Int, err error)
 
 	// JoinPoolTokenInMaxShareAmountOut add liquidity to a specified pool with a maximum amount of tokens in (tokenInMaxAmount)
-	// and swaps to an exact number of shares (shareOutAmount).
+	// and swaps to an exact number of shares (shareOutAmount), charging the given swap fee.
 	JoinPoolTokenInMaxShareAmountOut(
 		ctx sdk.Context,
 		tokenInDenom string,
 		shareOutAmount sdk.Int,
+		swapFee sdk.Dec,
 	) (tokenInAmount sdk.Int, err error)
 
 	// ExitSwapExactAmountOut removes liquidity from a specified pool with a maximum amount of LP shares (shareInMaxAmount)
-	// and swaps to an exact amount of one of the token pairs (tokenOut).
+	// and swaps to an exact amount of one of the token pairs (tokenOut), charging the given swap fee.
 	ExitSwapExactAmountOut(
 		ctx sdk.Context,
 		tokenOut sdk.Coin,
 		shareInMaxAmount sdk.Int,
+		swapFee sdk.Dec,
 	) (shareInAmount sdk.Int, err error)
 
 	// IncreaseLiquidity increases the pool's liquidity by the specified sharesOut and coinsIn.
@@ -108,6 +110,25 @@ type WeightedPoolExtension interface {
 	GetTokenWeight(denom string) (sdk.Int, error)
 }
 
+// PausablePoolExtension is an extension of the PoolI interface
+// for pools whose swaps and joins can be paused, making them inactive.
+type PausablePoolExtension interface {
+	CFMMPoolI
+
+	// SetPaused pauses or resumes swaps and joins against the pool.
+	SetPaused(paused bool)
+}
+
+// DynamicSwapFeePoolExtension is an extension of the PoolI interface
+// for pools that can charge a dynamic swap fee.
+type DynamicSwapFeePoolExtension interface {
+	CFMMPoolI
+
+	// GetDynamicSwapFeeParams returns the pool's dynamic swap fee params,
+	// or nil if the pool charges its static swap fee.
+	GetDynamicSwapFeeParams() *DynamicSwapFeeParams
+}
+
 // TODO: move to swaprouter
 func NewPoolAddress(poolId uint64) sdk.AccAddress {
 	key := append([]byte("pool"), sdk.Uint64ToBigEndian(poolId)...)
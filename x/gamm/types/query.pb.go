@@ -1126,6 +1126,228 @@ func (m *QueryPoolsWithFilterResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// =============================== PoolsByDenom
+type QueryPoolsByDenomRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPoolsByDenomRequest) Reset()         { *m = QueryPoolsByDenomRequest{} }
+func (m *QueryPoolsByDenomRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsByDenomRequest) ProtoMessage()    {}
+func (*QueryPoolsByDenomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{23}
+}
+func (m *QueryPoolsByDenomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolsByDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolsByDenomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolsByDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsByDenomRequest.Merge(m, src)
+}
+func (m *QueryPoolsByDenomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolsByDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsByDenomRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolsByDenomRequest proto.InternalMessageInfo
+
+func (m *QueryPoolsByDenomRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryPoolsByDenomRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPoolsByDenomResponse struct {
+	Pools []*types.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPoolsByDenomResponse) Reset()         { *m = QueryPoolsByDenomResponse{} }
+func (m *QueryPoolsByDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsByDenomResponse) ProtoMessage()    {}
+func (*QueryPoolsByDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{24}
+}
+func (m *QueryPoolsByDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolsByDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolsByDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolsByDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsByDenomResponse.Merge(m, src)
+}
+func (m *QueryPoolsByDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolsByDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsByDenomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolsByDenomResponse proto.InternalMessageInfo
+
+func (m *QueryPoolsByDenomResponse) GetPools() []*types.Any {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+func (m *QueryPoolsByDenomResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// =============================== PoolsByDenomPair
+type QueryPoolsByDenomPairRequest struct {
+	DenomA string `protobuf:"bytes,1,opt,name=denom_a,json=denomA,proto3" json:"denom_a,omitempty" yaml:"denom_a"`
+	DenomB string `protobuf:"bytes,2,opt,name=denom_b,json=denomB,proto3" json:"denom_b,omitempty" yaml:"denom_b"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPoolsByDenomPairRequest) Reset()         { *m = QueryPoolsByDenomPairRequest{} }
+func (m *QueryPoolsByDenomPairRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsByDenomPairRequest) ProtoMessage()    {}
+func (*QueryPoolsByDenomPairRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{25}
+}
+func (m *QueryPoolsByDenomPairRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolsByDenomPairRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolsByDenomPairRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolsByDenomPairRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsByDenomPairRequest.Merge(m, src)
+}
+func (m *QueryPoolsByDenomPairRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolsByDenomPairRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsByDenomPairRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolsByDenomPairRequest proto.InternalMessageInfo
+
+func (m *QueryPoolsByDenomPairRequest) GetDenomA() string {
+	if m != nil {
+		return m.DenomA
+	}
+	return ""
+}
+
+func (m *QueryPoolsByDenomPairRequest) GetDenomB() string {
+	if m != nil {
+		return m.DenomB
+	}
+	return ""
+}
+
+func (m *QueryPoolsByDenomPairRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPoolsByDenomPairResponse struct {
+	Pools []*types.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPoolsByDenomPairResponse) Reset()         { *m = QueryPoolsByDenomPairResponse{} }
+func (m *QueryPoolsByDenomPairResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsByDenomPairResponse) ProtoMessage()    {}
+func (*QueryPoolsByDenomPairResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{26}
+}
+func (m *QueryPoolsByDenomPairResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolsByDenomPairResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolsByDenomPairResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolsByDenomPairResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsByDenomPairResponse.Merge(m, src)
+}
+func (m *QueryPoolsByDenomPairResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolsByDenomPairResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsByDenomPairResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolsByDenomPairResponse proto.InternalMessageInfo
+
+func (m *QueryPoolsByDenomPairResponse) GetPools() []*types.Any {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+func (m *QueryPoolsByDenomPairResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QuerySpotPriceResponse defines the gRPC response structure for a SpotPrice
 // query.
 //
@@ -1139,7 +1361,7 @@ func (m *QuerySpotPriceResponse) Reset()         { *m = QuerySpotPriceResponse{}
 func (m *QuerySpotPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySpotPriceResponse) ProtoMessage()    {}
 func (*QuerySpotPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{23}
+	return fileDescriptor_d9a717df9ca609ef, []int{27}
 }
 func (m *QuerySpotPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1188,7 +1410,7 @@ func (m *QuerySwapExactAmountInRequest) Reset()         { *m = QuerySwapExactAmo
 func (m *QuerySwapExactAmountInRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySwapExactAmountInRequest) ProtoMessage()    {}
 func (*QuerySwapExactAmountInRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{24}
+	return fileDescriptor_d9a717df9ca609ef, []int{28}
 }
 func (m *QuerySwapExactAmountInRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1253,7 +1475,7 @@ func (m *QuerySwapExactAmountInResponse) Reset()         { *m = QuerySwapExactAm
 func (m *QuerySwapExactAmountInResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySwapExactAmountInResponse) ProtoMessage()    {}
 func (*QuerySwapExactAmountInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{25}
+	return fileDescriptor_d9a717df9ca609ef, []int{29}
 }
 func (m *QuerySwapExactAmountInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1282,27 +1504,29 @@ func (m *QuerySwapExactAmountInResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QuerySwapExactAmountInResponse proto.InternalMessageInfo
 
-// =============================== EstimateSwapExactAmountOut
-type QuerySwapExactAmountOutRequest struct {
-	// TODO: CHANGE THIS TO RESERVED IN A PATCH RELEASE
-	Sender   string               `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
-	PoolId   uint64               `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
-	Routes   []SwapAmountOutRoute `protobuf:"bytes,3,rep,name=routes,proto3" json:"routes" yaml:"routes"`
-	TokenOut string               `protobuf:"bytes,4,opt,name=token_out,json=tokenOut,proto3" json:"token_out,omitempty" yaml:"token_out"`
+// =============================== EstimateBestRoutesExactAmountIn
+type QueryBestRoutesExactAmountInRequest struct {
+	TokenIn       string `protobuf:"bytes,1,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty" yaml:"token_in"`
+	TokenOutDenom string `protobuf:"bytes,2,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+	// max_hops is the maximum number of pools in a route.
+	MaxHops uint32 `protobuf:"varint,3,opt,name=max_hops,json=maxHops,proto3" json:"max_hops,omitempty" yaml:"max_hops"`
+	// limit is the maximum number of routes returned. Zero returns the best
+	// route only.
+	Limit uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty" yaml:"limit"`
 }
 
-func (m *QuerySwapExactAmountOutRequest) Reset()         { *m = QuerySwapExactAmountOutRequest{} }
-func (m *QuerySwapExactAmountOutRequest) String() string { return proto.CompactTextString(m) }
-func (*QuerySwapExactAmountOutRequest) ProtoMessage()    {}
-func (*QuerySwapExactAmountOutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{26}
+func (m *QueryBestRoutesExactAmountInRequest) Reset()         { *m = QueryBestRoutesExactAmountInRequest{} }
+func (m *QueryBestRoutesExactAmountInRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBestRoutesExactAmountInRequest) ProtoMessage()    {}
+func (*QueryBestRoutesExactAmountInRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{30}
 }
-func (m *QuerySwapExactAmountOutRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryBestRoutesExactAmountInRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySwapExactAmountOutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBestRoutesExactAmountInRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySwapExactAmountOutRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBestRoutesExactAmountInRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1312,62 +1536,63 @@ func (m *QuerySwapExactAmountOutRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QuerySwapExactAmountOutRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySwapExactAmountOutRequest.Merge(m, src)
+func (m *QueryBestRoutesExactAmountInRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBestRoutesExactAmountInRequest.Merge(m, src)
 }
-func (m *QuerySwapExactAmountOutRequest) XXX_Size() int {
+func (m *QueryBestRoutesExactAmountInRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySwapExactAmountOutRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySwapExactAmountOutRequest.DiscardUnknown(m)
+func (m *QueryBestRoutesExactAmountInRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBestRoutesExactAmountInRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySwapExactAmountOutRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryBestRoutesExactAmountInRequest proto.InternalMessageInfo
 
-func (m *QuerySwapExactAmountOutRequest) GetSender() string {
+func (m *QueryBestRoutesExactAmountInRequest) GetTokenIn() string {
 	if m != nil {
-		return m.Sender
+		return m.TokenIn
 	}
 	return ""
 }
 
-func (m *QuerySwapExactAmountOutRequest) GetPoolId() uint64 {
+func (m *QueryBestRoutesExactAmountInRequest) GetTokenOutDenom() string {
 	if m != nil {
-		return m.PoolId
+		return m.TokenOutDenom
 	}
-	return 0
+	return ""
 }
 
-func (m *QuerySwapExactAmountOutRequest) GetRoutes() []SwapAmountOutRoute {
+func (m *QueryBestRoutesExactAmountInRequest) GetMaxHops() uint32 {
 	if m != nil {
-		return m.Routes
+		return m.MaxHops
 	}
-	return nil
+	return 0
 }
 
-func (m *QuerySwapExactAmountOutRequest) GetTokenOut() string {
+func (m *QueryBestRoutesExactAmountInRequest) GetLimit() uint32 {
 	if m != nil {
-		return m.TokenOut
+		return m.Limit
 	}
-	return ""
+	return 0
 }
 
-type QuerySwapExactAmountOutResponse struct {
-	TokenInAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=token_in_amount,json=tokenInAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_in_amount" yaml:"token_in_amount"`
+type EstimatedRoute struct {
+	Routes         []SwapAmountInRoute                    `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes" yaml:"routes"`
+	TokenOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_out_amount" yaml:"token_out_amount"`
 }
 
-func (m *QuerySwapExactAmountOutResponse) Reset()         { *m = QuerySwapExactAmountOutResponse{} }
-func (m *QuerySwapExactAmountOutResponse) String() string { return proto.CompactTextString(m) }
-func (*QuerySwapExactAmountOutResponse) ProtoMessage()    {}
-func (*QuerySwapExactAmountOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{27}
+func (m *EstimatedRoute) Reset()         { *m = EstimatedRoute{} }
+func (m *EstimatedRoute) String() string { return proto.CompactTextString(m) }
+func (*EstimatedRoute) ProtoMessage()    {}
+func (*EstimatedRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{31}
 }
-func (m *QuerySwapExactAmountOutResponse) XXX_Unmarshal(b []byte) error {
+func (m *EstimatedRoute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySwapExactAmountOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EstimatedRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySwapExactAmountOutResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EstimatedRoute.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1377,33 +1602,42 @@ func (m *QuerySwapExactAmountOutResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QuerySwapExactAmountOutResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySwapExactAmountOutResponse.Merge(m, src)
+func (m *EstimatedRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimatedRoute.Merge(m, src)
 }
-func (m *QuerySwapExactAmountOutResponse) XXX_Size() int {
+func (m *EstimatedRoute) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySwapExactAmountOutResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySwapExactAmountOutResponse.DiscardUnknown(m)
+func (m *EstimatedRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimatedRoute.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySwapExactAmountOutResponse proto.InternalMessageInfo
+var xxx_messageInfo_EstimatedRoute proto.InternalMessageInfo
 
-type QueryTotalLiquidityRequest struct {
+func (m *EstimatedRoute) GetRoutes() []SwapAmountInRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
 }
 
-func (m *QueryTotalLiquidityRequest) Reset()         { *m = QueryTotalLiquidityRequest{} }
-func (m *QueryTotalLiquidityRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalLiquidityRequest) ProtoMessage()    {}
-func (*QueryTotalLiquidityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{28}
+type QueryBestRoutesExactAmountInResponse struct {
+	// routes are sorted by descending token out amount.
+	Routes []EstimatedRoute `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes" yaml:"routes"`
 }
-func (m *QueryTotalLiquidityRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryBestRoutesExactAmountInResponse) Reset()         { *m = QueryBestRoutesExactAmountInResponse{} }
+func (m *QueryBestRoutesExactAmountInResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBestRoutesExactAmountInResponse) ProtoMessage()    {}
+func (*QueryBestRoutesExactAmountInResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{32}
+}
+func (m *QueryBestRoutesExactAmountInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTotalLiquidityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBestRoutesExactAmountInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalLiquidityRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBestRoutesExactAmountInResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1413,34 +1647,45 @@ func (m *QueryTotalLiquidityRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalLiquidityRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalLiquidityRequest.Merge(m, src)
+func (m *QueryBestRoutesExactAmountInResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBestRoutesExactAmountInResponse.Merge(m, src)
 }
-func (m *QueryTotalLiquidityRequest) XXX_Size() int {
+func (m *QueryBestRoutesExactAmountInResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalLiquidityRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalLiquidityRequest.DiscardUnknown(m)
+func (m *QueryBestRoutesExactAmountInResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBestRoutesExactAmountInResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalLiquidityRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryBestRoutesExactAmountInResponse proto.InternalMessageInfo
 
-type QueryTotalLiquidityResponse struct {
-	Liquidity github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=liquidity,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"liquidity" yaml:"liquidity"`
+func (m *QueryBestRoutesExactAmountInResponse) GetRoutes() []EstimatedRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
 }
 
-func (m *QueryTotalLiquidityResponse) Reset()         { *m = QueryTotalLiquidityResponse{} }
-func (m *QueryTotalLiquidityResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalLiquidityResponse) ProtoMessage()    {}
-func (*QueryTotalLiquidityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{29}
+// =============================== EstimateSplitRouteSwapExactAmountIn
+type QuerySplitRouteSwapExactAmountInRequest struct {
+	Routes       []SwapAmountInSplitRoute `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes" yaml:"routes"`
+	TokenInDenom string                   `protobuf:"bytes,2,opt,name=token_in_denom,json=tokenInDenom,proto3" json:"token_in_denom,omitempty" yaml:"token_in_denom"`
 }
-func (m *QueryTotalLiquidityResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QuerySplitRouteSwapExactAmountInRequest) Reset() {
+	*m = QuerySplitRouteSwapExactAmountInRequest{}
+}
+func (m *QuerySplitRouteSwapExactAmountInRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySplitRouteSwapExactAmountInRequest) ProtoMessage()    {}
+func (*QuerySplitRouteSwapExactAmountInRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{33}
+}
+func (m *QuerySplitRouteSwapExactAmountInRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTotalLiquidityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySplitRouteSwapExactAmountInRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalLiquidityResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySplitRouteSwapExactAmountInRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1450,28 +1695,261 @@ func (m *QueryTotalLiquidityResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalLiquidityResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalLiquidityResponse.Merge(m, src)
+func (m *QuerySplitRouteSwapExactAmountInRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySplitRouteSwapExactAmountInRequest.Merge(m, src)
 }
-func (m *QueryTotalLiquidityResponse) XXX_Size() int {
+func (m *QuerySplitRouteSwapExactAmountInRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalLiquidityResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalLiquidityResponse.DiscardUnknown(m)
+func (m *QuerySplitRouteSwapExactAmountInRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySplitRouteSwapExactAmountInRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalLiquidityResponse proto.InternalMessageInfo
+var xxx_messageInfo_QuerySplitRouteSwapExactAmountInRequest proto.InternalMessageInfo
 
-func (m *QueryTotalLiquidityResponse) GetLiquidity() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *QuerySplitRouteSwapExactAmountInRequest) GetRoutes() []SwapAmountInSplitRoute {
 	if m != nil {
-		return m.Liquidity
+		return m.Routes
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryPoolRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolRequest")
-	proto.RegisterType((*QueryPoolResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolResponse")
+func (m *QuerySplitRouteSwapExactAmountInRequest) GetTokenInDenom() string {
+	if m != nil {
+		return m.TokenInDenom
+	}
+	return ""
+}
+
+type QuerySplitRouteSwapExactAmountInResponse struct {
+	TokenOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_out_amount" yaml:"token_out_amount"`
+}
+
+func (m *QuerySplitRouteSwapExactAmountInResponse) Reset() {
+	*m = QuerySplitRouteSwapExactAmountInResponse{}
+}
+func (m *QuerySplitRouteSwapExactAmountInResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySplitRouteSwapExactAmountInResponse) ProtoMessage()    {}
+func (*QuerySplitRouteSwapExactAmountInResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{34}
+}
+func (m *QuerySplitRouteSwapExactAmountInResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySplitRouteSwapExactAmountInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySplitRouteSwapExactAmountInResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySplitRouteSwapExactAmountInResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySplitRouteSwapExactAmountInResponse.Merge(m, src)
+}
+func (m *QuerySplitRouteSwapExactAmountInResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySplitRouteSwapExactAmountInResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySplitRouteSwapExactAmountInResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySplitRouteSwapExactAmountInResponse proto.InternalMessageInfo
+
+// =============================== EstimateSwapExactAmountOut
+type QuerySwapExactAmountOutRequest struct {
+	// TODO: CHANGE THIS TO RESERVED IN A PATCH RELEASE
+	Sender   string               `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolId   uint64               `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Routes   []SwapAmountOutRoute `protobuf:"bytes,3,rep,name=routes,proto3" json:"routes" yaml:"routes"`
+	TokenOut string               `protobuf:"bytes,4,opt,name=token_out,json=tokenOut,proto3" json:"token_out,omitempty" yaml:"token_out"`
+}
+
+func (m *QuerySwapExactAmountOutRequest) Reset()         { *m = QuerySwapExactAmountOutRequest{} }
+func (m *QuerySwapExactAmountOutRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapExactAmountOutRequest) ProtoMessage()    {}
+func (*QuerySwapExactAmountOutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{35}
+}
+func (m *QuerySwapExactAmountOutRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapExactAmountOutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapExactAmountOutRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySwapExactAmountOutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapExactAmountOutRequest.Merge(m, src)
+}
+func (m *QuerySwapExactAmountOutRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapExactAmountOutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapExactAmountOutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwapExactAmountOutRequest proto.InternalMessageInfo
+
+func (m *QuerySwapExactAmountOutRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *QuerySwapExactAmountOutRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *QuerySwapExactAmountOutRequest) GetRoutes() []SwapAmountOutRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func (m *QuerySwapExactAmountOutRequest) GetTokenOut() string {
+	if m != nil {
+		return m.TokenOut
+	}
+	return ""
+}
+
+type QuerySwapExactAmountOutResponse struct {
+	TokenInAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=token_in_amount,json=tokenInAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_in_amount" yaml:"token_in_amount"`
+}
+
+func (m *QuerySwapExactAmountOutResponse) Reset()         { *m = QuerySwapExactAmountOutResponse{} }
+func (m *QuerySwapExactAmountOutResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapExactAmountOutResponse) ProtoMessage()    {}
+func (*QuerySwapExactAmountOutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{36}
+}
+func (m *QuerySwapExactAmountOutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapExactAmountOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapExactAmountOutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySwapExactAmountOutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapExactAmountOutResponse.Merge(m, src)
+}
+func (m *QuerySwapExactAmountOutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapExactAmountOutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapExactAmountOutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwapExactAmountOutResponse proto.InternalMessageInfo
+
+type QueryTotalLiquidityRequest struct {
+}
+
+func (m *QueryTotalLiquidityRequest) Reset()         { *m = QueryTotalLiquidityRequest{} }
+func (m *QueryTotalLiquidityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalLiquidityRequest) ProtoMessage()    {}
+func (*QueryTotalLiquidityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{37}
+}
+func (m *QueryTotalLiquidityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalLiquidityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalLiquidityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalLiquidityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalLiquidityRequest.Merge(m, src)
+}
+func (m *QueryTotalLiquidityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalLiquidityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalLiquidityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalLiquidityRequest proto.InternalMessageInfo
+
+type QueryTotalLiquidityResponse struct {
+	Liquidity github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=liquidity,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"liquidity" yaml:"liquidity"`
+}
+
+func (m *QueryTotalLiquidityResponse) Reset()         { *m = QueryTotalLiquidityResponse{} }
+func (m *QueryTotalLiquidityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalLiquidityResponse) ProtoMessage()    {}
+func (*QueryTotalLiquidityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{38}
+}
+func (m *QueryTotalLiquidityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalLiquidityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalLiquidityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalLiquidityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalLiquidityResponse.Merge(m, src)
+}
+func (m *QueryTotalLiquidityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalLiquidityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalLiquidityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalLiquidityResponse proto.InternalMessageInfo
+
+func (m *QueryTotalLiquidityResponse) GetLiquidity() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Liquidity
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryPoolRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolRequest")
+	proto.RegisterType((*QueryPoolResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolResponse")
 	proto.RegisterType((*QueryPoolsRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolsRequest")
 	proto.RegisterType((*QueryPoolsResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolsResponse")
 	proto.RegisterType((*QueryNumPoolsRequest)(nil), "osmosis.gamm.v1beta1.QueryNumPoolsRequest")
@@ -1493,9 +1971,18 @@ func init() {
 	proto.RegisterType((*QuerySpotPriceRequest)(nil), "osmosis.gamm.v1beta1.QuerySpotPriceRequest")
 	proto.RegisterType((*QueryPoolsWithFilterRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolsWithFilterRequest")
 	proto.RegisterType((*QueryPoolsWithFilterResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolsWithFilterResponse")
+	proto.RegisterType((*QueryPoolsByDenomRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolsByDenomRequest")
+	proto.RegisterType((*QueryPoolsByDenomResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolsByDenomResponse")
+	proto.RegisterType((*QueryPoolsByDenomPairRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolsByDenomPairRequest")
+	proto.RegisterType((*QueryPoolsByDenomPairResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolsByDenomPairResponse")
 	proto.RegisterType((*QuerySpotPriceResponse)(nil), "osmosis.gamm.v1beta1.QuerySpotPriceResponse")
 	proto.RegisterType((*QuerySwapExactAmountInRequest)(nil), "osmosis.gamm.v1beta1.QuerySwapExactAmountInRequest")
 	proto.RegisterType((*QuerySwapExactAmountInResponse)(nil), "osmosis.gamm.v1beta1.QuerySwapExactAmountInResponse")
+	proto.RegisterType((*QueryBestRoutesExactAmountInRequest)(nil), "osmosis.gamm.v1beta1.QueryBestRoutesExactAmountInRequest")
+	proto.RegisterType((*EstimatedRoute)(nil), "osmosis.gamm.v1beta1.EstimatedRoute")
+	proto.RegisterType((*QueryBestRoutesExactAmountInResponse)(nil), "osmosis.gamm.v1beta1.QueryBestRoutesExactAmountInResponse")
+	proto.RegisterType((*QuerySplitRouteSwapExactAmountInRequest)(nil), "osmosis.gamm.v1beta1.QuerySplitRouteSwapExactAmountInRequest")
+	proto.RegisterType((*QuerySplitRouteSwapExactAmountInResponse)(nil), "osmosis.gamm.v1beta1.QuerySplitRouteSwapExactAmountInResponse")
 	proto.RegisterType((*QuerySwapExactAmountOutRequest)(nil), "osmosis.gamm.v1beta1.QuerySwapExactAmountOutRequest")
 	proto.RegisterType((*QuerySwapExactAmountOutResponse)(nil), "osmosis.gamm.v1beta1.QuerySwapExactAmountOutResponse")
 	proto.RegisterType((*QueryTotalLiquidityRequest)(nil), "osmosis.gamm.v1beta1.QueryTotalLiquidityRequest")
@@ -1505,120 +1992,144 @@ func init() {
 func init() { proto.RegisterFile("osmosis/gamm/v1beta1/query.proto", fileDescriptor_d9a717df9ca609ef) }
 
 var fileDescriptor_d9a717df9ca609ef = []byte{
-	// 1795 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4b, 0x6c, 0x1c, 0x49,
-	0x19, 0x76, 0x8d, 0x1f, 0xeb, 0x29, 0xaf, 0x5f, 0xb5, 0x4e, 0x32, 0x19, 0x3b, 0x33, 0xa1, 0xd8,
-	0xb5, 0xbd, 0x1b, 0xbb, 0x27, 0x7e, 0xac, 0x40, 0x86, 0xdd, 0xac, 0x9d, 0xb5, 0xe3, 0xb1, 0xd8,
-	0xb5, 0xe9, 0x44, 0x89, 0x80, 0xc3, 0xa8, 0x6d, 0x77, 0xc6, 0x9d, 0xcc, 0x74, 0xb5, 0xa7, 0xab,
-	0x63, 0x5b, 0x28, 0x8a, 0x14, 0x21, 0x94, 0x03, 0x48, 0x48, 0x21, 0x39, 0x20, 0x04, 0x1c, 0x10,
-	0x42, 0x39, 0x23, 0x71, 0xe2, 0x84, 0x90, 0x22, 0x24, 0xa4, 0x20, 0x2e, 0x88, 0xc3, 0x80, 0x12,
-	0xb8, 0x71, 0xf2, 0x85, 0x23, 0xa8, 0xaa, 0xfe, 0x7e, 0xcc, 0xfb, 0x01, 0x91, 0xc2, 0xc9, 0x9e,
-	0xfa, 0x1f, 0xf5, 0xfd, 0xdf, 0xff, 0xd7, 0xdf, 0x7f, 0x15, 0xbe, 0xc8, 0xdc, 0x22, 0x73, 0x2d,
-	0x37, 0x93, 0x37, 0x8a, 0xc5, 0xcc, 0xbd, 0x85, 0x5d, 0x93, 0x1b, 0x0b, 0x99, 0x43, 0xcf, 0x2c,
-	0x9d, 0x68, 0x4e, 0x89, 0x71, 0x46, 0x26, 0x40, 0x43, 0x13, 0x1a, 0x1a, 0x68, 0x24, 0x27, 0xf2,
-	0x2c, 0xcf, 0xa4, 0x42, 0x46, 0xfc, 0xa7, 0x74, 0x93, 0x17, 0xea, 0x7a, 0xe3, 0xc7, 0x20, 0x4e,
-	0xed, 0x49, 0x79, 0x66, 0xd7, 0x70, 0xcd, 0x40, 0xba, 0xc7, 0x2c, 0x1b, 0xe4, 0x1f, 0x44, 0xe5,
-	0x12, 0x43, 0xa0, 0xe5, 0x18, 0x79, 0xcb, 0x36, 0xb8, 0xc5, 0x7c, 0xdd, 0xa9, 0x3c, 0x63, 0xf9,
-	0x82, 0x99, 0x31, 0x1c, 0x2b, 0x63, 0xd8, 0x36, 0xe3, 0x52, 0xe8, 0x82, 0xf4, 0x3c, 0x48, 0xe5,
-	0xaf, 0x5d, 0xef, 0x76, 0xc6, 0xb0, 0x4f, 0x7c, 0x91, 0xda, 0x24, 0xa7, 0xc0, 0xab, 0x1f, 0x4a,
-	0x44, 0xaf, 0xe0, 0xb1, 0xaf, 0x8b, 0x5d, 0x77, 0x18, 0x2b, 0xe8, 0xe6, 0xa1, 0x67, 0xba, 0x9c,
-	0x5c, 0xc2, 0x6f, 0x39, 0x8c, 0x15, 0x72, 0xd6, 0x7e, 0x02, 0x5d, 0x44, 0xb3, 0x7d, 0x6b, 0xe4,
-	0xb4, 0x9c, 0x1e, 0x39, 0x31, 0x8a, 0x85, 0x15, 0x0a, 0x02, 0xaa, 0x0f, 0x88, 0xff, 0xb2, 0xfb,
-	0x74, 0x13, 0x8f, 0x47, 0x1c, 0xb8, 0x0e, 0xb3, 0x5d, 0x93, 0x2c, 0xe1, 0x3e, 0x21, 0x96, 0xe6,
-	0x43, 0x8b, 0x13, 0x9a, 0x82, 0xa6, 0xf9, 0xd0, 0xb4, 0x55, 0xfb, 0x64, 0x2d, 0xfe, 0xfb, 0x5f,
-	0xcd, 0xf7, 0x0b, 0xab, 0xac, 0x2e, 0x95, 0xe9, 0xb7, 0x22, 0x9e, 0x5c, 0x1f, 0xcb, 0x06, 0xc6,
-	0x21, 0x0f, 0x89, 0x98, 0xf4, 0x37, 0xad, 0x41, 0x08, 0x82, 0x34, 0x4d, 0x25, 0x0e, 0x48, 0xd3,
-	0x76, 0x8c, 0xbc, 0x09, 0xb6, 0x7a, 0xc4, 0x92, 0xfe, 0x10, 0x61, 0x12, 0xf5, 0x0e, 0x40, 0x3f,
-	0xc4, 0xfd, 0x62, 0x6f, 0x37, 0x81, 0x2e, 0xf6, 0xb6, 0x83, 0x54, 0x69, 0x93, 0x6b, 0x75, 0x50,
-	0xcd, 0xb4, 0x44, 0xa5, 0xf6, 0xac, 0x80, 0x75, 0x16, 0x4f, 0x48, 0x54, 0x9f, 0x7b, 0xc5, 0x68,
-	0xd8, 0x74, 0x0b, 0x9f, 0xa9, 0x5a, 0x07, 0xc0, 0x0b, 0x38, 0x6e, 0x7b, 0xc5, 0x9c, 0x0f, 0x5a,
-	0x64, 0x67, 0xe2, 0xb4, 0x9c, 0x1e, 0x53, 0xd9, 0x09, 0x44, 0x54, 0x1f, 0xb4, 0xc1, 0x94, 0x5e,
-	0x85, 0x3d, 0xc4, 0xaf, 0x1b, 0x27, 0x8e, 0xd9, 0x55, 0x9a, 0x7d, 0x40, 0xa1, 0x93, 0x10, 0x90,
-	0x54, 0xe6, 0x27, 0x8e, 0x29, 0xfd, 0xc4, 0xa3, 0x80, 0x02, 0x11, 0xd5, 0x07, 0x1d, 0x30, 0xa5,
-	0xbf, 0x46, 0x38, 0x25, 0x9d, 0x5d, 0x35, 0x0a, 0x7b, 0x5b, 0xcc, 0xb2, 0x85, 0xd3, 0xeb, 0x07,
-	0x46, 0xc9, 0x74, 0xbb, 0xc1, 0x46, 0x0e, 0x70, 0x9c, 0xb3, 0xbb, 0xa6, 0xed, 0xe6, 0x2c, 0x91,
-	0x0c, 0x91, 0xc8, 0xf3, 0x15, 0xc9, 0xf0, 0xd3, 0x70, 0x95, 0x59, 0xf6, 0xda, 0xe5, 0xe7, 0xe5,
-	0x74, 0xcf, 0xb3, 0xbf, 0xa6, 0x67, 0xf3, 0x16, 0x3f, 0xf0, 0x76, 0xb5, 0x3d, 0x56, 0x84, 0x23,
-	0x01, 0x7f, 0xe6, 0xdd, 0xfd, 0xbb, 0x19, 0x81, 0xd9, 0x95, 0x06, 0xae, 0x3e, 0xa8, 0xbc, 0x67,
-	0x6d, 0xfa, 0x30, 0x86, 0xd3, 0x0d, 0x91, 0x03, 0x21, 0x2e, 0x1e, 0x73, 0xc5, 0x4a, 0x8e, 0x79,
-	0x3c, 0x67, 0x14, 0x99, 0x67, 0x73, 0xe0, 0x25, 0x2b, 0x76, 0xfe, 0x4b, 0x39, 0x3d, 0xdd, 0xc6,
-	0xce, 0x59, 0x9b, 0x9f, 0x96, 0xd3, 0xe7, 0x54, 0xc4, 0xd5, 0xfe, 0xa8, 0x3e, 0x22, 0x97, 0xb6,
-	0x3d, 0xbe, 0x2a, 0x17, 0xc8, 0x1d, 0x8c, 0x81, 0x02, 0xe6, 0xf1, 0xd7, 0xc1, 0x01, 0x30, 0xbc,
-	0xed, 0x71, 0xfa, 0x23, 0x84, 0x67, 0x02, 0x12, 0xd6, 0x8f, 0x2d, 0x2e, 0x48, 0x90, 0x5a, 0x1b,
-	0x25, 0x56, 0xac, 0xcc, 0xe3, 0xb9, 0xaa, 0x3c, 0x06, 0x39, 0xbb, 0x89, 0x47, 0x55, 0x54, 0x96,
-	0xed, 0x93, 0x14, 0x93, 0x24, 0x69, 0x9d, 0x91, 0xa4, 0x0f, 0x4b, 0x37, 0x59, 0x5b, 0x11, 0x41,
-	0x9f, 0x22, 0x3c, 0xdb, 0x1a, 0x1c, 0xa4, 0xaa, 0x92, 0x35, 0xf4, 0x5a, 0x59, 0x5b, 0xc7, 0x67,
-	0x83, 0x03, 0xb4, 0x63, 0x94, 0x8c, 0x62, 0x57, 0xb5, 0x4e, 0xaf, 0xe1, 0x73, 0x35, 0x6e, 0x20,
-	0x9a, 0x39, 0x3c, 0xe0, 0xc8, 0x95, 0x66, 0x6d, 0x57, 0x07, 0x1d, 0xfa, 0x19, 0x9c, 0xc1, 0x1b,
-	0x8c, 0x1b, 0x05, 0xe1, 0xed, 0x6b, 0xd6, 0xa1, 0x67, 0xed, 0x5b, 0xfc, 0xa4, 0x2b, 0x5c, 0x3f,
-	0x43, 0x70, 0x32, 0xea, 0xf9, 0x03, 0x80, 0xf7, 0x71, 0xbc, 0xe0, 0x2f, 0xb6, 0x66, 0xfb, 0x53,
-	0xc1, 0x76, 0xd8, 0x49, 0x02, 0x4b, 0xda, 0x59, 0x06, 0x42, 0xbb, 0x0d, 0xa0, 0x4e, 0x22, 0xec,
-	0xbe, 0xdd, 0x50, 0x0f, 0x27, 0x6a, 0xfd, 0x40, 0x88, 0xdf, 0xc0, 0x6f, 0x73, 0xb1, 0x9c, 0x93,
-	0x55, 0xe9, 0x67, 0xa2, 0x49, 0x94, 0x93, 0x10, 0xe5, 0x3b, 0x6a, 0xb3, 0xa8, 0x31, 0xd5, 0x87,
-	0x78, 0xb8, 0x05, 0xfd, 0x0d, 0xc2, 0xef, 0xd6, 0xf4, 0x9e, 0xcf, 0xd9, 0xf5, 0x23, 0xc3, 0xf9,
-	0xbf, 0xe8, 0x9d, 0xff, 0x42, 0xf8, 0xbd, 0x16, 0xf8, 0x81, 0xc4, 0x07, 0x9d, 0x1d, 0xcb, 0x75,
-	0xa0, 0x70, 0xdc, 0xa7, 0xd0, 0x37, 0xa5, 0x5d, 0x9e, 0x55, 0xf2, 0x19, 0xc6, 0x2a, 0x05, 0xd0,
-	0x4d, 0xbb, 0xe9, 0x4b, 0x71, 0xe5, 0x41, 0x1c, 0xfd, 0x7f, 0x22, 0xf8, 0x78, 0x5e, 0x77, 0x18,
-	0xdf, 0x29, 0x59, 0x7b, 0x5d, 0x7d, 0x82, 0xc9, 0x3a, 0x1e, 0x13, 0xc1, 0xe7, 0x0c, 0xd7, 0x35,
-	0x79, 0x6e, 0xdf, 0xb4, 0x59, 0x11, 0xb0, 0x4d, 0x86, 0x9f, 0x8a, 0x6a, 0x0d, 0xaa, 0x8f, 0x88,
-	0xa5, 0x55, 0xb1, 0xf2, 0xa9, 0x58, 0x20, 0x9b, 0x78, 0xfc, 0xd0, 0x63, 0xbc, 0xd2, 0x4f, 0xaf,
-	0xf4, 0x33, 0x75, 0x5a, 0x4e, 0x27, 0x94, 0x9f, 0x1a, 0x15, 0xaa, 0x8f, 0xca, 0xb5, 0xd0, 0xd3,
-	0x4a, 0x2c, 0x81, 0xb6, 0xfa, 0x06, 0xfb, 0xc6, 0xfa, 0xf5, 0xa1, 0x23, 0x8b, 0x1f, 0x88, 0x4c,
-	0x6e, 0x98, 0x26, 0xfd, 0x7e, 0x0c, 0x4f, 0x86, 0xa3, 0xd6, 0x2d, 0x8b, 0x1f, 0x6c, 0x58, 0x05,
-	0x6e, 0x96, 0xfc, 0xa0, 0x1f, 0x21, 0x3c, 0x5c, 0xb4, 0xec, 0x5c, 0x07, 0xbd, 0x60, 0x13, 0x52,
-	0x3c, 0xa1, 0xc0, 0x55, 0x58, 0x77, 0x96, 0xe5, 0xb7, 0x8b, 0x96, 0x1d, 0x74, 0x26, 0x32, 0x19,
-	0x1d, 0x5e, 0x24, 0x97, 0xe1, 0x98, 0x52, 0x35, 0x7a, 0xf6, 0x76, 0x3d, 0x7a, 0xfe, 0x04, 0xe1,
-	0xa9, 0xfa, 0x7c, 0xbc, 0x21, 0x43, 0xa8, 0x0e, 0x9f, 0xa6, 0x48, 0x79, 0x02, 0xb2, 0x65, 0x8c,
-	0x5d, 0x87, 0xf1, 0x9c, 0x23, 0x56, 0x61, 0x8a, 0x39, 0x13, 0x1e, 0xb5, 0x50, 0x46, 0xf5, 0xb8,
-	0xeb, 0x5b, 0x8b, 0xba, 0xa0, 0xff, 0x46, 0xf8, 0x82, 0x72, 0x7a, 0x64, 0x38, 0xeb, 0xc7, 0xc6,
-	0x1e, 0x4c, 0x2a, 0x59, 0xdb, 0x2f, 0x83, 0xf7, 0xf1, 0x80, 0x6b, 0xda, 0xfb, 0x66, 0x09, 0xfc,
-	0x8e, 0x9f, 0x96, 0xd3, 0xc3, 0xe0, 0x57, 0xae, 0x53, 0x1d, 0x14, 0xa2, 0xc7, 0x24, 0xd6, 0xf2,
-	0x98, 0x68, 0x58, 0xf5, 0x1c, 0xd1, 0xd0, 0x54, 0x59, 0xbf, 0x73, 0x5a, 0x4e, 0x8f, 0x46, 0x9a,
-	0x43, 0xce, 0xb2, 0xa9, 0xfe, 0x96, 0xfc, 0x37, 0x6b, 0x93, 0x9b, 0x78, 0xa0, 0xc4, 0x3c, 0x6e,
-	0xba, 0x89, 0x3e, 0x49, 0xff, 0x8c, 0x56, 0xef, 0xf6, 0xa7, 0x89, 0x38, 0x82, 0x10, 0x84, 0xfe,
-	0xda, 0x19, 0x28, 0x4a, 0x00, 0xad, 0x9c, 0x50, 0x1d, 0xbc, 0xd1, 0x27, 0xfe, 0x94, 0x5b, 0x87,
-	0x81, 0x70, 0x54, 0x54, 0x80, 0xfe, 0x77, 0xa3, 0x62, 0xb5, 0x3f, 0xaa, 0x8f, 0xc8, 0xa5, 0x60,
-	0x54, 0xa4, 0xdf, 0x89, 0xd5, 0xc7, 0xb5, 0xed, 0xf1, 0xd7, 0x9d, 0x9a, 0x5b, 0x01, 0xd5, 0xbd,
-	0x92, 0xea, 0xd9, 0x56, 0x54, 0x0b, 0x4c, 0x6d, 0x70, 0x2d, 0x2e, 0x21, 0x41, 0xe0, 0x89, 0xbe,
-	0xea, 0x4b, 0x48, 0x20, 0xa2, 0xf0, 0x39, 0x12, 0x4d, 0xf9, 0xb1, 0x3f, 0xb0, 0xd4, 0xa3, 0x01,
-	0xf2, 0xe3, 0xe0, 0x51, 0xbf, 0x60, 0x2a, 0xd3, 0xb3, 0xd9, 0x71, 0x7a, 0xce, 0x56, 0xd6, 0x5f,
-	0x90, 0x9d, 0x61, 0x28, 0x43, 0x48, 0xce, 0x14, 0x4e, 0x86, 0xb3, 0x45, 0xf5, 0x44, 0x46, 0x7f,
-	0x8c, 0xa0, 0xb3, 0x56, 0x8b, 0xdf, 0x88, 0x01, 0x6b, 0xf1, 0xd9, 0x04, 0xee, 0x97, 0xf0, 0xc8,
-	0x03, 0x2c, 0x5b, 0x95, 0x4b, 0x1a, 0x1c, 0xa6, 0x9a, 0x7b, 0x7e, 0x72, 0xb6, 0xb5, 0xa2, 0x0a,
-	0x92, 0x7e, 0xf1, 0xe1, 0x9f, 0xfe, 0xfe, 0x38, 0x76, 0x81, 0x4c, 0x66, 0xea, 0xbe, 0xbc, 0xa8,
-	0xde, 0xf8, 0x3d, 0x84, 0x07, 0xfd, 0xbb, 0x33, 0xf9, 0xa0, 0x89, 0xef, 0xaa, 0x8b, 0x77, 0xf2,
-	0x52, 0x5b, 0xba, 0x00, 0x65, 0x46, 0x42, 0xf9, 0x02, 0x49, 0xd7, 0x87, 0x12, 0xdc, 0xc6, 0xc9,
-	0xcf, 0x11, 0x1e, 0xa9, 0xcc, 0x19, 0xb9, 0xdc, 0x64, 0xa3, 0xba, 0xd9, 0x4f, 0x2e, 0x74, 0x60,
-	0x01, 0x00, 0xe7, 0x25, 0xc0, 0x19, 0xf2, 0x5e, 0x7d, 0x80, 0x6a, 0xda, 0x0c, 0x12, 0x48, 0x7e,
-	0x81, 0xf0, 0x68, 0xd5, 0x47, 0x8a, 0x2c, 0xb4, 0x4a, 0x4c, 0xcd, 0x07, 0x3e, 0xb9, 0xd8, 0x89,
-	0x09, 0x20, 0x9d, 0x93, 0x48, 0xa7, 0xc9, 0xbb, 0xf5, 0x91, 0xde, 0x96, 0xda, 0xe6, 0x3e, 0xf0,
-	0xf9, 0x5d, 0x84, 0xfb, 0x84, 0x27, 0x32, 0xdd, 0x62, 0x2b, 0x1f, 0xd2, 0x4c, 0x4b, 0xbd, 0xf6,
-	0x18, 0x93, 0xdb, 0x67, 0xbe, 0x0d, 0x9d, 0xed, 0x3e, 0x79, 0x8a, 0xf0, 0xa0, 0xff, 0x24, 0xd2,
-	0xb4, 0xce, 0xaa, 0x1e, 0x5f, 0x9a, 0xd6, 0x59, 0xf5, 0x1b, 0x0b, 0x5d, 0x90, 0xa0, 0x2e, 0x91,
-	0xf7, 0x1b, 0x83, 0x92, 0x23, 0x4c, 0x04, 0xd8, 0x13, 0x84, 0x13, 0x8d, 0x06, 0x6d, 0xb2, 0xd2,
-	0x64, 0xf3, 0x16, 0xb7, 0x8b, 0xe4, 0x57, 0xba, 0xb2, 0x85, 0x40, 0x7a, 0xc8, 0x6f, 0x11, 0x26,
-	0xb5, 0x8f, 0x27, 0x64, 0xb9, 0x4d, 0xaf, 0x95, 0x58, 0x3e, 0xec, 0xd0, 0x0a, 0x50, 0x7c, 0x22,
-	0xe9, 0x5c, 0x21, 0x5f, 0x6e, 0x2b, 0xc7, 0x99, 0x3b, 0xcc, 0xb2, 0x73, 0xee, 0x91, 0xe1, 0xe4,
-	0x4c, 0xf1, 0x99, 0xc8, 0x59, 0x36, 0xf9, 0x07, 0xc2, 0x93, 0x4d, 0x1e, 0x18, 0xc8, 0x47, 0x2d,
-	0x80, 0x35, 0x7f, 0x35, 0x49, 0x7e, 0xdc, 0xad, 0x39, 0x04, 0x78, 0x4d, 0x06, 0xb8, 0x4a, 0xae,
-	0xb4, 0x17, 0xa0, 0x79, 0x6c, 0x71, 0x15, 0xa0, 0x7a, 0x92, 0x51, 0xdf, 0x26, 0x11, 0xe7, 0x4f,
-	0x11, 0xc6, 0xe1, 0x4b, 0x03, 0x99, 0x6b, 0x51, 0xb4, 0x15, 0xef, 0x1a, 0xc9, 0xf9, 0x36, 0xb5,
-	0x01, 0xf4, 0xb2, 0x04, 0xad, 0x91, 0xb9, 0xf6, 0x40, 0xab, 0x67, 0x0c, 0xf2, 0x3b, 0x84, 0x49,
-	0xed, 0x93, 0x43, 0xd3, 0x7a, 0x6a, 0xf8, 0xe2, 0xd1, 0xb4, 0x9e, 0x1a, 0xbf, 0x6b, 0xd0, 0x35,
-	0x89, 0xfc, 0xab, 0x64, 0xa5, 0x3d, 0xe4, 0xaa, 0xeb, 0xca, 0x9f, 0x61, 0xeb, 0xfd, 0x25, 0xc2,
-	0x43, 0x91, 0x07, 0x05, 0x32, 0xdf, 0x0a, 0x4a, 0x65, 0xc5, 0x68, 0xed, 0xaa, 0x03, 0xe4, 0x15,
-	0x09, 0x79, 0x99, 0x2c, 0x76, 0x02, 0x59, 0xdd, 0x68, 0x45, 0x51, 0xc4, 0x83, 0xab, 0x02, 0x69,
-	0xd6, 0xc8, 0xaa, 0xef, 0xbb, 0xc9, 0xb9, 0xf6, 0x94, 0x01, 0xe4, 0x97, 0x3a, 0xac, 0x08, 0x61,
-	0xec, 0x3e, 0x8a, 0x21, 0xf2, 0x07, 0x84, 0xcf, 0xaf, 0xbb, 0xdc, 0x2a, 0x1a, 0xdc, 0xac, 0x99,
-	0xbe, 0xc9, 0x52, 0x33, 0x10, 0x0d, 0x6e, 0x2b, 0xc9, 0xe5, 0xce, 0x8c, 0x20, 0x82, 0x75, 0x19,
-	0xc1, 0x15, 0xf2, 0x51, 0xfd, 0x08, 0x22, 0x47, 0x10, 0xd0, 0x66, 0x22, 0x7d, 0x26, 0x3c, 0x86,
-	0x7f, 0x44, 0x38, 0xd9, 0x20, 0x9e, 0x6d, 0x8f, 0x93, 0x0e, 0xb0, 0x85, 0x43, 0x7e, 0xd3, 0x62,
-	0x6f, 0x3c, 0x13, 0xd3, 0x0d, 0x19, 0xd2, 0x27, 0xe4, 0xe3, 0xff, 0x22, 0x24, 0xe6, 0xf1, 0xb5,
-	0xad, 0xe7, 0x2f, 0x53, 0xe8, 0xc5, 0xcb, 0x14, 0xfa, 0xdb, 0xcb, 0x14, 0xfa, 0xc1, 0xab, 0x54,
-	0xcf, 0x8b, 0x57, 0xa9, 0x9e, 0x3f, 0xbf, 0x4a, 0xf5, 0x7c, 0xf3, 0x72, 0x64, 0xf6, 0x84, 0x3d,
-	0xe6, 0x0b, 0xc6, 0xae, 0x1b, 0x6c, 0x78, 0x6f, 0x61, 0x29, 0x73, 0xac, 0xb6, 0x95, 0x93, 0xe8,
-	0xee, 0x80, 0xbc, 0x29, 0x2f, 0xfd, 0x27, 0x00, 0x00, 0xff, 0xff, 0xbf, 0xce, 0x32, 0x25, 0xda,
-	0x1b, 0x00, 0x00,
+	// 2184 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x5d, 0x6c, 0x1c, 0x57,
+	0x15, 0xce, 0xdd, 0xd8, 0x8e, 0xf7, 0xc4, 0x7f, 0xb9, 0x75, 0x9c, 0xf5, 0x38, 0xf1, 0x86, 0x9b,
+	0xd4, 0x76, 0x1b, 0x7b, 0x36, 0x76, 0x1c, 0x01, 0x86, 0x26, 0xf5, 0xa6, 0x76, 0xec, 0x88, 0x36,
+	0x66, 0x5c, 0xb5, 0x82, 0x3e, 0x8c, 0x66, 0xed, 0xa9, 0x3d, 0xed, 0xee, 0xcc, 0x64, 0x67, 0xb6,
+	0xf1, 0xaa, 0xaa, 0x2a, 0x55, 0x08, 0x55, 0x08, 0x24, 0xa4, 0xd2, 0x3e, 0xf0, 0x17, 0x1e, 0x10,
+	0x42, 0x3c, 0xf0, 0x84, 0xc4, 0x13, 0x12, 0x12, 0x42, 0x54, 0x95, 0x90, 0x8a, 0x78, 0x41, 0x08,
+	0x16, 0x94, 0xc0, 0x1b, 0x3c, 0xb0, 0x2f, 0x3c, 0x82, 0xee, 0xcf, 0xfc, 0xec, 0xec, 0xec, 0xce,
+	0xec, 0x92, 0x88, 0xf4, 0xc9, 0xde, 0x7b, 0xcf, 0x39, 0xf7, 0x3b, 0xe7, 0x3b, 0xf7, 0xdc, 0x7b,
+	0xee, 0xc0, 0x79, 0xcb, 0xa9, 0x58, 0x8e, 0xe1, 0x14, 0x0e, 0xb4, 0x4a, 0xa5, 0xf0, 0xc6, 0x72,
+	0x49, 0x77, 0xb5, 0xe5, 0xc2, 0x9d, 0x9a, 0x5e, 0xad, 0xcb, 0x76, 0xd5, 0x72, 0x2d, 0x3c, 0x29,
+	0x24, 0x64, 0x2a, 0x21, 0x0b, 0x09, 0x69, 0xf2, 0xc0, 0x3a, 0xb0, 0x98, 0x40, 0x81, 0xfe, 0xc7,
+	0x65, 0xa5, 0x73, 0xb1, 0xd6, 0xdc, 0x23, 0x31, 0x3d, 0xbb, 0xc7, 0xe6, 0x0b, 0x25, 0xcd, 0xd1,
+	0xfd, 0xd9, 0x3d, 0xcb, 0x30, 0xc5, 0xfc, 0xd3, 0xe1, 0x79, 0x86, 0xc1, 0x97, 0xb2, 0xb5, 0x03,
+	0xc3, 0xd4, 0x5c, 0xc3, 0xf2, 0x64, 0xcf, 0x1e, 0x58, 0xd6, 0x41, 0x59, 0x2f, 0x68, 0xb6, 0x51,
+	0xd0, 0x4c, 0xd3, 0x72, 0xd9, 0xa4, 0x23, 0x66, 0xa7, 0xc5, 0x2c, 0xfb, 0x55, 0xaa, 0xbd, 0x5a,
+	0xd0, 0xcc, 0xba, 0x37, 0xc5, 0x17, 0x51, 0x39, 0x78, 0xfe, 0x83, 0x4f, 0x91, 0xeb, 0x30, 0xf1,
+	0x45, 0xba, 0xea, 0x8e, 0x65, 0x95, 0x15, 0xfd, 0x4e, 0x4d, 0x77, 0x5c, 0x7c, 0x09, 0x4e, 0xd8,
+	0x96, 0x55, 0x56, 0x8d, 0xfd, 0x1c, 0x3a, 0x8f, 0x16, 0x06, 0x8a, 0xb8, 0xd9, 0xc8, 0x8f, 0xd5,
+	0xb5, 0x4a, 0x79, 0x8d, 0x88, 0x09, 0xa2, 0x0c, 0xd1, 0xff, 0xb6, 0xf7, 0xc9, 0x16, 0x9c, 0x0a,
+	0x19, 0x70, 0x6c, 0xcb, 0x74, 0x74, 0x7c, 0x05, 0x06, 0xe8, 0x34, 0x53, 0x3f, 0xb9, 0x32, 0x29,
+	0x73, 0x68, 0xb2, 0x07, 0x4d, 0x5e, 0x37, 0xeb, 0xc5, 0xec, 0x47, 0x3f, 0x5b, 0x1a, 0xa4, 0x5a,
+	0xdb, 0x0a, 0x13, 0x26, 0xaf, 0x84, 0x2c, 0x39, 0x1e, 0x96, 0x4d, 0x80, 0x20, 0x0e, 0xb9, 0x0c,
+	0xb3, 0x37, 0x27, 0x0b, 0x17, 0x68, 0xd0, 0x64, 0x4e, 0x9c, 0x08, 0x9a, 0xbc, 0xa3, 0x1d, 0xe8,
+	0x42, 0x57, 0x09, 0x69, 0x92, 0x6f, 0x21, 0xc0, 0x61, 0xeb, 0x02, 0xe8, 0x55, 0x18, 0xa4, 0x6b,
+	0x3b, 0x39, 0x74, 0xfe, 0x78, 0x1a, 0xa4, 0x5c, 0x1a, 0xdf, 0x8c, 0x41, 0x35, 0x9f, 0x88, 0x8a,
+	0xaf, 0xd9, 0x02, 0x6b, 0x0a, 0x26, 0x19, 0xaa, 0x17, 0x6a, 0x95, 0xb0, 0xdb, 0xe4, 0x16, 0x9c,
+	0x8e, 0x8c, 0x0b, 0xc0, 0xcb, 0x90, 0x35, 0x6b, 0x15, 0xd5, 0x03, 0x4d, 0xd9, 0x99, 0x6c, 0x36,
+	0xf2, 0x13, 0x9c, 0x1d, 0x7f, 0x8a, 0x28, 0xc3, 0xa6, 0x50, 0x25, 0x37, 0xc4, 0x1a, 0xf4, 0xd7,
+	0x8b, 0x75, 0x5b, 0xef, 0x8b, 0x66, 0x0f, 0x50, 0x60, 0x24, 0x00, 0xc4, 0x84, 0xdd, 0xba, 0xad,
+	0x33, 0x3b, 0xd9, 0x30, 0x20, 0x7f, 0x8a, 0x28, 0xc3, 0xb6, 0x50, 0x25, 0x3f, 0x47, 0x30, 0xcb,
+	0x8c, 0xdd, 0xd0, 0xca, 0x7b, 0xb7, 0x2c, 0xc3, 0xa4, 0x46, 0x77, 0x0f, 0xb5, 0xaa, 0xee, 0xf4,
+	0x83, 0x0d, 0x1f, 0x42, 0xd6, 0xb5, 0x5e, 0xd7, 0x4d, 0x47, 0x35, 0x28, 0x19, 0x94, 0xc8, 0xe9,
+	0x16, 0x32, 0x3c, 0x1a, 0x6e, 0x58, 0x86, 0x59, 0xbc, 0xfc, 0x61, 0x23, 0x7f, 0xec, 0x27, 0x7f,
+	0xc9, 0x2f, 0x1c, 0x18, 0xee, 0x61, 0xad, 0x24, 0xef, 0x59, 0x15, 0xb1, 0x25, 0xc4, 0x9f, 0x25,
+	0x67, 0xff, 0xf5, 0x02, 0xc5, 0xec, 0x30, 0x05, 0x47, 0x19, 0xe6, 0xd6, 0xb7, 0x4d, 0xf2, 0x4e,
+	0x06, 0xf2, 0x1d, 0x91, 0x8b, 0x80, 0x38, 0x30, 0xe1, 0xd0, 0x11, 0xd5, 0xaa, 0xb9, 0xaa, 0x56,
+	0xb1, 0x6a, 0xa6, 0x2b, 0xe2, 0xb2, 0x4d, 0x57, 0xfe, 0x63, 0x23, 0x3f, 0x97, 0x62, 0xe5, 0x6d,
+	0xd3, 0x6d, 0x36, 0xf2, 0x67, 0xb8, 0xc7, 0x51, 0x7b, 0x44, 0x19, 0x63, 0x43, 0xb7, 0x6b, 0xee,
+	0x3a, 0x1b, 0xc0, 0xaf, 0x01, 0x88, 0x10, 0x58, 0x35, 0xf7, 0x51, 0xc4, 0x40, 0x44, 0xf8, 0x76,
+	0xcd, 0x25, 0xdf, 0x46, 0x30, 0xef, 0x07, 0x61, 0xe3, 0xc8, 0x70, 0x69, 0x10, 0x98, 0xd4, 0x66,
+	0xd5, 0xaa, 0xb4, 0xf2, 0x78, 0x26, 0xc2, 0xa3, 0xcf, 0xd9, 0x4b, 0x30, 0xce, 0xbd, 0x32, 0x4c,
+	0x2f, 0x48, 0x19, 0x16, 0x24, 0xb9, 0xb7, 0x20, 0x29, 0xa3, 0xcc, 0xcc, 0xb6, 0xc9, 0x03, 0x41,
+	0x3e, 0x40, 0xb0, 0x90, 0x0c, 0x4e, 0x50, 0xd5, 0x1a, 0x35, 0xf4, 0x48, 0xa3, 0xb6, 0x01, 0x53,
+	0xfe, 0x06, 0xda, 0xd1, 0xaa, 0x5a, 0xa5, 0xaf, 0x5c, 0x27, 0x37, 0xe1, 0x4c, 0x9b, 0x19, 0xe1,
+	0xcd, 0x22, 0x0c, 0xd9, 0x6c, 0xa4, 0x5b, 0xd9, 0x55, 0x84, 0x0c, 0x79, 0x5e, 0xec, 0xc1, 0x17,
+	0x2d, 0x57, 0x2b, 0x53, 0x6b, 0x5f, 0x30, 0xee, 0xd4, 0x8c, 0x7d, 0xc3, 0xad, 0xf7, 0x85, 0xeb,
+	0x07, 0x08, 0xf2, 0x1d, 0xed, 0x09, 0x80, 0x6f, 0x41, 0xb6, 0xec, 0x0d, 0x26, 0x47, 0xfb, 0x39,
+	0x1a, 0xed, 0xa0, 0x92, 0xf8, 0x9a, 0xa4, 0x37, 0x06, 0x02, 0xbd, 0x4d, 0x38, 0x13, 0x20, 0xec,
+	0xbf, 0xdc, 0x90, 0x1a, 0xe4, 0xda, 0xed, 0x08, 0x17, 0xbf, 0x04, 0x23, 0x2e, 0x1d, 0x56, 0x59,
+	0x56, 0x7a, 0x4c, 0x74, 0xf1, 0x72, 0x46, 0x78, 0xf9, 0x04, 0x5f, 0x2c, 0xac, 0x4c, 0x94, 0x93,
+	0x6e, 0xb0, 0x04, 0xf9, 0x05, 0x82, 0x8b, 0x6d, 0xb5, 0xe7, 0x05, 0x6b, 0xf7, 0xae, 0x66, 0x7f,
+	0x22, 0x6a, 0xe7, 0xbf, 0x11, 0x3c, 0x99, 0x80, 0x5f, 0x04, 0xf1, 0xed, 0xde, 0xb6, 0xe5, 0x86,
+	0x08, 0xe1, 0x29, 0x2f, 0x84, 0x9e, 0x2a, 0xe9, 0x73, 0xaf, 0xe2, 0xe7, 0x01, 0x38, 0x05, 0xa2,
+	0x9a, 0xf6, 0x53, 0x97, 0xb2, 0xdc, 0x02, 0xdd, 0xfa, 0xff, 0x40, 0xe2, 0xf0, 0xdc, 0xb5, 0x2d,
+	0x77, 0xa7, 0x6a, 0xec, 0xf5, 0x75, 0x04, 0xe3, 0x0d, 0x98, 0xa0, 0xce, 0xab, 0x9a, 0xe3, 0xe8,
+	0xae, 0xba, 0xaf, 0x9b, 0x56, 0x45, 0x60, 0x9b, 0x09, 0x8e, 0x8a, 0xa8, 0x04, 0x51, 0xc6, 0xe8,
+	0xd0, 0x3a, 0x1d, 0x79, 0x8e, 0x0e, 0xe0, 0x2d, 0x38, 0x75, 0xa7, 0x66, 0xb9, 0xad, 0x76, 0x8e,
+	0x33, 0x3b, 0x67, 0x9b, 0x8d, 0x7c, 0x8e, 0xdb, 0x69, 0x13, 0x21, 0xca, 0x38, 0x1b, 0x0b, 0x2c,
+	0xad, 0x65, 0x72, 0xe8, 0xd6, 0xc0, 0xf0, 0xc0, 0xc4, 0xa0, 0x72, 0xf2, 0xae, 0xe1, 0x1e, 0x52,
+	0x26, 0x37, 0x75, 0x9d, 0x7c, 0x23, 0x03, 0x33, 0xc1, 0x55, 0xeb, 0x65, 0xc3, 0x3d, 0xdc, 0x34,
+	0xca, 0xae, 0x5e, 0xf5, 0x9c, 0x7e, 0x17, 0xc1, 0x68, 0xc5, 0x30, 0xd5, 0x1e, 0x6a, 0xc1, 0x96,
+	0xa0, 0x78, 0x92, 0x83, 0x6b, 0xd1, 0xee, 0x8d, 0xe5, 0x91, 0x8a, 0x61, 0xfa, 0x95, 0x09, 0xcf,
+	0x84, 0x2f, 0x2f, 0x2c, 0x96, 0xc1, 0x35, 0x25, 0x72, 0xf5, 0x3c, 0xde, 0xf7, 0xd5, 0xf3, 0xfb,
+	0x08, 0xce, 0xc6, 0xc7, 0xe3, 0x31, 0xb9, 0x84, 0x7e, 0x0d, 0x89, 0x8a, 0xc6, 0x00, 0x16, 0xeb,
+	0x8c, 0x5d, 0x8f, 0xad, 0x39, 0x18, 0xe4, 0x29, 0xc2, 0xef, 0x30, 0x13, 0xcd, 0x46, 0x7e, 0x84,
+	0xb3, 0x20, 0xd2, 0x82, 0x4f, 0x3f, 0xb4, 0x8b, 0xfa, 0x77, 0x10, 0x4c, 0xc7, 0x80, 0x79, 0x4c,
+	0x42, 0xf5, 0xcb, 0x16, 0x2e, 0x05, 0xba, 0x1d, 0xcd, 0xa8, 0x86, 0x76, 0x34, 0x8b, 0x87, 0xaa,
+	0x89, 0x80, 0x85, 0x76, 0xb4, 0x98, 0x20, 0xca, 0x10, 0xfb, 0x6f, 0x3d, 0x10, 0x2e, 0xe5, 0x32,
+	0xf1, 0xc2, 0x25, 0x4f, 0xb8, 0xf8, 0xd0, 0xd2, 0xf1, 0x1e, 0x82, 0x73, 0x1d, 0x5c, 0x78, 0x4c,
+	0x82, 0xac, 0xc0, 0x54, 0xb4, 0x5c, 0x0a, 0x64, 0xab, 0x00, 0x8e, 0x6d, 0xb9, 0xaa, 0x4d, 0x47,
+	0x45, 0x80, 0x4f, 0x07, 0xa5, 0x3f, 0x98, 0x23, 0x4a, 0xd6, 0xf1, 0xb4, 0x69, 0x9d, 0x22, 0xff,
+	0xf1, 0xbc, 0xa6, 0x55, 0x6a, 0xe3, 0x48, 0xdb, 0x13, 0x37, 0xe7, 0x6d, 0xd3, 0x63, 0xee, 0x29,
+	0x18, 0x72, 0x74, 0x73, 0x5f, 0xaf, 0x0a, 0xbb, 0xa7, 0x9a, 0x8d, 0xfc, 0xa8, 0xb0, 0xcb, 0xc6,
+	0x89, 0x22, 0x04, 0xc2, 0x65, 0x3b, 0x93, 0x58, 0xb6, 0x65, 0xe0, 0x67, 0xa0, 0x6a, 0x70, 0xd6,
+	0xb2, 0xc5, 0x27, 0x9a, 0x8d, 0xfc, 0x78, 0xe8, 0xb0, 0x52, 0x0d, 0x93, 0x28, 0x27, 0xd8, 0xbf,
+	0xdb, 0x26, 0x7e, 0x09, 0x86, 0xaa, 0x56, 0xcd, 0xd5, 0x9d, 0xdc, 0x00, 0x0b, 0xff, 0xbc, 0x1c,
+	0xf7, 0x1a, 0x21, 0x53, 0x3f, 0x7c, 0x17, 0xa8, 0x7c, 0xf1, 0xb4, 0x28, 0x92, 0x02, 0x34, 0x37,
+	0x42, 0x14, 0x61, 0x8d, 0xbc, 0xef, 0x75, 0x5d, 0x31, 0x11, 0x08, 0x5a, 0x17, 0x0e, 0xe8, 0xe1,
+	0xb5, 0x2e, 0x51, 0x7b, 0x44, 0x19, 0x63, 0x43, 0x7e, 0xeb, 0x42, 0xfe, 0x85, 0xe0, 0x02, 0xc3,
+	0x55, 0xa4, 0x99, 0xca, 0xb0, 0xc6, 0xf2, 0x13, 0x8e, 0x23, 0x4a, 0x11, 0xc7, 0x22, 0x8c, 0x07,
+	0x8b, 0x87, 0x4f, 0x4b, 0xa9, 0xd9, 0xc8, 0x4f, 0x45, 0xd1, 0x89, 0x62, 0x36, 0xea, 0x81, 0xe3,
+	0x67, 0xa5, 0x0c, 0xc3, 0x15, 0xed, 0x48, 0x3d, 0xb4, 0x6c, 0x87, 0x71, 0x37, 0x1a, 0x5e, 0xd3,
+	0x9b, 0x21, 0xca, 0x89, 0x8a, 0x76, 0xb4, 0x65, 0xd9, 0x0e, 0x2d, 0x96, 0x65, 0xa3, 0x62, 0xb8,
+	0xb9, 0x01, 0x26, 0x1c, 0x2a, 0x96, 0x6c, 0x98, 0x28, 0x7c, 0x9a, 0xfc, 0x19, 0xc1, 0xd8, 0x86,
+	0xe3, 0x1a, 0x15, 0xcd, 0xd5, 0xf7, 0x99, 0xcf, 0x21, 0xda, 0xd1, 0xc3, 0xa4, 0x3d, 0x96, 0xd3,
+	0xcc, 0xa3, 0xe6, 0xf4, 0x4d, 0xb8, 0xd8, 0x9d, 0x52, 0x91, 0x70, 0xbb, 0x11, 0xa7, 0x2f, 0xc6,
+	0x3b, 0xdd, 0x1a, 0xaa, 0xa4, 0x44, 0xff, 0x8d, 0xd7, 0x9f, 0xee, 0xda, 0x65, 0x83, 0x2f, 0xdf,
+	0x71, 0xd3, 0xbf, 0x12, 0x01, 0xb0, 0x98, 0x1c, 0xf5, 0xc0, 0x6a, 0x52, 0xe8, 0xaf, 0xc3, 0x98,
+	0x97, 0x97, 0x2d, 0x09, 0x38, 0xdd, 0x6c, 0xe4, 0x4f, 0xb7, 0xe6, 0xad, 0x97, 0x7f, 0x23, 0x22,
+	0x7b, 0x59, 0xfa, 0x91, 0x7b, 0x5e, 0x33, 0xdb, 0xd5, 0x93, 0xff, 0xe7, 0xe6, 0xfd, 0x4a, 0x26,
+	0xbe, 0xa8, 0xdc, 0xae, 0xb9, 0x8f, 0xba, 0xae, 0xbe, 0xec, 0x53, 0x77, 0x9c, 0x51, 0xb7, 0x90,
+	0x44, 0x1d, 0xc5, 0x94, 0x86, 0xb6, 0x65, 0xc8, 0xfa, 0x8e, 0xe7, 0x06, 0xa2, 0x2f, 0x5a, 0xfe,
+	0x14, 0x11, 0xbd, 0x0d, 0xbd, 0xe1, 0xbf, 0xe7, 0x75, 0xbf, 0x71, 0x61, 0x10, 0xfc, 0xd8, 0x5e,
+	0x3d, 0x0a, 0x5e, 0x3c, 0x78, 0x40, 0xb6, 0x7a, 0xa6, 0x67, 0x2a, 0x92, 0x3c, 0x1e, 0x3b, 0xa3,
+	0x22, 0x7b, 0x04, 0x39, 0x67, 0x41, 0x0a, 0x1a, 0xd5, 0x68, 0x7b, 0x4f, 0xbe, 0x8b, 0x60, 0x26,
+	0x76, 0xfa, 0xb1, 0xe8, 0xd6, 0x57, 0x3e, 0x92, 0x60, 0x90, 0xc1, 0xc3, 0x6f, 0x03, 0xbb, 0x67,
+	0x38, 0xb8, 0x43, 0x49, 0x6c, 0x7b, 0x34, 0x96, 0x16, 0x92, 0x05, 0xb9, 0x93, 0xe4, 0xc2, 0x3b,
+	0xbf, 0xff, 0xdb, 0x7b, 0x99, 0x73, 0x78, 0xa6, 0x10, 0xfb, 0x8c, 0xcf, 0x2f, 0x36, 0x5f, 0x47,
+	0x30, 0xec, 0x3d, 0xc4, 0xe2, 0xa7, 0xbb, 0xd8, 0x8e, 0xbc, 0xe2, 0x4a, 0x97, 0x52, 0xc9, 0x0a,
+	0x28, 0xf3, 0x0c, 0xca, 0xa7, 0x70, 0x3e, 0x1e, 0x8a, 0xff, 0xb4, 0x8b, 0x7f, 0x88, 0x60, 0xac,
+	0x95, 0x33, 0x7c, 0xb9, 0xcb, 0x42, 0xb1, 0xec, 0x4b, 0xcb, 0x3d, 0x68, 0x08, 0x80, 0x4b, 0x0c,
+	0xe0, 0x3c, 0x7e, 0x32, 0x1e, 0x20, 0x7f, 0xba, 0xf0, 0x09, 0xc4, 0x3f, 0x42, 0x30, 0x1e, 0xe9,
+	0x78, 0xf0, 0x72, 0x12, 0x31, 0x6d, 0xdd, 0xa2, 0xb4, 0xd2, 0x8b, 0x8a, 0x40, 0xba, 0xc8, 0x90,
+	0xce, 0xe1, 0x8b, 0xf1, 0x48, 0x5f, 0x65, 0xd2, 0xfa, 0xbe, 0x88, 0xe7, 0xf7, 0x10, 0x8c, 0x84,
+	0xef, 0xc2, 0x58, 0x4e, 0x5a, 0xb2, 0xb5, 0x45, 0x92, 0x0a, 0xa9, 0xe5, 0xd3, 0xe1, 0x63, 0xb0,
+	0xd4, 0x52, 0x9d, 0x1f, 0x0a, 0xf8, 0xa7, 0x08, 0x26, 0xa2, 0x77, 0x75, 0xbc, 0x92, 0x72, 0xcd,
+	0x50, 0x6f, 0x22, 0x5d, 0xe9, 0x49, 0x47, 0x60, 0x5d, 0x66, 0x58, 0x2f, 0xe1, 0xa7, 0xd2, 0x60,
+	0x55, 0x6d, 0x8a, 0xed, 0xab, 0x08, 0x06, 0xa8, 0x3d, 0x3c, 0x97, 0xb0, 0xa0, 0x07, 0x6c, 0x3e,
+	0x51, 0x2e, 0x5d, 0x0a, 0x32, 0x30, 0x85, 0x37, 0xc5, 0x51, 0xf1, 0x16, 0xfe, 0x00, 0xc1, 0xb0,
+	0xf7, 0xc1, 0xa2, 0xeb, 0xc6, 0x8d, 0x7c, 0x1a, 0x91, 0x2e, 0xa5, 0x92, 0x4d, 0x1f, 0x21, 0xf6,
+	0xc0, 0x10, 0x02, 0xf6, 0x3e, 0x82, 0x5c, 0xa7, 0x67, 0x30, 0xbc, 0xd6, 0x65, 0xf1, 0x84, 0xb7,
+	0x3f, 0xe9, 0x73, 0x7d, 0xe9, 0x0a, 0x47, 0x8e, 0xe1, 0x5f, 0x21, 0xc0, 0xed, 0x9f, 0x36, 0xf0,
+	0x6a, 0x4a, 0xab, 0xad, 0x58, 0xae, 0xf6, 0xa8, 0x25, 0x50, 0x3c, 0xcb, 0xc2, 0xb9, 0x86, 0x3f,
+	0x93, 0x8a, 0xe3, 0xc2, 0x6b, 0x96, 0x61, 0xaa, 0xce, 0x5d, 0xcd, 0x56, 0x75, 0x7a, 0xee, 0xaa,
+	0x86, 0x89, 0xff, 0x8e, 0x60, 0xa6, 0xcb, 0xf3, 0x3f, 0x7e, 0x26, 0x01, 0x58, 0xf7, 0x6f, 0x1a,
+	0xd2, 0xb5, 0x7e, 0xd5, 0x85, 0x83, 0x37, 0x99, 0x83, 0xeb, 0xf8, 0x7a, 0x3a, 0x07, 0xf5, 0x23,
+	0xc3, 0xe5, 0x0e, 0xf2, 0x0f, 0x26, 0xfc, 0xb0, 0xa7, 0x7e, 0xde, 0x43, 0x00, 0xc1, 0x77, 0x00,
+	0xbc, 0x98, 0x90, 0xb4, 0x2d, 0x5f, 0x1d, 0xa4, 0xa5, 0x94, 0xd2, 0x02, 0xf4, 0x2a, 0x03, 0x2d,
+	0xe3, 0xc5, 0x74, 0xa0, 0xf9, 0x47, 0x06, 0xfc, 0x6b, 0x04, 0xb8, 0xfd, 0x83, 0x40, 0xd7, 0x7c,
+	0xea, 0xf8, 0x3d, 0x42, 0xba, 0xda, 0xa3, 0x96, 0x40, 0x5e, 0x64, 0xc8, 0x3f, 0x8f, 0xd7, 0xd2,
+	0x21, 0xe7, 0xc7, 0x18, 0xfb, 0x19, 0x9c, 0x65, 0x3f, 0x46, 0x70, 0x32, 0xf4, 0xdc, 0x8f, 0x97,
+	0x92, 0xa0, 0xb4, 0x66, 0x8c, 0x9c, 0x56, 0x5c, 0x40, 0x5e, 0x63, 0x90, 0x57, 0xf1, 0x4a, 0x2f,
+	0x90, 0xf9, 0x7b, 0x33, 0x4d, 0x8a, 0xac, 0xff, 0x70, 0x82, 0xbb, 0x15, 0xb2, 0xe8, 0x6b, 0xb4,
+	0xb4, 0x98, 0x4e, 0x58, 0x80, 0xfc, 0x74, 0x8f, 0x19, 0x41, 0x95, 0x9d, 0x77, 0x33, 0x08, 0xff,
+	0x16, 0xc1, 0xb4, 0xd7, 0xd1, 0xb5, 0xb5, 0x33, 0xb8, 0xdb, 0x21, 0xd5, 0xa9, 0x8d, 0x93, 0x56,
+	0x7b, 0x53, 0x12, 0x1e, 0x6c, 0x30, 0x0f, 0xae, 0xe3, 0x67, 0xe2, 0x3d, 0x08, 0x6d, 0x41, 0x81,
+	0xb6, 0x10, 0xaa, 0x33, 0xc1, 0x36, 0xfc, 0x1d, 0x02, 0xa9, 0x83, 0x3f, 0xf4, 0x63, 0x42, 0x0f,
+	0xd8, 0x82, 0xae, 0x49, 0xba, 0xda, 0xa3, 0x96, 0x70, 0x69, 0x93, 0xb9, 0xf4, 0x2c, 0xbe, 0xf6,
+	0x3f, 0xb8, 0x64, 0xd5, 0x5c, 0xfc, 0x27, 0x04, 0x79, 0xcf, 0xa7, 0x0e, 0x4d, 0x3c, 0xfe, 0x6c,
+	0x17, 0x88, 0xdd, 0xdf, 0x72, 0xa4, 0xb5, 0x7e, 0x54, 0xd3, 0xed, 0x67, 0xdf, 0xb1, 0x92, 0xee,
+	0xb8, 0x2a, 0x6f, 0xe9, 0xda, 0x28, 0xfb, 0x27, 0x82, 0x0b, 0x3e, 0x65, 0x9d, 0x7b, 0xeb, 0xae,
+	0x27, 0x45, 0xf2, 0xeb, 0x82, 0x74, 0xad, 0x5f, 0xf5, 0x74, 0x27, 0x45, 0xc0, 0x21, 0xb5, 0xc5,
+	0x7d, 0x55, 0xe3, 0x52, 0xb4, 0x78, 0xeb, 0xc3, 0xfb, 0xb3, 0xe8, 0xe3, 0xfb, 0xb3, 0xe8, 0xaf,
+	0xf7, 0x67, 0xd1, 0x37, 0x1f, 0xcc, 0x1e, 0xfb, 0xf8, 0xc1, 0xec, 0xb1, 0x3f, 0x3c, 0x98, 0x3d,
+	0xf6, 0xe5, 0xcb, 0xa1, 0xde, 0x4c, 0x2c, 0xb2, 0x54, 0xd6, 0x4a, 0x8e, 0xbf, 0xe2, 0x1b, 0xcb,
+	0x57, 0x0a, 0x47, 0x7c, 0x5d, 0xd6, 0xa9, 0x95, 0x86, 0xd8, 0x33, 0xf0, 0x95, 0xff, 0x0e, 0x00,
+	0xa9, 0xf4, 0x1d, 0x92, 0x47, 0x25, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1639,6 +2150,11 @@ type QueryClient interface {
 	// PoolsWithFilter allows you to query specific pools with requested
 	// parameters
 	PoolsWithFilter(ctx context.Context, in *QueryPoolsWithFilterRequest, opts ...grpc.CallOption) (*QueryPoolsWithFilterResponse, error)
+	// PoolsByDenom returns the pools containing the denom, in order of pool id.
+	PoolsByDenom(ctx context.Context, in *QueryPoolsByDenomRequest, opts ...grpc.CallOption) (*QueryPoolsByDenomResponse, error)
+	// PoolsByDenomPair returns the pools containing both denoms, in order of pool
+	// id.
+	PoolsByDenomPair(ctx context.Context, in *QueryPoolsByDenomPairRequest, opts ...grpc.CallOption) (*QueryPoolsByDenomPairResponse, error)
 	// Per Pool gRPC Endpoints
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// PoolType returns the type of the pool.
@@ -1659,6 +2175,13 @@ type QueryClient interface {
 	// Estimate the swap.
 	EstimateSwapExactAmountIn(ctx context.Context, in *QuerySwapExactAmountInRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountInResponse, error)
 	EstimateSwapExactAmountOut(ctx context.Context, in *QuerySwapExactAmountOutRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountOutResponse, error)
+	// EstimateBestRoutesExactAmountIn searches the routes from the token in to
+	// the token out denom through active pools, and returns the routes with the
+	// highest estimated token out amounts.
+	EstimateBestRoutesExactAmountIn(ctx context.Context, in *QueryBestRoutesExactAmountInRequest, opts ...grpc.CallOption) (*QueryBestRoutesExactAmountInResponse, error)
+	// EstimateSplitRouteSwapExactAmountIn estimates the total token out amount
+	// of a split route swap.
+	EstimateSplitRouteSwapExactAmountIn(ctx context.Context, in *QuerySplitRouteSwapExactAmountInRequest, opts ...grpc.CallOption) (*QuerySplitRouteSwapExactAmountInResponse, error)
 }
 
 type queryClient struct {
@@ -1705,6 +2228,24 @@ func (c *queryClient) PoolsWithFilter(ctx context.Context, in *QueryPoolsWithFil
 	return out, nil
 }
 
+func (c *queryClient) PoolsByDenom(ctx context.Context, in *QueryPoolsByDenomRequest, opts ...grpc.CallOption) (*QueryPoolsByDenomResponse, error) {
+	out := new(QueryPoolsByDenomResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/PoolsByDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoolsByDenomPair(ctx context.Context, in *QueryPoolsByDenomPairRequest, opts ...grpc.CallOption) (*QueryPoolsByDenomPairResponse, error) {
+	out := new(QueryPoolsByDenomPairResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/PoolsByDenomPair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error) {
 	out := new(QueryPoolResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/Pool", in, out, opts...)
@@ -1805,6 +2346,24 @@ func (c *queryClient) EstimateSwapExactAmountOut(ctx context.Context, in *QueryS
 	return out, nil
 }
 
+func (c *queryClient) EstimateBestRoutesExactAmountIn(ctx context.Context, in *QueryBestRoutesExactAmountInRequest, opts ...grpc.CallOption) (*QueryBestRoutesExactAmountInResponse, error) {
+	out := new(QueryBestRoutesExactAmountInResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/EstimateBestRoutesExactAmountIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateSplitRouteSwapExactAmountIn(ctx context.Context, in *QuerySplitRouteSwapExactAmountInRequest, opts ...grpc.CallOption) (*QuerySplitRouteSwapExactAmountInResponse, error) {
+	out := new(QuerySplitRouteSwapExactAmountInResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/EstimateSplitRouteSwapExactAmountIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
@@ -1813,6 +2372,11 @@ type QueryServer interface {
 	// PoolsWithFilter allows you to query specific pools with requested
 	// parameters
 	PoolsWithFilter(context.Context, *QueryPoolsWithFilterRequest) (*QueryPoolsWithFilterResponse, error)
+	// PoolsByDenom returns the pools containing the denom, in order of pool id.
+	PoolsByDenom(context.Context, *QueryPoolsByDenomRequest) (*QueryPoolsByDenomResponse, error)
+	// PoolsByDenomPair returns the pools containing both denoms, in order of pool
+	// id.
+	PoolsByDenomPair(context.Context, *QueryPoolsByDenomPairRequest) (*QueryPoolsByDenomPairResponse, error)
 	// Per Pool gRPC Endpoints
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// PoolType returns the type of the pool.
@@ -1833,6 +2397,13 @@ type QueryServer interface {
 	// Estimate the swap.
 	EstimateSwapExactAmountIn(context.Context, *QuerySwapExactAmountInRequest) (*QuerySwapExactAmountInResponse, error)
 	EstimateSwapExactAmountOut(context.Context, *QuerySwapExactAmountOutRequest) (*QuerySwapExactAmountOutResponse, error)
+	// EstimateBestRoutesExactAmountIn searches the routes from the token in to
+	// the token out denom through active pools, and returns the routes with the
+	// highest estimated token out amounts.
+	EstimateBestRoutesExactAmountIn(context.Context, *QueryBestRoutesExactAmountInRequest) (*QueryBestRoutesExactAmountInResponse, error)
+	// EstimateSplitRouteSwapExactAmountIn estimates the total token out amount
+	// of a split route swap.
+	EstimateSplitRouteSwapExactAmountIn(context.Context, *QuerySplitRouteSwapExactAmountInRequest) (*QuerySplitRouteSwapExactAmountInResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1851,6 +2422,12 @@ func (*UnimplementedQueryServer) TotalLiquidity(ctx context.Context, req *QueryT
 func (*UnimplementedQueryServer) PoolsWithFilter(ctx context.Context, req *QueryPoolsWithFilterRequest) (*QueryPoolsWithFilterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PoolsWithFilter not implemented")
 }
+func (*UnimplementedQueryServer) PoolsByDenom(ctx context.Context, req *QueryPoolsByDenomRequest) (*QueryPoolsByDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolsByDenom not implemented")
+}
+func (*UnimplementedQueryServer) PoolsByDenomPair(ctx context.Context, req *QueryPoolsByDenomPairRequest) (*QueryPoolsByDenomPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolsByDenomPair not implemented")
+}
 func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryPoolRequest) (*QueryPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
 }
@@ -1884,6 +2461,12 @@ func (*UnimplementedQueryServer) EstimateSwapExactAmountIn(ctx context.Context,
 func (*UnimplementedQueryServer) EstimateSwapExactAmountOut(ctx context.Context, req *QuerySwapExactAmountOutRequest) (*QuerySwapExactAmountOutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountOut not implemented")
 }
+func (*UnimplementedQueryServer) EstimateBestRoutesExactAmountIn(ctx context.Context, req *QueryBestRoutesExactAmountInRequest) (*QueryBestRoutesExactAmountInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateBestRoutesExactAmountIn not implemented")
+}
+func (*UnimplementedQueryServer) EstimateSplitRouteSwapExactAmountIn(ctx context.Context, req *QuerySplitRouteSwapExactAmountInRequest) (*QuerySplitRouteSwapExactAmountInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSplitRouteSwapExactAmountIn not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1961,44 +2544,80 @@ func _Query_PoolsWithFilter_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolRequest)
+func _Query_PoolsByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolsByDenomRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).Pool(ctx, in)
+		return srv.(QueryServer).PoolsByDenom(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/Pool",
+		FullMethod: "/osmosis.gamm.v1beta1.Query/PoolsByDenom",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Pool(ctx, req.(*QueryPoolRequest))
+		return srv.(QueryServer).PoolsByDenom(ctx, req.(*QueryPoolsByDenomRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_PoolType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolTypeRequest)
+func _Query_PoolsByDenomPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolsByDenomPairRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).PoolType(ctx, in)
+		return srv.(QueryServer).PoolsByDenomPair(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/osmosis.gamm.v1beta1.Query/PoolType",
+		FullMethod: "/osmosis.gamm.v1beta1.Query/PoolsByDenomPair",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolType(ctx, req.(*QueryPoolTypeRequest))
+		return srv.(QueryServer).PoolsByDenomPair(ctx, req.(*QueryPoolsByDenomPairRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_CalcJoinPoolNoSwapShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCalcJoinPoolNoSwapSharesRequest)
+func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Pool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/Pool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pool(ctx, req.(*QueryPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/PoolType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolType(ctx, req.(*QueryPoolTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CalcJoinPoolNoSwapShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCalcJoinPoolNoSwapSharesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2159,6 +2778,42 @@ func _Query_EstimateSwapExactAmountOut_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EstimateBestRoutesExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBestRoutesExactAmountInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateBestRoutesExactAmountIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/EstimateBestRoutesExactAmountIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateBestRoutesExactAmountIn(ctx, req.(*QueryBestRoutesExactAmountInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateSplitRouteSwapExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySplitRouteSwapExactAmountInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSplitRouteSwapExactAmountIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/EstimateSplitRouteSwapExactAmountIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSplitRouteSwapExactAmountIn(ctx, req.(*QuerySplitRouteSwapExactAmountInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2179,6 +2834,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PoolsWithFilter",
 			Handler:    _Query_PoolsWithFilter_Handler,
 		},
+		{
+			MethodName: "PoolsByDenom",
+			Handler:    _Query_PoolsByDenom_Handler,
+		},
+		{
+			MethodName: "PoolsByDenomPair",
+			Handler:    _Query_PoolsByDenomPair_Handler,
+		},
 		{
 			MethodName: "Pool",
 			Handler:    _Query_Pool_Handler,
@@ -2223,6 +2886,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateSwapExactAmountOut",
 			Handler:    _Query_EstimateSwapExactAmountOut_Handler,
 		},
+		{
+			MethodName: "EstimateBestRoutesExactAmountIn",
+			Handler:    _Query_EstimateBestRoutesExactAmountIn_Handler,
+		},
+		{
+			MethodName: "EstimateSplitRouteSwapExactAmountIn",
+			Handler:    _Query_EstimateSplitRouteSwapExactAmountIn_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/v1beta1/query.proto",
@@ -3073,7 +3744,7 @@ func (m *QueryPoolsWithFilterResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySpotPriceResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPoolsByDenomRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3083,27 +3754,39 @@ func (m *QuerySpotPriceResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySpotPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPoolsByDenomRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySpotPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPoolsByDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SpotPrice) > 0 {
-		i -= len(m.SpotPrice)
-		copy(dAtA[i:], m.SpotPrice)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpotPrice)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySwapExactAmountInRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPoolsByDenomResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3113,20 +3796,32 @@ func (m *QuerySwapExactAmountInRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySwapExactAmountInRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPoolsByDenomResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySwapExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPoolsByDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Routes) > 0 {
-		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3134,32 +3829,62 @@ func (m *QuerySwapExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.TokenIn) > 0 {
-		i -= len(m.TokenIn)
-		copy(dAtA[i:], m.TokenIn)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolsByDenomPairRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolsByDenomPairRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsByDenomPairRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+	if len(m.DenomB) > 0 {
+		i -= len(m.DenomB)
+		copy(dAtA[i:], m.DenomB)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DenomB)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+	if len(m.DenomA) > 0 {
+		i -= len(m.DenomA)
+		copy(dAtA[i:], m.DenomA)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DenomA)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySwapExactAmountInResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPoolsByDenomPairResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3169,30 +3894,46 @@ func (m *QuerySwapExactAmountInResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySwapExactAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPoolsByDenomPairResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPoolsByDenomPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.TokenOutAmount.Size()
-		i -= size
-		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySwapExactAmountOutRequest) Marshal() (dAtA []byte, err error) {
+func (m *QuerySpotPriceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3202,23 +3943,46 @@ func (m *QuerySwapExactAmountOutRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySwapExactAmountOutRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySpotPriceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySwapExactAmountOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySpotPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TokenOut) > 0 {
-		i -= len(m.TokenOut)
-		copy(dAtA[i:], m.TokenOut)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOut)))
+	if len(m.SpotPrice) > 0 {
+		i -= len(m.SpotPrice)
+		copy(dAtA[i:], m.SpotPrice)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpotPrice)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapExactAmountInRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapExactAmountInRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if len(m.Routes) > 0 {
 		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -3230,9 +3994,16 @@ func (m *QuerySwapExactAmountOutRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x22
 		}
 	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.PoolId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
 		i--
@@ -3248,7 +4019,7 @@ func (m *QuerySwapExactAmountOutRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySwapExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
+func (m *QuerySwapExactAmountInResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3258,20 +4029,20 @@ func (m *QuerySwapExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySwapExactAmountOutResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySwapExactAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.TokenInAmount.Size()
+		size := m.TokenOutAmount.Size()
 		i -= size
-		if _, err := m.TokenInAmount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintQuery(dAtA, i, uint64(size))
@@ -3281,7 +4052,7 @@ func (m *QuerySwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryBestRoutesExactAmountInRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3291,43 +4062,77 @@ func (m *QueryTotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTotalLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBestRoutesExactAmountInRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTotalLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBestRoutesExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryTotalLiquidityResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxHops != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxHops))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimatedRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *QueryTotalLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *EstimatedRoute) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTotalLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EstimatedRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Liquidity) > 0 {
-		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
+	{
+		size := m.TokenOutAmount.Size()
+		i -= size
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3341,185 +4146,281 @@ func (m *QueryTotalLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+func (m *QueryBestRoutesExactAmountInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pool != nil {
-		l = m.Pool.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryBestRoutesExactAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBestRoutesExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySplitRouteSwapExactAmountInRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryNumPoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QuerySplitRouteSwapExactAmountInRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryNumPoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySplitRouteSwapExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NumPools != 0 {
-		n += 1 + sovQuery(uint64(m.NumPools))
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolTypeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+func (m *QuerySplitRouteSwapExactAmountInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPoolTypeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PoolType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QuerySplitRouteSwapExactAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCalcJoinPoolSharesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySplitRouteSwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	if len(m.TokensIn) > 0 {
-		for _, e := range m.TokensIn {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.TokenOutAmount.Size()
+		i -= size
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCalcJoinPoolSharesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ShareOutAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if len(m.TokensOut) > 0 {
-		for _, e := range m.TokensOut {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *QuerySwapExactAmountOutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCalcExitPoolCoinsFromSharesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = m.ShareInAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QuerySwapExactAmountOutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCalcExitPoolCoinsFromSharesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySwapExactAmountOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.TokensOut) > 0 {
-		for _, e := range m.TokensOut {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.TokenOut) > 0 {
+		i -= len(m.TokenOut)
+		copy(dAtA[i:], m.TokenOut)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOut)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	return n
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolParamsRequest) Size() (n int) {
+func (m *QuerySwapExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapExactAmountOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenInAmount.Size()
+		i -= size
+		if _, err := m.TokenInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalLiquidityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Liquidity) > 0 {
+		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPoolRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3531,47 +4432,73 @@ func (m *QueryPoolParamsRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryPoolParamsResponse) Size() (n int) {
+func (m *QueryPoolResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
+	if m.Pool != nil {
+		l = m.Pool.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryTotalPoolLiquidityRequest) Size() (n int) {
+func (m *QueryPoolsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryTotalPoolLiquidityResponse) Size() (n int) {
+func (m *QueryPoolsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Liquidity) > 0 {
-		for _, e := range m.Liquidity {
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryTotalSharesRequest) Size() (n int) {
+func (m *QueryNumPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNumPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumPools != 0 {
+		n += 1 + sovQuery(uint64(m.NumPools))
+	}
+	return n
+}
+
+func (m *QueryPoolTypeRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3583,18 +4510,20 @@ func (m *QueryTotalSharesRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryTotalSharesResponse) Size() (n int) {
+func (m *QueryPoolTypeResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.TotalShares.Size()
-	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.PoolType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) Size() (n int) {
+func (m *QueryCalcJoinPoolSharesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3612,24 +4541,24 @@ func (m *QueryCalcJoinPoolNoSwapSharesRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) Size() (n int) {
+func (m *QueryCalcJoinPoolSharesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = m.ShareOutAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	if len(m.TokensOut) > 0 {
 		for _, e := range m.TokensOut {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	l = m.SharesOut.Size()
-	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QuerySpotPriceRequest) Size() (n int) {
+func (m *QueryCalcExitPoolCoinsFromSharesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3638,91 +4567,71 @@ func (m *QuerySpotPriceRequest) Size() (n int) {
 	if m.PoolId != 0 {
 		n += 1 + sovQuery(uint64(m.PoolId))
 	}
-	l = len(m.BaseAssetDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.QuoteAssetDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
+	l = m.ShareInAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryPoolsWithFilterRequest) Size() (n int) {
+func (m *QueryCalcExitPoolCoinsFromSharesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.MinLiquidity) > 0 {
-		for _, e := range m.MinLiquidity {
+	if len(m.TokensOut) > 0 {
+		for _, e := range m.TokensOut {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	l = len(m.PoolType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	return n
 }
 
-func (m *QueryPoolsWithFilterResponse) Size() (n int) {
+func (m *QueryPoolParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
 	}
 	return n
 }
 
-func (m *QuerySpotPriceResponse) Size() (n int) {
+func (m *QueryPoolParamsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.SpotPrice)
-	if l > 0 {
+	if m.Params != nil {
+		l = m.Params.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QuerySwapExactAmountInRequest) Size() (n int) {
+func (m *QueryTotalPoolLiquidityRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if m.PoolId != 0 {
 		n += 1 + sovQuery(uint64(m.PoolId))
 	}
-	l = len(m.TokenIn)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
+	return n
+}
+
+func (m *QueryTotalPoolLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Liquidity) > 0 {
+		for _, e := range m.Liquidity {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -3730,18 +4639,216 @@ func (m *QuerySwapExactAmountInRequest) Size() (n int) {
 	return n
 }
 
-func (m *QuerySwapExactAmountInResponse) Size() (n int) {
+func (m *QueryTotalSharesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.TokenOutAmount.Size()
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryTotalSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalShares.Size()
 	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QuerySwapExactAmountOutRequest) Size() (n int) {
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if len(m.TokensIn) > 0 {
+		for _, e := range m.TokensIn {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TokensOut) > 0 {
+		for _, e := range m.TokensOut {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.SharesOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySpotPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.BaseAssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteAssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsWithFilterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinLiquidity) > 0 {
+		for _, e := range m.MinLiquidity {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.PoolType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsWithFilterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsByDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsByDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsByDenomPairRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomA)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DenomB)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsByDenomPairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpotPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpotPrice)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwapExactAmountInRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3754,61 +4861,814 @@ func (m *QuerySwapExactAmountOutRequest) Size() (n int) {
 	if m.PoolId != 0 {
 		n += 1 + sovQuery(uint64(m.PoolId))
 	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	if len(m.Routes) > 0 {
 		for _, e := range m.Routes {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	l = len(m.TokenOut)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	return n
 }
 
-func (m *QuerySwapExactAmountOutResponse) Size() (n int) {
+func (m *QuerySwapExactAmountInResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.TokenInAmount.Size()
+	l = m.TokenOutAmount.Size()
 	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryTotalLiquidityRequest) Size() (n int) {
+func (m *QueryBestRoutesExactAmountInRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxHops != 0 {
+		n += 1 + sovQuery(uint64(m.MaxHops))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
 	return n
 }
 
-func (m *QueryTotalLiquidityResponse) Size() (n int) {
+func (m *EstimatedRoute) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Liquidity) > 0 {
-		for _, e := range m.Liquidity {
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryBestRoutesExactAmountInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QuerySplitRouteSwapExactAmountInRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.TokenInDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySplitRouteSwapExactAmountInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySwapExactAmountOutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.TokenOut)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwapExactAmountOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenInAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTotalLiquidityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Liquidity) > 0 {
+		for _, e := range m.Liquidity {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pool == nil {
+				m.Pool = &types.Any{}
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, &types.Any{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNumPoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNumPoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNumPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNumPoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNumPoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNumPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPools", wireType)
+			}
+			m.NumPools = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPools |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolTypeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolTypeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCalcJoinPoolSharesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3831,10 +5691,10 @@ func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCalcJoinPoolSharesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCalcJoinPoolSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3856,6 +5716,40 @@ func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokensIn = append(m.TokensIn, types1.Coin{})
+			if err := m.TokensIn[len(m.TokensIn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3877,7 +5771,7 @@ func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCalcJoinPoolSharesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3900,15 +5794,49 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCalcJoinPoolSharesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCalcJoinPoolSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3935,10 +5863,8 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pool == nil {
-				m.Pool = &types.Any{}
-			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TokensOut = append(m.TokensOut, types1.Coin{})
+			if err := m.TokensOut[len(m.TokensOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3963,7 +5889,7 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCalcExitPoolCoinsFromSharesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3986,17 +5912,36 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareInAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4006,25 +5951,23 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ShareInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4049,7 +5992,7 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCalcExitPoolCoinsFromSharesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4072,15 +6015,15 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4107,14 +6050,133 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, &types.Any{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TokensOut = append(m.TokensOut, types1.Coin{})
+			if err := m.TokensOut[len(m.TokensOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4141,10 +6203,10 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Params == nil {
+				m.Params = &types.Any{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4169,7 +6231,7 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNumPoolsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4192,12 +6254,31 @@ func (m *QueryNumPoolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNumPoolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalPoolLiquidityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNumPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalPoolLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4219,7 +6300,7 @@ func (m *QueryNumPoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNumPoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4242,17 +6323,17 @@ func (m *QueryNumPoolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNumPoolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalPoolLiquidityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNumPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalPoolLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumPools", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
 			}
-			m.NumPools = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4262,11 +6343,26 @@ func (m *QueryNumPoolsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumPools |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Liquidity = append(m.Liquidity, types1.Coin{})
+			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4288,7 +6384,7 @@ func (m *QueryNumPoolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolTypeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalSharesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4311,10 +6407,10 @@ func (m *QueryPoolTypeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolTypeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalSharesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4357,7 +6453,7 @@ func (m *QueryPoolTypeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolTypeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalSharesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4380,17 +6476,17 @@ func (m *QueryPoolTypeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolTypeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalSharesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4400,23 +6496,24 @@ func (m *QueryPoolTypeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolType = string(dAtA[iNdEx:postIndex])
+			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4439,7 +6536,7 @@ func (m *QueryPoolTypeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCalcJoinPoolSharesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4462,10 +6559,10 @@ func (m *QueryCalcJoinPoolSharesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCalcJoinPoolSharesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCalcJoinPoolNoSwapSharesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCalcJoinPoolSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCalcJoinPoolNoSwapSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4542,7 +6639,7 @@ func (m *QueryCalcJoinPoolSharesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCalcJoinPoolSharesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCalcJoinPoolNoSwapSharesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4565,15 +6662,49 @@ func (m *QueryCalcJoinPoolSharesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCalcJoinPoolSharesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCalcJoinPoolNoSwapSharesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCalcJoinPoolSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCalcJoinPoolNoSwapSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokensOut = append(m.TokensOut, types1.Coin{})
+			if err := m.TokensOut[len(m.TokensOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesOut", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4601,15 +6732,84 @@ func (m *QueryCalcJoinPoolSharesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SharesOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpotPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpotPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpotPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokensOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4619,25 +6819,55 @@ func (m *QueryCalcJoinPoolSharesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokensOut = append(m.TokensOut, types1.Coin{})
-			if err := m.TokensOut[len(m.TokensOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.QuoteAssetDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4660,7 +6890,7 @@ func (m *QueryCalcJoinPoolSharesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCalcExitPoolCoinsFromSharesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolsWithFilterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4683,17 +6913,17 @@ func (m *QueryCalcExitPoolCoinsFromSharesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolsWithFilterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolsWithFilterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLiquidity", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4703,14 +6933,29 @@ func (m *QueryCalcExitPoolCoinsFromSharesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinLiquidity = append(m.MinLiquidity, types1.Coin{})
+			if err := m.MinLiquidity[len(m.MinLiquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareInAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4738,7 +6983,41 @@ func (m *QueryCalcExitPoolCoinsFromSharesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ShareInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PoolType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4763,7 +7042,7 @@ func (m *QueryCalcExitPoolCoinsFromSharesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCalcExitPoolCoinsFromSharesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolsWithFilterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4786,15 +7065,15 @@ func (m *QueryCalcExitPoolCoinsFromSharesResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolsWithFilterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCalcExitPoolCoinsFromSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolsWithFilterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokensOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4821,8 +7100,44 @@ func (m *QueryCalcExitPoolCoinsFromSharesResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokensOut = append(m.TokensOut, types1.Coin{})
-			if err := m.TokensOut[len(m.TokensOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pools = append(m.Pools, &types.Any{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4847,7 +7162,7 @@ func (m *QueryCalcExitPoolCoinsFromSharesResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryPoolParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolsByDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4870,17 +7185,17 @@ func (m *QueryPoolParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolsByDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolsByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.PoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4890,64 +7205,27 @@ func (m *QueryPoolParamsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPoolParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4974,10 +7252,10 @@ func (m *QueryPoolParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &types.Any{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5002,7 +7280,7 @@ func (m *QueryPoolParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolsByDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5025,17 +7303,17 @@ func (m *QueryTotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalPoolLiquidityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolsByDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalPoolLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolsByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5045,11 +7323,62 @@ func (m *QueryTotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, &types.Any{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5071,7 +7400,7 @@ func (m *QueryTotalPoolLiquidityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolsByDenomPairRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5094,15 +7423,79 @@ func (m *QueryTotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalPoolLiquidityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolsByDenomPairRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalPoolLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolsByDenomPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomB = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5129,8 +7522,10 @@ func (m *QueryTotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Liquidity = append(m.Liquidity, types1.Coin{})
-			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5155,7 +7550,7 @@ func (m *QueryTotalPoolLiquidityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalSharesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolsByDenomPairResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5178,17 +7573,17 @@ func (m *QueryTotalSharesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalSharesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolsByDenomPairResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolsByDenomPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5198,64 +7593,29 @@ func (m *QueryTotalSharesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTotalSharesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Pools = append(m.Pools, &types.Any{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalSharesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5282,7 +7642,10 @@ func (m *QueryTotalSharesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5307,7 +7670,7 @@ func (m *QueryTotalSharesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySpotPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5330,36 +7693,17 @@ func (m *QueryCalcJoinPoolNoSwapSharesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCalcJoinPoolNoSwapSharesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySpotPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCalcJoinPoolNoSwapSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySpotPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokensIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5369,25 +7713,23 @@ func (m *QueryCalcJoinPoolNoSwapSharesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokensIn = append(m.TokensIn, types1.Coin{})
-			if err := m.TokensIn[len(m.TokensIn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SpotPrice = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5410,7 +7752,7 @@ func (m *QueryCalcJoinPoolNoSwapSharesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCalcJoinPoolNoSwapSharesResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5433,17 +7775,17 @@ func (m *QueryCalcJoinPoolNoSwapSharesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCalcJoinPoolNoSwapSharesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySwapExactAmountInRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCalcJoinPoolNoSwapSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySwapExactAmountInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokensOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5453,31 +7795,80 @@ func (m *QueryCalcJoinPoolNoSwapSharesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokensOut = append(m.TokensOut, types1.Coin{})
-			if err := m.TokensOut[len(m.TokensOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SharesOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5487,23 +7878,23 @@ func (m *QueryCalcJoinPoolNoSwapSharesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SharesOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Routes = append(m.Routes, SwapAmountInRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5528,7 +7919,7 @@ func (m *QueryCalcJoinPoolNoSwapSharesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpotPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5551,34 +7942,15 @@ func (m *QuerySpotPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpotPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySwapExactAmountInResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpotPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5606,39 +7978,9 @@ func (m *QuerySpotPriceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseAssetDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.QuoteAssetDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5661,7 +8003,7 @@ func (m *QuerySpotPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolsWithFilterRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBestRoutesExactAmountInRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5684,17 +8026,17 @@ func (m *QueryPoolsWithFilterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolsWithFilterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBestRoutesExactAmountInRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolsWithFilterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBestRoutesExactAmountInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinLiquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5704,29 +8046,27 @@ func (m *QueryPoolsWithFilterRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinLiquidity = append(m.MinLiquidity, types1.Coin{})
-			if err := m.MinLiquidity[len(m.MinLiquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5754,13 +8094,13 @@ func (m *QueryPoolsWithFilterRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolType = string(dAtA[iNdEx:postIndex])
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHops", wireType)
 			}
-			var msglen int
+			m.MaxHops = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5770,28 +8110,30 @@ func (m *QueryPoolsWithFilterRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxHops |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5813,7 +8155,7 @@ func (m *QueryPoolsWithFilterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolsWithFilterResponse) Unmarshal(dAtA []byte) error {
+func (m *EstimatedRoute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5836,15 +8178,15 @@ func (m *QueryPoolsWithFilterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolsWithFilterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimatedRoute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolsWithFilterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimatedRoute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5871,16 +8213,16 @@ func (m *QueryPoolsWithFilterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, &types.Any{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Routes = append(m.Routes, SwapAmountInRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5890,25 +8232,23 @@ func (m *QueryPoolsWithFilterResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5933,7 +8273,7 @@ func (m *QueryPoolsWithFilterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpotPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBestRoutesExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5956,17 +8296,17 @@ func (m *QuerySpotPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpotPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBestRoutesExactAmountInResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpotPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBestRoutesExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5976,23 +8316,25 @@ func (m *QuerySpotPriceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpotPrice = string(dAtA[iNdEx:postIndex])
+			m.Routes = append(m.Routes, EstimatedRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6015,7 +8357,7 @@ func (m *QuerySpotPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySplitRouteSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6038,17 +8380,17 @@ func (m *QuerySwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySwapExactAmountInRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySplitRouteSwapExactAmountInRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySwapExactAmountInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySplitRouteSwapExactAmountInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6058,46 +8400,29 @@ func (m *QuerySwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Routes = append(m.Routes, SwapAmountInSplitRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6125,41 +8450,7 @@ func (m *QuerySwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenIn = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Routes = append(m.Routes, SwapAmountInRoute{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6182,7 +8473,7 @@ func (m *QuerySwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6205,10 +8496,10 @@ func (m *QuerySwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySwapExactAmountInResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySplitRouteSwapExactAmountInResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySplitRouteSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 type SwapAmountInRoutes []SwapAmountInRoute
@@ -45,6 +46,47 @@ func (routes SwapAmountInRoutes) Length() int {
 	return len(routes)
 }
 
+type SwapAmountInSplitRoutes []SwapAmountInSplitRoute
+
+// Validate checks that the split routes swap positive amounts to the same token out denom,
+// and don't share pools, so that they can be estimated independently of each other.
+func (routes SwapAmountInSplitRoutes) Validate() error {
+	if len(routes) == 0 {
+		return ErrEmptyRoutes
+	}
+
+	poolIds := make(map[uint64]bool)
+	for _, route := range routes {
+		if err := SwapAmountInRoutes(route.Pools).Validate(); err != nil {
+			return err
+		}
+		if route.TokenInAmount.IsNil() || !route.TokenInAmount.IsPositive() {
+			return ErrNotPositiveRequireAmount
+		}
+		if route.TokenOutDenom() != routes[0].TokenOutDenom() {
+			return sdkerrors.Wrapf(ErrInvalidSplitRoutes, "routes swap to different denoms %s and %s", routes[0].TokenOutDenom(), route.TokenOutDenom())
+		}
+
+		routePoolIds := make(map[uint64]bool)
+		for _, pool := range route.Pools {
+			if poolIds[pool.PoolId] {
+				return sdkerrors.Wrapf(ErrInvalidSplitRoutes, "pool %d is in several routes", pool.PoolId)
+			}
+			routePoolIds[pool.PoolId] = true
+		}
+		for poolId := range routePoolIds {
+			poolIds[poolId] = true
+		}
+	}
+
+	return nil
+}
+
+// TokenOutDenom returns the denom the split route swaps to.
+func (route SwapAmountInSplitRoute) TokenOutDenom() string {
+	return route.Pools[len(route.Pools)-1].TokenOutDenom
+}
+
 type SwapAmountOutRoutes []SwapAmountOutRoute
 
 func (routes SwapAmountOutRoutes) Validate() error {
@@ -0,0 +1,43 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	downtimetypes "github.com/osmosis-labs/osmosis/v13/x/downtime-detector/types"
+)
+
+// Validate checks that the dynamic swap fee params are well formed,
+// and that the base swap fee lies within the min and max swap fee.
+func (p DynamicSwapFeeParams) Validate(baseSwapFee sdk.Dec) error {
+	if p.MinSwapFee.IsNil() || p.MinSwapFee.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidDynamicSwapFee, "min swap fee must be non-negative")
+	}
+	if p.MaxSwapFee.IsNil() || p.MaxSwapFee.GTE(sdk.OneDec()) {
+		return sdkerrors.Wrap(ErrInvalidDynamicSwapFee, "max swap fee must be less than one")
+	}
+	if baseSwapFee.LT(p.MinSwapFee) || baseSwapFee.GT(p.MaxSwapFee) {
+		return sdkerrors.Wrapf(ErrInvalidDynamicSwapFee, "swap fee %s must be between the min swap fee %s and the max swap fee %s",
+			baseSwapFee, p.MinSwapFee, p.MaxSwapFee)
+	}
+
+	if p.VolatilityMultiplier.IsNil() || p.VolatilityMultiplier.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidDynamicSwapFee, "volatility multiplier must be non-negative")
+	}
+	if p.VolatilityMultiplier.IsPositive() && p.VolatilityWindow <= 0 {
+		return sdkerrors.Wrap(ErrInvalidDynamicSwapFee, "volatility window must be positive")
+	}
+
+	if p.DowntimeSurcharge.IsNil() || p.DowntimeSurcharge.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidDynamicSwapFee, "downtime surcharge must be non-negative")
+	}
+	if p.DowntimeSurcharge.IsPositive() {
+		if !downtimetypes.IsSupportedDowntime(p.Downtime) {
+			return sdkerrors.Wrapf(ErrInvalidDynamicSwapFee, "downtime %s is not tracked by the downtime detector", p.Downtime)
+		}
+		if p.DowntimeRecovery <= 0 {
+			return sdkerrors.Wrap(ErrInvalidDynamicSwapFee, "downtime recovery must be positive")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,648 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/gamm/v1beta1/swap_fee.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DynamicSwapFeeParams configures a pool's optional dynamic swap fee. The
+// dynamic swap fee is the pool's base swap fee, plus a surcharge that scales
+// with the recent volatility of the pool's spot price, plus a surcharge while
+// the chain is recovering from downtime, bounded by the min and max swap fee.
+type DynamicSwapFeeParams struct {
+	// min_swap_fee is the lower bound of the dynamic swap fee.
+	MinSwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=min_swap_fee,json=minSwapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_swap_fee" yaml:"min_swap_fee"`
+	// max_swap_fee is the upper bound of the dynamic swap fee.
+	MaxSwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_swap_fee,json=maxSwapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_swap_fee" yaml:"max_swap_fee"`
+	// volatility_window is the duration of the arithmetic twap the spot price is
+	// compared against to measure volatility.
+	VolatilityWindow time.Duration `protobuf:"bytes,3,opt,name=volatility_window,json=volatilityWindow,proto3,stdduration" json:"volatility_window" yaml:"volatility_window"`
+	// volatility_multiplier scales the relative deviation of the spot price from
+	// the twap into the volatility surcharge. Zero disables the surcharge.
+	VolatilityMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=volatility_multiplier,json=volatilityMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"volatility_multiplier" yaml:"volatility_multiplier"`
+	// downtime is the length of chain downtime that triggers the downtime
+	// surcharge. It must be one of the downtime durations tracked by the
+	// downtime detector module.
+	Downtime time.Duration `protobuf:"bytes,5,opt,name=downtime,proto3,stdduration" json:"downtime" yaml:"downtime"`
+	// downtime_recovery is how long the downtime surcharge applies after
+	// the chain recovers from downtime.
+	DowntimeRecovery time.Duration `protobuf:"bytes,6,opt,name=downtime_recovery,json=downtimeRecovery,proto3,stdduration" json:"downtime_recovery" yaml:"downtime_recovery"`
+	// downtime_surcharge is added to the swap fee while the chain is recovering
+	// from downtime. Zero disables the surcharge.
+	DowntimeSurcharge github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=downtime_surcharge,json=downtimeSurcharge,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"downtime_surcharge" yaml:"downtime_surcharge"`
+}
+
+func (m *DynamicSwapFeeParams) Reset()         { *m = DynamicSwapFeeParams{} }
+func (m *DynamicSwapFeeParams) String() string { return proto.CompactTextString(m) }
+func (*DynamicSwapFeeParams) ProtoMessage()    {}
+func (*DynamicSwapFeeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_071a71a6fd33ec4f, []int{0}
+}
+func (m *DynamicSwapFeeParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicSwapFeeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicSwapFeeParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicSwapFeeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicSwapFeeParams.Merge(m, src)
+}
+func (m *DynamicSwapFeeParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicSwapFeeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicSwapFeeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicSwapFeeParams proto.InternalMessageInfo
+
+func (m *DynamicSwapFeeParams) GetVolatilityWindow() time.Duration {
+	if m != nil {
+		return m.VolatilityWindow
+	}
+	return 0
+}
+
+func (m *DynamicSwapFeeParams) GetDowntime() time.Duration {
+	if m != nil {
+		return m.Downtime
+	}
+	return 0
+}
+
+func (m *DynamicSwapFeeParams) GetDowntimeRecovery() time.Duration {
+	if m != nil {
+		return m.DowntimeRecovery
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DynamicSwapFeeParams)(nil), "osmosis.gamm.v1beta1.DynamicSwapFeeParams")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/gamm/v1beta1/swap_fee.proto", fileDescriptor_071a71a6fd33ec4f)
+}
+
+var fileDescriptor_071a71a6fd33ec4f = []byte{
+	// 464 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x3f, 0x8f, 0xd3, 0x30,
+	0x18, 0xc6, 0x1b, 0xfe, 0x14, 0x08, 0x48, 0x40, 0x28, 0x52, 0xee, 0x40, 0xc9, 0x29, 0x20, 0x74,
+	0xcb, 0xc5, 0x94, 0xdb, 0x18, 0xab, 0xc2, 0x00, 0x02, 0xa1, 0xdc, 0x80, 0xc4, 0x52, 0x39, 0xa9,
+	0xcf, 0x67, 0x61, 0xc7, 0x91, 0xed, 0x34, 0x0d, 0x2b, 0x5f, 0x80, 0x11, 0xf1, 0x89, 0x6e, 0xbc,
+	0x11, 0x31, 0x14, 0xd4, 0x7e, 0x83, 0x7e, 0x02, 0x54, 0xc7, 0x4e, 0x0b, 0x1d, 0x50, 0xc5, 0x54,
+	0xfb, 0xe9, 0x93, 0xe7, 0xf7, 0xbe, 0x8f, 0x64, 0xf7, 0x11, 0x97, 0x8c, 0x4b, 0x22, 0x01, 0x86,
+	0x8c, 0x81, 0x49, 0x3f, 0x45, 0x0a, 0xf6, 0x81, 0xac, 0x60, 0x31, 0x3a, 0x45, 0x28, 0x2e, 0x04,
+	0x57, 0xdc, 0xeb, 0x19, 0x53, 0xbc, 0x32, 0xc5, 0xc6, 0xb4, 0xdf, 0xc3, 0x1c, 0x73, 0x6d, 0x00,
+	0xab, 0x53, 0xe3, 0xdd, 0x0f, 0x30, 0xe7, 0x98, 0x22, 0xa0, 0x6f, 0x69, 0x79, 0x0a, 0xc6, 0xa5,
+	0x80, 0x8a, 0xf0, 0xbc, 0xf9, 0x3f, 0xfa, 0xd6, 0x75, 0x7b, 0xc3, 0x3a, 0x87, 0x8c, 0x64, 0x27,
+	0x15, 0x2c, 0x5e, 0x22, 0xf4, 0x0e, 0x0a, 0xc8, 0xa4, 0x87, 0xdd, 0x5b, 0x8c, 0xe4, 0x23, 0x8b,
+	0xf6, 0x9d, 0x03, 0xe7, 0xf0, 0xc6, 0xe0, 0xc5, 0xf9, 0x2c, 0xec, 0xfc, 0x98, 0x85, 0x4f, 0x30,
+	0x51, 0x67, 0x65, 0x1a, 0x67, 0x9c, 0x81, 0x4c, 0x8f, 0x63, 0x7e, 0x8e, 0xe4, 0xf8, 0x23, 0x50,
+	0x75, 0x81, 0x64, 0x3c, 0x44, 0xd9, 0x72, 0x16, 0xde, 0xab, 0x21, 0xa3, 0xcf, 0xa3, 0xcd, 0xac,
+	0x28, 0x71, 0x19, 0xc9, 0x0d, 0x4e, 0x83, 0xe0, 0x74, 0x0d, 0xba, 0xf4, 0x9f, 0x20, 0x38, 0xfd,
+	0x03, 0x04, 0xa7, 0x16, 0x44, 0xdd, 0xbb, 0x13, 0x4e, 0xa1, 0x22, 0x94, 0xa8, 0x7a, 0x54, 0x91,
+	0x7c, 0xcc, 0x2b, 0xff, 0xf2, 0x81, 0x73, 0x78, 0xf3, 0xd9, 0x5e, 0xdc, 0xd4, 0x14, 0xdb, 0x9a,
+	0xe2, 0xa1, 0xa9, 0x69, 0xf0, 0x78, 0x35, 0xc8, 0x72, 0x16, 0xfa, 0x4d, 0xfc, 0x56, 0x42, 0xf4,
+	0xf5, 0x67, 0xe8, 0x24, 0x77, 0xd6, 0xfa, 0x7b, 0x2d, 0x7b, 0x9f, 0x1d, 0xf7, 0xfe, 0x86, 0x99,
+	0x95, 0x54, 0x91, 0x82, 0x12, 0x24, 0xfc, 0x2b, 0x7a, 0xc1, 0xb7, 0x3b, 0x2f, 0xf8, 0x70, 0x6b,
+	0x82, 0x75, 0x68, 0x94, 0xf4, 0xd6, 0xfa, 0x9b, 0x56, 0xf6, 0x12, 0xf7, 0xfa, 0x98, 0x57, 0xb9,
+	0x22, 0x0c, 0xf9, 0x57, 0xff, 0xb5, 0xea, 0x03, 0xb3, 0xea, 0xed, 0x06, 0x64, 0x3f, 0x6c, 0x36,
+	0x6c, 0x73, 0x56, 0x3d, 0xda, 0xf3, 0x48, 0xa0, 0x8c, 0x4f, 0x90, 0xa8, 0xfd, 0xee, 0x8e, 0x3d,
+	0x6e, 0x25, 0x98, 0x1e, 0xad, 0x9e, 0x18, 0xd9, 0xfb, 0xe4, 0x7a, 0xad, 0x57, 0x96, 0x22, 0x3b,
+	0x83, 0x02, 0x23, 0xff, 0x9a, 0xee, 0xf0, 0xf5, 0xce, 0x1d, 0xee, 0xfd, 0x45, 0x6f, 0x13, 0xa3,
+	0xa4, 0x5d, 0xea, 0xc4, 0x6a, 0x83, 0x57, 0xe7, 0xf3, 0xc0, 0xb9, 0x98, 0x07, 0xce, 0xaf, 0x79,
+	0xe0, 0x7c, 0x59, 0x04, 0x9d, 0x8b, 0x45, 0xd0, 0xf9, 0xbe, 0x08, 0x3a, 0x1f, 0x9e, 0x6e, 0x10,
+	0xcd, 0x6b, 0x3c, 0xa2, 0x30, 0x95, 0xf6, 0x02, 0x26, 0xfd, 0x63, 0x30, 0x6d, 0x5e, 0xb1, 0xe6,
+	0xa7, 0x5d, 0x5d, 0xc9, 0xf1, 0xef, 0x01, 0x00, 0x07, 0x3d, 0x4b, 0x8f, 0xe2, 0x03, 0x00, 0x00,
+}
+
+func (m *DynamicSwapFeeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicSwapFeeParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicSwapFeeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DowntimeSurcharge.Size()
+		i -= size
+		if _, err := m.DowntimeSurcharge.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwapFee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeRecovery, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeRecovery):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSwapFee(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Downtime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Downtime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSwapFee(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.VolatilityMultiplier.Size()
+		i -= size
+		if _, err := m.VolatilityMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwapFee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VolatilityWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VolatilityWindow):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSwapFee(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxSwapFee.Size()
+		i -= size
+		if _, err := m.MaxSwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwapFee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MinSwapFee.Size()
+		i -= size
+		if _, err := m.MinSwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwapFee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSwapFee(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSwapFee(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DynamicSwapFeeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinSwapFee.Size()
+	n += 1 + l + sovSwapFee(uint64(l))
+	l = m.MaxSwapFee.Size()
+	n += 1 + l + sovSwapFee(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VolatilityWindow)
+	n += 1 + l + sovSwapFee(uint64(l))
+	l = m.VolatilityMultiplier.Size()
+	n += 1 + l + sovSwapFee(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Downtime)
+	n += 1 + l + sovSwapFee(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeRecovery)
+	n += 1 + l + sovSwapFee(uint64(l))
+	l = m.DowntimeSurcharge.Size()
+	n += 1 + l + sovSwapFee(uint64(l))
+	return n
+}
+
+func sovSwapFee(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSwapFee(x uint64) (n int) {
+	return sovSwapFee(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DynamicSwapFeeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwapFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicSwapFeeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicSwapFeeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwapFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwapFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwapFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwapFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolatilityWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwapFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwapFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VolatilityWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolatilityMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwapFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwapFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VolatilityMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Downtime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwapFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwapFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Downtime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeRecovery", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwapFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwapFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DowntimeRecovery, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeSurcharge", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwapFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwapFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DowntimeSurcharge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwapFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwapFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSwapFee(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSwapFee
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSwapFee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSwapFee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSwapFee
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSwapFee
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSwapFee
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSwapFee        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSwapFee          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSwapFee = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,89 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+)
+
+func TestDynamicSwapFeeParamsValidate(t *testing.T) {
+	baseSwapFee := sdk.NewDecWithPrec(3, 3)
+	validParams := func() types.DynamicSwapFeeParams {
+		return types.DynamicSwapFeeParams{
+			MinSwapFee:           sdk.NewDecWithPrec(1, 3),
+			MaxSwapFee:           sdk.NewDecWithPrec(1, 2),
+			VolatilityWindow:     time.Hour,
+			VolatilityMultiplier: sdk.NewDecWithPrec(5, 2),
+			Downtime:             10 * time.Minute,
+			DowntimeRecovery:     30 * time.Minute,
+			DowntimeSurcharge:    sdk.NewDecWithPrec(2, 3),
+		}
+	}
+
+	tests := map[string]struct {
+		modify     func(*types.DynamicSwapFeeParams)
+		expectPass bool
+	}{
+		"valid": {
+			modify:     func(p *types.DynamicSwapFeeParams) {},
+			expectPass: true,
+		},
+		"surcharges disabled": {
+			modify: func(p *types.DynamicSwapFeeParams) {
+				p.VolatilityMultiplier = sdk.ZeroDec()
+				p.VolatilityWindow = 0
+				p.DowntimeSurcharge = sdk.ZeroDec()
+				p.Downtime = 0
+				p.DowntimeRecovery = 0
+			},
+			expectPass: true,
+		},
+		"negative min swap fee": {
+			modify: func(p *types.DynamicSwapFeeParams) { p.MinSwapFee = sdk.NewDecWithPrec(-1, 3) },
+		},
+		"max swap fee of one": {
+			modify: func(p *types.DynamicSwapFeeParams) { p.MaxSwapFee = sdk.OneDec() },
+		},
+		"base swap fee below min swap fee": {
+			modify: func(p *types.DynamicSwapFeeParams) { p.MinSwapFee = sdk.NewDecWithPrec(4, 3) },
+		},
+		"base swap fee above max swap fee": {
+			modify: func(p *types.DynamicSwapFeeParams) { p.MaxSwapFee = sdk.NewDecWithPrec(2, 3) },
+		},
+		"nil volatility multiplier": {
+			modify: func(p *types.DynamicSwapFeeParams) { p.VolatilityMultiplier = sdk.Dec{} },
+		},
+		"negative volatility multiplier": {
+			modify: func(p *types.DynamicSwapFeeParams) { p.VolatilityMultiplier = sdk.NewDecWithPrec(-1, 2) },
+		},
+		"zero volatility window": {
+			modify: func(p *types.DynamicSwapFeeParams) { p.VolatilityWindow = 0 },
+		},
+		"negative downtime surcharge": {
+			modify: func(p *types.DynamicSwapFeeParams) { p.DowntimeSurcharge = sdk.NewDecWithPrec(-1, 3) },
+		},
+		"downtime not tracked by the downtime detector": {
+			modify: func(p *types.DynamicSwapFeeParams) { p.Downtime = 7 * time.Minute },
+		},
+		"zero downtime recovery": {
+			modify: func(p *types.DynamicSwapFeeParams) { p.DowntimeRecovery = 0 },
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			params := validParams()
+			tc.modify(&params)
+			err := params.Validate(baseSwapFee)
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidDynamicSwapFee)
+			}
+		})
+	}
+}
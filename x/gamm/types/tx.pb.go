@@ -395,6 +395,157 @@ func (m *MsgSwapExactAmountInResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSwapExactAmountInResponse proto.InternalMessageInfo
 
+// ===================== MsgSplitRouteSwapExactAmountIn
+// SwapAmountInSplitRoute is a route swapping token_in_amount of the token in
+// denom of a split route swap.
+type SwapAmountInSplitRoute struct {
+	Pools         []SwapAmountInRoute                    `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools" yaml:"pools"`
+	TokenInAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=token_in_amount,json=tokenInAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_in_amount" yaml:"token_in_amount"`
+}
+
+func (m *SwapAmountInSplitRoute) Reset()         { *m = SwapAmountInSplitRoute{} }
+func (m *SwapAmountInSplitRoute) String() string { return proto.CompactTextString(m) }
+func (*SwapAmountInSplitRoute) ProtoMessage()    {}
+func (*SwapAmountInSplitRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{7}
+}
+func (m *SwapAmountInSplitRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapAmountInSplitRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapAmountInSplitRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapAmountInSplitRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapAmountInSplitRoute.Merge(m, src)
+}
+func (m *SwapAmountInSplitRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapAmountInSplitRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapAmountInSplitRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapAmountInSplitRoute proto.InternalMessageInfo
+
+func (m *SwapAmountInSplitRoute) GetPools() []SwapAmountInRoute {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+// MsgSplitRouteSwapExactAmountIn swaps the token in through several routes to
+// the same token out denom, and requires the total token out amount of the
+// routes to be at least token_out_min_amount. Routes cannot share pools.
+type MsgSplitRouteSwapExactAmountIn struct {
+	Sender            string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Routes            []SwapAmountInSplitRoute               `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
+	TokenInDenom      string                                 `protobuf:"bytes,3,opt,name=token_in_denom,json=tokenInDenom,proto3" json:"token_in_denom,omitempty" yaml:"token_in_denom"`
+	TokenOutMinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=token_out_min_amount,json=tokenOutMinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_out_min_amount" yaml:"token_out_min_amount"`
+}
+
+func (m *MsgSplitRouteSwapExactAmountIn) Reset()         { *m = MsgSplitRouteSwapExactAmountIn{} }
+func (m *MsgSplitRouteSwapExactAmountIn) String() string { return proto.CompactTextString(m) }
+func (*MsgSplitRouteSwapExactAmountIn) ProtoMessage()    {}
+func (*MsgSplitRouteSwapExactAmountIn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{8}
+}
+func (m *MsgSplitRouteSwapExactAmountIn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSplitRouteSwapExactAmountIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSplitRouteSwapExactAmountIn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSplitRouteSwapExactAmountIn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSplitRouteSwapExactAmountIn.Merge(m, src)
+}
+func (m *MsgSplitRouteSwapExactAmountIn) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSplitRouteSwapExactAmountIn) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSplitRouteSwapExactAmountIn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSplitRouteSwapExactAmountIn proto.InternalMessageInfo
+
+func (m *MsgSplitRouteSwapExactAmountIn) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSplitRouteSwapExactAmountIn) GetRoutes() []SwapAmountInSplitRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func (m *MsgSplitRouteSwapExactAmountIn) GetTokenInDenom() string {
+	if m != nil {
+		return m.TokenInDenom
+	}
+	return ""
+}
+
+type MsgSplitRouteSwapExactAmountInResponse struct {
+	TokenOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_out_amount" yaml:"token_out_amount"`
+}
+
+func (m *MsgSplitRouteSwapExactAmountInResponse) Reset() {
+	*m = MsgSplitRouteSwapExactAmountInResponse{}
+}
+func (m *MsgSplitRouteSwapExactAmountInResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSplitRouteSwapExactAmountInResponse) ProtoMessage()    {}
+func (*MsgSplitRouteSwapExactAmountInResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{9}
+}
+func (m *MsgSplitRouteSwapExactAmountInResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSplitRouteSwapExactAmountInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSplitRouteSwapExactAmountInResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSplitRouteSwapExactAmountInResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSplitRouteSwapExactAmountInResponse.Merge(m, src)
+}
+func (m *MsgSplitRouteSwapExactAmountInResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSplitRouteSwapExactAmountInResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSplitRouteSwapExactAmountInResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSplitRouteSwapExactAmountInResponse proto.InternalMessageInfo
+
 // ===================== MsgSwapExactAmountOut
 type SwapAmountOutRoute struct {
 	PoolId       uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
@@ -405,7 +556,7 @@ func (m *SwapAmountOutRoute) Reset()         { *m = SwapAmountOutRoute{} }
 func (m *SwapAmountOutRoute) String() string { return proto.CompactTextString(m) }
 func (*SwapAmountOutRoute) ProtoMessage()    {}
 func (*SwapAmountOutRoute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{7}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{10}
 }
 func (m *SwapAmountOutRoute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -459,7 +610,7 @@ func (m *MsgSwapExactAmountOut) Reset()         { *m = MsgSwapExactAmountOut{} }
 func (m *MsgSwapExactAmountOut) String() string { return proto.CompactTextString(m) }
 func (*MsgSwapExactAmountOut) ProtoMessage()    {}
 func (*MsgSwapExactAmountOut) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{8}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{11}
 }
 func (m *MsgSwapExactAmountOut) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -517,7 +668,7 @@ func (m *MsgSwapExactAmountOutResponse) Reset()         { *m = MsgSwapExactAmoun
 func (m *MsgSwapExactAmountOutResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSwapExactAmountOutResponse) ProtoMessage()    {}
 func (*MsgSwapExactAmountOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{9}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{12}
 }
 func (m *MsgSwapExactAmountOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -559,7 +710,7 @@ func (m *MsgJoinSwapExternAmountIn) Reset()         { *m = MsgJoinSwapExternAmou
 func (m *MsgJoinSwapExternAmountIn) String() string { return proto.CompactTextString(m) }
 func (*MsgJoinSwapExternAmountIn) ProtoMessage()    {}
 func (*MsgJoinSwapExternAmountIn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{10}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{13}
 }
 func (m *MsgJoinSwapExternAmountIn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -617,7 +768,7 @@ func (m *MsgJoinSwapExternAmountInResponse) Reset()         { *m = MsgJoinSwapEx
 func (m *MsgJoinSwapExternAmountInResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgJoinSwapExternAmountInResponse) ProtoMessage()    {}
 func (*MsgJoinSwapExternAmountInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{11}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{14}
 }
 func (m *MsgJoinSwapExternAmountInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -659,7 +810,7 @@ func (m *MsgJoinSwapShareAmountOut) Reset()         { *m = MsgJoinSwapShareAmoun
 func (m *MsgJoinSwapShareAmountOut) String() string { return proto.CompactTextString(m) }
 func (*MsgJoinSwapShareAmountOut) ProtoMessage()    {}
 func (*MsgJoinSwapShareAmountOut) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{12}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{15}
 }
 func (m *MsgJoinSwapShareAmountOut) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -717,7 +868,7 @@ func (m *MsgJoinSwapShareAmountOutResponse) Reset()         { *m = MsgJoinSwapSh
 func (m *MsgJoinSwapShareAmountOutResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgJoinSwapShareAmountOutResponse) ProtoMessage()    {}
 func (*MsgJoinSwapShareAmountOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{13}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{16}
 }
 func (m *MsgJoinSwapShareAmountOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -759,7 +910,7 @@ func (m *MsgExitSwapShareAmountIn) Reset()         { *m = MsgExitSwapShareAmount
 func (m *MsgExitSwapShareAmountIn) String() string { return proto.CompactTextString(m) }
 func (*MsgExitSwapShareAmountIn) ProtoMessage()    {}
 func (*MsgExitSwapShareAmountIn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{14}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{17}
 }
 func (m *MsgExitSwapShareAmountIn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -817,7 +968,7 @@ func (m *MsgExitSwapShareAmountInResponse) Reset()         { *m = MsgExitSwapSha
 func (m *MsgExitSwapShareAmountInResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExitSwapShareAmountInResponse) ProtoMessage()    {}
 func (*MsgExitSwapShareAmountInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{15}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{18}
 }
 func (m *MsgExitSwapShareAmountInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -858,7 +1009,7 @@ func (m *MsgExitSwapExternAmountOut) Reset()         { *m = MsgExitSwapExternAmo
 func (m *MsgExitSwapExternAmountOut) String() string { return proto.CompactTextString(m) }
 func (*MsgExitSwapExternAmountOut) ProtoMessage()    {}
 func (*MsgExitSwapExternAmountOut) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{16}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{19}
 }
 func (m *MsgExitSwapExternAmountOut) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -916,7 +1067,7 @@ func (m *MsgExitSwapExternAmountOutResponse) Reset()         { *m = MsgExitSwapE
 func (m *MsgExitSwapExternAmountOutResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExitSwapExternAmountOutResponse) ProtoMessage()    {}
 func (*MsgExitSwapExternAmountOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{17}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{20}
 }
 func (m *MsgExitSwapExternAmountOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -953,6 +1104,9 @@ func init() {
 	proto.RegisterType((*SwapAmountInRoute)(nil), "osmosis.gamm.v1beta1.SwapAmountInRoute")
 	proto.RegisterType((*MsgSwapExactAmountIn)(nil), "osmosis.gamm.v1beta1.MsgSwapExactAmountIn")
 	proto.RegisterType((*MsgSwapExactAmountInResponse)(nil), "osmosis.gamm.v1beta1.MsgSwapExactAmountInResponse")
+	proto.RegisterType((*SwapAmountInSplitRoute)(nil), "osmosis.gamm.v1beta1.SwapAmountInSplitRoute")
+	proto.RegisterType((*MsgSplitRouteSwapExactAmountIn)(nil), "osmosis.gamm.v1beta1.MsgSplitRouteSwapExactAmountIn")
+	proto.RegisterType((*MsgSplitRouteSwapExactAmountInResponse)(nil), "osmosis.gamm.v1beta1.MsgSplitRouteSwapExactAmountInResponse")
 	proto.RegisterType((*SwapAmountOutRoute)(nil), "osmosis.gamm.v1beta1.SwapAmountOutRoute")
 	proto.RegisterType((*MsgSwapExactAmountOut)(nil), "osmosis.gamm.v1beta1.MsgSwapExactAmountOut")
 	proto.RegisterType((*MsgSwapExactAmountOutResponse)(nil), "osmosis.gamm.v1beta1.MsgSwapExactAmountOutResponse")
@@ -969,78 +1123,84 @@ func init() {
 func init() { proto.RegisterFile("osmosis/gamm/v1beta1/tx.proto", fileDescriptor_cfc8fd3ac7df3247) }
 
 var fileDescriptor_cfc8fd3ac7df3247 = []byte{
-	// 1121 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4b, 0x6f, 0x1b, 0x45,
-	0x1c, 0xcf, 0xd8, 0x6e, 0x1e, 0x93, 0xe6, 0xb5, 0x4d, 0x1a, 0x67, 0xdb, 0xda, 0xe9, 0x80, 0x20,
-	0xa5, 0xea, 0x6e, 0x93, 0x48, 0x80, 0xb8, 0x00, 0x86, 0x20, 0x5c, 0x61, 0xa5, 0xda, 0x5e, 0x2a,
-	0x2e, 0xd6, 0x3a, 0x5e, 0xb9, 0xab, 0x66, 0x67, 0x2c, 0xcf, 0x6c, 0x70, 0x85, 0x04, 0x12, 0x8f,
-	0x3b, 0x08, 0xf1, 0xf8, 0x04, 0x88, 0xaf, 0xc0, 0x01, 0x0e, 0x70, 0xe9, 0xb1, 0x37, 0x28, 0x07,
-	0x0b, 0x25, 0xdf, 0xc0, 0x9f, 0xa0, 0xda, 0x9d, 0xd9, 0xa7, 0x77, 0x63, 0x6f, 0x62, 0x37, 0xa7,
-	0xc4, 0x3b, 0xff, 0xf7, 0xff, 0x37, 0xbf, 0xff, 0x7f, 0x17, 0xde, 0x20, 0xd4, 0x22, 0xd4, 0xa4,
-	0x6a, 0x4b, 0xb7, 0x2c, 0xf5, 0x68, 0xbb, 0x61, 0x30, 0x7d, 0x5b, 0x65, 0x5d, 0xa5, 0xdd, 0x21,
-	0x8c, 0x48, 0xab, 0xe2, 0x58, 0x71, 0x8e, 0x15, 0x71, 0x2c, 0xaf, 0xb6, 0x48, 0x8b, 0xb8, 0x02,
-	0xaa, 0xf3, 0x1f, 0x97, 0x95, 0x4b, 0x07, 0xae, 0xb0, 0xda, 0xd0, 0xa9, 0xe1, 0x5b, 0x3a, 0x20,
-	0x26, 0xe6, 0xe7, 0xe8, 0x8f, 0x1c, 0x9c, 0xaf, 0xd1, 0xd6, 0x3d, 0x62, 0xe2, 0xfb, 0x84, 0x1c,
-	0x4a, 0xb7, 0xe0, 0x34, 0x35, 0x70, 0xd3, 0xe8, 0x14, 0xc1, 0x26, 0xd8, 0x9a, 0xab, 0xac, 0xf4,
-	0x7b, 0xe5, 0x85, 0x27, 0xba, 0x75, 0xf8, 0x0e, 0xe2, 0xcf, 0x91, 0x26, 0x04, 0xa4, 0xdb, 0x70,
-	0xa6, 0x4d, 0xc8, 0x61, 0xdd, 0x6c, 0x16, 0x73, 0x9b, 0x60, 0xab, 0x50, 0x91, 0xfa, 0xbd, 0xf2,
-	0x22, 0x97, 0x15, 0x07, 0x48, 0x9b, 0x76, 0xfe, 0xab, 0x36, 0xa5, 0x0e, 0x5c, 0xa6, 0x8f, 0xf4,
-	0x8e, 0x51, 0x27, 0x36, 0xab, 0xeb, 0x16, 0xb1, 0x31, 0x2b, 0xe6, 0x5d, 0x0f, 0x1f, 0x3f, 0xed,
-	0x95, 0xa7, 0xfe, 0xeb, 0x95, 0x5f, 0x6b, 0x99, 0xec, 0x91, 0xdd, 0x50, 0x0e, 0x88, 0xa5, 0x8a,
-	0xa0, 0xf9, 0x9f, 0x3b, 0xb4, 0xf9, 0x58, 0x65, 0x4f, 0xda, 0x06, 0x55, 0xaa, 0x98, 0xf5, 0x7b,
-	0xe5, 0xab, 0x21, 0x1f, 0xdc, 0x94, 0x63, 0x15, 0x69, 0x8b, 0xae, 0x87, 0x7d, 0x9b, 0xbd, 0xef,
-	0x3e, 0x94, 0x1a, 0x70, 0x81, 0x91, 0xc7, 0x06, 0xae, 0x9b, 0xb8, 0x6e, 0xe9, 0x5d, 0x5a, 0x2c,
-	0x6c, 0xe6, 0xb7, 0xe6, 0x77, 0x36, 0x14, 0x6e, 0x57, 0x71, 0x6a, 0xe2, 0x95, 0x4f, 0xf9, 0x80,
-	0x98, 0xb8, 0xf2, 0x8a, 0x13, 0x4b, 0xbf, 0x57, 0xbe, 0xc6, 0x3d, 0x84, 0xb5, 0x85, 0x27, 0x8a,
-	0xb4, 0x79, 0xf7, 0x71, 0x15, 0xd7, 0xf4, 0x2e, 0x45, 0xcf, 0x01, 0xbc, 0x12, 0xaa, 0x9f, 0x66,
-	0xd0, 0x36, 0xc1, 0xd4, 0x90, 0x68, 0x42, 0xbe, 0xbc, 0xa2, 0xd5, 0xcc, 0xf9, 0xae, 0x8b, 0xfa,
-	0xc7, 0xec, 0x0d, 0x26, 0x5c, 0x83, 0xb3, 0x5e, 0xc8, 0xc5, 0xdc, 0xb0, 0x5c, 0xd7, 0x45, 0xae,
-	0x4b, 0xd1, 0x5c, 0x91, 0x36, 0x23, 0xf2, 0x43, 0x7f, 0x72, 0x6c, 0xec, 0x75, 0x4d, 0x36, 0x51,
-	0x6c, 0xb4, 0xe1, 0x12, 0xcf, 0xcd, 0xc4, 0x63, 0x82, 0x46, 0xcc, 0x1c, 0xd2, 0x16, 0xdc, 0x27,
-	0x55, 0x2c, 0x0a, 0x65, 0xc0, 0x45, 0x9e, 0xaf, 0x53, 0x4d, 0xcb, 0xc4, 0x23, 0x40, 0xe3, 0x55,
-	0x51, 0xae, 0xeb, 0xe1, 0x72, 0x09, 0xf5, 0x00, 0x1b, 0x97, 0xdd, 0xe7, 0xfb, 0x36, 0xab, 0x99,
-	0x98, 0xa2, 0x96, 0x8b, 0x0d, 0xaf, 0x7e, 0x3e, 0x36, 0xee, 0xc3, 0x39, 0x5f, 0xbd, 0x08, 0x86,
-	0x39, 0x2e, 0x0a, 0xc7, 0xcb, 0x31, 0xc7, 0x48, 0x9b, 0xf5, 0x9c, 0xa1, 0x6f, 0x00, 0x5c, 0x79,
-	0xf0, 0x99, 0xde, 0xe6, 0xe9, 0x55, 0xb1, 0x46, 0x6c, 0x66, 0x84, 0x9b, 0x00, 0x86, 0x36, 0xa1,
-	0x02, 0x97, 0x82, 0x9c, 0x9a, 0x06, 0x26, 0x96, 0xdb, 0xb9, 0xb9, 0x8a, 0x1c, 0x94, 0x35, 0x26,
-	0x80, 0xb4, 0x05, 0x2f, 0x82, 0x0f, 0xdd, 0xdf, 0xff, 0xe4, 0xe0, 0x6a, 0x8d, 0xb6, 0x9c, 0x48,
-	0xf6, 0xba, 0xfa, 0x01, 0xf3, 0xc2, 0xc9, 0x82, 0x9c, 0x3d, 0x38, 0xdd, 0x71, 0xa2, 0xa7, 0x02,
-	0xc1, 0xaf, 0x2b, 0x49, 0x6c, 0xa7, 0x0c, 0x64, 0x5b, 0x29, 0x38, 0x75, 0xd2, 0x84, 0x72, 0xe4,
-	0x2a, 0x38, 0x60, 0x3a, 0xdf, 0x55, 0x90, 0xbe, 0x80, 0xab, 0x49, 0x1d, 0x2f, 0x16, 0xdc, 0x74,
-	0x6a, 0x99, 0x71, 0x7a, 0x2d, 0x1d, 0x45, 0x48, 0x5b, 0x09, 0x81, 0x88, 0xe7, 0x88, 0x7e, 0x00,
-	0xf0, 0x7a, 0x52, 0x65, 0xc3, 0x7c, 0x13, 0x18, 0x1b, 0x0f, 0xdf, 0xc4, 0xed, 0x21, 0x6d, 0xd1,
-	0x0b, 0x4c, 0x44, 0xf5, 0x35, 0x80, 0x52, 0xd0, 0x88, 0x7d, 0x9b, 0x9d, 0x01, 0x77, 0xef, 0x79,
-	0x57, 0xd1, 0xc4, 0x23, 0xc3, 0xee, 0xb2, 0x68, 0x0b, 0x47, 0xdd, 0xf3, 0x1c, 0x5c, 0x1b, 0xac,
-	0xcd, 0xbe, 0xcd, 0xb2, 0xc0, 0xee, 0xa3, 0x18, 0xec, 0xb6, 0x86, 0xc1, 0xce, 0xcb, 0x36, 0x86,
-	0xbb, 0xcf, 0xe1, 0x95, 0x84, 0xa9, 0x21, 0xf8, 0xec, 0x93, 0xcc, 0xad, 0x90, 0x53, 0x07, 0x11,
-	0xd2, 0x96, 0x83, 0x39, 0x24, 0x68, 0x2d, 0x42, 0x2c, 0x85, 0x61, 0xa8, 0x1f, 0x85, 0x58, 0xbe,
-	0x07, 0xf0, 0x46, 0x62, 0x6d, 0x7d, 0xe0, 0xb5, 0x3d, 0xde, 0x08, 0x2e, 0x05, 0x38, 0x1f, 0x79,
-	0xc7, 0xcc, 0x79, 0x2c, 0xe3, 0x91, 0x37, 0xfa, 0x2b, 0x07, 0x37, 0xc4, 0xc8, 0xe5, 0x71, 0x31,
-	0xa3, 0x83, 0xcf, 0x42, 0x35, 0x99, 0x86, 0xd4, 0xf8, 0x09, 0x25, 0x98, 0xe7, 0xe3, 0x23, 0x94,
-	0x24, 0x9b, 0x48, 0x5b, 0xf1, 0xf6, 0x84, 0x80, 0x50, 0x7e, 0x01, 0xf0, 0x66, 0x6a, 0x11, 0x2f,
-	0x74, 0x8b, 0x41, 0xbf, 0xe6, 0x23, 0xfd, 0x7d, 0xe0, 0x9c, 0x9e, 0xe9, 0x4e, 0x67, 0xea, 0xef,
-	0xbb, 0x03, 0x3c, 0xc4, 0xef, 0xec, 0x46, 0xbf, 0x57, 0x5e, 0x8b, 0x01, 0x33, 0x89, 0x86, 0x12,
-	0x6b, 0x55, 0x98, 0xf4, 0xc6, 0x97, 0x42, 0x37, 0x97, 0x5e, 0x06, 0xdd, 0xa0, 0x1f, 0xa3, 0x18,
-	0x8a, 0x36, 0xea, 0x02, 0x09, 0xe2, 0xb7, 0x3c, 0x2c, 0x8a, 0xbd, 0x2b, 0x16, 0xd7, 0x04, 0xf9,
-	0x21, 0x61, 0x7f, 0xca, 0x67, 0xdc, 0x9f, 0x92, 0x16, 0xe1, 0xc2, 0x64, 0x17, 0xe1, 0xb4, 0xbd,
-	0xe6, 0xd2, 0x4b, 0xda, 0x6b, 0x7e, 0x06, 0x70, 0x33, 0xad, 0x55, 0x17, 0xbb, 0xdb, 0xfc, 0x9d,
-	0x83, 0x72, 0x28, 0xb2, 0x30, 0x41, 0x4e, 0x92, 0x86, 0x22, 0x23, 0x3c, 0x3f, 0x86, 0x11, 0xee,
-	0x50, 0x84, 0x8f, 0x82, 0x10, 0x45, 0x14, 0xce, 0x47, 0x11, 0x09, 0x26, 0x91, 0xb6, 0x2c, 0xc0,
-	0x15, 0x50, 0xc4, 0x4f, 0x00, 0xa2, 0xf4, 0x2a, 0x86, 0x39, 0x22, 0x0e, 0x7c, 0x30, 0x51, 0xe0,
-	0xef, 0xfc, 0x3e, 0x03, 0xf3, 0x35, 0xda, 0x92, 0x1e, 0xc2, 0x59, 0xff, 0xdb, 0xc7, 0xcd, 0xe4,
-	0x9d, 0x2f, 0xf4, 0x7a, 0x2f, 0xdf, 0x1a, 0x2a, 0xe2, 0xe7, 0xf4, 0x10, 0xce, 0xfa, 0x6f, 0xce,
-	0xe9, 0x96, 0x3d, 0x91, 0x53, 0x2c, 0x0f, 0xbc, 0x3f, 0x52, 0xfe, 0xb2, 0x17, 0x7d, 0xc5, 0x7a,
-	0x23, 0x55, 0x7f, 0x40, 0x56, 0xde, 0x19, 0x5d, 0xd6, 0x77, 0x7a, 0xc4, 0x57, 0xfd, 0xd8, 0x86,
-	0x7d, 0x7b, 0x54, 0x4b, 0xfb, 0x36, 0x93, 0x77, 0x33, 0x08, 0xfb, 0x7e, 0xbf, 0x02, 0xf0, 0x6a,
-	0xca, 0xaa, 0xa7, 0x9e, 0xda, 0x8c, 0x41, 0x05, 0xf9, 0xad, 0x8c, 0x0a, 0x89, 0x41, 0xc4, 0xf6,
-	0x91, 0xe1, 0x41, 0x44, 0x15, 0x46, 0x08, 0x22, 0x65, 0x90, 0x7e, 0x0b, 0xe0, 0x7a, 0x1a, 0x1d,
-	0xdd, 0x3d, 0x15, 0x3d, 0x09, 0x1a, 0xf2, 0xdb, 0x59, 0x35, 0xfc, 0x38, 0xbe, 0x84, 0x6b, 0xc9,
-	0xa3, 0x55, 0x19, 0x6a, 0x32, 0x22, 0x2f, 0xbf, 0x99, 0x4d, 0xde, 0x0b, 0xa0, 0x72, 0xef, 0xe9,
-	0x71, 0x09, 0x3c, 0x3b, 0x2e, 0x81, 0xff, 0x8f, 0x4b, 0xe0, 0xbb, 0x93, 0xd2, 0xd4, 0xb3, 0x93,
-	0xd2, 0xd4, 0xbf, 0x27, 0xa5, 0xa9, 0x4f, 0xef, 0x86, 0x68, 0x42, 0xd8, 0xbe, 0x73, 0xa8, 0x37,
-	0xa8, 0xf7, 0x43, 0x3d, 0xda, 0xde, 0x55, 0xbb, 0xfc, 0xb3, 0xaa, 0x4b, 0x1a, 0x8d, 0x69, 0xf7,
-	0x33, 0xe8, 0xee, 0x8b, 0x00, 0x00, 0x00, 0xff, 0xff, 0x66, 0x91, 0x23, 0xe6, 0x73, 0x15, 0x00,
-	0x00,
+	// 1218 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xdb, 0x6f, 0xdb, 0x54,
+	0x18, 0xef, 0x49, 0xb2, 0xae, 0xfd, 0x7a, 0x77, 0x6f, 0xae, 0xbb, 0x25, 0xdd, 0x01, 0x8d, 0x96,
+	0x31, 0x7b, 0x6d, 0x11, 0x43, 0x08, 0x09, 0x08, 0x14, 0x91, 0x8a, 0x28, 0x93, 0xfb, 0x32, 0xf1,
+	0x52, 0x39, 0xad, 0x95, 0x59, 0x6b, 0x7c, 0xa2, 0x1c, 0xbb, 0x64, 0x42, 0x02, 0x89, 0xcb, 0x3b,
+	0x13, 0xe2, 0xf2, 0xc2, 0x2b, 0xe2, 0x9f, 0x80, 0x07, 0x78, 0xd9, 0x0b, 0xd2, 0xde, 0xd8, 0x78,
+	0x08, 0xa8, 0xfd, 0x0f, 0xfa, 0x17, 0x20, 0xdb, 0xc7, 0xd7, 0xd8, 0x75, 0xdd, 0x34, 0xeb, 0x53,
+	0x12, 0x9f, 0xef, 0xfe, 0xfd, 0xce, 0xef, 0x7c, 0xc7, 0x81, 0xeb, 0x84, 0x36, 0x09, 0xd5, 0xa8,
+	0xd4, 0x50, 0x9a, 0x4d, 0xe9, 0x70, 0xbd, 0xae, 0x1a, 0xca, 0xba, 0x64, 0x74, 0xc4, 0x56, 0x9b,
+	0x18, 0x84, 0x9b, 0x63, 0xcb, 0xa2, 0xb5, 0x2c, 0xb2, 0x65, 0x61, 0xae, 0x41, 0x1a, 0xc4, 0x16,
+	0x90, 0xac, 0x6f, 0x8e, 0xac, 0x50, 0xdc, 0xb3, 0x85, 0xa5, 0xba, 0x42, 0x55, 0xcf, 0xd2, 0x1e,
+	0xd1, 0x74, 0x67, 0x1d, 0xff, 0x96, 0x83, 0xb1, 0x2a, 0x6d, 0x6c, 0x13, 0x4d, 0xbf, 0x47, 0xc8,
+	0x01, 0xb7, 0x06, 0xc3, 0x54, 0xd5, 0xf7, 0xd5, 0x36, 0x8f, 0x56, 0xd0, 0xea, 0x68, 0x79, 0xe6,
+	0xa4, 0x5b, 0x9a, 0x78, 0xa4, 0x34, 0x0f, 0xde, 0xc2, 0xce, 0x73, 0x2c, 0x33, 0x01, 0xee, 0x16,
+	0x5c, 0x6d, 0x11, 0x72, 0xb0, 0xab, 0xed, 0xf3, 0xb9, 0x15, 0xb4, 0x5a, 0x28, 0x73, 0x27, 0xdd,
+	0xd2, 0xa4, 0x23, 0xcb, 0x16, 0xb0, 0x3c, 0x6c, 0x7d, 0xab, 0xec, 0x73, 0x6d, 0x98, 0xa6, 0x0f,
+	0x94, 0xb6, 0xba, 0x4b, 0x4c, 0x63, 0x57, 0x69, 0x12, 0x53, 0x37, 0xf8, 0xbc, 0xed, 0xe1, 0xa3,
+	0x27, 0xdd, 0xd2, 0xd0, 0x3f, 0xdd, 0xd2, 0xcd, 0x86, 0x66, 0x3c, 0x30, 0xeb, 0xe2, 0x1e, 0x69,
+	0x4a, 0x2c, 0x68, 0xe7, 0xe3, 0x36, 0xdd, 0x7f, 0x28, 0x19, 0x8f, 0x5a, 0x2a, 0x15, 0x2b, 0xba,
+	0x71, 0xd2, 0x2d, 0x2d, 0x04, 0x7c, 0x38, 0xa6, 0x2c, 0xab, 0x58, 0x9e, 0xb4, 0x3d, 0xd4, 0x4c,
+	0xe3, 0x3d, 0xfb, 0x21, 0x57, 0x87, 0x09, 0x83, 0x3c, 0x54, 0xf5, 0x5d, 0x4d, 0xdf, 0x6d, 0x2a,
+	0x1d, 0xca, 0x17, 0x56, 0xf2, 0xab, 0x63, 0x1b, 0x4b, 0xa2, 0x63, 0x57, 0xb4, 0x6a, 0xe2, 0x96,
+	0x4f, 0x7c, 0x9f, 0x68, 0x7a, 0xf9, 0x25, 0x2b, 0x96, 0x93, 0x6e, 0x69, 0xd9, 0xf1, 0x10, 0xd4,
+	0x66, 0x9e, 0x28, 0x96, 0xc7, 0xec, 0xc7, 0x15, 0xbd, 0xaa, 0x74, 0x28, 0x7e, 0x8e, 0x60, 0x36,
+	0x50, 0x3f, 0x59, 0xa5, 0x2d, 0xa2, 0x53, 0x95, 0xa3, 0x31, 0xf9, 0x3a, 0x15, 0xad, 0x64, 0xce,
+	0x77, 0x91, 0xd5, 0x3f, 0x62, 0xaf, 0x37, 0xe1, 0x2a, 0x8c, 0xb8, 0x21, 0xf3, 0xb9, 0xb4, 0x5c,
+	0x17, 0x59, 0xae, 0x53, 0xe1, 0x5c, 0xb1, 0x7c, 0x95, 0xe5, 0x87, 0x7f, 0x77, 0xb0, 0xb1, 0xd5,
+	0xd1, 0x8c, 0x81, 0x62, 0xa3, 0x05, 0x53, 0x4e, 0x6e, 0x9a, 0x7e, 0x41, 0xd0, 0x88, 0x98, 0xc3,
+	0xf2, 0x84, 0xfd, 0xa4, 0xa2, 0xb3, 0x42, 0xa9, 0x30, 0xe9, 0xe4, 0x6b, 0x55, 0xb3, 0xa9, 0xe9,
+	0x67, 0x80, 0xc6, 0xcb, 0xac, 0x5c, 0xd7, 0x82, 0xe5, 0x62, 0xea, 0x3e, 0x36, 0xc6, 0xed, 0xe7,
+	0x35, 0xd3, 0xa8, 0x6a, 0x3a, 0xc5, 0x0d, 0x98, 0x0d, 0xd4, 0xcf, 0xc3, 0xc6, 0x3d, 0x18, 0xf5,
+	0xd4, 0x79, 0x94, 0xe6, 0x98, 0x67, 0x8e, 0xa7, 0x23, 0x8e, 0xb1, 0x3c, 0xe2, 0x3a, 0xc3, 0x5f,
+	0x23, 0x98, 0xd9, 0xf9, 0x54, 0x69, 0x39, 0xe9, 0x55, 0x74, 0x99, 0x98, 0x86, 0x1a, 0x6c, 0x02,
+	0x4a, 0x6d, 0x42, 0x19, 0xa6, 0xfc, 0x9c, 0xf6, 0x55, 0x9d, 0x34, 0xed, 0xce, 0x8d, 0x96, 0x05,
+	0xbf, 0xac, 0x11, 0x01, 0x2c, 0x4f, 0xb8, 0x11, 0x7c, 0x60, 0xff, 0xfe, 0x3b, 0x07, 0x73, 0x55,
+	0xda, 0xb0, 0x22, 0xd9, 0xea, 0x28, 0x7b, 0x86, 0x1b, 0x4e, 0x16, 0xe4, 0x6c, 0xc1, 0x70, 0xdb,
+	0x8a, 0x9e, 0x32, 0x04, 0xbf, 0x22, 0xc6, 0xb1, 0x9d, 0xd8, 0x93, 0x6d, 0xb9, 0x60, 0xd5, 0x49,
+	0x66, 0xca, 0xa1, 0xad, 0x60, 0x81, 0xa9, 0xbf, 0xad, 0xc0, 0x7d, 0x0e, 0x73, 0x71, 0x1d, 0xe7,
+	0x0b, 0x76, 0x3a, 0xd5, 0xcc, 0x38, 0x5d, 0x4e, 0x46, 0x11, 0x96, 0x67, 0x02, 0x20, 0x72, 0x72,
+	0xc4, 0xdf, 0x21, 0xb8, 0x16, 0x57, 0xd9, 0x20, 0xdf, 0xf8, 0xc6, 0x2e, 0x86, 0x6f, 0xa2, 0xf6,
+	0xb0, 0x3c, 0xe9, 0x06, 0xc6, 0xa2, 0xfa, 0x17, 0xc1, 0x42, 0xb0, 0x11, 0x3b, 0xad, 0x03, 0xcd,
+	0x70, 0xb0, 0xb7, 0x03, 0x57, 0x2c, 0x60, 0x51, 0x1e, 0x65, 0xeb, 0xe2, 0x1c, 0x6b, 0xc5, 0xb8,
+	0x0f, 0x53, 0x8a, 0x65, 0xc7, 0x96, 0x45, 0x14, 0x1e, 0x25, 0xb3, 0x1c, 0x73, 0xfd, 0x11, 0x45,
+	0xc4, 0x9c, 0x8b, 0x68, 0x97, 0x28, 0xf0, 0xb3, 0x1c, 0x14, 0xad, 0xba, 0x7b, 0x89, 0xf5, 0x85,
+	0xed, 0xed, 0x08, 0xb6, 0x5f, 0x4b, 0xaf, 0x8a, 0xef, 0x39, 0x02, 0xf0, 0x77, 0x5c, 0x0a, 0xd3,
+	0x74, 0xb6, 0x5d, 0x1d, 0xce, 0x5c, 0x3a, 0xe9, 0x96, 0xe6, 0x23, 0xc9, 0xb1, 0xdd, 0x3a, 0xce,
+	0x72, 0xb3, 0x37, 0xeb, 0xa5, 0x43, 0xfa, 0x67, 0x04, 0x37, 0x4f, 0x2f, 0xed, 0xe5, 0x82, 0xfb,
+	0x2b, 0x04, 0x9c, 0xdf, 0x89, 0x9a, 0x69, 0x9c, 0x83, 0x54, 0xdf, 0xed, 0x69, 0x52, 0x3a, 0xa7,
+	0x86, 0xba, 0x84, 0x9f, 0xe7, 0x60, 0xbe, 0x77, 0xe3, 0xd7, 0x4c, 0x23, 0x0b, 0xee, 0x3e, 0x8c,
+	0xe0, 0x6e, 0x35, 0x0d, 0x77, 0x35, 0x33, 0x16, 0x73, 0x9f, 0xc1, 0x6c, 0xcc, 0x48, 0xc4, 0x80,
+	0xf7, 0x71, 0xe6, 0x56, 0x08, 0x89, 0x53, 0x16, 0x96, 0xa7, 0xfd, 0x21, 0x8b, 0x9d, 0xd9, 0xa1,
+	0x53, 0xb3, 0xb0, 0x82, 0xfa, 0x3f, 0x35, 0x1f, 0x23, 0xb8, 0x1e, 0x5b, 0x5b, 0x0f, 0x78, 0x31,
+	0x84, 0x83, 0x06, 0x4b, 0x38, 0x7f, 0xe4, 0x60, 0x89, 0xcd, 0x93, 0x4e, 0x5c, 0x86, 0xda, 0xd6,
+	0xcf, 0xc3, 0x35, 0x99, 0x26, 0xb0, 0x8b, 0x3f, 0x2d, 0xfd, 0x61, 0xf5, 0xe2, 0xa8, 0x25, 0xce,
+	0x26, 0x96, 0x67, 0xdc, 0x21, 0xd8, 0xa7, 0x96, 0x9f, 0x10, 0xdc, 0x48, 0x2c, 0xe2, 0xa5, 0x8e,
+	0xe8, 0xf8, 0x97, 0x7c, 0xa8, 0xbf, 0x3b, 0xd6, 0xea, 0xb9, 0xf6, 0x74, 0xa6, 0xfe, 0xf6, 0x7d,
+	0x58, 0xc4, 0xd5, 0xaa, 0x30, 0xe8, 0xeb, 0x4c, 0x02, 0xdd, 0x5c, 0x79, 0x11, 0x74, 0x83, 0xbf,
+	0x0f, 0x63, 0x28, 0xdc, 0xa8, 0x4b, 0x24, 0x88, 0x5f, 0xf3, 0xc0, 0xb3, 0x4b, 0x45, 0x24, 0xae,
+	0x01, 0xf2, 0x43, 0xcc, 0xe5, 0x20, 0x9f, 0xf1, 0x72, 0x10, 0x77, 0xcb, 0x2b, 0x0c, 0xf6, 0x96,
+	0x97, 0x34, 0xe1, 0x5c, 0x79, 0x41, 0x13, 0xce, 0x8f, 0x08, 0x56, 0x92, 0x5a, 0x75, 0xb9, 0xb3,
+	0xcd, 0x9f, 0x39, 0x10, 0x02, 0x91, 0x05, 0x09, 0x72, 0x90, 0x34, 0x14, 0x3a, 0xc2, 0xf3, 0x17,
+	0x70, 0x84, 0x5b, 0x14, 0xe1, 0xa1, 0x20, 0x40, 0x11, 0x85, 0xfe, 0x28, 0x22, 0xc6, 0x24, 0x96,
+	0xa7, 0x19, 0xb8, 0x7c, 0x8a, 0xf8, 0x01, 0x01, 0x4e, 0xae, 0x62, 0x90, 0x23, 0xa2, 0xc0, 0x47,
+	0x03, 0x05, 0xfe, 0xc6, 0x5f, 0x23, 0x90, 0xaf, 0xd2, 0x06, 0x77, 0x1f, 0x46, 0xbc, 0x17, 0x7b,
+	0x37, 0xe2, 0x67, 0xbe, 0xc0, 0xbb, 0x2b, 0x61, 0x2d, 0x55, 0xc4, 0xcb, 0xe9, 0x3e, 0x8c, 0x78,
+	0xaf, 0x85, 0x92, 0x2d, 0xbb, 0x22, 0xc2, 0x5a, 0xaa, 0x48, 0x60, 0x3f, 0xcc, 0xf4, 0xde, 0xb1,
+	0x5e, 0x4d, 0xd4, 0xef, 0x91, 0x15, 0x36, 0xce, 0x2e, 0xeb, 0x39, 0x3d, 0x04, 0x2e, 0xb2, 0x68,
+	0x81, 0xeb, 0xd6, 0x59, 0x2d, 0xd5, 0x4c, 0x43, 0xd8, 0xcc, 0x20, 0xec, 0xf9, 0xfd, 0x12, 0xc1,
+	0x42, 0xc2, 0xa8, 0x27, 0x9d, 0xda, 0x8c, 0x5e, 0x05, 0xe1, 0x6e, 0x46, 0x85, 0xd8, 0x20, 0x22,
+	0xf3, 0x48, 0x7a, 0x10, 0x61, 0x05, 0xe1, 0x6e, 0x46, 0x05, 0x2f, 0x88, 0x6f, 0x10, 0x2c, 0x26,
+	0xd1, 0xd1, 0x9d, 0x53, 0xd1, 0x13, 0xa3, 0x21, 0xbc, 0x99, 0x55, 0xc3, 0x8b, 0xe3, 0x0b, 0x98,
+	0x8f, 0x3f, 0x5a, 0xc5, 0x54, 0x93, 0x21, 0x79, 0xe1, 0x8d, 0x6c, 0xf2, 0x5e, 0x00, 0x8f, 0x11,
+	0x2c, 0x9f, 0xf6, 0xba, 0xe1, 0xf5, 0x64, 0x9c, 0x25, 0x6b, 0x09, 0x6f, 0x9f, 0x47, 0xcb, 0x8d,
+	0xa9, 0xbc, 0xfd, 0xe4, 0xa8, 0x88, 0x9e, 0x1e, 0x15, 0xd1, 0x7f, 0x47, 0x45, 0xf4, 0xed, 0x71,
+	0x71, 0xe8, 0xe9, 0x71, 0x71, 0xe8, 0xd9, 0x71, 0x71, 0xe8, 0x93, 0x3b, 0x01, 0xea, 0x62, 0x1e,
+	0x6e, 0x1f, 0x28, 0x75, 0xea, 0xfe, 0x90, 0x0e, 0xd7, 0x37, 0xa5, 0x8e, 0xf3, 0x3f, 0x86, 0x4d,
+	0x64, 0xf5, 0x61, 0xfb, 0x7f, 0x87, 0xcd, 0xff, 0x07, 0x00, 0xb9, 0x54, 0x67, 0x19, 0xe4, 0x18,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1063,6 +1223,7 @@ type MsgClient interface {
 	JoinSwapShareAmountOut(ctx context.Context, in *MsgJoinSwapShareAmountOut, opts ...grpc.CallOption) (*MsgJoinSwapShareAmountOutResponse, error)
 	ExitSwapExternAmountOut(ctx context.Context, in *MsgExitSwapExternAmountOut, opts ...grpc.CallOption) (*MsgExitSwapExternAmountOutResponse, error)
 	ExitSwapShareAmountIn(ctx context.Context, in *MsgExitSwapShareAmountIn, opts ...grpc.CallOption) (*MsgExitSwapShareAmountInResponse, error)
+	SplitRouteSwapExactAmountIn(ctx context.Context, in *MsgSplitRouteSwapExactAmountIn, opts ...grpc.CallOption) (*MsgSplitRouteSwapExactAmountInResponse, error)
 }
 
 type msgClient struct {
@@ -1145,6 +1306,15 @@ func (c *msgClient) ExitSwapShareAmountIn(ctx context.Context, in *MsgExitSwapSh
 	return out, nil
 }
 
+func (c *msgClient) SplitRouteSwapExactAmountIn(ctx context.Context, in *MsgSplitRouteSwapExactAmountIn, opts ...grpc.CallOption) (*MsgSplitRouteSwapExactAmountInResponse, error) {
+	out := new(MsgSplitRouteSwapExactAmountInResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Msg/SplitRouteSwapExactAmountIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	JoinPool(context.Context, *MsgJoinPool) (*MsgJoinPoolResponse, error)
@@ -1155,6 +1325,7 @@ type MsgServer interface {
 	JoinSwapShareAmountOut(context.Context, *MsgJoinSwapShareAmountOut) (*MsgJoinSwapShareAmountOutResponse, error)
 	ExitSwapExternAmountOut(context.Context, *MsgExitSwapExternAmountOut) (*MsgExitSwapExternAmountOutResponse, error)
 	ExitSwapShareAmountIn(context.Context, *MsgExitSwapShareAmountIn) (*MsgExitSwapShareAmountInResponse, error)
+	SplitRouteSwapExactAmountIn(context.Context, *MsgSplitRouteSwapExactAmountIn) (*MsgSplitRouteSwapExactAmountInResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1185,6 +1356,9 @@ func (*UnimplementedMsgServer) ExitSwapExternAmountOut(ctx context.Context, req
 func (*UnimplementedMsgServer) ExitSwapShareAmountIn(ctx context.Context, req *MsgExitSwapShareAmountIn) (*MsgExitSwapShareAmountInResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExitSwapShareAmountIn not implemented")
 }
+func (*UnimplementedMsgServer) SplitRouteSwapExactAmountIn(ctx context.Context, req *MsgSplitRouteSwapExactAmountIn) (*MsgSplitRouteSwapExactAmountInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitRouteSwapExactAmountIn not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1334,6 +1508,24 @@ func _Msg_ExitSwapShareAmountIn_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SplitRouteSwapExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSplitRouteSwapExactAmountIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SplitRouteSwapExactAmountIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Msg/SplitRouteSwapExactAmountIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SplitRouteSwapExactAmountIn(ctx, req.(*MsgSplitRouteSwapExactAmountIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1370,6 +1562,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExitSwapShareAmountIn",
 			Handler:    _Msg_ExitSwapShareAmountIn_Handler,
 		},
+		{
+			MethodName: "SplitRouteSwapExactAmountIn",
+			Handler:    _Msg_SplitRouteSwapExactAmountIn_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/v1beta1/tx.proto",
@@ -1709,7 +1905,7 @@ func (m *MsgSwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *SwapAmountOutRoute) Marshal() (dAtA []byte, err error) {
+func (m *SwapAmountInSplitRoute) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1719,32 +1915,44 @@ func (m *SwapAmountOutRoute) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SwapAmountOutRoute) MarshalTo(dAtA []byte) (int, error) {
+func (m *SwapAmountInSplitRoute) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SwapAmountOutRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SwapAmountInSplitRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TokenInDenom) > 0 {
-		i -= len(m.TokenInDenom)
-		copy(dAtA[i:], m.TokenInDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenInDenom)))
-		i--
-		dAtA[i] = 0x12
+	{
+		size := m.TokenInAmount.Size()
+		i -= size
+		if _, err := m.TokenInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	i--
+	dAtA[i] = 0x12
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSwapExactAmountOut) Marshal() (dAtA []byte, err error) {
+func (m *MsgSplitRouteSwapExactAmountIn) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1754,36 +1962,33 @@ func (m *MsgSwapExactAmountOut) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSwapExactAmountOut) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSplitRouteSwapExactAmountIn) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSwapExactAmountOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSplitRouteSwapExactAmountIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.TokenOutMinAmount.Size()
+		i -= size
+		if _, err := m.TokenOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x22
-	{
-		size := m.TokenInMaxAmount.Size()
-		i -= size
-		if _, err := m.TokenInMaxAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	i--
-	dAtA[i] = 0x1a
 	if len(m.Routes) > 0 {
 		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1808,7 +2013,7 @@ func (m *MsgSwapExactAmountOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSwapExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSplitRouteSwapExactAmountInResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1818,20 +2023,20 @@ func (m *MsgSwapExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSwapExactAmountOutResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSplitRouteSwapExactAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSplitRouteSwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.TokenInAmount.Size()
+		size := m.TokenOutAmount.Size()
 		i -= size
-		if _, err := m.TokenInAmount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
@@ -1841,7 +2046,7 @@ func (m *MsgSwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgJoinSwapExternAmountIn) Marshal() (dAtA []byte, err error) {
+func (m *SwapAmountOutRoute) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1851,52 +2056,32 @@ func (m *MsgJoinSwapExternAmountIn) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgJoinSwapExternAmountIn) MarshalTo(dAtA []byte) (int, error) {
+func (m *SwapAmountOutRoute) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgJoinSwapExternAmountIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SwapAmountOutRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.ShareOutMinAmount.Size()
-		i -= size
-		if _, err := m.ShareOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x1a
 	if m.PoolId != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
 		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgJoinSwapExternAmountInResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSwapExactAmountOut) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1906,27 +2091,179 @@ func (m *MsgJoinSwapExternAmountInResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgJoinSwapExternAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSwapExactAmountOut) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgJoinSwapExternAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSwapExactAmountOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.ShareOutAmount.Size()
+		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
 		i -= size
-		if _, err := m.ShareOutAmount.MarshalTo(dAtA[i:]); err != nil {
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TokenInMaxAmount.Size()
+		i -= size
+		if _, err := m.TokenInMaxAmount.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	dAtA[i] = 0x1a
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapExactAmountOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenInAmount.Size()
+		i -= size
+		if _, err := m.TokenInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgJoinSwapExternAmountIn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgJoinSwapExternAmountIn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgJoinSwapExternAmountIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ShareOutMinAmount.Size()
+		i -= size
+		if _, err := m.ShareOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgJoinSwapExternAmountInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgJoinSwapExternAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgJoinSwapExternAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ShareOutAmount.Size()
+		i -= size
+		if _, err := m.ShareOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *MsgJoinSwapShareAmountOut) Marshal() (dAtA []byte, err error) {
@@ -2348,6 +2685,59 @@ func (m *MsgSwapExactAmountInResponse) Size() (n int) {
 	return n
 }
 
+func (m *SwapAmountInSplitRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.TokenInAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSplitRouteSwapExactAmountIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.TokenInDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TokenOutMinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSplitRouteSwapExactAmountInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
 func (m *SwapAmountOutRoute) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3444,6 +3834,390 @@ func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SwapAmountInSplitRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapAmountInSplitRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapAmountInSplitRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, SwapAmountInRoute{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapAmountInSplitRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SwapAmountOutRoute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -16,6 +16,12 @@ const (
 	FlagOwner     = "owner"
 	FlagLockIds   = "lock-ids"
 	FlagEndEpoch  = "end-epoch"
+
+	FlagWeights         = "weights"
+	FlagSplittingPolicy = "splitting-policy"
+	FlagRecipient       = "recipient"
+	FlagTraderVolume    = "trader-volume"
+	FlagBoostCurve      = "boost-curve"
 )
 
 // FlagSetCreateGauge returns flags for creating gauges.
@@ -27,5 +33,35 @@ func FlagSetCreateGauge() *flag.FlagSet {
 	fs.String(FlagStartTime, "", "Timestamp to begin distribution")
 	fs.Uint64(FlagEpochs, 0, "Total epochs to distribute tokens")
 	fs.Bool(FlagPerpetual, false, "Perpetual distribution")
+	fs.Bool(FlagTraderVolume, false, "Distribute to the traders of the pool of the provided share denom by their swap volume, instead of to locks")
+	fs.StringSlice(FlagBoostCurve, []string{}, "Reward multipliers of locks of at least a duration, as duration=multiplier pairs in increasing duration, e.g. 168h=1.5,336h=2")
+	return fs
+}
+
+// FlagSetCreateGroupGauge returns flags for creating group gauges.
+func FlagSetCreateGroupGauge() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagStartTime, "", "Timestamp to begin distribution")
+	fs.Uint64(FlagEpochs, 0, "Total epochs to distribute tokens")
+	fs.Bool(FlagPerpetual, false, "Perpetual distribution")
+	fs.StringSlice(FlagWeights, []string{}, "Weights of the underlying gauges, in order, for the static splitting policy")
+	fs.String(FlagSplittingPolicy, "static", "How rewards are split among the underlying gauges: static (by weights) or volume (by pool swap fees)")
+	return fs
+}
+
+// FlagSetCancelGauge returns flags for cancelling gauges.
+func FlagSetCancelGauge() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagRecipient, "", "Address receiving the undistributed coins, defaults to the gauge owner")
+	return fs
+}
+
+// FlagSetClaimRewards returns flags for claiming rewards.
+func FlagSetClaimRewards() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagLockIds, "", "Comma separated IDs of the locks to claim the rewards of, defaults to all of the sender's locks")
 	return fs
 }
@@ -31,6 +31,12 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdUpcomingGauges(),
 		GetCmdUpcomingGaugesPerDenom(),
 		GetCmdRewardsEst(),
+		GetCmdGroupGaugeByID(),
+		GetCmdGroupGauges(),
+		GetCmdLockRewardsBreakdown(),
+		GetCmdAccountRewardsBreakdown(),
+		osmocli.GetParams[*types.QueryParamsRequest](
+			types.ModuleName, types.NewQueryClient),
 	)
 
 	return cmd
@@ -66,6 +72,46 @@ func GetCmdGaugeByID() *cobra.Command {
 `, types.ModuleName, types.NewQueryClient)
 }
 
+// GetCmdGroupGaugeByID returns a group gauge by ID.
+func GetCmdGroupGaugeByID() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.GroupGaugeByIDRequest](
+		"group-gauge-by-id [id]",
+		"Query group gauge by id.",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} group-gauge-by-id 1
+`, types.ModuleName, types.NewQueryClient)
+}
+
+// GetCmdGroupGauges returns all group gauges.
+func GetCmdGroupGauges() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.GroupGaugesRequest](
+		"group-gauges",
+		"Query group gauges",
+		`{{.Short}}`,
+		types.ModuleName, types.NewQueryClient,
+	)
+}
+
+// GetCmdLockRewardsBreakdown returns the estimated next distribution rewards of a lock, by gauge.
+func GetCmdLockRewardsBreakdown() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.LockRewardsBreakdownRequest](
+		"lock-rewards-breakdown [lock_id]",
+		"Query the rewards a lock is estimated to receive in the next distribution, by gauge",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} lock-rewards-breakdown 1
+`, types.ModuleName, types.NewQueryClient)
+}
+
+// GetCmdAccountRewardsBreakdown returns the estimated next distribution rewards of an account's locks, by gauge.
+func GetCmdAccountRewardsBreakdown() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.AccountRewardsBreakdownRequest](
+		"account-rewards-breakdown [address]",
+		"Query the rewards the locks of an account are estimated to receive in the next distribution, by gauge",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} account-rewards-breakdown osmo1...
+`, types.ModuleName, types.NewQueryClient)
+}
+
 // GetCmdActiveGauges returns active gauges.
 func GetCmdActiveGauges() *cobra.Command {
 	return osmocli.SimpleQueryCmd[*types.ActiveGaugesRequest](
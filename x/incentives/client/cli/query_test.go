@@ -61,6 +61,24 @@ func (s *QueryTestSuite) TestQueriesNeverAlterState() {
 			&types.GaugesRequest{},
 			&types.GaugesResponse{},
 		},
+		{
+			"Query group gauges",
+			"/osmosis.incentives.Query/GroupGauges",
+			&types.GroupGaugesRequest{},
+			&types.GroupGaugesResponse{},
+		},
+		{
+			"Query lock rewards breakdown",
+			"/osmosis.incentives.Query/LockRewardsBreakdown",
+			&types.LockRewardsBreakdownRequest{LockId: 1},
+			&types.LockRewardsBreakdownResponse{},
+		},
+		{
+			"Query account rewards breakdown",
+			"/osmosis.incentives.Query/AccountRewardsBreakdown",
+			&types.AccountRewardsBreakdownRequest{Owner: s.TestAccs[0].String()},
+			&types.AccountRewardsBreakdownResponse{},
+		},
 		{
 			"Query lockable durations",
 			"/osmosis.incentives.Query/LockableDurations",
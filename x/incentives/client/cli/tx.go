@@ -2,11 +2,15 @@ package cli
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
+	"github.com/osmosis-labs/osmosis/v13/osmoutils"
 	"github.com/osmosis-labs/osmosis/v13/osmoutils/osmocli"
 	"github.com/osmosis-labs/osmosis/v13/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
@@ -23,6 +27,9 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		NewCreateGaugeCmd(),
 		NewAddToGaugeCmd(),
+		NewCreateGroupGaugeCmd(),
+		NewCancelGaugeCmd(),
+		NewClaimRewardsCmd(),
 	)
 
 	return cmd
@@ -33,6 +40,7 @@ func NewCreateGaugeCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create-gauge [lockup_denom] [reward] [flags]",
 		Short: "create a gauge to distribute rewards to users",
+		Long:  "create a gauge to distribute rewards to users. with --trader-volume, lockup_denom must be a pool share denom and the rewards are distributed to the pool's traders by their swap volume. with --boost-curve, the rewards of longer locks are multiplied by the multiplier of the longest curve duration they reach",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -48,47 +56,38 @@ func NewCreateGaugeCmd() *cobra.Command {
 				return err
 			}
 
-			var startTime time.Time
-			timeStr, err := cmd.Flags().GetString(FlagStartTime)
+			startTime, epochs, err := parseGaugeSchedule(cmd)
 			if err != nil {
 				return err
 			}
-			if timeStr == "" { // empty start time
-				startTime = time.Unix(0, 0)
-			} else if timeUnix, err := strconv.ParseInt(timeStr, 10, 64); err == nil { // unix time
-				startTime = time.Unix(timeUnix, 0)
-			} else if timeRFC, err := time.Parse(time.RFC3339, timeStr); err == nil { // RFC time
-				startTime = timeRFC
-			} else { // invalid input
-				return errors.New("invalid start time format")
-			}
 
-			epochs, err := cmd.Flags().GetUint64(FlagEpochs)
+			duration, err := cmd.Flags().GetDuration(FlagDuration)
 			if err != nil {
 				return err
 			}
 
-			perpetual, err := cmd.Flags().GetBool(FlagPerpetual)
+			distributeTo := lockuptypes.QueryCondition{
+				LockQueryType: lockuptypes.ByDuration,
+				Denom:         denom,
+				Duration:      duration,
+				Timestamp:     time.Unix(0, 0), // XXX check
+			}
+
+			traderVolume, err := cmd.Flags().GetBool(FlagTraderVolume)
 			if err != nil {
 				return err
 			}
-
-			if perpetual {
-				epochs = 1
+			if traderVolume {
+				// the rewards go to the traders of the pool of the provided share denom, regardless of locks.
+				distributeTo.LockQueryType = lockuptypes.ByTraderVolume
+				distributeTo.Duration = 0
 			}
 
-			duration, err := cmd.Flags().GetDuration(FlagDuration)
+			boostCurve, err := parseBoostCurve(cmd)
 			if err != nil {
 				return err
 			}
 
-			distributeTo := lockuptypes.QueryCondition{
-				LockQueryType: lockuptypes.ByDuration,
-				Denom:         denom,
-				Duration:      duration,
-				Timestamp:     time.Unix(0, 0), // XXX check
-			}
-
 			msg := types.NewMsgCreateGauge(
 				epochs == 1,
 				clientCtx.GetFromAddress(),
@@ -97,6 +96,7 @@ func NewCreateGaugeCmd() *cobra.Command {
 				startTime,
 				epochs,
 			)
+			msg.BoostCurve = boostCurve
 
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
 		},
@@ -113,3 +113,200 @@ func NewAddToGaugeCmd() *cobra.Command {
 		Short: "add coins to gauge to distribute more rewards to users",
 	})
 }
+
+// NewCancelGaugeCmd broadcasts a CancelGauge message.
+func NewCancelGaugeCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgCancelGauge](&osmocli.TxCliDesc{
+		Use:     "cancel-gauge [gauge_id] [flags]",
+		Short:   "cancel an upcoming or active gauge and refund its undistributed coins",
+		Long:    "cancel an upcoming or active gauge and refund its undistributed coins. if no recipient is provided, the owner is refunded. gauges that received coins from others than their owner can not be cancelled",
+		Example: "osmosisd tx incentives cancel-gauge 1 --recipient osmo1...",
+		CustomFlagOverrides: map[string]string{
+			"recipient": FlagRecipient,
+		},
+		Flags: osmocli.FlagDesc{OptionalFlags: []*pflag.FlagSet{FlagSetCancelGauge()}},
+	})
+}
+
+// NewClaimRewardsCmd broadcasts a ClaimRewards message.
+func NewClaimRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "claim-rewards [flags]",
+		Short:   "claim the pending incentive rewards of your locks",
+		Long:    "claim the pending incentive rewards of your locks. if no lock ids are provided, the rewards of all your locks are claimed",
+		Example: "osmosisd tx incentives claim-rewards --lock-ids 1,2",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			lockIdsCombined, err := cmd.Flags().GetString(FlagLockIds)
+			if err != nil {
+				return err
+			}
+			lockIds := []uint64{}
+			if lockIdsCombined != "" {
+				lockIds, err = osmoutils.ParseUint64SliceFromString(lockIdsCombined, ",")
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgClaimRewards(clientCtx.GetFromAddress(), lockIds)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetClaimRewards())
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCreateGroupGaugeCmd broadcasts a CreateGroupGauge message.
+func NewCreateGroupGaugeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-group-gauge [gauge_ids] [reward] [flags]",
+		Short: "create a group gauge to forward rewards to other gauges each epoch, split by weight or pool swap fees",
+		Example: `osmosisd tx incentives create-group-gauge 1,2,3 1000uosmo --weights 1,1,2 --epochs 10
+osmosisd tx incentives create-group-gauge 1,2,3 1000uosmo --splitting-policy volume --perpetual`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			gaugeIds, err := osmoutils.ParseUint64SliceFromString(args[0], ",")
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			startTime, epochs, err := parseGaugeSchedule(cmd)
+			if err != nil {
+				return err
+			}
+
+			splittingPolicy, err := parseSplittingPolicy(cmd)
+			if err != nil {
+				return err
+			}
+
+			weightStrs, err := cmd.Flags().GetStringSlice(FlagWeights)
+			if err != nil {
+				return err
+			}
+			weights := make([]sdk.Int, 0, len(weightStrs))
+			for _, weightStr := range weightStrs {
+				weight, ok := sdk.NewIntFromString(weightStr)
+				if !ok {
+					return fmt.Errorf("invalid weight: %s", weightStr)
+				}
+				weights = append(weights, weight)
+			}
+
+			msg := types.NewMsgCreateGroupGauge(
+				epochs == 1,
+				clientCtx.GetFromAddress(),
+				coins,
+				startTime,
+				epochs,
+				gaugeIds,
+				weights,
+				splittingPolicy,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetCreateGroupGauge())
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseGaugeSchedule returns the start time and number of epochs of a gauge from the command's flags.
+// Perpetual gauges are paid over a single epoch.
+func parseGaugeSchedule(cmd *cobra.Command) (time.Time, uint64, error) {
+	var startTime time.Time
+	timeStr, err := cmd.Flags().GetString(FlagStartTime)
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+	if timeStr == "" { // empty start time
+		startTime = time.Unix(0, 0)
+	} else if timeUnix, err := strconv.ParseInt(timeStr, 10, 64); err == nil { // unix time
+		startTime = time.Unix(timeUnix, 0)
+	} else if timeRFC, err := time.Parse(time.RFC3339, timeStr); err == nil { // RFC time
+		startTime = timeRFC
+	} else { // invalid input
+		return time.Time{}, 0, errors.New("invalid start time format")
+	}
+
+	epochs, err := cmd.Flags().GetUint64(FlagEpochs)
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+
+	perpetual, err := cmd.Flags().GetBool(FlagPerpetual)
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+
+	if perpetual {
+		epochs = 1
+	}
+	return startTime, epochs, nil
+}
+
+// parseSplittingPolicy returns the group gauge splitting policy from the command's flags.
+func parseSplittingPolicy(cmd *cobra.Command) (types.SplittingPolicy, error) {
+	policyStr, err := cmd.Flags().GetString(FlagSplittingPolicy)
+	if err != nil {
+		return 0, err
+	}
+	switch policyStr {
+	case "static":
+		return types.ByStaticWeight, nil
+	case "volume":
+		return types.ByVolume, nil
+	default:
+		return 0, fmt.Errorf("invalid splitting policy %s, expected static or volume", policyStr)
+	}
+}
+
+// parseBoostCurve returns the gauge boost curve from the command's duration=multiplier flag pairs.
+func parseBoostCurve(cmd *cobra.Command) ([]types.BoostPoint, error) {
+	pointStrs, err := cmd.Flags().GetStringSlice(FlagBoostCurve)
+	if err != nil {
+		return nil, err
+	}
+	boostCurve := make([]types.BoostPoint, 0, len(pointStrs))
+	for _, pointStr := range pointStrs {
+		durationStr, multiplierStr, ok := strings.Cut(pointStr, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid boost curve point %s, expected duration=multiplier", pointStr)
+		}
+		duration, err := time.ParseDuration(durationStr)
+		if err != nil {
+			return nil, err
+		}
+		multiplier, err := sdk.NewDecFromStr(multiplierStr)
+		if err != nil {
+			return nil, err
+		}
+		boostCurve = append(boostCurve, types.BoostPoint{Duration: duration, Multiplier: multiplier})
+	}
+	return boostCurve, nil
+}
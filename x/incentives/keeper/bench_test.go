@@ -69,7 +69,8 @@ func genQueryCondition(
 }
 
 // benchmarkDistributionLogic creates gauges with lockups that get distributed to. Benchmarks the performance of the distribution process.
-func benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs int, b *testing.B) {
+// If useRewardAccumulators is set, the gauges accrue their rewards in the reward accumulators instead of sending them to every lock.
+func benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs int, useRewardAccumulators bool, b *testing.B) {
 	b.StopTimer()
 
 	blockStartTime := time.Now().UTC()
@@ -79,6 +80,10 @@ func benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numD
 
 	r := rand.New(rand.NewSource(10))
 
+	params := app.IncentivesKeeper.GetParams(ctx)
+	params.UseRewardAccumulators = useRewardAccumulators
+	app.IncentivesKeeper.SetParams(ctx, params)
+
 	// setup accounts with balances
 	addrs := []sdk.AccAddress{}
 	for i := 0; i < numAccts; i++ {
@@ -175,7 +180,7 @@ func BenchmarkDistributionLogicTiny(b *testing.B) {
 	numGauges := 1
 	numLockups := 1
 	numDistrs := 1
-	benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs, b)
+	benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs, false, b)
 }
 
 func BenchmarkDistributionLogicSmall(b *testing.B) {
@@ -184,7 +189,7 @@ func BenchmarkDistributionLogicSmall(b *testing.B) {
 	numGauges := 10
 	numLockups := 1000
 	numDistrs := 100
-	benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs, b)
+	benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs, false, b)
 }
 
 func BenchmarkDistributionLogicMedium(b *testing.B) {
@@ -194,7 +199,7 @@ func BenchmarkDistributionLogicMedium(b *testing.B) {
 	numLockups := 20000
 	numDistrs := 1
 
-	benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs, b)
+	benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs, false, b)
 }
 
 func BenchmarkDistributionLogicLarge(b *testing.B) {
@@ -204,7 +209,7 @@ func BenchmarkDistributionLogicLarge(b *testing.B) {
 	numLockups := 100000
 	numDistrs := 1
 
-	benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs, b)
+	benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs, false, b)
 }
 
 func BenchmarkDistributionLogicHuge(b *testing.B) {
@@ -213,5 +218,24 @@ func BenchmarkDistributionLogicHuge(b *testing.B) {
 	numGauges := 1000
 	numLockups := 1000
 	numDistrs := 30000
-	benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs, b)
+	benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs, false, b)
+}
+
+func BenchmarkDistributionLogicSmallAccumulators(b *testing.B) {
+	numAccts := 10
+	numDenoms := 1
+	numGauges := 10
+	numLockups := 1000
+	numDistrs := 100
+	benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs, true, b)
+}
+
+func BenchmarkDistributionLogicMediumAccumulators(b *testing.B) {
+	numAccts := 1000
+	numDenoms := 8
+	numGauges := 30
+	numLockups := 20000
+	numDistrs := 1
+
+	benchmarkDistributionLogic(numAccts, numDenoms, numGauges, numLockups, numDistrs, true, b)
 }
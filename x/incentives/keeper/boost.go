@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/osmosis-labs/osmosis/v13/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// getBoostedLockSum returns the sum of the boosted amounts of all locks a boosted gauge distributes to.
+func (k Keeper) getBoostedLockSum(ctx sdk.Context, gauge types.Gauge) sdk.Dec {
+	lockSum, unlockingLocks := k.getNotUnlockingBoostedLockSum(ctx, gauge)
+	for _, lock := range unlockingLocks {
+		lockSum = lockSum.Add(getBoostedLockAmount(ctx, gauge, lock))
+	}
+	return lockSum
+}
+
+// getNotUnlockingBoostedLockSum returns the sum of the boosted amounts of the locks a boosted gauge distributes to
+// that are not unlocking, along with the unlocking locks it distributes to.
+// Rather than iterating the locks that are not unlocking, it is computed from the lockup accumulation store: every
+// lock of at least the gauge duration counts its amount times the multiplier at the gauge duration, plus its amount
+// times the increase of the multiplier at every further curve point its duration reaches. The unlocking locks, which
+// the accumulation store counts by their durations, are then taken out.
+func (k Keeper) getNotUnlockingBoostedLockSum(ctx sdk.Context, gauge types.Gauge) (sdk.Dec, []lockuptypes.PeriodLock) {
+	distrTo := gauge.DistributeTo
+	multiplier := gauge.BoostMultiplier(distrTo.Duration)
+	lockSum := k.lk.GetPeriodLocksAccumulation(ctx, distrTo).ToDec().Mul(multiplier)
+	for _, point := range gauge.BoostCurve {
+		if point.Duration <= distrTo.Duration {
+			continue
+		}
+		amountLocked := k.lk.GetPeriodLocksAccumulation(ctx, lockuptypes.QueryCondition{
+			LockQueryType: lockuptypes.ByDuration,
+			Denom:         distrTo.Denom,
+			Duration:      point.Duration,
+		})
+		lockSum = lockSum.Add(amountLocked.ToDec().Mul(point.Multiplier.Sub(multiplier)))
+		multiplier = point.Multiplier
+	}
+
+	unlockingLocks := k.lk.GetLocksLongerThanDurationDenomUnlockingOnly(ctx, distrTo.Denom, distrTo.Duration)
+	for _, lock := range unlockingLocks {
+		denomLockAmt := lock.Coins.AmountOfNoDenomValidation(distrTo.Denom)
+		lockSum = lockSum.Sub(denomLockAmt.ToDec().Mul(gauge.BoostMultiplier(lock.Duration)))
+	}
+	return lockSum, unlockingLocks
+}
+
+// getLockBoostMultiplier returns the gauge's boost multiplier for the lock. The boost of an unlocking lock decays,
+// so its multiplier is that of its remaining duration rather than of its lock duration.
+func getLockBoostMultiplier(ctx sdk.Context, gauge types.Gauge, lock lockuptypes.PeriodLock) sdk.Dec {
+	if lock.IsUnlocking() {
+		return gauge.BoostMultiplier(lock.EndTime.Sub(ctx.BlockTime()))
+	}
+	return gauge.BoostMultiplier(lock.Duration)
+}
+
+// getBoostedLockAmount returns the amount of the gauge's denom in the lock, multiplied by the gauge's
+// boost multiplier for the lock.
+func getBoostedLockAmount(ctx sdk.Context, gauge types.Gauge, lock lockuptypes.PeriodLock) sdk.Dec {
+	denomLockAmt := lock.Coins.AmountOfNoDenomValidation(gauge.DistributeTo.Denom)
+	return denomLockAmt.ToDec().Mul(getLockBoostMultiplier(ctx, gauge, lock))
+}
+
+// distributeBoostedInternal runs the distribution logic for a boosted gauge, and adds the sends to
+// the distrInfo struct. It also updates the gauge for the distribution.
+// Each lock's share is its amount weighted by the gauge's boost multiplier for the lock.
+// Locks is expected to be the correct set of lock recipients for this gauge.
+func (k Keeper) distributeBoostedInternal(
+	ctx sdk.Context, gauge types.Gauge, locks []lockuptypes.PeriodLock, distrInfo *distributionInfo,
+) (sdk.Coins, error) {
+	totalDistrCoins := sdk.NewCoins()
+	lockSum := k.getBoostedLockSum(ctx, gauge)
+	if !lockSum.IsPositive() {
+		return nil, nil
+	}
+
+	remainCoins := gauge.Coins.Sub(gauge.DistributedCoins)
+	remainEpochs := gauge.RemainEpochs()
+	epochLockSum := lockSum.MulInt64(int64(remainEpochs))
+
+	for _, lock := range locks {
+		boostedLockAmt := getBoostedLockAmount(ctx, gauge, lock)
+		distrCoins := sdk.Coins{}
+		for _, coin := range remainCoins {
+			// distribution amount = gauge_size * boosted_lock_amount / (total_boosted_lock_amount * remain_epochs)
+			amt := coin.Amount.ToDec().Mul(boostedLockAmt).QuoTruncate(epochLockSum).TruncateInt()
+			if amt.IsPositive() {
+				distrCoins = distrCoins.Add(sdk.Coin{Denom: coin.Denom, Amount: amt})
+			}
+		}
+		if distrCoins.Empty() {
+			continue
+		}
+		if err := distrInfo.addLockRewards(lock.Owner, lock.RewardReceiverAddress, distrCoins); err != nil {
+			return nil, err
+		}
+		totalDistrCoins = totalDistrCoins.Add(distrCoins...)
+	}
+
+	err := k.updateGaugePostDistribute(ctx, gauge, totalDistrCoins)
+	return totalDistrCoins, err
+}
+
+// accrueBoostedGaugeRewards accrues the boosted gauge's coins for this epoch into the boost rewards per share of the
+// reward accumulators of the gauge's denom, to be claimed by the locks of at least the gauge duration that are not
+// unlocking, and adds the sends to the unlocking locks to the distrInfo struct. It also updates the gauge.
+// Each boosted share accrues at the gauge duration times the multiplier at the gauge duration, and at every further
+// curve point times the increase of the multiplier there, so that a lock accrues its amount times its multiplier.
+// Like accrueGaugeRewards, the epoch is not filled if nothing is accrued or sent, so that the coins carry over.
+// Returns the accrued and sent coins.
+func (k Keeper) accrueBoostedGaugeRewards(ctx sdk.Context, gauge types.Gauge, distrInfo *distributionInfo) (sdk.Coins, error) {
+	notUnlockingLockSum, unlockingLocks := k.getNotUnlockingBoostedLockSum(ctx, gauge)
+	lockSum := notUnlockingLockSum
+	for _, lock := range unlockingLocks {
+		lockSum = lockSum.Add(getBoostedLockAmount(ctx, gauge, lock))
+	}
+	if !lockSum.IsPositive() {
+		return nil, nil
+	}
+
+	remainCoins := gauge.Coins.Sub(gauge.DistributedCoins)
+	remainEpochs := gauge.RemainEpochs()
+
+	rewardsPerShare := sdk.DecCoins{}
+	for _, coin := range remainCoins {
+		// rewards per boosted share = gauge_size * rewards_per_share_unit / (total_boosted_lock_amount * remain_epochs)
+		coinPerShare := coin.Amount.Mul(types.RewardsPerShareUnit).ToDec().QuoTruncate(lockSum.MulInt64(int64(remainEpochs)))
+		if coinPerShare.IsPositive() {
+			rewardsPerShare = rewardsPerShare.Add(sdk.NewDecCoinFromDec(coin.Denom, coinPerShare))
+		}
+	}
+
+	accruedCoins := sdk.Coins{}
+	if notUnlockingLockSum.IsPositive() && !rewardsPerShare.IsZero() {
+		k.accrueBoostRewardsPerShare(ctx, gauge, rewardsPerShare)
+		for _, coin := range rewardsPerShare {
+			// rounding up keeps the accrued amount at least what the locks can claim.
+			accruedCoins = accruedCoins.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(notUnlockingLockSum).QuoInt(types.RewardsPerShareUnit).Ceil().TruncateInt()))
+		}
+	}
+
+	for _, lock := range unlockingLocks {
+		distrCoins, _ := rewardsPerShare.MulDecTruncate(getBoostedLockAmount(ctx, gauge, lock)).QuoDecTruncate(types.RewardsPerShareUnit.ToDec()).TruncateDecimal()
+		if distrCoins.Empty() {
+			continue
+		}
+		if err := distrInfo.addLockRewards(lock.Owner, lock.RewardReceiverAddress, distrCoins); err != nil {
+			return nil, err
+		}
+		accruedCoins = accruedCoins.Add(distrCoins...)
+	}
+	if accruedCoins.Empty() {
+		return nil, nil
+	}
+
+	err := k.updateGaugePostDistribute(ctx, gauge, accruedCoins)
+	return accruedCoins, err
+}
+
+// accrueBoostRewardsPerShare adds the provided rewards per boosted share to the boost rewards per share of the
+// accumulators of the gauge's denom, weighted by the multiplier at the gauge duration and by its increase at every
+// further curve point. Boost curves are non-decreasing, so that no increase is negative.
+func (k Keeper) accrueBoostRewardsPerShare(ctx sdk.Context, gauge types.Gauge, rewardsPerShare sdk.DecCoins) {
+	distrTo := gauge.DistributeTo
+	multiplier := gauge.BoostMultiplier(distrTo.Duration)
+	k.addBoostRewardsPerShare(ctx, distrTo.Denom, distrTo.Duration, rewardsPerShare.MulDecTruncate(multiplier))
+	for _, point := range gauge.BoostCurve {
+		if point.Duration <= distrTo.Duration {
+			continue
+		}
+		k.addBoostRewardsPerShare(ctx, distrTo.Denom, point.Duration, rewardsPerShare.MulDecTruncate(point.Multiplier.Sub(multiplier)))
+		multiplier = point.Multiplier
+	}
+}
+
+// addBoostRewardsPerShare adds the provided boost rewards per share to the accumulator of the provided denom and duration.
+func (k Keeper) addBoostRewardsPerShare(ctx sdk.Context, denom string, duration time.Duration, boostRewardsPerShare sdk.DecCoins) {
+	if boostRewardsPerShare.IsZero() {
+		return
+	}
+	accumulator := k.GetRewardAccumulator(ctx, denom, duration)
+	accumulator.BoostRewardsPerShare = accumulator.BoostRewardsPerShare.Add(boostRewardsPerShare...)
+	k.setRewardAccumulator(ctx, accumulator)
+}
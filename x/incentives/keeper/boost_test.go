@@ -0,0 +1,251 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/v13/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ = suite.TestingSuite(nil)
+
+// defaultBoostCurve doubles the rewards of locks of at least two seconds and triples those of at least four seconds.
+var defaultBoostCurve = []types.BoostPoint{
+	{Duration: 2 * time.Second, Multiplier: sdk.NewDec(2)},
+	{Duration: 4 * time.Second, Multiplier: sdk.NewDec(3)},
+}
+
+// setupBoostedGauge creates a perpetual gauge distributing the provided coins to locks of the default LP denom of at
+// least the provided duration, weighted by the provided boost curve.
+func (suite *KeeperTestSuite) setupBoostedGauge(coins sdk.Coins, duration time.Duration, boostCurve []types.BoostPoint) (uint64, *types.Gauge) {
+	gaugeID, gauge, _, _ := suite.setupNewGaugeWithDuration(true, coins, duration, defaultLPDenom, boostCurve...)
+	return gaugeID, gauge
+}
+
+func (suite *KeeperTestSuite) TestBoostMultiplier() {
+	gauge := types.Gauge{BoostCurve: defaultBoostCurve}
+	tests := map[string]struct {
+		duration           time.Duration
+		expectedMultiplier sdk.Dec
+	}{
+		"shorter than every point": {duration: time.Second, expectedMultiplier: sdk.OneDec()},
+		"exactly a point":          {duration: 2 * time.Second, expectedMultiplier: sdk.NewDec(2)},
+		"between points":           {duration: 3 * time.Second, expectedMultiplier: sdk.NewDec(2)},
+		"longer than every point":  {duration: time.Hour, expectedMultiplier: sdk.NewDec(3)},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.Require().Equal(tc.expectedMultiplier, gauge.BoostMultiplier(tc.duration))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGetBoostedLockSum() {
+	tests := map[string]struct {
+		gaugeDuration          time.Duration
+		lockDurations          []time.Duration
+		unlockingLockDurations []time.Duration
+		elapsed                time.Duration
+		expectedLockSum        sdk.Dec
+	}{
+		"locks across all curve segments": {
+			gaugeDuration:   time.Second,
+			lockDurations:   []time.Duration{time.Second, 2 * time.Second, 3 * time.Second, 5 * time.Second},
+			expectedLockSum: sdk.NewDec(10 + 20 + 20 + 30),
+		},
+		"gauge duration past a curve point": {
+			gaugeDuration:   2 * time.Second,
+			lockDurations:   []time.Duration{time.Second, 2 * time.Second, 5 * time.Second},
+			expectedLockSum: sdk.NewDec(20 + 30),
+		},
+		"unlocking lock has the multiplier of its remaining duration": {
+			gaugeDuration:          time.Second,
+			lockDurations:          []time.Duration{5 * time.Second},
+			unlockingLockDurations: []time.Duration{5 * time.Second},
+			elapsed:                2 * time.Second,
+			expectedLockSum:        sdk.NewDec(30 + 20),
+		},
+		"unlocking lock shorter than every curve point": {
+			gaugeDuration:          time.Second,
+			unlockingLockDurations: []time.Duration{5 * time.Second},
+			elapsed:                4 * time.Second,
+			expectedLockSum:        sdk.NewDec(10),
+		},
+		"no locks": {
+			gaugeDuration:   time.Second,
+			expectedLockSum: sdk.ZeroDec(),
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			_, gauge := suite.setupBoostedGauge(sdk.Coins{}, tc.gaugeDuration, defaultBoostCurve)
+			for _, duration := range tc.lockDurations {
+				suite.LockTokens(suite.TestAccs[0], defaultLPTokens, duration)
+			}
+			for _, duration := range tc.unlockingLockDurations {
+				lockID := suite.lockTokensWithID(suite.TestAccs[1], defaultLPTokens, duration)
+				err := suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lockID, nil)
+				suite.Require().NoError(err)
+			}
+			suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(tc.elapsed))
+
+			// System under test.
+			lockSum := suite.App.IncentivesKeeper.GetBoostedLockSum(suite.Ctx, *gauge)
+
+			suite.Require().Equal(tc.expectedLockSum, lockSum)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCreateBoostedGauge() {
+	tests := map[string]struct {
+		denom      string
+		boostCurve []types.BoostPoint
+
+		expectErr bool
+	}{
+		"valid curve": {
+			denom:      defaultLPDenom,
+			boostCurve: defaultBoostCurve,
+		},
+		"synthetic denom": {
+			denom:      defaultLPSyntheticDenom,
+			boostCurve: defaultBoostCurve,
+			expectErr:  true,
+		},
+		"durations not increasing": {
+			denom:      defaultLPDenom,
+			boostCurve: []types.BoostPoint{defaultBoostCurve[1], defaultBoostCurve[0]},
+			expectErr:  true,
+		},
+		"zero multiplier": {
+			denom:      defaultLPDenom,
+			boostCurve: []types.BoostPoint{{Duration: time.Second, Multiplier: sdk.ZeroDec()}},
+			expectErr:  true,
+		},
+		"multiplier below one": {
+			denom:      defaultLPDenom,
+			boostCurve: []types.BoostPoint{{Duration: time.Second, Multiplier: sdk.NewDecWithPrec(5, 1)}},
+			expectErr:  true,
+		},
+		"decreasing multipliers": {
+			denom: defaultLPDenom,
+			boostCurve: []types.BoostPoint{
+				{Duration: time.Second, Multiplier: sdk.NewDec(3)},
+				{Duration: 2 * time.Second, Multiplier: sdk.NewDec(2)},
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			distrTo := lockuptypes.QueryCondition{
+				LockQueryType: lockuptypes.ByDuration,
+				Denom:         tc.denom,
+				Duration:      time.Second,
+			}
+			suite.FundAcc(defaultGaugeOwner, sdk.Coins{sdk.NewInt64Coin(tc.denom, 10)})
+
+			// System under test.
+			gaugeID, err := suite.App.IncentivesKeeper.CreateBoostedGauge(suite.Ctx, true, defaultGaugeOwner, sdk.Coins{}, distrTo, suite.Ctx.BlockTime(), 1, tc.boostCurve)
+
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+			suite.Require().NoError(err)
+			suite.Require().True(gauge.IsBoostedGauge())
+			suite.Require().Equal(tc.boostCurve, gauge.BoostCurve)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDistributeBoostedGauge() {
+	suite.SetupTest()
+	_, gauge := suite.setupBoostedGauge(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 600)}, time.Second, defaultBoostCurve)
+	users := []sdk.AccAddress{suite.TestAccs[0], suite.TestAccs[1], suite.TestAccs[2]}
+	lockDurations := []time.Duration{time.Second, 2 * time.Second, 5 * time.Second}
+	for i, user := range users {
+		suite.LockTokens(user, defaultLPTokens, lockDurations[i])
+	}
+
+	// the estimate for a single lock matches its distribution.
+	locks := suite.App.LockupKeeper.GetAccountPeriodLocks(suite.Ctx, users[2])
+	_, estimate, _, err := suite.App.IncentivesKeeper.FilteredLocksDistributionEst(suite.Ctx, *gauge, locks)
+	suite.Require().NoError(err)
+
+	// System under test.
+	distributed, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+	suite.Require().NoError(err)
+
+	// equal locks are weighted 1:2:3 by their durations.
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 600)}, distributed)
+	for i, expectedAmount := range []int64{100, 200, 300} {
+		suite.Require().Equal(sdk.NewInt(expectedAmount), suite.App.BankKeeper.GetBalance(suite.Ctx, users[i], defaultRewardDenom).Amount)
+	}
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 300)}, estimate)
+}
+
+func (suite *KeeperTestSuite) TestAccrueBoostedGaugeRewards() {
+	suite.SetupTest()
+	suite.enableRewardAccumulators()
+	_, gauge := suite.setupBoostedGauge(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 600)}, time.Second, defaultBoostCurve)
+	shortLockID := suite.lockTokensWithID(suite.TestAccs[0], defaultLPTokens, time.Second)
+	longLockID := suite.lockTokensWithID(suite.TestAccs[1], defaultLPTokens, 5*time.Second)
+	unlockingLockID := suite.lockTokensWithID(suite.TestAccs[2], defaultLPTokens, 5*time.Second)
+	err := suite.App.LockupKeeper.BeginUnlock(suite.Ctx, unlockingLockID, nil)
+	suite.Require().NoError(err)
+	// the unlocking lock has three seconds left, so its boost decayed from three to two.
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(2 * time.Second))
+
+	// System under test.
+	distributed, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+	suite.Require().NoError(err)
+
+	// equal locks are weighted 1:3:2, the unlocking lock is sent its share and the others accrue theirs.
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 600)}, distributed)
+	suite.Require().Equal(sdk.NewInt(200), suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[2], defaultRewardDenom).Amount)
+	suite.Require().True(suite.App.IncentivesKeeper.GetLockPendingRewards(suite.Ctx, unlockingLockID).Empty())
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 100)}, suite.App.IncentivesKeeper.GetLockPendingRewards(suite.Ctx, shortLockID))
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 300)}, suite.App.IncentivesKeeper.GetLockPendingRewards(suite.Ctx, longLockID))
+
+	// each boosted share accrues 10, split by the multiplier increases along the curve.
+	for _, duration := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second} {
+		accumulator := suite.App.IncentivesKeeper.GetRewardAccumulator(suite.Ctx, defaultLPDenom, duration)
+		suite.Require().Equal(sdk.DecCoins{sdk.NewDecCoin(defaultRewardDenom, types.RewardsPerShareUnit.MulRaw(10))}, accumulator.BoostRewardsPerShare)
+		suite.Require().True(accumulator.RewardsPerShare.IsZero())
+	}
+}
+
+// TestAccrueBoostedGaugeRewardsOfGammShares tests that boosted gauges accrue for locks of realistic gamm share amounts.
+func (suite *KeeperTestSuite) TestAccrueBoostedGaugeRewardsOfGammShares() {
+	suite.SetupTest()
+	suite.enableRewardAccumulators()
+	_, gauge := suite.setupBoostedGauge(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1000000)}, time.Second, defaultBoostCurve)
+	lockAmount := sdk.NewCoins(sdk.NewCoin(defaultLPDenom, sdk.NewIntWithDecimal(5, 24)))
+	shortLockID := suite.lockTokensWithID(suite.TestAccs[0], lockAmount, time.Second)
+	longLockID := suite.lockTokensWithID(suite.TestAccs[1], lockAmount, 5*time.Second)
+
+	// System under test.
+	distributed, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+	suite.Require().NoError(err)
+
+	// equal locks are weighted 1:3.
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1000000)}, distributed)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 250000)}, suite.App.IncentivesKeeper.GetLockPendingRewards(suite.Ctx, shortLockID))
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 750000)}, suite.App.IncentivesKeeper.GetLockPendingRewards(suite.Ctx, longLockID))
+	updatedGauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gauge.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), updatedGauge.FilledEpochs)
+}
@@ -12,10 +12,14 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// getDistributedCoinsFromGauges returns coins that have been distributed already from the provided gauges
+// getDistributedCoinsFromGauges returns coins that have been distributed already from the provided gauges.
+// Group gauges are skipped, as the coins they forward are distributed by their underlying gauges.
 func (k Keeper) getDistributedCoinsFromGauges(gauges []types.Gauge) sdk.Coins {
 	coins := sdk.Coins{}
 	for _, gauge := range gauges {
+		if gauge.IsGroupGauge() {
+			continue
+		}
 		coins = coins.Add(gauge.DistributedCoins...)
 	}
 	return coins
@@ -64,15 +68,32 @@ func (k Keeper) moveUpcomingGaugeToActiveGauge(ctx sdk.Context, gauge types.Gaug
 
 // moveActiveGaugeToFinishedGauge moves a gauge that has completed its distribution from an active to a finished status.
 func (k Keeper) moveActiveGaugeToFinishedGauge(ctx sdk.Context, gauge types.Gauge) error {
+	return k.moveGaugeToFinishedGauge(ctx, gauge, types.KeyPrefixActiveGauges)
+}
+
+// moveGaugeToFinishedGauge moves a gauge from the queue with the provided key prefix to a finished status.
+func (k Keeper) moveGaugeToFinishedGauge(ctx sdk.Context, gauge types.Gauge, keyPrefix []byte) error {
 	timeKey := getTimeKey(gauge.StartTime)
-	if err := k.deleteGaugeRefByKey(ctx, combineKeys(types.KeyPrefixActiveGauges, timeKey), gauge.Id); err != nil {
+	if err := k.deleteGaugeRefByKey(ctx, combineKeys(keyPrefix, timeKey), gauge.Id); err != nil {
 		return err
 	}
 	if err := k.addGaugeRefByKey(ctx, combineKeys(types.KeyPrefixFinishedGauges, timeKey), gauge.Id); err != nil {
 		return err
 	}
-	if err := k.deleteGaugeIDForDenom(ctx, gauge.Id, gauge.DistributeTo.Denom); err != nil {
-		return err
+	switch {
+	case gauge.IsGroupGauge():
+	case gauge.IsTraderVolumeGauge():
+		poolId, err := getGaugePoolId(gauge)
+		if err != nil {
+			return err
+		}
+		if err := k.deleteGaugeRefByKey(ctx, traderGaugesByPoolStoreKey(poolId), gauge.Id); err != nil {
+			return err
+		}
+	default:
+		if err := k.deleteGaugeIDForDenom(ctx, gauge.Id, gauge.DistributeTo.Denom); err != nil {
+			return err
+		}
 	}
 	k.hooks.AfterFinishDistribution(ctx, gauge.Id)
 	return nil
@@ -107,14 +128,16 @@ func (k Keeper) FilteredLocksDistributionEst(ctx sdk.Context, gauge types.Gauge,
 		return types.Gauge{}, nil, true, nil
 	}
 
+	boostedLockSum := sdk.ZeroDec()
+	if gauge.IsBoostedGauge() {
+		boostedLockSum = k.getBoostedLockSum(ctx, gauge)
+	}
+
 	remainCoins := gauge.Coins.Sub(gauge.DistributedCoins)
 	// remainEpochs is the number of remaining epochs that the gauge will pay out its rewards.
 	// for a perpetual gauge, it will pay out everything in the next epoch, and we don't make
 	// an assumption of the rate at which it will get refilled at.
-	remainEpochs := uint64(1)
-	if !gauge.IsPerpetual {
-		remainEpochs = gauge.NumEpochsPaidOver - gauge.FilledEpochs
-	}
+	remainEpochs := gauge.RemainEpochs()
 	if remainEpochs == 0 {
 		return gauge, sdk.Coins{}, false, nil
 	}
@@ -141,6 +164,10 @@ func (k Keeper) FilteredLocksDistributionEst(ctx sdk.Context, gauge types.Gauge,
 			// distribution amount = gauge_size * denom_lock_amount / (total_denom_lock_amount * remain_epochs)
 			// distribution amount = gauge_size_per_epoch * denom_lock_amount / total_denom_lock_amount
 			amt := coin.Amount.Mul(denomLockAmt).Quo(TotalAmtLocked)
+			if gauge.IsBoostedGauge() {
+				// boosted gauges weight the lock amounts by their boost multipliers
+				amt = coin.Amount.ToDec().Mul(getBoostedLockAmount(ctx, gauge, lock)).QuoTruncate(boostedLockSum).TruncateInt()
+			}
 			filteredDistrCoins = filteredDistrCoins.Add(sdk.NewCoin(coin.Denom, amt))
 		}
 	}
@@ -173,20 +200,25 @@ func newDistributionInfo() distributionInfo {
 	}
 }
 
-// addLockRewards adds the provided rewards to the lockID mapped to the provided owner address.
-func (d *distributionInfo) addLockRewards(owner string, rewards sdk.Coins) error {
-	if id, ok := d.lockOwnerAddrToID[owner]; ok {
+// addLockRewards adds the provided rewards to the lockID mapped to the provided receiver address.
+// The receiver is the lock's reward receiver address if set, otherwise the lock owner.
+func (d *distributionInfo) addLockRewards(owner, rewardReceiver string, rewards sdk.Coins) error {
+	receiver := owner
+	if rewardReceiver != "" {
+		receiver = rewardReceiver
+	}
+	if id, ok := d.lockOwnerAddrToID[receiver]; ok {
 		oldDistrCoins := d.idToDistrCoins[id]
 		d.idToDistrCoins[id] = rewards.Add(oldDistrCoins...)
 	} else {
 		id := d.nextID
 		d.nextID += 1
-		d.lockOwnerAddrToID[owner] = id
-		decodedOwnerAddr, err := sdk.AccAddressFromBech32(owner)
+		d.lockOwnerAddrToID[receiver] = id
+		decodedOwnerAddr, err := sdk.AccAddressFromBech32(receiver)
 		if err != nil {
 			return err
 		}
-		d.idToBech32Addr = append(d.idToBech32Addr, owner)
+		d.idToBech32Addr = append(d.idToBech32Addr, receiver)
 		d.idToDecodedAddr = append(d.idToDecodedAddr, decodedOwnerAddr)
 		d.idToDistrCoins = append(d.idToDistrCoins, rewards)
 	}
@@ -273,12 +305,7 @@ func (k Keeper) distributeInternal(
 	}
 
 	remainCoins := gauge.Coins.Sub(gauge.DistributedCoins)
-	// if its a perpetual gauge, we set remaining epochs to 1.
-	// otherwise is is a non perpetual gauge and we determine how many epoch payouts are left
-	remainEpochs := uint64(1)
-	if !gauge.IsPerpetual {
-		remainEpochs = gauge.NumEpochsPaidOver - gauge.FilledEpochs
-	}
+	remainEpochs := gauge.RemainEpochs()
 
 	for _, lock := range locks {
 		distrCoins := sdk.Coins{}
@@ -296,7 +323,7 @@ func (k Keeper) distributeInternal(
 			continue
 		}
 		// update the amount for that address
-		err := distrInfo.addLockRewards(lock.Owner, distrCoins)
+		err := distrInfo.addLockRewards(lock.Owner, lock.RewardReceiverAddress, distrCoins)
 		if err != nil {
 			return nil, err
 		}
@@ -338,20 +365,73 @@ func (k Keeper) getDistributeToBaseLocks(ctx sdk.Context, gauge types.Gauge, cac
 	return FilterLocksByMinDuration(allLocks, gauge.DistributeTo.Duration)
 }
 
-// Distribute distributes coins from an array of gauges to all eligible locks.
+// Distribute distributes coins from an array of gauges to all eligible locks, or to the traders of a pool
+// for ByTraderVolume gauges. Boosted gauges weight each lock by their boost multiplier for its duration.
+// If reward accumulators are enabled, the other gauges distributing to native locks accrue their rewards
+// in the accumulator of their denom and duration instead, for the locks to claim.
+// Group gauges are distributed first, forwarding their coins to their underlying gauges,
+// so that the underlying gauges distribute the forwarded coins in the same epoch.
+// Group gauges that carry their epoch forward are not finished.
 func (k Keeper) Distribute(ctx sdk.Context, gauges []types.Gauge) (sdk.Coins, error) {
 	distrInfo := newDistributionInfo()
 
-	locksByDenomCache := make(map[string][]lockuptypes.PeriodLock)
 	totalDistributedCoins := sdk.Coins{}
+	updatedGauges := make(map[uint64]bool)
+	finishableGauges := make([]types.Gauge, 0, len(gauges))
 	for _, gauge := range gauges {
-		filteredLocks := k.getDistributeToBaseLocks(ctx, gauge, locksByDenomCache)
-		// send based on synthetic lockup coins if it's distributing to synthetic lockups
+		if !gauge.IsGroupGauge() {
+			finishableGauges = append(finishableGauges, gauge)
+			continue
+		}
+		// forwarded coins are counted once they are distributed by the underlying gauges.
+		updatedGaugeIds, filled, err := k.distributeGroupGauge(ctx, gauge)
+		if err != nil {
+			return nil, err
+		}
+		if filled {
+			finishableGauges = append(finishableGauges, gauge)
+		}
+		for _, gaugeId := range updatedGaugeIds {
+			updatedGauges[gaugeId] = true
+		}
+	}
+
+	useRewardAccumulators := k.GetParams(ctx).UseRewardAccumulators
+	locksByDenomCache := make(map[string][]lockuptypes.PeriodLock)
+	for _, gauge := range gauges {
+		if gauge.IsGroupGauge() {
+			continue
+		}
+		if updatedGauges[gauge.Id] {
+			updatedGauge, err := k.GetGaugeByID(ctx, gauge.Id)
+			if err != nil {
+				return nil, err
+			}
+			gauge = *updatedGauge
+		}
 		var gaugeDistributedCoins sdk.Coins
 		var err error
-		if lockuptypes.IsSyntheticDenom(gauge.DistributeTo.Denom) {
+		switch {
+		case gauge.IsTraderVolumeGauge():
+			// send based on the traders' volume if it's distributing to traders
+			gaugeDistributedCoins, err = k.distributeTraderVolumeInternal(ctx, gauge, &distrInfo)
+		case lockuptypes.IsSyntheticDenom(gauge.DistributeTo.Denom):
+			// send based on synthetic lockup coins if it's distributing to synthetic lockups
+			filteredLocks := k.getDistributeToBaseLocks(ctx, gauge, locksByDenomCache)
 			gaugeDistributedCoins, err = k.distributeSyntheticInternal(ctx, gauge, filteredLocks, &distrInfo)
-		} else {
+		case gauge.IsBoostedGauge() && useRewardAccumulators:
+			// accrue for the locks that are not unlocking to claim, and send to the unlocking locks, whose boosts decay,
+			// if it's a boosted gauge and reward accumulators are enabled
+			gaugeDistributedCoins, err = k.accrueBoostedGaugeRewards(ctx, gauge, &distrInfo)
+		case gauge.IsBoostedGauge():
+			// send based on the locks' amounts weighted by their durations if it's a boosted gauge
+			filteredLocks := k.getDistributeToBaseLocks(ctx, gauge, locksByDenomCache)
+			gaugeDistributedCoins, err = k.distributeBoostedInternal(ctx, gauge, filteredLocks, &distrInfo)
+		case useRewardAccumulators:
+			// accrue for the locks to claim, without iterating them, if reward accumulators are enabled
+			gaugeDistributedCoins, err = k.accrueGaugeRewards(ctx, gauge)
+		default:
+			filteredLocks := k.getDistributeToBaseLocks(ctx, gauge, locksByDenomCache)
 			gaugeDistributedCoins, err = k.distributeInternal(ctx, gauge, filteredLocks, &distrInfo)
 		}
 		if err != nil {
@@ -366,7 +446,7 @@ func (k Keeper) Distribute(ctx sdk.Context, gauges []types.Gauge) (sdk.Coins, er
 	}
 	k.hooks.AfterEpochDistribution(ctx)
 
-	k.checkFinishDistribution(ctx, gauges)
+	k.checkFinishDistribution(ctx, finishableGauges)
 	return totalDistributedCoins, nil
 }
 
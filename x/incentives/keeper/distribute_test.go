@@ -92,6 +92,34 @@ func (suite *KeeperTestSuite) TestDistribute() {
 	}
 }
 
+// TestDistributeToRewardReceiver tests that rewards of a lock with a reward receiver address set
+// are sent to the reward receiver rather than the lock owner.
+func (suite *KeeperTestSuite) TestDistributeToRewardReceiver() {
+	defaultGauge := perpGaugeDesc{
+		lockDenom:    defaultLPDenom,
+		lockDuration: defaultLockDuration,
+		rewardAmount: sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 3000)},
+	}
+	suite.SetupTest()
+	receiver := sdk.AccAddress([]byte("rewardReceiver------"))
+
+	gauges := suite.SetupGauges([]perpGaugeDesc{defaultGauge}, defaultLPDenom)
+	addrs := suite.SetupUserLocks([]userLocks{oneLockupUser, twoLockupUser})
+
+	// redirect the rewards of the first lock of twoLockupUser.
+	twoLockupUserLocks := suite.App.LockupKeeper.GetAccountPeriodLocks(suite.Ctx, addrs[1])
+	err := suite.App.LockupKeeper.SetLockRewardReceiverAddress(suite.Ctx, twoLockupUserLocks[0].ID, addrs[1], receiver.String())
+	suite.Require().NoError(err)
+
+	_, err = suite.App.IncentivesKeeper.Distribute(suite.Ctx, gauges)
+	suite.Require().NoError(err)
+
+	oneKRewardCoins := sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1000)}
+	suite.Require().Equal(oneKRewardCoins.String(), suite.App.BankKeeper.GetAllBalances(suite.Ctx, addrs[0]).String())
+	suite.Require().Equal(oneKRewardCoins.String(), suite.App.BankKeeper.GetAllBalances(suite.Ctx, addrs[1]).String())
+	suite.Require().Equal(oneKRewardCoins.String(), suite.App.BankKeeper.GetAllBalances(suite.Ctx, receiver).String())
+}
+
 // TestSyntheticDistribute tests that when the distribute command is executed on a provided gauge
 // the correct amount of rewards is sent to the correct synthetic lock owners.
 func (suite *KeeperTestSuite) TestSyntheticDistribute() {
@@ -278,6 +306,7 @@ func (suite *KeeperTestSuite) TestNoLockPerpetualGaugeDistribution() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeOwner.String(),
 	}
 	suite.Require().Equal(gauges[0].String(), expectedGauge.String())
 
@@ -325,6 +354,7 @@ func (suite *KeeperTestSuite) TestNoLockNonPerpetualGaugeDistribution() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeOwner.String(),
 	}
 	suite.Require().Equal(gauges[0].String(), expectedGauge.String())
 
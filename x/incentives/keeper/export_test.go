@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v13/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
 )
 
 // AddGaugeRefByKey appends the provided gauge ID into an array associated with the provided key.
@@ -40,3 +41,28 @@ func (k Keeper) MoveActiveGaugeToFinishedGauge(ctx sdk.Context, gauge types.Gaug
 func (k Keeper) ChargeFeeIfSufficientFeeDenomBalance(ctx sdk.Context, address sdk.AccAddress, fee sdk.Int, gaugeCoins sdk.Coins) error {
 	return k.chargeFeeIfSufficientFeeDenomBalance(ctx, address, fee, gaugeCoins)
 }
+
+// GetTraderGaugeIDsByPool returns the IDs of the upcoming and active ByTraderVolume gauges of the provided pool.
+func (k Keeper) GetTraderGaugeIDsByPool(ctx sdk.Context, poolId uint64) []uint64 {
+	return k.getGaugeRefs(ctx, traderGaugesByPoolStoreKey(poolId))
+}
+
+// GetBoostedLockSum returns the sum of the boosted amounts of all locks the provided boosted gauge distributes to.
+func (k Keeper) GetBoostedLockSum(ctx sdk.Context, gauge types.Gauge) sdk.Dec {
+	return k.getBoostedLockSum(ctx, gauge)
+}
+
+// DeleteLockRewardPositions deletes the reward positions of the provided lock.
+func (k Keeper) DeleteLockRewardPositions(ctx sdk.Context, lockID uint64) {
+	k.deleteLockRewardPositions(ctx, lockID)
+}
+
+// GetRewardsBreakdownGaugeIDs returns the IDs of the gauges distributing to the provided locks in the next distribution.
+func (k Keeper) GetRewardsBreakdownGaugeIDs(ctx sdk.Context, locks []lockuptypes.PeriodLock) ([]uint64, error) {
+	return k.getRewardsBreakdownGaugeIDs(ctx, locks)
+}
+
+// GetGaugesRewardsBreakdown estimates the rewards the provided locks receive from each of the provided gauges.
+func (k Keeper) GetGaugesRewardsBreakdown(ctx sdk.Context, gaugeIDs []uint64, locks []lockuptypes.PeriodLock) ([]types.GaugeRewardsBreakdown, error) {
+	return k.getGaugesRewardsBreakdown(ctx, gaugeIDs, locks)
+}
@@ -51,18 +51,24 @@ func (k Keeper) setGauge(ctx sdk.Context, gauge *types.Gauge) error {
 }
 
 // CreateGaugeRefKeys takes combinedKey (the keyPrefix for upcoming, active, or finished gauges combined with gauge start time) and adds a reference to the respective gauge ID.
-// If gauge is active or upcoming, creates reference between the denom and gauge ID.
+// If gauge is active or upcoming, creates reference between the denom and gauge ID, or between the pool and gauge ID
+// for ByTraderVolume gauges. Group gauges, which do not distribute to a denom, are not referenced.
 // Used to consolidate codepaths for InitGenesis and CreateGauge.
 func (k Keeper) CreateGaugeRefKeys(ctx sdk.Context, gauge *types.Gauge, combinedKeys []byte, activeOrUpcomingGauge bool) error {
 	if err := k.addGaugeRefByKey(ctx, combinedKeys, gauge.Id); err != nil {
 		return err
 	}
-	if activeOrUpcomingGauge {
-		if err := k.addGaugeIDForDenom(ctx, gauge.Id, gauge.DistributeTo.Denom); err != nil {
+	if !activeOrUpcomingGauge || gauge.IsGroupGauge() {
+		return nil
+	}
+	if gauge.IsTraderVolumeGauge() {
+		poolId, err := getGaugePoolId(*gauge)
+		if err != nil {
 			return err
 		}
+		return k.addGaugeRefByKey(ctx, traderGaugesByPoolStoreKey(poolId), gauge.Id)
 	}
-	return nil
+	return k.addGaugeIDForDenom(ctx, gauge.Id, gauge.DistributeTo.Denom)
 }
 
 // SetGaugeWithRefKey takes a single gauge and assigns a key.
@@ -92,6 +98,12 @@ func (k Keeper) SetGaugeWithRefKey(ctx sdk.Context, gauge *types.Gauge) error {
 
 // CreateGauge creates a gauge and sends coins to the gauge.
 func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddress, coins sdk.Coins, distrTo lockuptypes.QueryCondition, startTime time.Time, numEpochsPaidOver uint64) (uint64, error) {
+	return k.CreateBoostedGauge(ctx, isPerpetual, owner, coins, distrTo, startTime, numEpochsPaidOver, nil)
+}
+
+// CreateBoostedGauge creates a gauge that weights the rewards of its locks by the provided boost curve,
+// and sends coins to the gauge. A gauge with an empty boost curve distributes by lock amount only.
+func (k Keeper) CreateBoostedGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddress, coins sdk.Coins, distrTo lockuptypes.QueryCondition, startTime time.Time, numEpochsPaidOver uint64, boostCurve []types.BoostPoint) (uint64, error) {
 	// Ensure that this gauge's duration is one of the allowed durations on chain
 	durations := k.GetLockableDurations(ctx)
 	if distrTo.LockQueryType == lockuptypes.ByDuration {
@@ -119,6 +131,25 @@ func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddr
 		Coins:             coins,
 		StartTime:         startTime,
 		NumEpochsPaidOver: numEpochsPaidOver,
+		Owner:             owner.String(),
+		BoostCurve:        boostCurve,
+	}
+
+	// Ensure that a gauge distributing by trader volume pays out to the traders of a pool
+	if gauge.IsTraderVolumeGauge() {
+		if _, err := getGaugePoolId(gauge); err != nil {
+			return 0, err
+		}
+	}
+
+	// Ensure that a boosted gauge distributes to native locks, whose durations the accumulation store is keyed by
+	if gauge.IsBoostedGauge() {
+		if distrTo.LockQueryType != lockuptypes.ByDuration || lockuptypes.IsSyntheticDenom(distrTo.Denom) {
+			return 0, fmt.Errorf("boost curve is only allowed for gauges distributing to native locks by duration")
+		}
+		if err := types.ValidateBoostCurve(boostCurve); err != nil {
+			return 0, err
+		}
 	}
 
 	if err := k.bk.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, gauge.Coins); err != nil {
@@ -143,6 +174,7 @@ func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddr
 }
 
 // AddToGaugeRewards adds coins to gauge.
+// A gauge that receives coins from anyone other than its owner can no longer be cancelled.
 func (k Keeper) AddToGaugeRewards(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins, gaugeID uint64) error {
 	gauge, err := k.GetGaugeByID(ctx, gaugeID)
 	if err != nil {
@@ -153,6 +185,9 @@ func (k Keeper) AddToGaugeRewards(ctx sdk.Context, owner sdk.AccAddress, coins s
 	}
 
 	gauge.Coins = gauge.Coins.Add(coins...)
+	if gauge.Owner != owner.String() {
+		gauge.ReceivedThirdPartyCoins = true
+	}
 	err = k.setGauge(ctx, gauge)
 	if err != nil {
 		return err
@@ -161,6 +196,56 @@ func (k Keeper) AddToGaugeRewards(ctx sdk.Context, owner sdk.AccAddress, coins s
 	return nil
 }
 
+// CancelGauge cancels an upcoming or active gauge owned by the provided owner, moving it to finished
+// and refunding its undistributed coins to the recipient.
+// Gauges that received coins from anyone other than their owner can not be cancelled,
+// as their undistributed coins are not all the owner's.
+// Returns the refunded coins.
+func (k Keeper) CancelGauge(ctx sdk.Context, owner sdk.AccAddress, gaugeID uint64, recipient sdk.AccAddress) (sdk.Coins, error) {
+	gauge, err := k.GetGaugeByID(ctx, gaugeID)
+	if err != nil {
+		return nil, err
+	}
+	if gauge.Owner == "" || gauge.Owner != owner.String() {
+		return nil, fmt.Errorf("gauge %d can only be cancelled by its owner", gaugeID)
+	}
+	if gauge.ReceivedThirdPartyCoins {
+		return nil, fmt.Errorf("gauge %d received coins from others than its owner and can not be cancelled", gaugeID)
+	}
+
+	timeKey := getTimeKey(gauge.StartTime)
+	switch {
+	case findIndex(k.getGaugeRefs(ctx, combineKeys(types.KeyPrefixUpcomingGauges, timeKey)), gaugeID) > -1:
+		err = k.moveGaugeToFinishedGauge(ctx, *gauge, types.KeyPrefixUpcomingGauges)
+	case findIndex(k.getGaugeRefs(ctx, combineKeys(types.KeyPrefixActiveGauges, timeKey)), gaugeID) > -1:
+		err = k.moveGaugeToFinishedGauge(ctx, *gauge, types.KeyPrefixActiveGauges)
+	default:
+		err = fmt.Errorf("gauge %d is neither upcoming nor active", gaugeID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// the gauge keeps the coins it distributed, so that it no longer has any coins left to distribute.
+	refund := gauge.Coins.Sub(gauge.DistributedCoins)
+	gauge.Coins = gauge.DistributedCoins
+	if err := k.setGauge(ctx, gauge); err != nil {
+		return nil, err
+	}
+	if !refund.Empty() {
+		if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, refund); err != nil {
+			return nil, err
+		}
+	}
+	return refund, nil
+}
+
+// isFinishedGauge returns true if the gauge is in the finished queue.
+func (k Keeper) isFinishedGauge(ctx sdk.Context, gauge types.Gauge) bool {
+	finishedKey := combineKeys(types.KeyPrefixFinishedGauges, getTimeKey(gauge.StartTime))
+	return findIndex(k.getGaugeRefs(ctx, finishedKey), gauge.Id) > -1
+}
+
 // GetGaugeByID returns gauge from gauge ID.
 func (k Keeper) GetGaugeByID(ctx sdk.Context, gaugeID uint64) (*types.Gauge, error) {
 	gauge := types.Gauge{}
@@ -281,25 +366,58 @@ func (k Keeper) GetEpochInfo(ctx sdk.Context) epochtypes.EpochInfo {
 }
 
 // chargeFeeIfSufficientFeeDenomBalance charges fee in the base denom on the address if the address has
-// balance that is less than fee + amount of the coin from gaugeCoins that is of base denom.
+// balance that is at least fee + amount of the coin from gaugeCoins that is of base denom.
 // gaugeCoins might not have a coin of tx base denom. In that case, fee is only compared to balance.
+// Otherwise, the fee is charged in the first of the accepted fee denoms that the address can cover,
+// in the amount of that denom that txfees values at the fee in the base denom.
 // The fee is sent to the community pool.
 // Returns nil on success, error otherwise.
 func (k Keeper) chargeFeeIfSufficientFeeDenomBalance(ctx sdk.Context, address sdk.AccAddress, fee sdk.Int, gaugeCoins sdk.Coins) (err error) {
-	feeDenom, err := k.tk.GetBaseDenom(ctx)
+	if !fee.IsPositive() {
+		return nil
+	}
+
+	baseDenom, err := k.tk.GetBaseDenom(ctx)
 	if err != nil {
 		return err
 	}
 
-	totalCost := gaugeCoins.AmountOf(feeDenom).Add(fee)
-	accountBalance := k.bk.GetBalance(ctx, address, feeDenom).Amount
+	totalCost := gaugeCoins.AmountOf(baseDenom).Add(fee)
+	accountBalance := k.bk.GetBalance(ctx, address, baseDenom).Amount
+	if accountBalance.GTE(totalCost) {
+		return k.ck.FundCommunityPool(ctx, sdk.NewCoins(sdk.NewCoin(baseDenom, fee)), address)
+	}
 
-	if accountBalance.LT(totalCost) {
-		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "account's balance of %s (%s) is less than the total cost of the message (%s)", feeDenom, accountBalance, totalCost)
+	for _, denom := range k.GetParams(ctx).AcceptedFeeDenoms {
+		if denom == baseDenom {
+			continue
+		}
+		feeCoin, err := k.getFeeInDenom(ctx, fee, denom)
+		if err != nil {
+			// denoms that txfees can not value are skipped.
+			continue
+		}
+		if k.bk.GetBalance(ctx, address, denom).Amount.GTE(gaugeCoins.AmountOf(denom).Add(feeCoin.Amount)) {
+			return k.ck.FundCommunityPool(ctx, sdk.NewCoins(feeCoin), address)
+		}
 	}
 
-	if err := k.ck.FundCommunityPool(ctx, sdk.NewCoins(sdk.NewCoin(feeDenom, fee)), address); err != nil {
-		return err
+	return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "account's balance of %s (%s) is less than the total cost of the message (%s), and no accepted fee denom covers the fee", baseDenom, accountBalance, totalCost)
+}
+
+// getFeeInDenom returns the amount of denom that is worth fee in the base denom at the twap, rounded up.
+// Returns an error if denom is not a txfees fee token or has no value.
+func (k Keeper) getFeeInDenom(ctx sdk.Context, fee sdk.Int, denom string) (sdk.Coin, error) {
+	feeValue, err := k.convertToBaseTokenTwap(ctx, sdk.NewCoin(denom, fee))
+	if err != nil {
+		return sdk.Coin{}, err
 	}
-	return nil
+	if !feeValue.Amount.IsPositive() {
+		return sdk.Coin{}, fmt.Errorf("fee of %s%s has no value in the base denom", fee, denom)
+	}
+
+	// fee units of denom are worth feeValue units of the base denom,
+	// so fee * fee / feeValue units of denom are worth fee units of the base denom.
+	amount := fee.ToDec().MulInt(fee).QuoInt(feeValue.Amount).Ceil().TruncateInt()
+	return sdk.NewCoin(denom, amount), nil
 }
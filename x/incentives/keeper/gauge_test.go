@@ -7,6 +7,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v13/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
+	txfeestypes "github.com/osmosis-labs/osmosis/v13/x/txfees/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -111,6 +112,7 @@ func (suite *KeeperTestSuite) TestGaugeOperations() {
 			FilledEpochs:      0,
 			DistributedCoins:  sdk.Coins{},
 			StartTime:         startTime,
+			Owner:             defaultGaugeOwner.String(),
 		}
 		suite.Require().Equal(expectedGauge.String(), gauges[0].String())
 
@@ -332,3 +334,250 @@ func (suite *KeeperTestSuite) TestChargeFeeIfSufficientFeeDenomBalance() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestChargeFeeIfSufficientFeeDenomBalance_AcceptedFeeDenoms() {
+	const fee = int64(100)
+
+	testcases := map[string]struct {
+		accountBalanceToFund sdk.Coins
+		acceptedFeeDenoms    []string
+		gaugeCoins           sdk.Coins
+		// raiseSpotPrice buys most of the uion in its pool before the fee is charged.
+		raiseSpotPrice bool
+
+		expectedFee sdk.Coin
+		expectError bool
+	}{
+		"sufficient base denom balance, charged in base denom": {
+			accountBalanceToFund: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(fee)), sdk.NewCoin("uion", sdk.NewInt(fee*2))),
+			acceptedFeeDenoms:    []string{"uion"},
+			expectedFee:          sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(fee)),
+		},
+		"insufficient base denom balance, charged in accepted fee denom at the pool price": {
+			accountBalanceToFund: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(fee-1)), sdk.NewCoin("uion", sdk.NewInt(fee*2))),
+			acceptedFeeDenoms:    []string{"uion"},
+			expectedFee:          sdk.NewCoin("uion", sdk.NewInt(fee*2)),
+		},
+		"charged in accepted fee denom at the twap, not at a raised spot price": {
+			accountBalanceToFund: sdk.NewCoins(sdk.NewCoin("uion", sdk.NewInt(fee*2))),
+			acceptedFeeDenoms:    []string{"uion"},
+			raiseSpotPrice:       true,
+			expectedFee:          sdk.NewCoin("uion", sdk.NewInt(fee*2)),
+		},
+		"accepted fee denom balance covers the fee but not the gauge coins too, error": {
+			accountBalanceToFund: sdk.NewCoins(sdk.NewCoin("uion", sdk.NewInt(fee*2))),
+			acceptedFeeDenoms:    []string{"uion"},
+			gaugeCoins:           sdk.NewCoins(sdk.NewCoin("uion", sdk.NewInt(1))),
+			expectError:          true,
+		},
+		"denom not accepted, error": {
+			accountBalanceToFund: sdk.NewCoins(sdk.NewCoin("uion", sdk.NewInt(fee*2))),
+			acceptedFeeDenoms:    []string{},
+			expectError:          true,
+		},
+		"accepted fee denom that is not a fee token is skipped": {
+			accountBalanceToFund: sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(fee*10)), sdk.NewCoin("uion", sdk.NewInt(fee*2))),
+			acceptedFeeDenoms:    []string{"foo", "uion"},
+			expectedFee:          sdk.NewCoin("uion", sdk.NewInt(fee*2)),
+		},
+	}
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			suite.SetupTest()
+
+			// uion is worth half a base denom unit at the twap.
+			poolId := suite.PrepareBalancerPoolWithCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000000)), sdk.NewCoin("uion", sdk.NewInt(2000000)))
+			feeTokenProp := txfeestypes.NewUpdateFeeTokenProposal("Test Proposal", "test", txfeestypes.FeeToken{Denom: "uion", PoolID: poolId})
+			suite.Require().NoError(suite.App.TxFeesKeeper.HandleUpdateFeeTokenProposal(suite.Ctx, &feeTokenProp))
+			suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.TwapValuationWindow + time.Hour))
+			if tc.raiseSpotPrice {
+				suite.swap(poolId, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000000)), "uion")
+			}
+
+			params := suite.App.IncentivesKeeper.GetParams(suite.Ctx)
+			params.AcceptedFeeDenoms = tc.acceptedFeeDenoms
+			suite.App.IncentivesKeeper.SetParams(suite.Ctx, params)
+
+			testAccount := suite.TestAccs[1]
+			suite.FundAcc(testAccount, tc.accountBalanceToFund)
+			oldBalance := suite.App.BankKeeper.GetAllBalances(suite.Ctx, testAccount)
+
+			// System under test.
+			err := suite.App.IncentivesKeeper.ChargeFeeIfSufficientFeeDenomBalance(suite.Ctx, testAccount, sdk.NewInt(fee), tc.gaugeCoins)
+
+			newBalance := suite.App.BankKeeper.GetAllBalances(suite.Ctx, testAccount)
+			if tc.expectError {
+				suite.Require().Error(err)
+				suite.Require().Equal(oldBalance.String(), newBalance.String())
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(oldBalance.Sub(sdk.NewCoins(tc.expectedFee)).String(), newBalance.String())
+			}
+		})
+	}
+}
+
+// TestCancelGauge tests that cancelling a gauge moves it to finished and refunds its undistributed coins.
+func (suite *KeeperTestSuite) TestCancelGauge() {
+	owner := defaultGaugeOwner
+	recipient := sdk.AccAddress([]byte("Gauge_Refund_Addr___"))
+	coins := sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1000)}
+
+	tests := map[string]struct {
+		// setup returns the ID of the gauge to cancel.
+		setup     func() uint64
+		sender    sdk.AccAddress
+		recipient sdk.AccAddress
+
+		expectedRefund sdk.Coins
+		expectErr      bool
+	}{
+		"upcoming gauge, refund to owner": {
+			setup: func() uint64 {
+				gaugeID, _, _, _ := suite.SetupNewGauge(false, coins)
+				return gaugeID
+			},
+			sender:         owner,
+			recipient:      owner,
+			expectedRefund: coins,
+		},
+		"active gauge that distributed once, refund to recipient": {
+			setup: func() uint64 {
+				suite.LockTokens(suite.TestAccs[0], defaultLPTokens, defaultLockDuration)
+				gaugeID, gauge, _, startTime := suite.SetupNewGauge(false, coins)
+				suite.Ctx = suite.Ctx.WithBlockTime(startTime)
+				suite.Require().NoError(suite.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(suite.Ctx, *gauge))
+				_, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+				suite.Require().NoError(err)
+				return gaugeID
+			},
+			sender:         owner,
+			recipient:      recipient,
+			expectedRefund: sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 500)},
+		},
+		"perpetual gauge without coins": {
+			setup: func() uint64 {
+				gaugeID, _, _, _ := suite.SetupNewGauge(true, sdk.Coins{})
+				return gaugeID
+			},
+			sender:         owner,
+			recipient:      owner,
+			expectedRefund: nil,
+		},
+		"gauge with coins added by its owner": {
+			setup: func() uint64 {
+				gaugeID, _, _, _ := suite.SetupNewGauge(false, coins)
+				suite.FundAcc(owner, coins)
+				suite.Require().NoError(suite.App.IncentivesKeeper.AddToGaugeRewards(suite.Ctx, owner, coins, gaugeID))
+				return gaugeID
+			},
+			sender:         owner,
+			recipient:      owner,
+			expectedRefund: coins.Add(coins...),
+		},
+		"gauge with coins added by another account, error": {
+			setup: func() uint64 {
+				gaugeID, _, _, _ := suite.SetupNewGauge(false, coins)
+				suite.FundAcc(suite.TestAccs[1], coins)
+				suite.Require().NoError(suite.App.IncentivesKeeper.AddToGaugeRewards(suite.Ctx, suite.TestAccs[1], coins, gaugeID))
+				return gaugeID
+			},
+			sender:    owner,
+			recipient: owner,
+			expectErr: true,
+		},
+		"gauge with coins forwarded by another account's group gauge, error": {
+			setup: func() uint64 {
+				gaugeID, _, _, _ := suite.SetupNewGauge(true, sdk.Coins{})
+				groupGaugeID, err := suite.createGroupGauge(true, coins, 1, []uint64{gaugeID}, []sdk.Int{sdk.OneInt()}, types.ByStaticWeight)
+				suite.Require().NoError(err)
+				groupGauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, groupGaugeID)
+				suite.Require().NoError(err)
+				_, err = suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*groupGauge})
+				suite.Require().NoError(err)
+				return gaugeID
+			},
+			sender:    owner,
+			recipient: owner,
+			expectErr: true,
+		},
+		"not the owner, error": {
+			setup: func() uint64 {
+				gaugeID, _, _, _ := suite.SetupNewGauge(false, coins)
+				return gaugeID
+			},
+			sender:    recipient,
+			recipient: recipient,
+			expectErr: true,
+		},
+		"gauge without owner, error": {
+			setup: func() uint64 {
+				gauge := types.NewGauge(suite.App.IncentivesKeeper.GetLastGaugeID(suite.Ctx)+1, false, lockuptypes.QueryCondition{
+					LockQueryType: lockuptypes.ByDuration,
+					Denom:         defaultLPDenom,
+					Duration:      defaultLockDuration,
+				}, sdk.Coins{}, suite.Ctx.BlockTime().Add(time.Hour), 1, 0, sdk.Coins{})
+				suite.Require().NoError(suite.App.IncentivesKeeper.SetGaugeWithRefKey(suite.Ctx, &gauge))
+				suite.App.IncentivesKeeper.SetLastGaugeID(suite.Ctx, gauge.Id)
+				return gauge.Id
+			},
+			sender:    owner,
+			recipient: owner,
+			expectErr: true,
+		},
+		"finished gauge, error": {
+			setup: func() uint64 {
+				gaugeID, gauge, _, startTime := suite.SetupNewGauge(false, coins)
+				suite.Ctx = suite.Ctx.WithBlockTime(startTime)
+				suite.Require().NoError(suite.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(suite.Ctx, *gauge))
+				suite.Require().NoError(suite.App.IncentivesKeeper.MoveActiveGaugeToFinishedGauge(suite.Ctx, *gauge))
+				return gaugeID
+			},
+			sender:    owner,
+			recipient: owner,
+			expectErr: true,
+		},
+		"gauge does not exist, error": {
+			setup: func() uint64 {
+				return suite.App.IncentivesKeeper.GetLastGaugeID(suite.Ctx) + 1
+			},
+			sender:    owner,
+			recipient: owner,
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			gaugeID := tc.setup()
+			toDistributeBefore := suite.App.IncentivesKeeper.GetModuleToDistributeCoins(suite.Ctx)
+			distributedBefore := suite.App.IncentivesKeeper.GetModuleDistributedCoins(suite.Ctx)
+			recipientBalanceBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, tc.recipient)
+
+			// System under test.
+			refund, err := suite.App.IncentivesKeeper.CancelGauge(suite.Ctx, tc.sender, gaugeID, tc.recipient)
+
+			if tc.expectErr {
+				suite.Require().Error(err)
+				suite.Require().Equal(toDistributeBefore, suite.App.IncentivesKeeper.GetModuleToDistributeCoins(suite.Ctx))
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedRefund, refund)
+			suite.Require().Equal(recipientBalanceBefore.Add(tc.expectedRefund...), suite.App.BankKeeper.GetAllBalances(suite.Ctx, tc.recipient))
+
+			// the gauge is finished and no longer indexed by denom.
+			finishedGauges := suite.App.IncentivesKeeper.GetFinishedGauges(suite.Ctx)
+			suite.Require().Len(finishedGauges, 1)
+			suite.Require().Equal(gaugeID, finishedGauges[0].Id)
+			suite.Require().Empty(suite.App.IncentivesKeeper.GetNotFinishedGauges(suite.Ctx))
+			suite.Require().Empty(suite.App.IncentivesKeeper.GetAllGaugeIDsByDenom(suite.Ctx, defaultLPDenom))
+
+			// the refund is no longer to be distributed, while the distributed coins are unchanged.
+			suite.Require().Equal(toDistributeBefore.Sub(tc.expectedRefund), suite.App.IncentivesKeeper.GetModuleToDistributeCoins(suite.Ctx))
+			suite.Require().Equal(distributedBefore, suite.App.IncentivesKeeper.GetModuleDistributedCoins(suite.Ctx))
+		})
+	}
+}
@@ -18,14 +18,59 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		}
 	}
 	k.SetLastGaugeID(ctx, genState.LastGaugeId)
+	for _, groupGauge := range genState.GroupGauges {
+		k.setGroupGauge(ctx, groupGauge)
+	}
+	for _, poolVolume := range genState.PoolVolumes {
+		k.setPoolVolume(ctx, poolVolume)
+	}
+	for _, traderVolume := range genState.TraderVolumes {
+		k.setTraderVolume(ctx, traderVolume)
+	}
+	for _, accumulator := range genState.RewardAccumulators {
+		k.setRewardAccumulator(ctx, accumulator)
+	}
+	for _, position := range genState.LockRewardPositions {
+		k.setLockRewardPosition(ctx, position)
+	}
 }
 
 // ExportGenesis returns the x/incentives module's exported genesis.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	gauges := k.GetNotFinishedGauges(ctx)
+	groupGauges := []types.GroupGauge{}
+	for _, gauge := range gauges {
+		if !gauge.IsGroupGauge() {
+			continue
+		}
+		groupGauge, err := k.GetGroupGaugeByID(ctx, gauge.Id)
+		if err != nil {
+			panic(err)
+		}
+		// finished gauges are not exported, so records of finished underlying gauges are dropped.
+		records := []types.GroupGaugeRecord{}
+		for _, record := range groupGauge.Records {
+			underlyingGauge, err := k.GetGaugeByID(ctx, record.GaugeId)
+			if err != nil {
+				panic(err)
+			}
+			if !k.isFinishedGauge(ctx, *underlyingGauge) {
+				records = append(records, record)
+			}
+		}
+		groupGauge.Records = records
+		groupGauges = append(groupGauges, groupGauge)
+	}
+
 	return &types.GenesisState{
-		Params:            k.GetParams(ctx),
-		LockableDurations: k.GetLockableDurations(ctx),
-		Gauges:            k.GetNotFinishedGauges(ctx),
-		LastGaugeId:       k.GetLastGaugeID(ctx),
+		Params:              k.GetParams(ctx),
+		LockableDurations:   k.GetLockableDurations(ctx),
+		Gauges:              gauges,
+		LastGaugeId:         k.GetLastGaugeID(ctx),
+		GroupGauges:         groupGauges,
+		PoolVolumes:         k.GetAllPoolVolumes(ctx),
+		TraderVolumes:       k.GetAllTraderVolumes(ctx),
+		RewardAccumulators:  k.GetAllRewardAccumulators(ctx),
+		LockRewardPositions: k.GetAllLockRewardPositions(ctx),
 	}
 }
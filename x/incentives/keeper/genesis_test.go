@@ -63,6 +63,7 @@ func TestIncentivesExportGenesis(t *testing.T) {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins(nil),
 		StartTime:         startTime.UTC(),
+		Owner:             addr.String(),
 	})
 }
 
@@ -98,6 +99,11 @@ func TestIncentivesInitGenesis(t *testing.T) {
 	app.IncentivesKeeper.InitGenesis(ctx, types.GenesisState{
 		Params: types.Params{
 			DistrEpochIdentifier: "week",
+			CreateGaugeFee:       types.DefaultCreateGaugeFee,
+			AddToGaugeFee:        types.DefaultAddToGaugeFee,
+			AcceptedFeeDenoms:    []string{},
+			TraderVolumeCap:      types.DefaultTraderVolumeCap,
+			MinTraderSwapValue:   types.DefaultMinTraderSwapValue,
 		},
 		Gauges: []types.Gauge{gauge},
 		LockableDurations: []time.Duration{
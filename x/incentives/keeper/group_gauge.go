@@ -0,0 +1,241 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/osmosis/v13/osmoutils"
+	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/utils"
+	"github.com/osmosis-labs/osmosis/v13/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// groupGaugeStoreKey returns the store key of the group record of the provided group gauge ID.
+func groupGaugeStoreKey(gaugeId uint64) []byte {
+	return combineKeys(types.KeyPrefixGroupGauges, sdk.Uint64ToBigEndian(gaugeId))
+}
+
+// setGroupGauge sets the group gauge record inside store.
+func (k Keeper) setGroupGauge(ctx sdk.Context, groupGauge types.GroupGauge) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, groupGaugeStoreKey(groupGauge.GaugeId), &groupGauge)
+}
+
+// GetGroupGaugeByID returns the group record of the group gauge with the provided ID.
+func (k Keeper) GetGroupGaugeByID(ctx sdk.Context, gaugeId uint64) (types.GroupGauge, error) {
+	store := ctx.KVStore(k.storeKey)
+	groupGauge := types.GroupGauge{}
+	found, err := osmoutils.Get(store, groupGaugeStoreKey(gaugeId), &groupGauge)
+	if err != nil {
+		return types.GroupGauge{}, err
+	}
+	if !found {
+		return types.GroupGauge{}, fmt.Errorf("group gauge with ID %d does not exist", gaugeId)
+	}
+	return groupGauge, nil
+}
+
+// GetAllGroupGauges returns the group records of all group gauges.
+func (k Keeper) GetAllGroupGauges(ctx sdk.Context) []types.GroupGauge {
+	store := ctx.KVStore(k.storeKey)
+	groupGauges, err := osmoutils.GatherValuesFromStorePrefix(store, types.KeyPrefixGroupGauges, func(bz []byte) (types.GroupGauge, error) {
+		groupGauge := types.GroupGauge{}
+		err := proto.Unmarshal(bz, &groupGauge)
+		return groupGauge, err
+	})
+	if err != nil {
+		panic(err)
+	}
+	return groupGauges
+}
+
+// CreateGroupGauge creates a group gauge, which forwards its coins each epoch to the provided underlying gauges
+// split according to the splitting policy, and sends coins to it.
+// Underlying gauges must be existing gauges that distribute to locks and have not finished.
+// For ByVolume group gauges, they must distribute to pool shares.
+func (k Keeper) CreateGroupGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddress, coins sdk.Coins, startTime time.Time, numEpochsPaidOver uint64, gaugeIds []uint64, weights []sdk.Int, splittingPolicy types.SplittingPolicy) (uint64, error) {
+	records := make([]types.GroupGaugeRecord, 0, len(gaugeIds))
+	for i, gaugeId := range gaugeIds {
+		gauge, err := k.GetGaugeByID(ctx, gaugeId)
+		if err != nil {
+			return 0, err
+		}
+		if gauge.IsGroupGauge() {
+			return 0, fmt.Errorf("underlying gauge %d is a group gauge", gaugeId)
+		}
+		if k.isFinishedGauge(ctx, *gauge) {
+			return 0, fmt.Errorf("underlying gauge %d has finished distributing", gaugeId)
+		}
+
+		record := types.GroupGaugeRecord{GaugeId: gaugeId, Weight: sdk.ZeroInt(), CumulativeSwapFees: sdk.ZeroInt()}
+		switch splittingPolicy {
+		case types.ByStaticWeight:
+			record.Weight = weights[i]
+		case types.ByVolume:
+			poolId, err := getGaugePoolId(*gauge)
+			if err != nil {
+				return 0, err
+			}
+			record.CumulativeSwapFees = k.GetPoolSwapFees(ctx, poolId)
+		default:
+			return 0, fmt.Errorf("invalid splitting policy: %s", splittingPolicy)
+		}
+		records = append(records, record)
+	}
+
+	gauge := types.Gauge{
+		Id:                k.GetLastGaugeID(ctx) + 1,
+		IsPerpetual:       isPerpetual,
+		DistributeTo:      lockuptypes.QueryCondition{LockQueryType: lockuptypes.ByGroup},
+		Coins:             coins,
+		StartTime:         startTime,
+		NumEpochsPaidOver: numEpochsPaidOver,
+		Owner:             owner.String(),
+	}
+
+	if err := k.bk.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, gauge.Coins); err != nil {
+		return 0, err
+	}
+
+	if err := k.setGauge(ctx, &gauge); err != nil {
+		return 0, err
+	}
+	k.SetLastGaugeID(ctx, gauge.Id)
+	k.setGroupGauge(ctx, types.NewGroupGauge(gauge.Id, records, splittingPolicy))
+
+	combinedKeys := combineKeys(types.KeyPrefixUpcomingGauges, getTimeKey(gauge.StartTime))
+	if err := k.CreateGaugeRefKeys(ctx, &gauge, combinedKeys, true); err != nil {
+		return 0, err
+	}
+	k.hooks.AfterCreateGauge(ctx, gauge.Id)
+	return gauge.Id, nil
+}
+
+// distributeGroupGauge forwards the group gauge's coins for this epoch to its underlying gauges, split by the
+// weights of the underlying gauges that have not finished distributing, and updates the group gauge.
+// ByVolume group gauges first update their weights to the pool swap fees since the previous distribution.
+// If the total weight is zero, nothing is forwarded and the epoch is carried forward: it is not filled,
+// so that the group gauge does not finish with coins left, and forwards them in later epochs instead.
+// Returns the IDs of the underlying gauges that received coins, and whether the epoch was filled.
+func (k Keeper) distributeGroupGauge(ctx sdk.Context, gauge types.Gauge) ([]uint64, bool, error) {
+	groupGauge, err := k.GetGroupGaugeByID(ctx, gauge.Id)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if groupGauge.SplittingPolicy == types.ByVolume {
+		if err := k.updateGroupGaugeVolumeWeights(ctx, &groupGauge); err != nil {
+			return nil, false, err
+		}
+		k.setGroupGauge(ctx, groupGauge)
+	}
+
+	underlyingGauges := make([]types.Gauge, 0, len(groupGauge.Records))
+	weights := make([]sdk.Int, 0, len(groupGauge.Records))
+	totalWeight := sdk.ZeroInt()
+	for _, record := range groupGauge.Records {
+		underlyingGauge, err := k.GetGaugeByID(ctx, record.GaugeId)
+		if err != nil {
+			return nil, false, err
+		}
+		if k.isFinishedGauge(ctx, *underlyingGauge) || !record.Weight.IsPositive() {
+			continue
+		}
+		underlyingGauges = append(underlyingGauges, *underlyingGauge)
+		weights = append(weights, record.Weight)
+		totalWeight = totalWeight.Add(record.Weight)
+	}
+	if totalWeight.IsZero() {
+		return nil, false, nil
+	}
+
+	// a perpetual gauge forwards all of its coins, a non perpetual gauge an equal share for each remaining epoch.
+	remainEpochs := gauge.RemainEpochs()
+	if remainEpochs == 0 {
+		return nil, false, nil
+	}
+	remainCoins := gauge.Coins.Sub(gauge.DistributedCoins)
+
+	forwardedCoins := sdk.NewCoins()
+	updatedGaugeIds := []uint64{}
+	for i, underlyingGauge := range underlyingGauges {
+		gaugeCoins := sdk.Coins{}
+		for _, coin := range remainCoins {
+			// forwarded amount = remaining amount * weight / (total weight * remain epochs)
+			amt := coin.Amount.Mul(weights[i]).Quo(totalWeight.MulRaw(int64(remainEpochs)))
+			if amt.IsPositive() {
+				gaugeCoins = gaugeCoins.Add(sdk.NewCoin(coin.Denom, amt))
+			}
+		}
+		if gaugeCoins.Empty() {
+			continue
+		}
+
+		underlyingGauge.Coins = underlyingGauge.Coins.Add(gaugeCoins...)
+		if gauge.ReceivedThirdPartyCoins || gauge.Owner != underlyingGauge.Owner {
+			underlyingGauge.ReceivedThirdPartyCoins = true
+		}
+		if err := k.setGauge(ctx, &underlyingGauge); err != nil {
+			return nil, false, err
+		}
+		k.hooks.AfterAddToGauge(ctx, underlyingGauge.Id)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.TypeEvtGroupGaugeDistribution,
+			sdk.NewAttribute(types.AttributeGaugeID, utils.Uint64ToString(gauge.Id)),
+			sdk.NewAttribute(types.AttributeUnderlyingGaugeID, utils.Uint64ToString(underlyingGauge.Id)),
+			sdk.NewAttribute(types.AttributeAmount, gaugeCoins.String()),
+		))
+
+		forwardedCoins = forwardedCoins.Add(gaugeCoins...)
+		updatedGaugeIds = append(updatedGaugeIds, underlyingGauge.Id)
+	}
+
+	if err := k.updateGaugePostDistribute(ctx, gauge, forwardedCoins); err != nil {
+		return nil, false, err
+	}
+	return updatedGaugeIds, true, nil
+}
+
+// updateGroupGaugeVolumeWeights sets the weight of each record of a ByVolume group gauge to the swap fees
+// paid on its pool since the previous update, and records the pool's current cumulative swap fees.
+func (k Keeper) updateGroupGaugeVolumeWeights(ctx sdk.Context, groupGauge *types.GroupGauge) error {
+	for i, record := range groupGauge.Records {
+		underlyingGauge, err := k.GetGaugeByID(ctx, record.GaugeId)
+		if err != nil {
+			return err
+		}
+		poolId, err := getGaugePoolId(*underlyingGauge)
+		if err != nil {
+			return err
+		}
+
+		cumulativeSwapFees := k.GetPoolSwapFees(ctx, poolId)
+		weight := cumulativeSwapFees.Sub(record.CumulativeSwapFees)
+		if weight.IsNegative() {
+			// the cumulative swap fees only decrease if they were reset, e.g. through genesis.
+			weight = cumulativeSwapFees
+		}
+		groupGauge.Records[i].Weight = weight
+		groupGauge.Records[i].CumulativeSwapFees = cumulativeSwapFees
+	}
+	return nil
+}
+
+// getGaugePoolId returns the ID of the pool whose shares the gauge distributes to.
+// Returns an error if the gauge does not distribute to pool shares.
+func getGaugePoolId(gauge types.Gauge) (uint64, error) {
+	denom := gauge.DistributeTo.Denom
+	if err := gammtypes.ValidatePoolShareDenom(denom); err != nil {
+		return 0, fmt.Errorf("gauge %d does not distribute to pool shares: %s", gauge.Id, denom)
+	}
+	poolId := gammtypes.MustGetPoolIdFromShareDenom(denom)
+	if gammtypes.GetPoolShareDenom(poolId) != denom {
+		return 0, fmt.Errorf("gauge %d does not distribute to pool shares: %s", gauge.Id, denom)
+	}
+	return poolId, nil
+}
@@ -0,0 +1,419 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/balancer"
+	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v13/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ = suite.TestingSuite(nil)
+
+// setupPoolGauge creates a perpetual gauge without coins distributing to the shares of the provided pool.
+func (suite *KeeperTestSuite) setupPoolGauge(poolId uint64) uint64 {
+	distrTo := lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByDuration,
+		Denom:         gammtypes.GetPoolShareDenom(poolId),
+		Duration:      defaultLockDuration,
+	}
+	gaugeID, _ := suite.CreateGauge(true, suite.TestAccs[0], sdk.Coins{}, distrTo, suite.Ctx.BlockTime(), 1)
+	return gaugeID
+}
+
+// createGroupGauge funds an account and creates a group gauge with it.
+func (suite *KeeperTestSuite) createGroupGauge(isPerpetual bool, coins sdk.Coins, numEpochsPaidOver uint64, gaugeIds []uint64, weights []sdk.Int, splittingPolicy types.SplittingPolicy) (uint64, error) {
+	addr := sdk.AccAddress([]byte("Group_Gauge_Creator_"))
+	suite.FundAcc(addr, coins)
+	return suite.App.IncentivesKeeper.CreateGroupGauge(suite.Ctx, isPerpetual, addr, coins, suite.Ctx.BlockTime(), numEpochsPaidOver, gaugeIds, weights, splittingPolicy)
+}
+
+func (suite *KeeperTestSuite) TestCreateGroupGauge() {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(defaultRewardDenom, 1000))
+	weights := []sdk.Int{sdk.NewInt(1), sdk.NewInt(2)}
+
+	tests := map[string]struct {
+		// setup returns the underlying gauge IDs.
+		setup           func() []uint64
+		splittingPolicy types.SplittingPolicy
+		weights         []sdk.Int
+
+		expectErr bool
+	}{
+		"static weights": {
+			setup: func() []uint64 {
+				gaugeID1, _, _, _ := suite.SetupNewGauge(true, sdk.Coins{})
+				gaugeID2, _, _, _ := suite.SetupNewGauge(false, sdk.Coins{})
+				return []uint64{gaugeID1, gaugeID2}
+			},
+			splittingPolicy: types.ByStaticWeight,
+			weights:         weights,
+		},
+		"volume": {
+			setup: func() []uint64 {
+				return []uint64{suite.setupPoolGauge(suite.PrepareBalancerPool()), suite.setupPoolGauge(suite.PrepareBalancerPool())}
+			},
+			splittingPolicy: types.ByVolume,
+		},
+		"underlying gauge does not exist, error": {
+			setup: func() []uint64 {
+				gaugeID, _, _, _ := suite.SetupNewGauge(true, sdk.Coins{})
+				return []uint64{gaugeID, gaugeID + 1}
+			},
+			splittingPolicy: types.ByStaticWeight,
+			weights:         weights,
+			expectErr:       true,
+		},
+		"underlying gauge is a group gauge, error": {
+			setup: func() []uint64 {
+				gaugeID1, _, _, _ := suite.SetupNewGauge(true, sdk.Coins{})
+				gaugeID2, _, _, _ := suite.SetupNewGauge(true, sdk.Coins{})
+				groupGaugeID, err := suite.createGroupGauge(true, coins, 1, []uint64{gaugeID1, gaugeID2}, weights, types.ByStaticWeight)
+				suite.Require().NoError(err)
+				return []uint64{gaugeID1, groupGaugeID}
+			},
+			splittingPolicy: types.ByStaticWeight,
+			weights:         weights,
+			expectErr:       true,
+		},
+		"underlying gauge has finished distributing, error": {
+			setup: func() []uint64 {
+				gaugeID1, _, _, _ := suite.SetupNewGauge(true, sdk.Coins{})
+				gaugeID2, gauge, _, startTime := suite.SetupNewGauge(false, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 10)})
+				suite.Ctx = suite.Ctx.WithBlockTime(startTime)
+				suite.Require().NoError(suite.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(suite.Ctx, *gauge))
+				for epoch := 0; epoch < 2; epoch++ {
+					gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID2)
+					suite.Require().NoError(err)
+					_, err = suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+					suite.Require().NoError(err)
+				}
+				return []uint64{gaugeID1, gaugeID2}
+			},
+			splittingPolicy: types.ByStaticWeight,
+			weights:         weights,
+			expectErr:       true,
+		},
+		"volume with an underlying gauge not distributing to pool shares, error": {
+			setup: func() []uint64 {
+				gaugeID, _, _, _ := suite.SetupNewGauge(true, sdk.Coins{})
+				return []uint64{suite.setupPoolGauge(suite.PrepareBalancerPool()), gaugeID}
+			},
+			splittingPolicy: types.ByVolume,
+			expectErr:       true,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			// lock tokens so the underlying gauges distribute
+			suite.LockTokens(suite.TestAccs[1], defaultLPTokens, defaultLockDuration)
+			gaugeIds := tc.setup()
+
+			// System under test.
+			groupGaugeID, err := suite.createGroupGauge(true, coins, 1, gaugeIds, tc.weights, tc.splittingPolicy)
+
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, groupGaugeID)
+			suite.Require().NoError(err)
+			suite.Require().True(gauge.IsGroupGauge())
+			suite.Require().Equal(coins, gauge.Coins)
+
+			groupGauge, err := suite.App.IncentivesKeeper.GetGroupGaugeByID(suite.Ctx, groupGaugeID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.splittingPolicy, groupGauge.SplittingPolicy)
+			suite.Require().Len(groupGauge.Records, len(gaugeIds))
+			for i, record := range groupGauge.Records {
+				suite.Require().Equal(gaugeIds[i], record.GaugeId)
+				if tc.splittingPolicy == types.ByStaticWeight {
+					suite.Require().Equal(tc.weights[i], record.Weight)
+				}
+			}
+
+			// group gauges are not indexed by denom.
+			suite.Require().Empty(suite.App.IncentivesKeeper.GetAllGaugeIDsByDenom(suite.Ctx, ""))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDistributeStaticWeightGroupGauge() {
+	suite.SetupTest()
+
+	gaugeID1, _, _, _ := suite.SetupNewGauge(true, sdk.Coins{})
+	gaugeID2, _, _, _ := suite.SetupNewGauge(true, sdk.Coins{})
+	groupGaugeID, err := suite.createGroupGauge(false, sdk.NewCoins(sdk.NewInt64Coin(defaultRewardDenom, 4000)), 2,
+		[]uint64{gaugeID1, gaugeID2}, []sdk.Int{sdk.NewInt(1), sdk.NewInt(3)}, types.ByStaticWeight)
+	suite.Require().NoError(err)
+	groupGauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, groupGaugeID)
+	suite.Require().NoError(err)
+	toDistributeBefore := suite.App.IncentivesKeeper.GetModuleToDistributeCoins(suite.Ctx)
+
+	// System under test.
+	// no locks exist, so the underlying gauges keep the forwarded coins.
+	_, err = suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*groupGauge})
+	suite.Require().NoError(err)
+
+	// half of the coins are forwarded in the first of two epochs, split 1:3.
+	gauge1, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(defaultRewardDenom, 500)), gauge1.Coins)
+	gauge2, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(defaultRewardDenom, 1500)), gauge2.Coins)
+
+	groupGauge, err = suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, groupGaugeID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), groupGauge.FilledEpochs)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(defaultRewardDenom, 2000)), groupGauge.DistributedCoins)
+
+	// forwarding coins between gauges does not change the coins left to distribute.
+	suite.Require().Equal(toDistributeBefore, suite.App.IncentivesKeeper.GetModuleToDistributeCoins(suite.Ctx))
+	suite.Require().True(suite.App.IncentivesKeeper.GetModuleDistributedCoins(suite.Ctx).Empty())
+}
+
+func (suite *KeeperTestSuite) TestDistributeGroupGaugeSkipsCancelledGauge() {
+	suite.SetupTest()
+
+	gaugeID1, _, _, _ := suite.SetupNewGauge(true, sdk.Coins{})
+	gaugeID2, _, _, _ := suite.SetupNewGauge(true, sdk.Coins{})
+	groupGaugeID, err := suite.createGroupGauge(true, sdk.NewCoins(sdk.NewInt64Coin(defaultRewardDenom, 1000)), 1,
+		[]uint64{gaugeID1, gaugeID2}, []sdk.Int{sdk.NewInt(1), sdk.NewInt(1)}, types.ByStaticWeight)
+	suite.Require().NoError(err)
+	_, err = suite.App.IncentivesKeeper.CancelGauge(suite.Ctx, defaultGaugeOwner, gaugeID2, suite.TestAccs[0])
+	suite.Require().NoError(err)
+	groupGauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, groupGaugeID)
+	suite.Require().NoError(err)
+
+	// System under test.
+	_, err = suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*groupGauge})
+	suite.Require().NoError(err)
+
+	// the cancelled gauge receives nothing, so all coins go to the remaining gauge.
+	gauge1, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(defaultRewardDenom, 1000)), gauge1.Coins)
+	gauge2, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID2)
+	suite.Require().NoError(err)
+	suite.Require().True(gauge2.Coins.Empty())
+}
+
+func (suite *KeeperTestSuite) TestDistributeGroupGaugeToLocks() {
+	suite.SetupTest()
+
+	// a single lock receives the rewards of both underlying gauges in the same epoch.
+	lockOwner := suite.TestAccs[1]
+	suite.LockTokens(lockOwner, defaultLPTokens, defaultLockDuration)
+	gaugeID1, _, _, _ := suite.SetupNewGauge(true, sdk.Coins{})
+	gaugeID2, _, _, startTime := suite.SetupNewGauge(true, sdk.Coins{})
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime)
+	_, err := suite.createGroupGauge(true, sdk.NewCoins(sdk.NewInt64Coin(defaultRewardDenom, 1000)), 1,
+		[]uint64{gaugeID1, gaugeID2}, []sdk.Int{sdk.NewInt(1), sdk.NewInt(1)}, types.ByStaticWeight)
+	suite.Require().NoError(err)
+
+	// System under test.
+	distributed, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, suite.App.IncentivesKeeper.GetUpcomingGauges(suite.Ctx))
+	suite.Require().NoError(err)
+
+	expectedRewards := sdk.NewCoins(sdk.NewInt64Coin(defaultRewardDenom, 1000))
+	suite.Require().Equal(expectedRewards, distributed)
+	suite.Require().Equal(expectedRewards.AmountOf(defaultRewardDenom), suite.App.BankKeeper.GetBalance(suite.Ctx, lockOwner, defaultRewardDenom).Amount)
+}
+
+func (suite *KeeperTestSuite) TestDistributeVolumeGroupGauge() {
+	suite.SetupTest()
+
+	swapFee := sdk.NewDecWithPrec(1, 2)
+	poolId1 := suite.prepareSwapFeePool("foo", swapFee)
+	poolId2 := suite.prepareSwapFeePool("bar", swapFee)
+	gaugeID1 := suite.setupPoolGauge(poolId1)
+	gaugeID2 := suite.setupPoolGauge(poolId2)
+
+	// swap fees paid before the group gauge is created are not counted.
+	suite.swap(poolId1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 50000), "foo")
+
+	groupGaugeID, err := suite.createGroupGauge(true, sdk.NewCoins(sdk.NewInt64Coin(defaultRewardDenom, 4000)), 1,
+		[]uint64{gaugeID1, gaugeID2}, nil, types.ByVolume)
+	suite.Require().NoError(err)
+	groupGauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, groupGaugeID)
+	suite.Require().NoError(err)
+
+	// pool 2's swap is valued through its base denom output.
+	suite.swap(poolId1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000), "foo")
+	suite.swap(poolId2, sdk.NewInt64Coin("bar", 30100), sdk.DefaultBondDenom)
+	suite.Require().Equal(sdk.NewInt(60000), suite.App.IncentivesKeeper.GetPoolVolume(suite.Ctx, poolId1))
+	suite.Require().Equal(sdk.NewInt(600), suite.App.IncentivesKeeper.GetPoolSwapFees(suite.Ctx, poolId1))
+	pool2SwapFees := suite.App.IncentivesKeeper.GetPoolSwapFees(suite.Ctx, poolId2)
+	suite.Require().Equal(swapFee.MulInt(suite.App.IncentivesKeeper.GetPoolVolume(suite.Ctx, poolId2)).TruncateInt(), pool2SwapFees)
+
+	// System under test.
+	_, err = suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*groupGauge})
+	suite.Require().NoError(err)
+
+	totalSwapFees := pool2SwapFees.AddRaw(100)
+	gauge1, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(4000).MulRaw(100).Quo(totalSwapFees), gauge1.Coins.AmountOf(defaultRewardDenom))
+	gauge2, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(4000).Mul(pool2SwapFees).Quo(totalSwapFees), gauge2.Coins.AmountOf(defaultRewardDenom))
+
+	record, err := suite.App.IncentivesKeeper.GetGroupGaugeByID(suite.Ctx, groupGaugeID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(100), record.Records[0].Weight)
+	suite.Require().Equal(sdk.NewInt(600), record.Records[0].CumulativeSwapFees)
+
+	// without swap fees since the previous distribution, nothing is forwarded.
+	groupGauge, err = suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, groupGaugeID)
+	suite.Require().NoError(err)
+	distributedBefore := groupGauge.DistributedCoins
+	_, err = suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*groupGauge})
+	suite.Require().NoError(err)
+	groupGauge, err = suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, groupGaugeID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(distributedBefore, groupGauge.DistributedCoins)
+}
+
+func (suite *KeeperTestSuite) TestDistributeVolumeGroupGaugeWithoutSwapFees() {
+	suite.SetupTest()
+
+	// swaps on a pool without swap fees do not count.
+	poolId := suite.prepareSwapFeePool("foo", sdk.ZeroDec())
+	gaugeID := suite.setupPoolGauge(poolId)
+	groupGaugeID, err := suite.createGroupGauge(true, sdk.NewCoins(sdk.NewInt64Coin(defaultRewardDenom, 1000)), 1,
+		[]uint64{gaugeID}, nil, types.ByVolume)
+	suite.Require().NoError(err)
+	suite.swap(poolId, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000), "foo")
+	suite.Require().Equal(sdk.NewInt(10000), suite.App.IncentivesKeeper.GetPoolVolume(suite.Ctx, poolId))
+	groupGauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, groupGaugeID)
+	suite.Require().NoError(err)
+
+	// System under test.
+	_, err = suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*groupGauge})
+	suite.Require().NoError(err)
+
+	gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+	suite.Require().NoError(err)
+	suite.Require().True(gauge.Coins.Empty())
+}
+
+func (suite *KeeperTestSuite) TestDistributeGroupGaugeCarriesEpochForward() {
+	suite.SetupTest()
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(defaultRewardDenom, 1000))
+	poolId := suite.prepareSwapFeePool("foo", sdk.NewDecWithPrec(1, 2))
+	gaugeID := suite.setupPoolGauge(poolId)
+	groupGaugeID, err := suite.createGroupGauge(false, coins, 1, []uint64{gaugeID}, nil, types.ByVolume)
+	suite.Require().NoError(err)
+	groupGauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, groupGaugeID)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(suite.Ctx, *groupGauge))
+
+	// System under test.
+	// without swap fees, the group gauge's only epoch is carried forward instead of finishing it.
+	_, err = suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*groupGauge})
+	suite.Require().NoError(err)
+
+	groupGauge, err = suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, groupGaugeID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(0), groupGauge.FilledEpochs)
+	suite.Require().True(groupGauge.DistributedCoins.Empty())
+	suite.Require().Empty(suite.App.IncentivesKeeper.GetFinishedGauges(suite.Ctx))
+	suite.Require().Equal(coins, suite.App.IncentivesKeeper.GetModuleToDistributeCoins(suite.Ctx))
+
+	// once swap fees are paid, all of the coins are forwarded and the group gauge finishes.
+	suite.swap(poolId, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000), "foo")
+	_, err = suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*groupGauge})
+	suite.Require().NoError(err)
+
+	gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(coins, gauge.Coins)
+	finishedGauges := suite.App.IncentivesKeeper.GetFinishedGauges(suite.Ctx)
+	suite.Require().Len(finishedGauges, 1)
+	suite.Require().Equal(groupGaugeID, finishedGauges[0].Id)
+	suite.Require().Equal(uint64(1), finishedGauges[0].FilledEpochs)
+	suite.Require().Equal(coins, finishedGauges[0].DistributedCoins)
+}
+
+// prepareSwapFeePool creates a balancer pool of the bond denom and the provided denom, with the provided swap fee.
+func (suite *KeeperTestSuite) prepareSwapFeePool(denom string, swapFee sdk.Dec) uint64 {
+	poolAssets := []balancer.PoolAsset{
+		{Weight: sdk.OneInt(), Token: sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000)},
+		{Weight: sdk.OneInt(), Token: sdk.NewInt64Coin(denom, 1000000)},
+	}
+	suite.FundAcc(suite.TestAccs[0], sdk.NewCoins(sdk.NewInt64Coin("uosmo", 10000000000), poolAssets[0].Token, poolAssets[1].Token))
+	msg := balancer.NewMsgCreateBalancerPool(suite.TestAccs[0], balancer.PoolParams{SwapFee: swapFee, ExitFee: sdk.ZeroDec()}, poolAssets, "")
+	poolId, err := suite.App.GAMMKeeper.CreatePool(suite.Ctx, msg)
+	suite.Require().NoError(err)
+	return poolId
+}
+
+// swap swaps tokenIn for tokenOutDenom on the provided pool.
+func (suite *KeeperTestSuite) swap(poolId uint64, tokenIn sdk.Coin, tokenOutDenom string) {
+	suite.swapFrom(suite.TestAccs[2], poolId, tokenIn, tokenOutDenom)
+}
+
+// swapFrom funds the sender with tokenIn and swaps it for tokenOutDenom on the provided pool.
+func (suite *KeeperTestSuite) swapFrom(sender sdk.AccAddress, poolId uint64, tokenIn sdk.Coin, tokenOutDenom string) {
+	suite.FundAcc(sender, sdk.NewCoins(tokenIn))
+	_, err := suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, sender, poolId, tokenIn, tokenOutDenom, sdk.OneInt())
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestGRPCGroupGauges() {
+	suite.SetupTest()
+
+	gaugeID1, _, _, _ := suite.SetupNewGauge(true, sdk.Coins{})
+	gaugeID2, _, _, _ := suite.SetupNewGauge(true, sdk.Coins{})
+	groupGaugeID, err := suite.createGroupGauge(true, sdk.NewCoins(sdk.NewInt64Coin(defaultRewardDenom, 1000)), 1,
+		[]uint64{gaugeID1, gaugeID2}, []sdk.Int{sdk.NewInt(1), sdk.NewInt(1)}, types.ByStaticWeight)
+	suite.Require().NoError(err)
+
+	res, err := suite.querier.GroupGaugeByID(sdk.WrapSDKContext(suite.Ctx), &types.GroupGaugeByIDRequest{Id: groupGaugeID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(groupGaugeID, res.Gauge.Id)
+	suite.Require().Equal(groupGaugeID, res.GroupGauge.GaugeId)
+
+	// a regular gauge is not a group gauge.
+	_, err = suite.querier.GroupGaugeByID(sdk.WrapSDKContext(suite.Ctx), &types.GroupGaugeByIDRequest{Id: gaugeID1})
+	suite.Require().Error(err)
+
+	allRes, err := suite.querier.GroupGauges(sdk.WrapSDKContext(suite.Ctx), &types.GroupGaugesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.GroupGauge{res.GroupGauge}, allRes.GroupGauges)
+}
+
+func (suite *KeeperTestSuite) TestGroupGaugeGenesis() {
+	suite.SetupTest()
+
+	gaugeID1, _, _, _ := suite.SetupNewGauge(true, sdk.Coins{})
+	gaugeID2, _, _, _ := suite.SetupNewGauge(true, sdk.Coins{})
+	_, err := suite.createGroupGauge(true, sdk.NewCoins(sdk.NewInt64Coin(defaultRewardDenom, 1000)), 1,
+		[]uint64{gaugeID1, gaugeID2}, []sdk.Int{sdk.NewInt(1), sdk.NewInt(1)}, types.ByStaticWeight)
+	suite.Require().NoError(err)
+	poolId := suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000), sdk.NewInt64Coin("foo", 1000000))
+	suite.swap(poolId, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), "foo")
+
+	genesis := suite.App.IncentivesKeeper.ExportGenesis(suite.Ctx)
+	suite.Require().NoError(genesis.Validate())
+	suite.Require().Equal(suite.App.IncentivesKeeper.GetAllGroupGauges(suite.Ctx), genesis.GroupGauges)
+	suite.Require().Equal([]types.PoolVolume{{PoolId: poolId, Volume: sdk.NewInt(100), SwapFees: sdk.ZeroInt()}}, genesis.PoolVolumes)
+
+	// System under test.
+	suite.SetupTest()
+	suite.Ctx = suite.Ctx.WithBlockTime(time.Now())
+	suite.App.IncentivesKeeper.InitGenesis(suite.Ctx, *genesis)
+
+	suite.Require().Equal(genesis, suite.App.IncentivesKeeper.ExportGenesis(suite.Ctx))
+}
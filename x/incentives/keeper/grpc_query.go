@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 
+	"github.com/gogo/protobuf/proto"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -12,6 +13,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
+	"github.com/osmosis-labs/osmosis/v13/osmoutils"
 	"github.com/osmosis-labs/osmosis/v13/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
 )
@@ -28,6 +30,57 @@ func NewQuerier(k Keeper) Querier {
 	return Querier{Keeper: k}
 }
 
+// Params returns incentives module params.
+func (q Querier) Params(goCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryParamsResponse{Params: q.Keeper.GetParams(ctx)}, nil
+}
+
+// GroupGaugeByID takes a group gauge ID and returns its gauge and group record.
+func (q Querier) GroupGaugeByID(goCtx context.Context, req *types.GroupGaugeByIDRequest) (*types.GroupGaugeByIDResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	groupGauge, err := q.Keeper.GetGroupGaugeByID(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	gauge, err := q.Keeper.GetGaugeByID(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GroupGaugeByIDResponse{Gauge: *gauge, GroupGauge: groupGauge}, nil
+}
+
+// GroupGauges returns the group records of all group gauges.
+func (q Querier) GroupGauges(goCtx context.Context, req *types.GroupGaugesRequest) (*types.GroupGaugesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(q.Keeper.storeKey), types.KeyPrefixGroupGauges)
+
+	groupGauges := []types.GroupGauge{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		groupGauge := types.GroupGauge{}
+		if err := proto.Unmarshal(value, &groupGauge); err != nil {
+			return err
+		}
+		groupGauges = append(groupGauges, groupGauge)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.GroupGaugesResponse{GroupGauges: groupGauges, Pagination: pageRes}, nil
+}
+
 // ModuleToDistributeCoins returns coins that are going to be distributed.
 func (q Querier) ModuleToDistributeCoins(goCtx context.Context, _ *types.ModuleToDistributeCoinsRequest) (*types.ModuleToDistributeCoinsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -167,6 +220,49 @@ func (q Querier) RewardsEst(goCtx context.Context, req *types.RewardsEstRequest)
 	return &types.RewardsEstResponse{Coins: q.Keeper.GetRewardsEst(ctx, ownerAddress, locks, req.EndEpoch)}, nil
 }
 
+// LockRewardsBreakdown returns the rewards a lock is estimated to receive in the next distribution, by gauge.
+func (q Querier) LockRewardsBreakdown(goCtx context.Context, req *types.LockRewardsBreakdownRequest) (*types.LockRewardsBreakdownResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	lock, err := q.Keeper.lk.GetLockByID(ctx, req.LockId)
+	if err != nil {
+		return nil, err
+	}
+
+	breakdowns, pageRes, err := q.paginateRewardsBreakdown(ctx, []lockuptypes.PeriodLock{*lock}, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.LockRewardsBreakdownResponse{Rewards: breakdowns, Pagination: pageRes}, nil
+}
+
+// AccountRewardsBreakdown returns the rewards the locks of an account are estimated to receive in the next distribution, by gauge.
+func (q Querier) AccountRewardsBreakdown(goCtx context.Context, req *types.AccountRewardsBreakdownRequest) (*types.AccountRewardsBreakdownResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Owner) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty owner")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	breakdowns, pageRes, err := q.paginateRewardsBreakdown(ctx, q.Keeper.lk.GetAccountPeriodLocks(ctx, owner), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.AccountRewardsBreakdownResponse{Rewards: breakdowns, Pagination: pageRes}, nil
+}
+
 // LockableDurations returns all of the allowed lockable durations on chain.
 func (q Querier) LockableDurations(ctx context.Context, _ *types.QueryLockableDurationsRequest) (*types.QueryLockableDurationsResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -174,6 +270,26 @@ func (q Querier) LockableDurations(ctx context.Context, _ *types.QueryLockableDu
 	return &types.QueryLockableDurationsResponse{LockableDurations: q.Keeper.GetLockableDurations(sdkCtx)}, nil
 }
 
+// paginateRewardsBreakdown paginates over the gauges distributing to the provided locks and returns the rewards breakdown
+// of the gauges in the requested page, so that only those gauges' rewards are estimated.
+func (q Querier) paginateRewardsBreakdown(ctx sdk.Context, locks []lockuptypes.PeriodLock, pagination *query.PageRequest) ([]types.GaugeRewardsBreakdown, *query.PageResponse, error) {
+	gaugeIDs, err := q.Keeper.getRewardsBreakdownGaugeIDs(ctx, locks)
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	gaugeIDs, pageRes, err := osmoutils.PaginateSlice(gaugeIDs, pagination)
+	if err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	breakdowns, err := q.Keeper.getGaugesRewardsBreakdown(ctx, gaugeIDs, locks)
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+	return breakdowns, pageRes, nil
+}
+
 // getGaugeFromIDJsonBytes returns gauges from the json bytes of gaugeIDs.
 func (q Querier) getGaugeFromIDJsonBytes(ctx sdk.Context, refValue []byte) ([]types.Gauge, error) {
 	gauges := []types.Gauge{}
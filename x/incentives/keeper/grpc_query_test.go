@@ -15,6 +15,21 @@ import (
 
 var _ = suite.TestingSuite(nil)
 
+// TestGRPCParams tests querying params via gRPC returns the module params, including the gauge fees.
+func (suite *KeeperTestSuite) TestGRPCParams() {
+	suite.SetupTest()
+
+	params := suite.App.IncentivesKeeper.GetParams(suite.Ctx)
+	params.CreateGaugeFee = sdk.NewInt(10)
+	params.AddToGaugeFee = sdk.NewInt(5)
+	params.AcceptedFeeDenoms = []string{"uion"}
+	suite.App.IncentivesKeeper.SetParams(suite.Ctx, params)
+
+	res, err := suite.querier.Params(sdk.WrapSDKContext(suite.Ctx), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, res.Params)
+}
+
 // TestGRPCGaugeByID tests querying gauges via gRPC returns the correct response.
 func (suite *KeeperTestSuite) TestGRPCGaugeByID() {
 	suite.SetupTest()
@@ -44,6 +59,7 @@ func (suite *KeeperTestSuite) TestGRPCGaugeByID() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeOwner.String(),
 	}
 	suite.Require().Equal(res.Gauge.String(), expectedGauge.String())
 }
@@ -77,6 +93,7 @@ func (suite *KeeperTestSuite) TestGRPCGauges() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeOwner.String(),
 	}
 	suite.Require().Equal(res.Data[0].String(), expectedGauge.String())
 
@@ -124,6 +141,7 @@ func (suite *KeeperTestSuite) TestGRPCActiveGauges() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeOwner.String(),
 	}
 	suite.Require().Equal(res.Data[0].String(), expectedGauge.String())
 
@@ -178,6 +196,7 @@ func (suite *KeeperTestSuite) TestGRPCActiveGaugesPerDenom() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeOwner.String(),
 	}
 	suite.Require().Equal(res.Data[0].String(), expectedGauge.String())
 
@@ -234,6 +253,7 @@ func (suite *KeeperTestSuite) TestGRPCUpcomingGauges() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeOwner.String(),
 	}
 	suite.Require().Equal(res.Data[0].String(), expectedGauge.String())
 
@@ -288,6 +308,7 @@ func (suite *KeeperTestSuite) TestGRPCUpcomingGaugesPerDenom() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeOwner.String(),
 	}
 	suite.Require().Equal(res.UpcomingGauges[0].String(), expectedGauge.String())
 
@@ -402,6 +423,66 @@ func (suite *KeeperTestSuite) TestRewardsEstWithPoolIncentives() {
 	suite.Require().Equal(res.Coins, coins.Add(mintCoins))
 }
 
+// TestGRPCLockRewardsBreakdown tests querying the rewards breakdown of a lock via gRPC returns its rewards by gauge, paginated.
+func (suite *KeeperTestSuite) TestGRPCLockRewardsBreakdown() {
+	suite.SetupTest()
+	ownerLockIDs, _, gaugeIDs := suite.setupRewardsBreakdown()
+
+	// query the first page of the long lock's breakdown
+	res, err := suite.querier.LockRewardsBreakdown(sdk.WrapSDKContext(suite.Ctx), &types.LockRewardsBreakdownRequest{
+		LockId:     ownerLockIDs[1],
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.GaugeRewardsBreakdown{
+		{GaugeId: gaugeIDs[0], LockIds: []uint64{ownerLockIDs[1]}, Coins: sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 100)}},
+	}, res.Rewards)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	// query the next page
+	res, err = suite.querier.LockRewardsBreakdown(sdk.WrapSDKContext(suite.Ctx), &types.LockRewardsBreakdownRequest{
+		LockId:     ownerLockIDs[1],
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.GaugeRewardsBreakdown{
+		{GaugeId: gaugeIDs[1], LockIds: []uint64{ownerLockIDs[1]}, Coins: sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 100)}},
+	}, res.Rewards)
+	suite.Require().Nil(res.Pagination.NextKey)
+
+	// querying a non-existent lock fails
+	_, err = suite.querier.LockRewardsBreakdown(sdk.WrapSDKContext(suite.Ctx), &types.LockRewardsBreakdownRequest{LockId: 100})
+	suite.Require().Error(err)
+}
+
+// TestGRPCAccountRewardsBreakdown tests querying the rewards breakdown of an account via gRPC returns the rewards
+// of all of its locks by gauge.
+func (suite *KeeperTestSuite) TestGRPCAccountRewardsBreakdown() {
+	suite.SetupTest()
+
+	// an account without locks has no rewards
+	res, err := suite.querier.AccountRewardsBreakdown(sdk.WrapSDKContext(suite.Ctx), &types.AccountRewardsBreakdownRequest{
+		Owner: suite.TestAccs[2].String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Rewards)
+
+	ownerLockIDs, _, gaugeIDs := suite.setupRewardsBreakdown()
+	res, err = suite.querier.AccountRewardsBreakdown(sdk.WrapSDKContext(suite.Ctx), &types.AccountRewardsBreakdownRequest{
+		Owner: suite.TestAccs[0].String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.GaugeRewardsBreakdown{
+		{GaugeId: gaugeIDs[0], LockIds: ownerLockIDs, Coins: sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 200)}},
+		{GaugeId: gaugeIDs[1], LockIds: ownerLockIDs[1:], Coins: sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 100)}},
+	}, res.Rewards)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	// querying an invalid owner fails
+	_, err = suite.querier.AccountRewardsBreakdown(sdk.WrapSDKContext(suite.Ctx), &types.AccountRewardsBreakdownRequest{Owner: "invalid"})
+	suite.Require().Error(err)
+}
+
 // TestGRPCToDistributeCoins tests querying coins that are going to be distributed via gRPC returns the correct response.
 func (suite *KeeperTestSuite) TestGRPCToDistributeCoins() {
 	suite.SetupTest()
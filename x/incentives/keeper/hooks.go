@@ -1,7 +1,10 @@
 package keeper
 
 import (
+	"time"
+
 	epochstypes "github.com/osmosis-labs/osmosis/v13/x/epochs/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v13/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
 
@@ -47,6 +50,8 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 		if err != nil {
 			return err
 		}
+		// trader volumes are only counted for the epoch they were traded in.
+		k.clearTraderVolumes(ctx)
 	}
 	return nil
 }
@@ -74,3 +79,95 @@ func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNu
 func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
 	return h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
 }
+
+// ___________________________________________________________________________________________________
+
+// gammhook is the wrapper struct for the incentives keeper's gamm hooks, which track pool volumes.
+type gammhook struct {
+	k Keeper
+}
+
+var _ gammtypes.GammHooks = gammhook{}
+
+// GammHooks returns the gamm hook wrapper struct.
+func (k Keeper) GammHooks() gammtypes.GammHooks {
+	return gammhook{k}
+}
+
+// AfterPoolCreated is called after CreatePool.
+func (h gammhook) AfterPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {}
+
+// AfterJoinPool is called after JoinPool, JoinSwapExternAmountIn, and JoinSwapShareAmountOut.
+func (h gammhook) AfterJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, enterCoins sdk.Coins, shareOutAmount sdk.Int) {
+}
+
+// AfterExitPool is called after ExitPool, ExitSwapShareAmountIn, and ExitSwapExternAmountOut.
+func (h gammhook) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, exitCoins sdk.Coins) {
+}
+
+// AfterSwap is called after SwapExactAmountIn and SwapExactAmountOut, and tracks the pool's and the trader's volume.
+func (h gammhook) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins, swapFee sdk.Dec) {
+	h.k.trackSwapVolume(ctx, sender, poolId, input, output, swapFee)
+}
+
+// AfterPoolAssetsChanged is called after an asset is added to or removed from a pool.
+func (h gammhook) AfterPoolAssetsChanged(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {}
+
+// ___________________________________________________________________________________________________
+
+// lockuphook is the wrapper struct for the incentives keeper's lockup hooks, which settle the rewards
+// locks accrued in the reward accumulators whenever their coins or duration change.
+type lockuphook struct {
+	k Keeper
+}
+
+var _ lockuptypes.LockupHooks = lockuphook{}
+
+// LockupHooks returns the lockup hook wrapper struct.
+func (k Keeper) LockupHooks() lockuptypes.LockupHooks {
+	return lockuphook{k}
+}
+
+// settleLockRewards settles the lock's rewards, logging rather than returning errors as lockup hooks can not fail.
+func (h lockuphook) settleLockRewards(ctx sdk.Context, lockID uint64, owner sdk.AccAddress) {
+	if _, err := h.k.settleLockRewards(ctx, lockID, owner); err != nil {
+		h.k.Logger(ctx).Error(err.Error())
+	}
+}
+
+// AfterAddTokensToLock is called after tokens are added to a lock, whose rewards are settled by OnTokenLocked.
+func (h lockuphook) AfterAddTokensToLock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins) {
+}
+
+// OnTokenLocked is called after a lock is created or tokens are added to it, and settles its rewards.
+func (h lockuphook) OnTokenLocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	h.settleLockRewards(ctx, lockID, address)
+}
+
+// OnStartUnlock is called after a lock starts unlocking, and settles its rewards. Unlocking locks keep accruing
+// rewards until they are unlocked, but boosted gauges send them their decaying share instead.
+func (h lockuphook) OnStartUnlock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	h.settleLockRewards(ctx, lockID, address)
+}
+
+// OnTokenUnlocked is called after a lock is unlocked and deleted, and sends its rewards to its owner.
+func (h lockuphook) OnTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	h.settleLockRewards(ctx, lockID, address)
+}
+
+// OnTokenSlashed is called after tokens of a lock are slashed, and settles its rewards.
+func (h lockuphook) OnTokenSlashed(ctx sdk.Context, lockID uint64, amount sdk.Coins) {
+	h.settleLockRewards(ctx, lockID, nil)
+}
+
+// OnLockupExtend is called after the duration of a lock is extended, and settles its rewards.
+func (h lockuphook) OnLockupExtend(ctx sdk.Context, lockID uint64, prevDuration time.Duration, newDuration time.Duration) {
+	h.settleLockRewards(ctx, lockID, nil)
+}
+
+// AfterLockSplit is called after coins of a lock are split into a new lock, and settles the rewards of both locks.
+// The original lock's rewards are settled first, as its positions still hold the split coins.
+func (h lockuphook) AfterLockSplit(ctx sdk.Context, lockID uint64, splitLockID uint64, splitCoins sdk.Coins) {
+	h.settleLockRewards(ctx, lockID, nil)
+	h.settleLockRewards(ctx, splitLockID, nil)
+}
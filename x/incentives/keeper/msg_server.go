@@ -33,11 +33,11 @@ func (server msgServer) CreateGauge(goCtx context.Context, msg *types.MsgCreateG
 		return nil, err
 	}
 
-	if err := server.keeper.chargeFeeIfSufficientFeeDenomBalance(ctx, owner, types.CreateGaugeFee, msg.Coins); err != nil {
+	if err := server.keeper.chargeFeeIfSufficientFeeDenomBalance(ctx, owner, server.keeper.GetParams(ctx).CreateGaugeFee, msg.Coins); err != nil {
 		return nil, err
 	}
 
-	gaugeID, err := server.keeper.CreateGauge(ctx, msg.IsPerpetual, owner, msg.Coins, msg.DistributeTo, msg.StartTime, msg.NumEpochsPaidOver)
+	gaugeID, err := server.keeper.CreateBoostedGauge(ctx, msg.IsPerpetual, owner, msg.Coins, msg.DistributeTo, msg.StartTime, msg.NumEpochsPaidOver, msg.BoostCurve)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
@@ -61,7 +61,7 @@ func (server msgServer) AddToGauge(goCtx context.Context, msg *types.MsgAddToGau
 		return nil, err
 	}
 
-	if err := server.keeper.chargeFeeIfSufficientFeeDenomBalance(ctx, owner, types.AddToGaugeFee, msg.Rewards); err != nil {
+	if err := server.keeper.chargeFeeIfSufficientFeeDenomBalance(ctx, owner, server.keeper.GetParams(ctx).AddToGaugeFee, msg.Rewards); err != nil {
 		return nil, err
 	}
 	err = server.keeper.AddToGaugeRewards(ctx, owner, msg.Rewards, msg.GaugeId)
@@ -78,3 +78,81 @@ func (server msgServer) AddToGauge(goCtx context.Context, msg *types.MsgAddToGau
 
 	return &types.MsgAddToGaugeResponse{}, nil
 }
+
+// CreateGroupGauge creates a group gauge and sends coins to the group gauge.
+// Emits create group gauge event and returns the create group gauge response.
+func (server msgServer) CreateGroupGauge(goCtx context.Context, msg *types.MsgCreateGroupGauge) (*types.MsgCreateGroupGaugeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.keeper.chargeFeeIfSufficientFeeDenomBalance(ctx, owner, server.keeper.GetParams(ctx).CreateGaugeFee, msg.Coins); err != nil {
+		return nil, err
+	}
+
+	gaugeID, err := server.keeper.CreateGroupGauge(ctx, msg.IsPerpetual, owner, msg.Coins, msg.StartTime, msg.NumEpochsPaidOver, msg.GaugeIds, msg.Weights, msg.SplittingPolicy)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtCreateGroupGauge,
+			sdk.NewAttribute(types.AttributeGaugeID, utils.Uint64ToString(gaugeID)),
+		),
+	})
+
+	return &types.MsgCreateGroupGaugeResponse{GroupGaugeId: gaugeID}, nil
+}
+
+// CancelGauge cancels a gauge and refunds its undistributed coins to the recipient, or to the owner if no recipient is set.
+// Emits cancel gauge event and returns the cancel gauge response.
+func (server msgServer) CancelGauge(goCtx context.Context, msg *types.MsgCancelGauge) (*types.MsgCancelGaugeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+	recipient := owner
+	if msg.Recipient != "" {
+		recipient, err = sdk.AccAddressFromBech32(msg.Recipient)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	refund, err := server.keeper.CancelGauge(ctx, owner, msg.GaugeId, recipient)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtCancelGauge,
+			sdk.NewAttribute(types.AttributeGaugeID, utils.Uint64ToString(msg.GaugeId)),
+			sdk.NewAttribute(types.AttributeReceiver, recipient.String()),
+			sdk.NewAttribute(types.AttributeAmount, refund.String()),
+		),
+	})
+
+	return &types.MsgCancelGaugeResponse{RefundedCoins: refund}, nil
+}
+
+// ClaimRewards claims the rewards accrued by the owner's locks in the reward accumulators.
+// Emits a claim rewards event for each lock with rewards and returns the claim rewards response.
+func (server msgServer) ClaimRewards(goCtx context.Context, msg *types.MsgClaimRewards) (*types.MsgClaimRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	claimedCoins, err := server.keeper.ClaimRewards(ctx, owner, msg.LockIds)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.MsgClaimRewardsResponse{ClaimedCoins: claimedCoins}, nil
+}
@@ -0,0 +1,287 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/osmosis/v13/osmoutils"
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/utils"
+	"github.com/osmosis-labs/osmosis/v13/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// rewardAccumulatorsPrefix returns the store prefix of the reward accumulators of the provided denom.
+func rewardAccumulatorsPrefix(denom string) []byte {
+	return combineKeys(types.KeyPrefixRewardAccumulators, []byte(denom), []byte{})
+}
+
+// rewardAccumulatorStoreKey returns the store key of the reward accumulator of the provided denom and duration.
+// Durations are big endian encoded, so that the accumulators of a denom are iterated by increasing duration.
+func rewardAccumulatorStoreKey(denom string, duration time.Duration) []byte {
+	return combineKeys(types.KeyPrefixRewardAccumulators, []byte(denom), sdk.Uint64ToBigEndian(uint64(duration)))
+}
+
+// lockRewardPositionsPrefix returns the store prefix of the reward positions of the provided lock.
+func lockRewardPositionsPrefix(lockID uint64) []byte {
+	return combineKeys(types.KeyPrefixLockRewardPositions, sdk.Uint64ToBigEndian(lockID), []byte{})
+}
+
+// lockRewardPositionStoreKey returns the store key of the reward position of the provided lock and denom.
+func lockRewardPositionStoreKey(lockID uint64, denom string) []byte {
+	return combineKeys(types.KeyPrefixLockRewardPositions, sdk.Uint64ToBigEndian(lockID), []byte(denom))
+}
+
+// GetRewardAccumulator returns the reward accumulator of the provided denom and duration.
+// Returns an accumulator without rewards if none has been accrued for the denom and duration.
+func (k Keeper) GetRewardAccumulator(ctx sdk.Context, denom string, duration time.Duration) types.RewardAccumulator {
+	store := ctx.KVStore(k.storeKey)
+	accumulator := types.RewardAccumulator{}
+	found, err := osmoutils.Get(store, rewardAccumulatorStoreKey(denom, duration), &accumulator)
+	if err != nil {
+		panic(err)
+	}
+	if !found {
+		return types.RewardAccumulator{Denom: denom, Duration: duration, RewardsPerShare: sdk.DecCoins{}, BoostRewardsPerShare: sdk.DecCoins{}}
+	}
+	return accumulator
+}
+
+// setRewardAccumulator sets the reward accumulator inside store.
+func (k Keeper) setRewardAccumulator(ctx sdk.Context, accumulator types.RewardAccumulator) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, rewardAccumulatorStoreKey(accumulator.Denom, accumulator.Duration), &accumulator)
+}
+
+// GetAllRewardAccumulators returns all reward accumulators.
+func (k Keeper) GetAllRewardAccumulators(ctx sdk.Context) []types.RewardAccumulator {
+	return k.getRewardAccumulatorsFromPrefix(ctx, types.KeyPrefixRewardAccumulators)
+}
+
+// getRewardAccumulatorsFromPrefix returns the reward accumulators stored under the provided prefix.
+func (k Keeper) getRewardAccumulatorsFromPrefix(ctx sdk.Context, prefix []byte) []types.RewardAccumulator {
+	store := ctx.KVStore(k.storeKey)
+	accumulators, err := osmoutils.GatherValuesFromStorePrefix(store, prefix, func(bz []byte) (types.RewardAccumulator, error) {
+		accumulator := types.RewardAccumulator{}
+		err := proto.Unmarshal(bz, &accumulator)
+		return accumulator, err
+	})
+	if err != nil {
+		panic(err)
+	}
+	return accumulators
+}
+
+// getRewardsPerShare returns the rewards per share and boost rewards per share accrued for a lock of the provided
+// denom and duration, which are the sums of the accumulators of the denom whose duration is not longer than the lock's.
+func (k Keeper) getRewardsPerShare(ctx sdk.Context, denom string, duration time.Duration) (sdk.DecCoins, sdk.DecCoins) {
+	rewardsPerShare := sdk.DecCoins{}
+	boostRewardsPerShare := sdk.DecCoins{}
+	for _, accumulator := range k.getRewardAccumulatorsFromPrefix(ctx, rewardAccumulatorsPrefix(denom)) {
+		if accumulator.Duration > duration {
+			break
+		}
+		rewardsPerShare = rewardsPerShare.Add(accumulator.RewardsPerShare...)
+		boostRewardsPerShare = boostRewardsPerShare.Add(accumulator.BoostRewardsPerShare...)
+	}
+	return rewardsPerShare, boostRewardsPerShare
+}
+
+// setLockRewardPosition sets the lock reward position inside store.
+func (k Keeper) setLockRewardPosition(ctx sdk.Context, position types.LockRewardPosition) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, lockRewardPositionStoreKey(position.LockId, position.Denom), &position)
+}
+
+// GetLockRewardPositions returns the reward positions of the provided lock, one for each denom in the lock.
+func (k Keeper) GetLockRewardPositions(ctx sdk.Context, lockID uint64) []types.LockRewardPosition {
+	return k.getLockRewardPositionsFromPrefix(ctx, lockRewardPositionsPrefix(lockID))
+}
+
+// GetAllLockRewardPositions returns the reward positions of all locks.
+func (k Keeper) GetAllLockRewardPositions(ctx sdk.Context) []types.LockRewardPosition {
+	return k.getLockRewardPositionsFromPrefix(ctx, types.KeyPrefixLockRewardPositions)
+}
+
+// getLockRewardPositionsFromPrefix returns the lock reward positions stored under the provided prefix.
+func (k Keeper) getLockRewardPositionsFromPrefix(ctx sdk.Context, prefix []byte) []types.LockRewardPosition {
+	store := ctx.KVStore(k.storeKey)
+	positions, err := osmoutils.GatherValuesFromStorePrefix(store, prefix, func(bz []byte) (types.LockRewardPosition, error) {
+		position := types.LockRewardPosition{}
+		err := proto.Unmarshal(bz, &position)
+		return position, err
+	})
+	if err != nil {
+		panic(err)
+	}
+	return positions
+}
+
+// resetLockRewardPositions replaces the reward positions of the provided lock by positions for its current coins,
+// duration and unlocking status, as of the current rewards per share.
+func (k Keeper) resetLockRewardPositions(ctx sdk.Context, lock lockuptypes.PeriodLock) {
+	k.deleteLockRewardPositions(ctx, lock.ID)
+	for _, coin := range lock.Coins {
+		rewardsPerShare, boostRewardsPerShare := k.getRewardsPerShare(ctx, coin.Denom, lock.Duration)
+		k.setLockRewardPosition(ctx, types.LockRewardPosition{
+			LockId:               lock.ID,
+			Denom:                coin.Denom,
+			Amount:               coin.Amount,
+			Duration:             lock.Duration,
+			RewardsPerShare:      rewardsPerShare,
+			BoostRewardsPerShare: boostRewardsPerShare,
+			Unlocking:            lock.IsUnlocking(),
+		})
+	}
+}
+
+// deleteLockRewardPositions deletes the reward positions of the provided lock.
+func (k Keeper) deleteLockRewardPositions(ctx sdk.Context, lockID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	for _, position := range k.GetLockRewardPositions(ctx, lockID) {
+		store.Delete(lockRewardPositionStoreKey(lockID, position.Denom))
+	}
+}
+
+// GetLockPendingRewards returns the rewards the provided lock accrued since its last reward settlement.
+// Rewards are paid out in whole coins, so the decimal part is dropped.
+func (k Keeper) GetLockPendingRewards(ctx sdk.Context, lockID uint64) sdk.Coins {
+	rewards, _ := k.getLockPendingDecRewards(ctx, lockID).TruncateDecimal()
+	return rewards
+}
+
+// getLockPendingDecRewards returns the rewards the provided lock accrued since its last reward settlement,
+// which for each of its positions is the position amount times the increase of its rewards per share,
+// and of its boost rewards per share unless the lock was unlocking, per RewardsPerShareUnit.
+func (k Keeper) getLockPendingDecRewards(ctx sdk.Context, lockID uint64) sdk.DecCoins {
+	rewardsPerShareUnit := sdk.DecCoins{}
+	for _, position := range k.GetLockRewardPositions(ctx, lockID) {
+		rewardsPerShare, boostRewardsPerShare := k.getRewardsPerShare(ctx, position.Denom, position.Duration)
+		rewardsPerShareUnit = rewardsPerShareUnit.Add(rewardsPerShare.Sub(position.RewardsPerShare).MulDecTruncate(position.Amount.ToDec())...)
+		if !position.Unlocking {
+			rewardsPerShareUnit = rewardsPerShareUnit.Add(boostRewardsPerShare.Sub(position.BoostRewardsPerShare).MulDecTruncate(position.Amount.ToDec())...)
+		}
+	}
+	return rewardsPerShareUnit.QuoDecTruncate(types.RewardsPerShareUnit.ToDec())
+}
+
+// settleLockRewards sends the rewards the lock accrued since its last settlement to its reward receiver,
+// and resets the lock's reward positions to its current coins and duration.
+// If the lock no longer exists, its positions are deleted and the rewards are sent to the provided owner.
+// Returns the sent rewards.
+func (k Keeper) settleLockRewards(ctx sdk.Context, lockID uint64, owner sdk.AccAddress) (sdk.Coins, error) {
+	rewards := k.GetLockPendingRewards(ctx, lockID)
+
+	receiver := owner
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	if err == nil {
+		receiver, err = sdk.AccAddressFromBech32(lock.RewardReceiver())
+		if err != nil {
+			return nil, err
+		}
+		k.resetLockRewardPositions(ctx, *lock)
+	} else {
+		k.deleteLockRewardPositions(ctx, lockID)
+	}
+
+	if rewards.Empty() || receiver.Empty() {
+		return sdk.Coins{}, nil
+	}
+	if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, rewards); err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtClaimRewards,
+		sdk.NewAttribute(types.AttributeLockID, utils.Uint64ToString(lockID)),
+		sdk.NewAttribute(types.AttributeReceiver, receiver.String()),
+		sdk.NewAttribute(types.AttributeAmount, rewards.String()),
+	))
+	return rewards, nil
+}
+
+// ClaimRewards sends the rewards accrued by the owner's locks with the provided IDs to their reward receivers,
+// or those of all of the owner's locks if no lock IDs are provided. Returns the total claimed rewards.
+func (k Keeper) ClaimRewards(ctx sdk.Context, owner sdk.AccAddress, lockIDs []uint64) (sdk.Coins, error) {
+	if len(lockIDs) == 0 {
+		for _, lock := range k.lk.GetAccountPeriodLocks(ctx, owner) {
+			lockIDs = append(lockIDs, lock.ID)
+		}
+	}
+
+	claimedCoins := sdk.Coins{}
+	for _, lockID := range lockIDs {
+		lock, err := k.lk.GetLockByID(ctx, lockID)
+		if err != nil {
+			return nil, err
+		}
+		if lock.Owner != owner.String() {
+			return nil, lockuptypes.ErrNotLockOwner
+		}
+		rewards, err := k.settleLockRewards(ctx, lockID, owner)
+		if err != nil {
+			return nil, err
+		}
+		claimedCoins = claimedCoins.Add(rewards...)
+	}
+	return claimedCoins, nil
+}
+
+// accrueGaugeRewards accrues the gauge's coins for this epoch into the reward accumulator of the gauge's denom and
+// duration, to be claimed by the locks of at least the gauge duration, and updates the gauge.
+// The accrued amount is rounded down to what the accumulator can represent for the current locked amount,
+// and the remainder is left in the gauge for the next epochs.
+// Nothing is accrued, and the epoch is not filled, if no tokens are locked for the gauge or none of its coins
+// can be represented, so that its coins carry over to the next epoch.
+// Returns the accrued coins.
+func (k Keeper) accrueGaugeRewards(ctx sdk.Context, gauge types.Gauge) (sdk.Coins, error) {
+	totalShares := k.lk.GetPeriodLocksAccumulation(ctx, gauge.DistributeTo)
+	if !totalShares.IsPositive() {
+		return nil, nil
+	}
+
+	remainCoins := gauge.Coins.Sub(gauge.DistributedCoins)
+	remainEpochs := gauge.RemainEpochs()
+
+	rewardsPerShare := sdk.DecCoins{}
+	accruedCoins := sdk.Coins{}
+	for _, coin := range remainCoins {
+		// rewards per share = gauge_size * rewards_per_share_unit / (total_lock_amount * remain_epochs)
+		coinPerShare := coin.Amount.Mul(types.RewardsPerShareUnit).ToDec().QuoTruncate(totalShares.MulRaw(int64(remainEpochs)).ToDec())
+		if !coinPerShare.IsPositive() {
+			continue
+		}
+		rewardsPerShare = rewardsPerShare.Add(sdk.NewDecCoinFromDec(coin.Denom, coinPerShare))
+		// rounding up keeps the accrued amount at least what the locks can claim.
+		accruedCoins = accruedCoins.Add(sdk.NewCoin(coin.Denom, coinPerShare.MulInt(totalShares).QuoInt(types.RewardsPerShareUnit).Ceil().TruncateInt()))
+	}
+	if rewardsPerShare.IsZero() {
+		return nil, nil
+	}
+
+	accumulator := k.GetRewardAccumulator(ctx, gauge.DistributeTo.Denom, gauge.DistributeTo.Duration)
+	accumulator.RewardsPerShare = accumulator.RewardsPerShare.Add(rewardsPerShare...)
+	k.setRewardAccumulator(ctx, accumulator)
+
+	err := k.updateGaugePostDistribute(ctx, gauge, accruedCoins)
+	return accruedCoins, err
+}
+
+// MigrateToRewardAccumulators creates the reward positions of all existing locks and enables the reward
+// accumulators, so that gauges distributing to native locks by duration accrue their rewards to be claimed
+// rather than sending them to every lock owner each epoch.
+func (k Keeper) MigrateToRewardAccumulators(ctx sdk.Context) error {
+	locks, err := k.lk.GetPeriodLocks(ctx)
+	if err != nil {
+		return err
+	}
+	for _, lock := range locks {
+		k.resetLockRewardPositions(ctx, lock)
+	}
+
+	params := k.GetParams(ctx)
+	params.UseRewardAccumulators = true
+	k.SetParams(ctx, params)
+	return nil
+}
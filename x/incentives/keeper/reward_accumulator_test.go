@@ -0,0 +1,349 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/osmosis-labs/osmosis/v13/x/incentives/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// enableRewardAccumulators makes gauges distributing to native locks accrue their rewards for the locks to claim.
+func (suite *KeeperTestSuite) enableRewardAccumulators() {
+	params := suite.App.IncentivesKeeper.GetParams(suite.Ctx)
+	params.UseRewardAccumulators = true
+	suite.App.IncentivesKeeper.SetParams(suite.Ctx, params)
+}
+
+// setupAccumulatorGauge enables the reward accumulators and creates a perpetual gauge distributing the provided coins
+// to locks of the default LP denom of at least the provided duration.
+func (suite *KeeperTestSuite) setupAccumulatorGauge(coins sdk.Coins, duration time.Duration) *types.Gauge {
+	suite.enableRewardAccumulators()
+	_, gauge, _, _ := suite.setupNewGaugeWithDuration(true, coins, duration, defaultLPDenom)
+	return gauge
+}
+
+// lockTokensWithID locks tokens for the specified duration and returns the ID of the lock.
+func (suite *KeeperTestSuite) lockTokensWithID(addr sdk.AccAddress, coins sdk.Coins, duration time.Duration) uint64 {
+	suite.FundAcc(addr, coins)
+	lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, addr, coins, duration)
+	suite.Require().NoError(err)
+	return lock.ID
+}
+
+func (suite *KeeperTestSuite) TestAccrueGaugeRewards() {
+	suite.SetupTest()
+	shortGauge := suite.setupAccumulatorGauge(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 100)}, time.Second)
+	longGauge := suite.setupAccumulatorGauge(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 100)}, 2*time.Second)
+	shortLockID := suite.lockTokensWithID(suite.TestAccs[0], defaultLPTokens, time.Second)
+	longLockID := suite.lockTokensWithID(suite.TestAccs[1], defaultLPTokens, 2*time.Second)
+
+	// System under test.
+	distributed, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*shortGauge, *longGauge})
+	suite.Require().NoError(err)
+
+	// the rewards are accrued rather than sent to the lock owners.
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 200)}, distributed)
+	for _, user := range suite.TestAccs[:2] {
+		suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, user, defaultRewardDenom).IsZero())
+	}
+
+	// the short gauge is split among both locks, the long gauge goes to the long lock only.
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 50)}, suite.App.IncentivesKeeper.GetLockPendingRewards(suite.Ctx, shortLockID))
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 150)}, suite.App.IncentivesKeeper.GetLockPendingRewards(suite.Ctx, longLockID))
+	accumulator := suite.App.IncentivesKeeper.GetRewardAccumulator(suite.Ctx, defaultLPDenom, time.Second)
+	suite.Require().Equal(sdk.DecCoins{sdk.NewDecCoin(defaultRewardDenom, types.RewardsPerShareUnit.MulRaw(5))}, accumulator.RewardsPerShare)
+
+	// the gauges are updated as if they distributed.
+	gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, shortGauge.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), gauge.FilledEpochs)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 100)}, gauge.DistributedCoins)
+}
+
+func (suite *KeeperTestSuite) TestAccrueGaugeRewardsRoundsDown() {
+	suite.SetupTest()
+	gauge := suite.setupAccumulatorGauge(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 100)}, time.Second)
+	lockIDs := []uint64{}
+	for _, user := range suite.TestAccs {
+		lockIDs = append(lockIDs, suite.lockTokensWithID(user, defaultLPTokens, time.Second))
+	}
+
+	// System under test.
+	distributed, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+	suite.Require().NoError(err)
+
+	// the locks can never claim more than what was accrued.
+	claimable := sdk.Coins{}
+	for _, lockID := range lockIDs {
+		claimable = claimable.Add(suite.App.IncentivesKeeper.GetLockPendingRewards(suite.Ctx, lockID)...)
+	}
+	suite.Require().True(distributed.IsAllGTE(claimable))
+	suite.Require().True(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 100)}.IsAllGTE(distributed))
+}
+
+// TestAccrueGaugeRewardsOfGammShares tests that gauges accrue for locks of realistic gamm share amounts,
+// which have 18 decimals, so that the rewards per single locked unit are far below 10^-18.
+func (suite *KeeperTestSuite) TestAccrueGaugeRewardsOfGammShares() {
+	suite.SetupTest()
+	gauge := suite.setupAccumulatorGauge(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1000000)}, time.Second)
+	lockIDs := []uint64{
+		suite.lockTokensWithID(suite.TestAccs[0], sdk.NewCoins(sdk.NewCoin(defaultLPDenom, sdk.NewIntWithDecimal(7, 24))), time.Second),
+		suite.lockTokensWithID(suite.TestAccs[1], sdk.NewCoins(sdk.NewCoin(defaultLPDenom, sdk.NewIntWithDecimal(3, 24))), time.Second),
+	}
+
+	// System under test.
+	distributed, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1000000)}, distributed)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 700000)}, suite.App.IncentivesKeeper.GetLockPendingRewards(suite.Ctx, lockIDs[0]))
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 300000)}, suite.App.IncentivesKeeper.GetLockPendingRewards(suite.Ctx, lockIDs[1]))
+	updatedGauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gauge.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), updatedGauge.FilledEpochs)
+	suite.Require().Equal(distributed, updatedGauge.DistributedCoins)
+}
+
+// TestAccrueGaugeRewardsCarriesOver tests that a gauge whose coins are too small to accrue anything for the
+// locked amount does not fill the epoch, so that its coins are carried over rather than left in the gauge.
+func (suite *KeeperTestSuite) TestAccrueGaugeRewardsCarriesOver() {
+	suite.SetupTest()
+	gauge := suite.setupAccumulatorGauge(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1)}, time.Second)
+	suite.lockTokensWithID(suite.TestAccs[0], sdk.NewCoins(sdk.NewCoin(defaultLPDenom, sdk.NewIntWithDecimal(2, 36))), time.Second)
+
+	// System under test.
+	distributed, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+	suite.Require().NoError(err)
+
+	suite.Require().True(distributed.Empty())
+	suite.Require().True(suite.App.IncentivesKeeper.GetRewardAccumulator(suite.Ctx, defaultLPDenom, time.Second).RewardsPerShare.IsZero())
+	updatedGauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gauge.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(0), updatedGauge.FilledEpochs)
+	suite.Require().True(updatedGauge.DistributedCoins.Empty())
+}
+
+func (suite *KeeperTestSuite) TestClaimRewards() {
+	tests := map[string]struct {
+		claimer       int
+		lockIndexes   []int
+		expectedClaim sdk.Coins
+		expectErr     bool
+	}{
+		"claim all locks": {
+			claimer:       0,
+			expectedClaim: sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 200)},
+		},
+		"claim one lock": {
+			claimer:       0,
+			lockIndexes:   []int{1},
+			expectedClaim: sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 100)},
+		},
+		"claim a lock of another owner": {
+			claimer:     1,
+			lockIndexes: []int{0},
+			expectErr:   true,
+		},
+		"claim a non-existent lock": {
+			claimer:     0,
+			lockIndexes: []int{3},
+			expectErr:   true,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			gauge := suite.setupAccumulatorGauge(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 300)}, time.Second)
+			owner := suite.TestAccs[0]
+			lockIDsByIndex := []uint64{
+				suite.lockTokensWithID(owner, defaultLPTokens, time.Second),
+				suite.lockTokensWithID(owner, defaultLPTokens, time.Second),
+				suite.lockTokensWithID(suite.TestAccs[1], defaultLPTokens, time.Second),
+			}
+			// the last lock ID does not exist.
+			lockIDsByIndex = append(lockIDsByIndex, lockIDsByIndex[2]+1)
+			_, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+			suite.Require().NoError(err)
+
+			lockIDs := []uint64{}
+			for _, i := range tc.lockIndexes {
+				lockIDs = append(lockIDs, lockIDsByIndex[i])
+			}
+			claimer := suite.TestAccs[tc.claimer]
+
+			// System under test.
+			claimed, err := suite.App.IncentivesKeeper.ClaimRewards(suite.Ctx, claimer, lockIDs)
+
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedClaim, claimed)
+			suite.Require().Equal(tc.expectedClaim, suite.App.BankKeeper.GetAllBalances(suite.Ctx, claimer).FilterDenoms([]string{defaultRewardDenom}))
+
+			// claimed locks have no pending rewards left.
+			for _, lockID := range lockIDs {
+				suite.Require().True(suite.App.IncentivesKeeper.GetLockPendingRewards(suite.Ctx, lockID).Empty())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSettleRewardsOnLockChanges() {
+	halfLPTokens := sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, 5)}
+	tests := map[string]struct {
+		changeLock        func(owner sdk.AccAddress, lockID uint64)
+		expectedPositions []types.LockRewardPosition
+	}{
+		"add tokens to lock": {
+			changeLock: func(owner sdk.AccAddress, lockID uint64) {
+				suite.FundAcc(owner, defaultLPTokens)
+				_, err := suite.App.LockupKeeper.AddTokensToLockByID(suite.Ctx, lockID, owner, defaultLPTokens[0])
+				suite.Require().NoError(err)
+			},
+			expectedPositions: []types.LockRewardPosition{{Amount: sdk.NewInt(20), Duration: time.Second}},
+		},
+		"extend lock": {
+			changeLock: func(owner sdk.AccAddress, lockID uint64) {
+				err := suite.App.LockupKeeper.ExtendLockup(suite.Ctx, lockID, owner, 2*time.Second)
+				suite.Require().NoError(err)
+			},
+			expectedPositions: []types.LockRewardPosition{{Amount: sdk.NewInt(10), Duration: 2 * time.Second}},
+		},
+		"slash lock": {
+			changeLock: func(owner sdk.AccAddress, lockID uint64) {
+				_, err := suite.App.LockupKeeper.SlashTokensFromLockByID(suite.Ctx, lockID, halfLPTokens)
+				suite.Require().NoError(err)
+			},
+			expectedPositions: []types.LockRewardPosition{{Amount: sdk.NewInt(5), Duration: time.Second}},
+		},
+		"start unlocking lock": {
+			changeLock: func(owner sdk.AccAddress, lockID uint64) {
+				err := suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lockID, nil)
+				suite.Require().NoError(err)
+			},
+			expectedPositions: []types.LockRewardPosition{{Amount: sdk.NewInt(10), Duration: time.Second, Unlocking: true}},
+		},
+		"partially unlock lock": {
+			changeLock: func(owner sdk.AccAddress, lockID uint64) {
+				err := suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lockID, halfLPTokens)
+				suite.Require().NoError(err)
+			},
+			expectedPositions: []types.LockRewardPosition{{Amount: sdk.NewInt(5), Duration: time.Second}},
+		},
+		"unlock lock": {
+			changeLock: func(owner sdk.AccAddress, lockID uint64) {
+				err := suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lockID, nil)
+				suite.Require().NoError(err)
+				suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Second))
+				err = suite.App.LockupKeeper.UnlockMaturedLock(suite.Ctx, lockID)
+				suite.Require().NoError(err)
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			gauge := suite.setupAccumulatorGauge(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 100)}, time.Second)
+			owner := suite.TestAccs[0]
+			lockID := suite.lockTokensWithID(owner, defaultLPTokens, time.Second)
+			_, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+			suite.Require().NoError(err)
+
+			// System under test.
+			tc.changeLock(owner, lockID)
+
+			// the rewards accrued before the change are sent to the owner.
+			suite.Require().Equal(sdk.NewInt(100), suite.App.BankKeeper.GetBalance(suite.Ctx, owner, defaultRewardDenom).Amount)
+			suite.Require().True(suite.App.IncentivesKeeper.GetLockPendingRewards(suite.Ctx, lockID).Empty())
+
+			// the positions follow the lock's current coins and duration, as of the current rewards per share.
+			positions := suite.App.IncentivesKeeper.GetLockRewardPositions(suite.Ctx, lockID)
+			suite.Require().Len(positions, len(tc.expectedPositions))
+			for i, expectedPosition := range tc.expectedPositions {
+				suite.Require().Equal(defaultLPDenom, positions[i].Denom)
+				suite.Require().Equal(expectedPosition.Amount, positions[i].Amount)
+				suite.Require().Equal(expectedPosition.Duration, positions[i].Duration)
+				suite.Require().Equal(expectedPosition.Unlocking, positions[i].Unlocking)
+				suite.Require().Equal(
+					suite.App.IncentivesKeeper.GetRewardAccumulator(suite.Ctx, defaultLPDenom, time.Second).RewardsPerShare,
+					positions[i].RewardsPerShare,
+				)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSettleRewardsOnLockSplit() {
+	suite.SetupTest()
+	gauge := suite.setupAccumulatorGauge(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 100)}, time.Second)
+	owner := suite.TestAccs[0]
+	lockID := suite.lockTokensWithID(owner, defaultLPTokens, time.Second)
+	_, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+	suite.Require().NoError(err)
+
+	// System under test.
+	splitLock, err := suite.App.LockupKeeper.SplitLock(suite.Ctx, lockID, owner, sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, 4)})
+	suite.Require().NoError(err)
+
+	// the rewards accrued before the split are sent to the owner, and the new lock has none.
+	suite.Require().Equal(sdk.NewInt(100), suite.App.BankKeeper.GetBalance(suite.Ctx, owner, defaultRewardDenom).Amount)
+	suite.Require().True(suite.App.IncentivesKeeper.GetLockPendingRewards(suite.Ctx, splitLock.ID).Empty())
+
+	// later rewards are split among both locks by their amounts.
+	gauge, err = suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gauge.Id)
+	suite.Require().NoError(err)
+	suite.AddToGauge(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 100)}, gauge.Id)
+	gauge, err = suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gauge.Id)
+	suite.Require().NoError(err)
+	_, err = suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 60)}, suite.App.IncentivesKeeper.GetLockPendingRewards(suite.Ctx, lockID))
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 40)}, suite.App.IncentivesKeeper.GetLockPendingRewards(suite.Ctx, splitLock.ID))
+}
+
+func (suite *KeeperTestSuite) TestMigrateToRewardAccumulators() {
+	suite.SetupTest()
+	lockIDs := []uint64{
+		suite.lockTokensWithID(suite.TestAccs[0], defaultLPTokens, time.Second),
+		suite.lockTokensWithID(suite.TestAccs[1], defaultLPTokens, 2*time.Second),
+	}
+	for _, lockID := range lockIDs {
+		suite.App.IncentivesKeeper.DeleteLockRewardPositions(suite.Ctx, lockID)
+	}
+	suite.Require().Empty(suite.App.IncentivesKeeper.GetAllLockRewardPositions(suite.Ctx))
+
+	// System under test.
+	err := suite.App.IncentivesKeeper.MigrateToRewardAccumulators(suite.Ctx)
+	suite.Require().NoError(err)
+
+	suite.Require().True(suite.App.IncentivesKeeper.GetParams(suite.Ctx).UseRewardAccumulators)
+	for _, lockID := range lockIDs {
+		suite.Require().Len(suite.App.IncentivesKeeper.GetLockRewardPositions(suite.Ctx, lockID), 1)
+	}
+}
+
+func (suite *KeeperTestSuite) TestRewardAccumulatorsGenesis() {
+	suite.SetupTest()
+	gauge := suite.setupAccumulatorGauge(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 100)}, time.Second)
+	suite.lockTokensWithID(suite.TestAccs[0], defaultLPTokens, time.Second)
+	_, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+	suite.Require().NoError(err)
+	accumulators := suite.App.IncentivesKeeper.GetAllRewardAccumulators(suite.Ctx)
+	positions := suite.App.IncentivesKeeper.GetAllLockRewardPositions(suite.Ctx)
+	suite.Require().Len(accumulators, 1)
+	suite.Require().Len(positions, 1)
+
+	// System under test.
+	genesis := suite.App.IncentivesKeeper.ExportGenesis(suite.Ctx)
+	suite.Require().NoError(genesis.Validate())
+	suite.SetupTest()
+	suite.App.IncentivesKeeper.InitGenesis(suite.Ctx, *genesis)
+
+	suite.Require().Equal(accumulators, suite.App.IncentivesKeeper.GetAllRewardAccumulators(suite.Ctx))
+	suite.Require().Equal(positions, suite.App.IncentivesKeeper.GetAllLockRewardPositions(suite.Ctx))
+}
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"sort"
+
+	"github.com/osmosis-labs/osmosis/v13/osmoutils"
+	"github.com/osmosis-labs/osmosis/v13/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetRewardsBreakdown returns the rewards the provided locks are estimated to receive in the next distribution,
+// broken down by the upcoming and active gauges they come from, in increasing gauge ID order.
+// Each gauge's rewards are estimated with FilteredLocksDistributionEst over the locks it distributes to.
+// Only gauges distributing to locks of native denoms by duration are included, as the locks of synthetic,
+// trader volume and group gauges are not known from the provided locks.
+func (k Keeper) GetRewardsBreakdown(ctx sdk.Context, locks []lockuptypes.PeriodLock) ([]types.GaugeRewardsBreakdown, error) {
+	gaugeIDs, err := k.getRewardsBreakdownGaugeIDs(ctx, locks)
+	if err != nil {
+		return nil, err
+	}
+	return k.getGaugesRewardsBreakdown(ctx, gaugeIDs, locks)
+}
+
+// getRewardsBreakdownGaugeIDs returns the IDs of the upcoming and active gauges that distribute to any of the
+// provided locks in the next distribution, in increasing order, without estimating their rewards.
+func (k Keeper) getRewardsBreakdownGaugeIDs(ctx sdk.Context, locks []lockuptypes.PeriodLock) ([]uint64, error) {
+	denoms := []string{}
+	for _, lock := range locks {
+		for _, coin := range lock.Coins {
+			denoms = append(denoms, coin.Denom)
+		}
+	}
+	candidateIDs := []uint64{}
+	seenGaugeIDs := map[uint64]bool{}
+	for _, denom := range denoms {
+		for _, gaugeID := range k.getAllGaugeIDsByDenom(ctx, denom) {
+			if !seenGaugeIDs[gaugeID] {
+				seenGaugeIDs[gaugeID] = true
+				candidateIDs = append(candidateIDs, gaugeID)
+			}
+		}
+	}
+	osmoutils.SortSlice(candidateIDs)
+
+	// upcoming gauges start distributing at the first epoch end after their start time.
+	// if the epoch end is overdue, the next distribution happens in the next block.
+	epochInfo := k.GetEpochInfo(ctx)
+	nextDistrTime := epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration)
+	if nextDistrTime.Before(ctx.BlockTime()) {
+		nextDistrTime = ctx.BlockTime()
+	}
+
+	gaugeIDs := []uint64{}
+	for _, gaugeID := range candidateIDs {
+		gauge, err := k.GetGaugeByID(ctx, gaugeID)
+		if err != nil {
+			return nil, err
+		}
+		if gauge.DistributeTo.LockQueryType != lockuptypes.ByDuration || lockuptypes.IsSyntheticDenom(gauge.DistributeTo.Denom) {
+			continue
+		}
+		if gauge.StartTime.After(nextDistrTime) {
+			continue
+		}
+		if len(filterLocksForGauge(*gauge, locks)) == 0 {
+			continue
+		}
+		gaugeIDs = append(gaugeIDs, gaugeID)
+	}
+	return gaugeIDs, nil
+}
+
+// getGaugesRewardsBreakdown estimates the rewards the provided locks receive from each of the provided gauges
+// in the next distribution, skipping gauges that would distribute nothing to them.
+func (k Keeper) getGaugesRewardsBreakdown(ctx sdk.Context, gaugeIDs []uint64, locks []lockuptypes.PeriodLock) ([]types.GaugeRewardsBreakdown, error) {
+	breakdowns := []types.GaugeRewardsBreakdown{}
+	for _, gaugeID := range gaugeIDs {
+		gauge, err := k.GetGaugeByID(ctx, gaugeID)
+		if err != nil {
+			return nil, err
+		}
+		gaugeLocks := filterLocksForGauge(*gauge, locks)
+		_, distrCoins, isBuggedGauge, err := k.FilteredLocksDistributionEst(ctx, *gauge, gaugeLocks)
+		if err != nil {
+			return nil, err
+		}
+		if isBuggedGauge || distrCoins.IsZero() {
+			continue
+		}
+
+		lockIDs := make([]uint64, 0, len(gaugeLocks))
+		for _, lock := range gaugeLocks {
+			lockIDs = append(lockIDs, lock.ID)
+		}
+		breakdowns = append(breakdowns, types.GaugeRewardsBreakdown{
+			GaugeId: gauge.Id,
+			LockIds: lockIDs,
+			Coins:   distrCoins,
+		})
+	}
+	return breakdowns, nil
+}
+
+// filterLocksForGauge returns the provided locks that the gauge distributes to, which are the locks
+// of the gauge's denom that are at least as long as the gauge duration, sorted by lock ID.
+func filterLocksForGauge(gauge types.Gauge, locks []lockuptypes.PeriodLock) []lockuptypes.PeriodLock {
+	gaugeLocks := []lockuptypes.PeriodLock{}
+	for _, lock := range locks {
+		if lock.Duration >= gauge.DistributeTo.Duration && lock.Coins.AmountOf(gauge.DistributeTo.Denom).IsPositive() {
+			gaugeLocks = append(gaugeLocks, lock)
+		}
+	}
+	sort.Slice(gaugeLocks, func(i, j int) bool { return gaugeLocks[i].ID < gaugeLocks[j].ID })
+	return gaugeLocks
+}
@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/v13/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ = suite.TestingSuite(nil)
+
+// setupRewardsBreakdown creates two locks of different durations for the first test account and one for the second,
+// along with gauges of different durations and start times distributing to them.
+// Returns the IDs of the first account's locks, the second account's lock and the gauges.
+func (suite *KeeperTestSuite) setupRewardsBreakdown() (ownerLockIDs []uint64, otherLockID uint64, gaugeIDs []uint64) {
+	ownerLockIDs = []uint64{
+		suite.lockTokensWithID(suite.TestAccs[0], defaultLPTokens, time.Second),
+		suite.lockTokensWithID(suite.TestAccs[0], defaultLPTokens, 2*time.Second),
+	}
+	otherLockID = suite.lockTokensWithID(suite.TestAccs[1], defaultLPTokens, 2*time.Second)
+
+	gauges := []struct {
+		coins     sdk.Coins
+		duration  time.Duration
+		startTime time.Time
+	}{
+		{sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 300)}, time.Second, suite.Ctx.BlockTime()},
+		{sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 200)}, 2 * time.Second, suite.Ctx.BlockTime()},
+		// starts after the next distribution
+		{sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 200)}, time.Second, suite.Ctx.BlockTime().Add(365 * 24 * time.Hour)},
+		// longer than every lock
+		{sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 200)}, time.Hour, suite.Ctx.BlockTime()},
+	}
+	for _, gauge := range gauges {
+		distrTo := lockuptypes.QueryCondition{
+			LockQueryType: lockuptypes.ByDuration,
+			Denom:         defaultLPDenom,
+			Duration:      gauge.duration,
+		}
+		gaugeID, _ := suite.CreateGauge(true, defaultGaugeOwner, gauge.coins, distrTo, gauge.startTime, 1)
+		gaugeIDs = append(gaugeIDs, gaugeID)
+	}
+	return ownerLockIDs, otherLockID, gaugeIDs
+}
+
+func (suite *KeeperTestSuite) TestGetRewardsBreakdown() {
+	suite.SetupTest()
+	ownerLockIDs, otherLockID, gaugeIDs := suite.setupRewardsBreakdown()
+	shortLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, ownerLockIDs[0])
+	suite.Require().NoError(err)
+	longLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, ownerLockIDs[1])
+	suite.Require().NoError(err)
+	otherLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, otherLockID)
+	suite.Require().NoError(err)
+
+	tests := map[string]struct {
+		locks              []lockuptypes.PeriodLock
+		expectedBreakdowns []types.GaugeRewardsBreakdown
+	}{
+		"lock rewarded by a single gauge": {
+			locks: []lockuptypes.PeriodLock{*shortLock},
+			expectedBreakdowns: []types.GaugeRewardsBreakdown{
+				{GaugeId: gaugeIDs[0], LockIds: []uint64{shortLock.ID}, Coins: sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 100)}},
+			},
+		},
+		"lock rewarded by several gauges": {
+			locks: []lockuptypes.PeriodLock{*longLock},
+			expectedBreakdowns: []types.GaugeRewardsBreakdown{
+				{GaugeId: gaugeIDs[0], LockIds: []uint64{longLock.ID}, Coins: sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 100)}},
+				{GaugeId: gaugeIDs[1], LockIds: []uint64{longLock.ID}, Coins: sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 100)}},
+			},
+		},
+		"several locks sum by gauge": {
+			locks: []lockuptypes.PeriodLock{*longLock, *shortLock, *otherLock},
+			expectedBreakdowns: []types.GaugeRewardsBreakdown{
+				{GaugeId: gaugeIDs[0], LockIds: []uint64{shortLock.ID, longLock.ID, otherLock.ID}, Coins: sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 300)}},
+				{GaugeId: gaugeIDs[1], LockIds: []uint64{longLock.ID, otherLock.ID}, Coins: sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 200)}},
+			},
+		},
+		"no locks": {
+			expectedBreakdowns: []types.GaugeRewardsBreakdown{},
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			// System under test.
+			breakdowns, err := suite.App.IncentivesKeeper.GetRewardsBreakdown(suite.Ctx, tc.locks)
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedBreakdowns, breakdowns)
+
+			// the breakdown matches the estimate of the next epoch.
+			if len(tc.locks) > 0 {
+				totalCoins := sdk.Coins{}
+				for _, breakdown := range breakdowns {
+					totalCoins = totalCoins.Add(breakdown.Coins...)
+				}
+				estimate := suite.App.IncentivesKeeper.GetRewardsEst(suite.Ctx, nil, tc.locks, suite.App.IncentivesKeeper.GetEpochInfo(suite.Ctx).CurrentEpoch)
+				suite.Require().True(estimate.IsAllGTE(totalCoins))
+			}
+		})
+	}
+}
+
+// TestGetRewardsBreakdownByGaugeIDs tests that the gauges distributing to the locks are found without estimating
+// their rewards, and that the breakdown of a subset of them only includes that subset.
+func (suite *KeeperTestSuite) TestGetRewardsBreakdownByGaugeIDs() {
+	suite.SetupTest()
+	ownerLockIDs, _, gaugeIDs := suite.setupRewardsBreakdown()
+	shortLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, ownerLockIDs[0])
+	suite.Require().NoError(err)
+	longLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, ownerLockIDs[1])
+	suite.Require().NoError(err)
+	locks := []lockuptypes.PeriodLock{*shortLock, *longLock}
+
+	// the upcoming gauge and the gauge longer than every lock are excluded.
+	breakdownGaugeIDs, err := suite.App.IncentivesKeeper.GetRewardsBreakdownGaugeIDs(suite.Ctx, locks)
+	suite.Require().NoError(err)
+	suite.Require().Equal(gaugeIDs[:2], breakdownGaugeIDs)
+
+	breakdownGaugeIDs, err = suite.App.IncentivesKeeper.GetRewardsBreakdownGaugeIDs(suite.Ctx, []lockuptypes.PeriodLock{*shortLock})
+	suite.Require().NoError(err)
+	suite.Require().Equal(gaugeIDs[:1], breakdownGaugeIDs)
+
+	// only the requested gauge is estimated.
+	breakdowns, err := suite.App.IncentivesKeeper.GetGaugesRewardsBreakdown(suite.Ctx, gaugeIDs[1:2], locks)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.GaugeRewardsBreakdown{
+		{GaugeId: gaugeIDs[1], LockIds: []uint64{longLock.ID}, Coins: sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 100)}},
+	}, breakdowns)
+}
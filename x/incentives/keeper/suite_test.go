@@ -34,7 +34,8 @@ var (
 		lockDurations: []time.Duration{defaultLockDuration, 2 * defaultLockDuration},
 		lockAmounts:   []sdk.Coins{defaultLPSyntheticTokens, defaultLPSyntheticTokens},
 	}
-	defaultRewardDenom string = "rewardDenom"
+	defaultRewardDenom string         = "rewardDenom"
+	defaultGaugeOwner  sdk.AccAddress = sdk.AccAddress([]byte("Gauge_Creation_Addr_"))
 )
 
 // TODO: Switch more code to use userLocks and perpGaugeDesc
@@ -142,17 +143,24 @@ func (suite *KeeperTestSuite) LockTokens(addr sdk.AccAddress, coins sdk.Coins, d
 	suite.Require().NoError(err)
 }
 
-// setupNewGaugeWithDuration creates a gauge with the specified duration.
-func (suite *KeeperTestSuite) setupNewGaugeWithDuration(isPerpetual bool, coins sdk.Coins, duration time.Duration, denom string) (
+// setupNewGaugeWithDuration creates a gauge with the specified duration, weighted by the boost curve if one is provided.
+func (suite *KeeperTestSuite) setupNewGaugeWithDuration(isPerpetual bool, coins sdk.Coins, duration time.Duration, denom string, boostCurve ...types.BoostPoint) (
 	uint64, *types.Gauge, sdk.Coins, time.Time,
 ) {
-	addr := sdk.AccAddress([]byte("Gauge_Creation_Addr_"))
-	startTime2 := time.Now()
 	distrTo := lockuptypes.QueryCondition{
 		LockQueryType: lockuptypes.ByDuration,
 		Denom:         denom,
 		Duration:      duration,
 	}
+	return suite.setupNewGaugeWithQuery(isPerpetual, coins, distrTo, boostCurve...)
+}
+
+// setupNewGaugeWithQuery creates a gauge distributing to the specified query, weighted by the boost curve if one is provided.
+func (suite *KeeperTestSuite) setupNewGaugeWithQuery(isPerpetual bool, coins sdk.Coins, distrTo lockuptypes.QueryCondition, boostCurve ...types.BoostPoint) (
+	uint64, *types.Gauge, sdk.Coins, time.Time,
+) {
+	addr := defaultGaugeOwner
+	startTime2 := time.Now()
 
 	// mints coins so supply exists on chain
 	mintCoins := sdk.Coins{sdk.NewInt64Coin(distrTo.Denom, 200)}
@@ -162,7 +170,11 @@ func (suite *KeeperTestSuite) setupNewGaugeWithDuration(isPerpetual bool, coins
 	if isPerpetual {
 		numEpochsPaidOver = uint64(1)
 	}
-	gaugeID, gauge := suite.CreateGauge(isPerpetual, addr, coins, distrTo, startTime2, numEpochsPaidOver)
+	suite.FundAcc(addr, coins)
+	gaugeID, err := suite.App.IncentivesKeeper.CreateBoostedGauge(suite.Ctx, isPerpetual, addr, coins, distrTo, startTime2, numEpochsPaidOver, boostCurve)
+	suite.Require().NoError(err)
+	gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+	suite.Require().NoError(err)
 	return gaugeID, gauge, coins, startTime2
 }
 
@@ -175,7 +187,7 @@ func (suite *KeeperTestSuite) SetupNewGauge(isPerpetual bool, coins sdk.Coins) (
 func (suite *KeeperTestSuite) setupNewGaugeWithDenom(isPerpetual bool, coins sdk.Coins, duration time.Duration, denom string) (
 	uint64, *types.Gauge, sdk.Coins, time.Time,
 ) {
-	addr := sdk.AccAddress([]byte("Gauge_Creation_Addr_"))
+	addr := defaultGaugeOwner
 	startTime2 := time.Now()
 	distrTo := lockuptypes.QueryCondition{
 		LockQueryType: lockuptypes.ByDuration,
@@ -0,0 +1,181 @@
+package keeper
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/osmosis/v13/osmoutils"
+	"github.com/osmosis-labs/osmosis/v13/x/incentives/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// poolTraderVolumesPrefix returns the store key prefix of the current epoch's trader volumes on the provided pool.
+func poolTraderVolumesPrefix(poolId uint64) []byte {
+	return combineKeys(types.KeyPrefixTraderVolumes, sdk.Uint64ToBigEndian(poolId), []byte{})
+}
+
+// traderVolumeStoreKey returns the store key of the provided trader's volume on the provided pool.
+func traderVolumeStoreKey(poolId uint64, trader sdk.AccAddress) []byte {
+	return append(poolTraderVolumesPrefix(poolId), trader...)
+}
+
+// traderGaugesByPoolStoreKey returns the store key of the IDs of the ByTraderVolume gauges of the provided pool.
+func traderGaugesByPoolStoreKey(poolId uint64) []byte {
+	return combineKeys(types.KeyPrefixTraderGaugesByPool, sdk.Uint64ToBigEndian(poolId))
+}
+
+// GetTraderVolume returns the net swap volume of the trader on the provided pool during the current epoch,
+// valued in the base denom.
+func (k Keeper) GetTraderVolume(ctx sdk.Context, poolId uint64, trader sdk.AccAddress) sdk.Int {
+	return k.getTraderVolume(ctx, poolId, trader).Volume
+}
+
+// getTraderVolume returns the swap volume of the trader on the provided pool during the current epoch.
+// Returns zero volume if none has been tracked for the trader.
+func (k Keeper) getTraderVolume(ctx sdk.Context, poolId uint64, trader sdk.AccAddress) types.TraderVolume {
+	store := ctx.KVStore(k.storeKey)
+	traderVolume := types.TraderVolume{}
+	found, err := osmoutils.Get(store, traderVolumeStoreKey(poolId, trader), &traderVolume)
+	if err != nil {
+		panic(err)
+	}
+	if !found {
+		return types.TraderVolume{PoolId: poolId, Address: trader.String(), Volume: sdk.ZeroInt()}
+	}
+	return traderVolume
+}
+
+// setTraderVolume sets the swap volume of a trader on a pool during the current epoch.
+func (k Keeper) setTraderVolume(ctx sdk.Context, traderVolume types.TraderVolume) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, traderVolumeStoreKey(traderVolume.PoolId, sdk.MustAccAddressFromBech32(traderVolume.Address)), &traderVolume)
+}
+
+// GetAllTraderVolumes returns the swap volumes of all traders on all pools during the current epoch.
+func (k Keeper) GetAllTraderVolumes(ctx sdk.Context) []types.TraderVolume {
+	return k.getTraderVolumesFromPrefix(ctx, types.KeyPrefixTraderVolumes)
+}
+
+// getPoolTraderVolumes returns the swap volumes of the traders on the provided pool during the current epoch.
+func (k Keeper) getPoolTraderVolumes(ctx sdk.Context, poolId uint64) []types.TraderVolume {
+	return k.getTraderVolumesFromPrefix(ctx, poolTraderVolumesPrefix(poolId))
+}
+
+// getTraderVolumesFromPrefix returns the trader volumes stored under the provided prefix.
+func (k Keeper) getTraderVolumesFromPrefix(ctx sdk.Context, prefix []byte) []types.TraderVolume {
+	store := ctx.KVStore(k.storeKey)
+	traderVolumes, err := osmoutils.GatherValuesFromStorePrefix(store, prefix, func(bz []byte) (types.TraderVolume, error) {
+		traderVolume := types.TraderVolume{}
+		err := proto.Unmarshal(bz, &traderVolume)
+		return traderVolume, err
+	})
+	if err != nil {
+		panic(err)
+	}
+	return traderVolumes
+}
+
+// clearTraderVolumes deletes the trader volumes of the current epoch.
+func (k Keeper) clearTraderVolumes(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixTraderVolumes)
+	defer iterator.Close()
+
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// hasTraderGauges returns true if the provided pool has upcoming or active ByTraderVolume gauges.
+func (k Keeper) hasTraderGauges(ctx sdk.Context, poolId uint64) bool {
+	return len(k.getGaugeRefs(ctx, traderGaugesByPoolStoreKey(poolId))) > 0
+}
+
+// trackTraderVolume adds the value of a swap to the trader's flows on the pool for the current epoch,
+// if the pool has ByTraderVolume gauges, and updates the trader's net volume.
+// Swaps worth less than the MinTraderSwapValue param are not counted, and the trader's volume
+// is capped at the TraderVolumeCap param if it is set.
+func (k Keeper) trackTraderVolume(ctx sdk.Context, trader sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins, value sdk.Int) {
+	if !k.hasTraderGauges(ctx, poolId) {
+		return
+	}
+	params := k.GetParams(ctx)
+	if value.LT(params.MinTraderSwapValue) {
+		return
+	}
+
+	traderVolume := k.getTraderVolume(ctx, poolId, trader)
+	for _, coin := range input {
+		traderVolume.ValueIn = traderVolume.ValueIn.Add(sdk.NewCoin(coin.Denom, value))
+	}
+	for _, coin := range output {
+		traderVolume.ValueOut = traderVolume.ValueOut.Add(sdk.NewCoin(coin.Denom, value))
+	}
+	traderVolume.Volume = netTraderVolume(traderVolume.ValueIn, traderVolume.ValueOut)
+	if params.TraderVolumeCap.IsPositive() {
+		traderVolume.Volume = sdk.MinInt(traderVolume.Volume, params.TraderVolumeCap)
+	}
+	k.setTraderVolume(ctx, traderVolume)
+}
+
+// netTraderVolume returns the sum over denoms of the value swapped into a pool in excess of the value
+// swapped out of it. Swapping tokens back and forth within an epoch, i.e. wash trading, only adds the
+// swap fees and slippage paid to the net volume.
+func netTraderVolume(valueIn sdk.Coins, valueOut sdk.Coins) sdk.Int {
+	volume := sdk.ZeroInt()
+	for _, coin := range valueIn {
+		netValue := coin.Amount.Sub(valueOut.AmountOf(coin.Denom))
+		if netValue.IsPositive() {
+			volume = volume.Add(netValue)
+		}
+	}
+	return volume
+}
+
+// distributeTraderVolumeInternal runs the distribution logic for a ByTraderVolume gauge, splitting the gauge's
+// coins for this epoch among the traders of its pool in proportion to their volume, and adds the sends to
+// the distrInfo struct. It also updates the gauge for the distribution.
+// Nothing is distributed if there was no volume during the epoch.
+func (k Keeper) distributeTraderVolumeInternal(ctx sdk.Context, gauge types.Gauge, distrInfo *distributionInfo) (sdk.Coins, error) {
+	poolId, err := getGaugePoolId(gauge)
+	if err != nil {
+		return nil, err
+	}
+	traderVolumes := k.getPoolTraderVolumes(ctx, poolId)
+	totalVolume := sdk.ZeroInt()
+	for _, traderVolume := range traderVolumes {
+		totalVolume = totalVolume.Add(traderVolume.Volume)
+	}
+	if totalVolume.IsZero() {
+		return nil, nil
+	}
+
+	remainCoins := gauge.Coins.Sub(gauge.DistributedCoins)
+	remainEpochs := gauge.RemainEpochs()
+
+	totalDistrCoins := sdk.NewCoins()
+	for _, traderVolume := range traderVolumes {
+		distrCoins := sdk.Coins{}
+		for _, coin := range remainCoins {
+			// distribution amount = gauge_size * trader_volume / (total_volume * remain_epochs)
+			amt := coin.Amount.Mul(traderVolume.Volume).Quo(totalVolume.Mul(sdk.NewInt(int64(remainEpochs))))
+			if amt.IsPositive() {
+				distrCoins = distrCoins.Add(sdk.Coin{Denom: coin.Denom, Amount: amt})
+			}
+		}
+		if distrCoins.Empty() {
+			continue
+		}
+		if err := distrInfo.addLockRewards(traderVolume.Address, "", distrCoins); err != nil {
+			return nil, err
+		}
+		totalDistrCoins = totalDistrCoins.Add(distrCoins...)
+	}
+
+	err = k.updateGaugePostDistribute(ctx, gauge, totalDistrCoins)
+	return totalDistrCoins, err
+}
@@ -0,0 +1,224 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v13/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ = suite.TestingSuite(nil)
+
+// setupTraderVolumeGauge creates a gauge distributing the provided coins to the traders of the provided pool.
+func (suite *KeeperTestSuite) setupTraderVolumeGauge(poolId uint64, isPerpetual bool, coins sdk.Coins) (uint64, *types.Gauge) {
+	distrTo := lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByTraderVolume,
+		Denom:         gammtypes.GetPoolShareDenom(poolId),
+	}
+	gaugeID, gauge, _, _ := suite.setupNewGaugeWithQuery(isPerpetual, coins, distrTo)
+	return gaugeID, gauge
+}
+
+// setTraderVolumeParams sets the trader volume cap and minimum swap value params.
+func (suite *KeeperTestSuite) setTraderVolumeParams(traderVolumeCap, minTraderSwapValue int64) {
+	params := suite.App.IncentivesKeeper.GetParams(suite.Ctx)
+	params.TraderVolumeCap = sdk.NewInt(traderVolumeCap)
+	params.MinTraderSwapValue = sdk.NewInt(minTraderSwapValue)
+	suite.App.IncentivesKeeper.SetParams(suite.Ctx, params)
+}
+
+func (suite *KeeperTestSuite) TestTrackTraderVolume() {
+	tests := map[string]struct {
+		hasTraderGauge     bool
+		traderVolumeCap    int64
+		minTraderSwapValue int64
+		swapAmounts        []int64
+
+		expectedVolume sdk.Int
+	}{
+		"swaps are added up": {
+			hasTraderGauge: true,
+			swapAmounts:    []int64{100, 200},
+			expectedVolume: sdk.NewInt(300),
+		},
+		"pool without trader gauge, not tracked": {
+			swapAmounts:    []int64{100, 200},
+			expectedVolume: sdk.ZeroInt(),
+		},
+		"swaps below the minimum value are not counted": {
+			hasTraderGauge:     true,
+			minTraderSwapValue: 150,
+			swapAmounts:        []int64{100, 200},
+			expectedVolume:     sdk.NewInt(200),
+		},
+		"volume is capped": {
+			hasTraderGauge:  true,
+			traderVolumeCap: 250,
+			swapAmounts:     []int64{100, 200},
+			expectedVolume:  sdk.NewInt(250),
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			suite.setTraderVolumeParams(tc.traderVolumeCap, tc.minTraderSwapValue)
+			poolId := suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000), sdk.NewInt64Coin("foo", 1000000))
+			if tc.hasTraderGauge {
+				suite.setupTraderVolumeGauge(poolId, true, sdk.Coins{})
+			}
+			trader := suite.TestAccs[1]
+
+			// System under test.
+			for _, amount := range tc.swapAmounts {
+				suite.swapFrom(trader, poolId, sdk.NewInt64Coin(sdk.DefaultBondDenom, amount), "foo")
+			}
+
+			suite.Require().Equal(tc.expectedVolume, suite.App.IncentivesKeeper.GetTraderVolume(suite.Ctx, poolId, trader))
+			// the pool volume is tracked regardless of trader gauges.
+			suite.Require().Equal(sdk.NewInt(300), suite.App.IncentivesKeeper.GetPoolVolume(suite.Ctx, poolId))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTraderVolumeWashTrading() {
+	suite.SetupTest()
+	suite.setTraderVolumeParams(0, 0)
+
+	poolId := suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000), sdk.NewInt64Coin("foo", 1000000))
+	gaugeID, gauge := suite.setupTraderVolumeGauge(poolId, false, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 20000)})
+	washTrader, trader := suite.TestAccs[1], suite.TestAccs[2]
+
+	// the wash trader swaps its 10000 stake back and forth ten times, the trader swaps 1000 stake once.
+	suite.FundAcc(washTrader, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000)))
+	for i := 0; i < 10; i++ {
+		fooOut, err := suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, washTrader, poolId, sdk.NewCoin(sdk.DefaultBondDenom, suite.App.BankKeeper.GetBalance(suite.Ctx, washTrader, sdk.DefaultBondDenom).Amount), "foo", sdk.OneInt())
+		suite.Require().NoError(err)
+		_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, washTrader, poolId, sdk.NewCoin("foo", fooOut), sdk.DefaultBondDenom, sdk.OneInt())
+		suite.Require().NoError(err)
+	}
+	suite.swapFrom(trader, poolId, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), "foo")
+
+	// round trips only count the slippage paid.
+	washVolume := suite.App.IncentivesKeeper.GetTraderVolume(suite.Ctx, poolId, washTrader)
+	suite.Require().True(washVolume.LT(sdk.NewInt(10)), washVolume.String())
+	suite.Require().Equal(sdk.NewInt(1000), suite.App.IncentivesKeeper.GetTraderVolume(suite.Ctx, poolId, trader))
+
+	// System under test.
+	_, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+	suite.Require().NoError(err)
+
+	// the wash trader receives at most 1% of the epoch's rewards.
+	suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, washTrader, defaultRewardDenom).Amount.LTE(sdk.NewInt(100)))
+	suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, trader, defaultRewardDenom).Amount.GTE(sdk.NewInt(9900)))
+	gauge, err = suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), gauge.FilledEpochs)
+}
+
+func (suite *KeeperTestSuite) TestCreateTraderVolumeGauge() {
+	suite.SetupTest()
+
+	// the gauge must distribute to the traders of a pool.
+	distrTo := lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByTraderVolume,
+		Denom:         defaultLPDenom,
+	}
+	suite.FundAcc(defaultGaugeOwner, sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, 10)})
+	_, err := suite.App.IncentivesKeeper.CreateGauge(suite.Ctx, true, defaultGaugeOwner, sdk.Coins{}, distrTo, suite.Ctx.BlockTime(), 1)
+	suite.Require().Error(err)
+
+	poolId := suite.PrepareBalancerPool()
+	gaugeID, gauge := suite.setupTraderVolumeGauge(poolId, true, sdk.Coins{})
+	suite.Require().True(gauge.IsTraderVolumeGauge())
+
+	// trader volume gauges are indexed by pool rather than denom.
+	suite.Require().NotContains(suite.App.IncentivesKeeper.GetAllGaugeIDsByDenom(suite.Ctx, gammtypes.GetPoolShareDenom(poolId)), gaugeID)
+	suite.Require().Equal([]uint64{gaugeID}, suite.App.IncentivesKeeper.GetTraderGaugeIDsByPool(suite.Ctx, poolId))
+}
+
+func (suite *KeeperTestSuite) TestDistributeTraderVolumeGauge() {
+	suite.SetupTest()
+	suite.setTraderVolumeParams(0, 0)
+
+	poolId := suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000), sdk.NewInt64Coin("foo", 1000000))
+	gaugeID, gauge := suite.setupTraderVolumeGauge(poolId, false, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 4000)})
+	trader1, trader2 := suite.TestAccs[1], suite.TestAccs[2]
+	suite.swapFrom(trader1, poolId, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), "foo")
+	suite.swapFrom(trader2, poolId, sdk.NewInt64Coin(sdk.DefaultBondDenom, 300), "foo")
+
+	// System under test.
+	distributed, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+	suite.Require().NoError(err)
+
+	// half of the coins are distributed in the first of two epochs, split 1:3.
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 2000)}, distributed)
+	suite.Require().Equal(sdk.NewInt(500), suite.App.BankKeeper.GetBalance(suite.Ctx, trader1, defaultRewardDenom).Amount)
+	suite.Require().Equal(sdk.NewInt(1500), suite.App.BankKeeper.GetBalance(suite.Ctx, trader2, defaultRewardDenom).Amount)
+
+	gauge, err = suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), gauge.FilledEpochs)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 2000)}, gauge.DistributedCoins)
+}
+
+func (suite *KeeperTestSuite) TestTraderVolumeEpochEnd() {
+	suite.SetupTest()
+	suite.setTraderVolumeParams(0, 0)
+	distrEpochIdentifier := suite.App.IncentivesKeeper.GetParams(suite.Ctx).DistrEpochIdentifier
+
+	poolId := suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000), sdk.NewInt64Coin("foo", 1000000))
+	gaugeID, _ := suite.setupTraderVolumeGauge(poolId, false, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1000)})
+	trader := suite.TestAccs[1]
+
+	// System under test.
+	for epoch := int64(1); epoch <= 2; epoch++ {
+		suite.swapFrom(trader, poolId, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), "foo")
+		suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Second))
+		err := suite.App.IncentivesKeeper.AfterEpochEnd(suite.Ctx, distrEpochIdentifier, epoch)
+		suite.Require().NoError(err)
+
+		// the trader receives all rewards of each epoch, and the epoch's volume is reset.
+		suite.Require().Equal(sdk.NewInt(500*epoch), suite.App.BankKeeper.GetBalance(suite.Ctx, trader, defaultRewardDenom).Amount)
+		suite.Require().Empty(suite.App.IncentivesKeeper.GetAllTraderVolumes(suite.Ctx))
+	}
+
+	// the finished gauge is no longer indexed, so volume is no longer tracked for the pool.
+	finishedGauges := suite.App.IncentivesKeeper.GetFinishedGauges(suite.Ctx)
+	suite.Require().Len(finishedGauges, 1)
+	suite.Require().Equal(gaugeID, finishedGauges[0].Id)
+	suite.Require().Empty(suite.App.IncentivesKeeper.GetTraderGaugeIDsByPool(suite.Ctx, poolId))
+	suite.swapFrom(trader, poolId, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), "foo")
+	suite.Require().Empty(suite.App.IncentivesKeeper.GetAllTraderVolumes(suite.Ctx))
+}
+
+func (suite *KeeperTestSuite) TestTraderVolumeGenesis() {
+	suite.SetupTest()
+	suite.setTraderVolumeParams(0, 0)
+
+	poolId := suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000), sdk.NewInt64Coin("foo", 1000000))
+	suite.setupTraderVolumeGauge(poolId, true, sdk.Coins{})
+	suite.swapFrom(suite.TestAccs[1], poolId, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), "foo")
+
+	genesis := suite.App.IncentivesKeeper.ExportGenesis(suite.Ctx)
+	suite.Require().NoError(genesis.Validate())
+	suite.Require().Equal([]types.TraderVolume{{
+		PoolId:   poolId,
+		Address:  suite.TestAccs[1].String(),
+		Volume:   sdk.NewInt(100),
+		ValueIn:  sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)},
+		ValueOut: sdk.Coins{sdk.NewInt64Coin("foo", 100)},
+	}}, genesis.TraderVolumes)
+
+	// System under test.
+	suite.SetupTest()
+	suite.App.IncentivesKeeper.InitGenesis(suite.Ctx, *genesis)
+
+	suite.Require().Equal(genesis.TraderVolumes, suite.App.IncentivesKeeper.GetAllTraderVolumes(suite.Ctx))
+	suite.Require().Len(suite.App.IncentivesKeeper.GetTraderGaugeIDsByPool(suite.Ctx, poolId), 1)
+}
@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/osmosis/v13/osmoutils"
+	"github.com/osmosis-labs/osmosis/v13/x/incentives/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// poolVolumeStoreKey returns the store key of the provided pool's cumulative volume.
+func poolVolumeStoreKey(poolId uint64) []byte {
+	return combineKeys(types.KeyPrefixPoolVolumes, sdk.Uint64ToBigEndian(poolId))
+}
+
+// GetPoolVolume returns the cumulative swap volume of the provided pool, valued in the base denom.
+// Returns zero if no volume has been tracked for the pool.
+func (k Keeper) GetPoolVolume(ctx sdk.Context, poolId uint64) sdk.Int {
+	return k.getPoolVolume(ctx, poolId).Volume
+}
+
+// GetPoolSwapFees returns the cumulative swap fees paid on the provided pool, valued in the base denom.
+// Returns zero if no swap fees have been tracked for the pool.
+func (k Keeper) GetPoolSwapFees(ctx sdk.Context, poolId uint64) sdk.Int {
+	return k.getPoolVolume(ctx, poolId).SwapFees
+}
+
+// getPoolVolume returns the cumulative swap volume and swap fees of the provided pool.
+// Returns zero volume and swap fees if none have been tracked for the pool.
+func (k Keeper) getPoolVolume(ctx sdk.Context, poolId uint64) types.PoolVolume {
+	store := ctx.KVStore(k.storeKey)
+	poolVolume := types.PoolVolume{}
+	found, err := osmoutils.Get(store, poolVolumeStoreKey(poolId), &poolVolume)
+	if err != nil {
+		panic(err)
+	}
+	if !found {
+		return types.PoolVolume{PoolId: poolId, Volume: sdk.ZeroInt(), SwapFees: sdk.ZeroInt()}
+	}
+	return poolVolume
+}
+
+// setPoolVolume sets the cumulative swap volume of the provided pool.
+func (k Keeper) setPoolVolume(ctx sdk.Context, poolVolume types.PoolVolume) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, poolVolumeStoreKey(poolVolume.PoolId), &poolVolume)
+}
+
+// GetAllPoolVolumes returns the cumulative swap volumes of all pools with tracked volume.
+func (k Keeper) GetAllPoolVolumes(ctx sdk.Context) []types.PoolVolume {
+	store := ctx.KVStore(k.storeKey)
+	poolVolumes, err := osmoutils.GatherValuesFromStorePrefix(store, types.KeyPrefixPoolVolumes, func(bz []byte) (types.PoolVolume, error) {
+		poolVolume := types.PoolVolume{}
+		err := proto.Unmarshal(bz, &poolVolume)
+		return poolVolume, err
+	})
+	if err != nil {
+		panic(err)
+	}
+	return poolVolumes
+}
+
+// trackSwapVolume adds the value of a swap, in the base denom, to the cumulative volume of the pool,
+// and the swap fee charged on that value to the cumulative swap fees of the pool.
+// The swap is also added to the sender's flows on the pool for the current epoch if the pool has
+// ByTraderVolume gauges.
+// Swaps that can not be valued are not tracked.
+func (k Keeper) trackSwapVolume(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins, swapFee sdk.Dec) {
+	value := k.getSwapValue(ctx, input, output)
+	if !value.IsPositive() {
+		return
+	}
+
+	poolVolume := k.getPoolVolume(ctx, poolId)
+	poolVolume.Volume = poolVolume.Volume.Add(value)
+	poolVolume.SwapFees = poolVolume.SwapFees.Add(swapFee.MulInt(value).TruncateInt())
+	k.setPoolVolume(ctx, poolVolume)
+	k.trackTraderVolume(ctx, sender, poolId, input, output, value)
+}
+
+// getSwapValue returns the value of a swap in the base denom.
+// The swap is valued by its base denom side if it has one, otherwise by converting the input
+// at the arithmetic twap of its fee token pool.
+// Returns zero if the swap can not be valued.
+func (k Keeper) getSwapValue(ctx sdk.Context, input sdk.Coins, output sdk.Coins) sdk.Int {
+	baseDenom, err := k.tk.GetBaseDenom(ctx)
+	if err != nil {
+		return sdk.ZeroInt()
+	}
+
+	value := input.AmountOf(baseDenom)
+	if value.IsZero() {
+		value = output.AmountOf(baseDenom)
+	}
+	if value.IsZero() {
+		for _, coin := range input {
+			baseCoin, err := k.convertToBaseTokenTwap(ctx, coin)
+			if err == nil {
+				value = baseCoin.Amount
+				break
+			}
+		}
+	}
+	return value
+}
+
+// convertToBaseTokenTwap converts a coin in a txfees fee token to the base denom, at the arithmetic twap
+// over TwapValuationWindow of the fee token's pool, so that its value can not be moved by manipulating
+// the pool's spot price.
+// Returns an error if the denom is not a fee token, or its pool has no twap for the whole window.
+func (k Keeper) convertToBaseTokenTwap(ctx sdk.Context, coin sdk.Coin) (sdk.Coin, error) {
+	baseDenom, err := k.tk.GetBaseDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if coin.Denom == baseDenom {
+		return coin, nil
+	}
+
+	feeToken, err := k.tk.GetFeeToken(ctx, coin.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	twap, err := k.twk.GetArithmeticTwapToNow(ctx, feeToken.PoolID, coin.Denom, baseDenom, ctx.BlockTime().Add(-types.TwapValuationWindow))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return sdk.NewCoin(baseDenom, twap.MulInt(coin.Amount).RoundInt()), nil
+}
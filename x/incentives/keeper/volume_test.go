@@ -0,0 +1,32 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/osmosis-labs/osmosis/v13/x/incentives/types"
+	txfeestypes "github.com/osmosis-labs/osmosis/v13/x/txfees/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestTrackSwapVolumeAtTwap() {
+	suite.SetupTest()
+
+	// foo is worth one base denom unit in its fee token pool.
+	feeTokenPoolId := suite.prepareSwapFeePool("foo", sdk.ZeroDec())
+	feeTokenProp := txfeestypes.NewUpdateFeeTokenProposal("Test Proposal", "test", txfeestypes.FeeToken{Denom: "foo", PoolID: feeTokenPoolId})
+	suite.Require().NoError(suite.App.TxFeesKeeper.HandleUpdateFeeTokenProposal(suite.Ctx, &feeTokenProp))
+	poolId := suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("foo", 1000000), sdk.NewInt64Coin("bar", 1000000))
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.TwapValuationWindow + time.Hour))
+
+	// buying most of the fee token pool's foo multiplies its spot price, but not its twap.
+	suite.swap(feeTokenPoolId, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000), "foo")
+	spotPrice, err := suite.App.GAMMKeeper.CalculateSpotPrice(suite.Ctx, feeTokenPoolId, sdk.DefaultBondDenom, "foo")
+	suite.Require().NoError(err)
+	suite.Require().True(spotPrice.GT(sdk.NewDec(3)))
+
+	// System under test.
+	suite.swap(poolId, sdk.NewInt64Coin("foo", 1000), "bar")
+
+	suite.Require().Equal(sdk.NewInt(1000), suite.App.IncentivesKeeper.GetPoolVolume(suite.Ctx, poolId))
+}
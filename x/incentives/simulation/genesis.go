@@ -23,6 +23,9 @@ func RandomizedGenState(simState *module.SimulationState) {
 	incentivesGenesis := types.GenesisState{
 		Params: types.Params{
 			DistrEpochIdentifier: distrEpochIdentifier,
+			CreateGaugeFee:       types.DefaultCreateGaugeFee,
+			AddToGaugeFee:        types.DefaultAddToGaugeFee,
+			AcceptedFeeDenoms:    []string{},
 		},
 		LockableDurations: []time.Duration{
 			time.Second,
@@ -126,14 +126,15 @@ func SimulateMsgCreateGauge(ak stakingTypes.AccountKeeper, bk stakingTypes.BankK
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
 		simAccount, _ := simtypes.RandomAcc(r, accs)
 		simCoins := bk.SpendableCoins(ctx, simAccount.Address)
-		if simCoins.AmountOf(sdk.DefaultBondDenom).LT(types.CreateGaugeFee) {
+		createGaugeFee := k.GetParams(ctx).CreateGaugeFee
+		if simCoins.AmountOf(sdk.DefaultBondDenom).LT(createGaugeFee) {
 			return simtypes.NoOpMsg(
 				types.ModuleName, types.TypeMsgCreateGauge, "Account have no coin"), nil, nil
 		}
 
 		isPerpetual := r.Int()%2 == 0
 		distributeTo := genQueryCondition(r, ctx.BlockTime(), simCoins, types.DefaultGenesis().LockableDurations)
-		rewards := genRewardCoins(r, simCoins, types.CreateGaugeFee)
+		rewards := genRewardCoins(r, simCoins, createGaugeFee)
 		startTimeSecs := r.Intn(1 * 60 * 60 * 24 * 7) // range of 1 week
 		startTime := ctx.BlockTime().Add(time.Duration(startTimeSecs) * time.Second)
 		durationSecs := r.Intn(1*60*60*24*7) + 1*60*60*24 // range of 1 week, min 1 day
@@ -165,7 +166,8 @@ func SimulateMsgAddToGauge(ak stakingTypes.AccountKeeper, bk stakingTypes.BankKe
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
 		simAccount, _ := simtypes.RandomAcc(r, accs)
 		simCoins := bk.SpendableCoins(ctx, simAccount.Address)
-		if simCoins.AmountOf(sdk.DefaultBondDenom).LT(types.AddToGaugeFee) {
+		addToGaugeFee := k.GetParams(ctx).AddToGaugeFee
+		if simCoins.AmountOf(sdk.DefaultBondDenom).LT(addToGaugeFee) {
 			return simtypes.NoOpMsg(
 				types.ModuleName, types.TypeMsgAddToGauge, "Account have no coin"), nil, nil
 		}
@@ -177,7 +179,7 @@ func SimulateMsgAddToGauge(ak stakingTypes.AccountKeeper, bk stakingTypes.BankKe
 		}
 		gaugeId := RandomGauge(ctx, r, k).Id
 
-		rewards := genRewardCoins(r, simCoins, types.AddToGaugeFee)
+		rewards := genRewardCoins(r, simCoins, addToGaugeFee)
 
 		msg := types.MsgAddToGauge{
 			Owner:   simAccount.Address.String(),
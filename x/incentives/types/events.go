@@ -6,8 +6,16 @@ const (
 	TypeEvtAddToGauge   = "add_to_gauge"
 	TypeEvtDistribution = "distribution"
 
+	TypeEvtCreateGroupGauge       = "create_group_gauge"
+	TypeEvtGroupGaugeDistribution = "group_gauge_distribution"
+	TypeEvtCancelGauge            = "cancel_gauge"
+	TypeEvtClaimRewards           = "claim_rewards"
+
 	AttributeGaugeID     = "gauge_id"
 	AttributeLockedDenom = "denom"
 	AttributeReceiver    = "receiver"
 	AttributeAmount      = "amount"
+
+	AttributeUnderlyingGaugeID = "underlying_gauge_id"
+	AttributeLockID            = "lock_id"
 )
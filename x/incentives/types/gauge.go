@@ -1,6 +1,7 @@
 package types
 
 import (
+	"fmt"
 	time "time"
 
 	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
@@ -8,13 +9,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var (
-	// CreateGaugeFee is the fee required to create a new gauge.
-	CreateGaugeFee = sdk.NewInt(50 * 1_000_000)
-	// AddToGagugeFee is the fee required to add to gauge.
-	AddToGaugeFee = sdk.NewInt(25 * 1_000_000)
-)
-
 // NewGauge creates a new gauge struct given the required gauge parameters.
 func NewGauge(id uint64, isPerpetual bool, distrTo lockuptypes.QueryCondition, coins sdk.Coins, startTime time.Time, numEpochsPaidOver uint64, filledEpochs uint64, distrCoins sdk.Coins) Gauge {
 	return Gauge{
@@ -46,3 +40,74 @@ func (gauge Gauge) IsActiveGauge(curTime time.Time) bool {
 func (gauge Gauge) IsFinishedGauge(curTime time.Time) bool {
 	return !gauge.IsUpcomingGauge(curTime) && !gauge.IsActiveGauge(curTime)
 }
+
+// RemainEpochs returns the number of epochs the gauge's remaining coins are paid out over, which is one for a
+// perpetual gauge, as it pays out all of its coins every epoch, and the epochs left to pay out otherwise.
+// Returns zero if a non perpetual gauge has paid out all of its epochs.
+func (gauge Gauge) RemainEpochs() uint64 {
+	if gauge.IsPerpetual {
+		return 1
+	}
+	if gauge.NumEpochsPaidOver <= gauge.FilledEpochs {
+		return 0
+	}
+	return gauge.NumEpochsPaidOver - gauge.FilledEpochs
+}
+
+// IsGroupGauge returns true if the gauge is a group gauge, which forwards its rewards to other gauges.
+func (gauge Gauge) IsGroupGauge() bool {
+	return gauge.DistributeTo.LockQueryType == lockuptypes.ByGroup
+}
+
+// IsTraderVolumeGauge returns true if the gauge distributes to the traders of a pool by their swap volume.
+func (gauge Gauge) IsTraderVolumeGauge() bool {
+	return gauge.DistributeTo.LockQueryType == lockuptypes.ByTraderVolume
+}
+
+// IsBoostedGauge returns true if the gauge weights the rewards of its locks by its boost curve.
+func (gauge Gauge) IsBoostedGauge() bool {
+	return len(gauge.BoostCurve) > 0
+}
+
+// BoostMultiplier returns the reward multiplier of a lock with the provided duration under the gauge's boost curve,
+// which is the multiplier of the longest curve point not longer than the duration, or one if there is none.
+// The boost curve is expected to be sorted by duration.
+func (gauge Gauge) BoostMultiplier(duration time.Duration) sdk.Dec {
+	multiplier := sdk.OneDec()
+	for _, point := range gauge.BoostCurve {
+		if point.Duration > duration {
+			break
+		}
+		multiplier = point.Multiplier
+	}
+	return multiplier
+}
+
+// ValidateBoostCurve checks that the boost curve points have strictly increasing durations and non-decreasing
+// multipliers of at least one, so that a longer lock is never boosted less than a shorter one.
+func ValidateBoostCurve(boostCurve []BoostPoint) error {
+	for i, point := range boostCurve {
+		if point.Multiplier.IsNil() || point.Multiplier.LT(sdk.OneDec()) {
+			return fmt.Errorf("boost multiplier must be at least one: %s", point.Multiplier)
+		}
+		if point.Duration <= 0 {
+			return fmt.Errorf("boost duration must be positive: %s", point.Duration)
+		}
+		if i > 0 && point.Duration <= boostCurve[i-1].Duration {
+			return fmt.Errorf("boost durations must be strictly increasing: %s after %s", point.Duration, boostCurve[i-1].Duration)
+		}
+		if i > 0 && point.Multiplier.LT(boostCurve[i-1].Multiplier) {
+			return fmt.Errorf("boost multipliers must be non-decreasing: %s after %s", point.Multiplier, boostCurve[i-1].Multiplier)
+		}
+	}
+	return nil
+}
+
+// NewGroupGauge creates a new group gauge record given its gauge ID, underlying gauge records and splitting policy.
+func NewGroupGauge(gaugeId uint64, records []GroupGaugeRecord, splittingPolicy SplittingPolicy) GroupGauge {
+	return GroupGauge{
+		GaugeId:         gaugeId,
+		Records:         records,
+		SplittingPolicy: splittingPolicy,
+	}
+}
@@ -3,9 +3,11 @@ package types
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // DefaultIndex is the default incentive module's global index.
@@ -14,9 +16,7 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the incentive module's default genesis state.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params: Params{
-			DistrEpochIdentifier: "week",
-		},
+		Params: DefaultParams(),
 		Gauges: []Gauge{},
 		LockableDurations: []time.Duration{
 			time.Second,
@@ -43,5 +43,69 @@ func (gs GenesisState) Validate() error {
 	if gs.Params.DistrEpochIdentifier == "" {
 		return errors.New("epoch identifier should NOT be empty")
 	}
-	return nil
+
+	groupGaugeIds := make(map[uint64]bool, len(gs.Gauges))
+	for _, gauge := range gs.Gauges {
+		if gauge.IsGroupGauge() {
+			groupGaugeIds[gauge.Id] = true
+		}
+		if err := ValidateBoostCurve(gauge.BoostCurve); err != nil {
+			return fmt.Errorf("invalid boost curve of gauge %d: %w", gauge.Id, err)
+		}
+	}
+	for _, groupGauge := range gs.GroupGauges {
+		if !groupGaugeIds[groupGauge.GaugeId] {
+			return fmt.Errorf("group record of gauge %d does not match a group gauge", groupGauge.GaugeId)
+		}
+		delete(groupGaugeIds, groupGauge.GaugeId)
+	}
+	if len(groupGaugeIds) != 0 {
+		return errors.New("every group gauge should have exactly one group record")
+	}
+
+	for _, poolVolume := range gs.PoolVolumes {
+		if poolVolume.Volume.IsNil() || poolVolume.Volume.IsNegative() {
+			return fmt.Errorf("volume of pool %d should be non-negative", poolVolume.PoolId)
+		}
+		if poolVolume.SwapFees.IsNil() || poolVolume.SwapFees.IsNegative() {
+			return fmt.Errorf("swap fees of pool %d should be non-negative", poolVolume.PoolId)
+		}
+	}
+
+	for _, traderVolume := range gs.TraderVolumes {
+		if _, err := sdk.AccAddressFromBech32(traderVolume.Address); err != nil {
+			return fmt.Errorf("invalid trader address %s: %w", traderVolume.Address, err)
+		}
+		if traderVolume.Volume.IsNil() || traderVolume.Volume.IsNegative() {
+			return fmt.Errorf("trader volume of %s on pool %d should be non-negative", traderVolume.Address, traderVolume.PoolId)
+		}
+		if err := traderVolume.ValueIn.Validate(); err != nil {
+			return fmt.Errorf("invalid value in of %s on pool %d: %w", traderVolume.Address, traderVolume.PoolId, err)
+		}
+		if err := traderVolume.ValueOut.Validate(); err != nil {
+			return fmt.Errorf("invalid value out of %s on pool %d: %w", traderVolume.Address, traderVolume.PoolId, err)
+		}
+	}
+
+	for _, accumulator := range gs.RewardAccumulators {
+		if err := accumulator.RewardsPerShare.Validate(); err != nil {
+			return fmt.Errorf("invalid rewards per share of denom %s and duration %s: %w", accumulator.Denom, accumulator.Duration, err)
+		}
+		if err := accumulator.BoostRewardsPerShare.Validate(); err != nil {
+			return fmt.Errorf("invalid boost rewards per share of denom %s and duration %s: %w", accumulator.Denom, accumulator.Duration, err)
+		}
+	}
+	for _, position := range gs.LockRewardPositions {
+		if position.Amount.IsNil() || position.Amount.IsNegative() {
+			return fmt.Errorf("reward position of lock %d for denom %s should be non-negative", position.LockId, position.Denom)
+		}
+		if err := position.RewardsPerShare.Validate(); err != nil {
+			return fmt.Errorf("invalid rewards per share of lock %d for denom %s: %w", position.LockId, position.Denom, err)
+		}
+		if err := position.BoostRewardsPerShare.Validate(); err != nil {
+			return fmt.Errorf("invalid boost rewards per share of lock %d for denom %s: %w", position.LockId, position.Denom, err)
+		}
+	}
+
+	return gs.Params.Validate()
 }
@@ -40,6 +40,19 @@ type GenesisState struct {
 	// last_gauge_id is what the gauge number will increment from when creating
 	// the next gauge after genesis
 	LastGaugeId uint64 `protobuf:"varint,4,opt,name=last_gauge_id,json=lastGaugeId,proto3" json:"last_gauge_id,omitempty"`
+	// group_gauges are the group records of the group gauges in gauges
+	GroupGauges []GroupGauge `protobuf:"bytes,5,rep,name=group_gauges,json=groupGauges,proto3" json:"group_gauges"`
+	// pool_volumes are the cumulative pool volumes used by ByVolume group gauges
+	PoolVolumes []PoolVolume `protobuf:"bytes,6,rep,name=pool_volumes,json=poolVolumes,proto3" json:"pool_volumes"`
+	// trader_volumes are the current epoch's trader volumes used by
+	// ByTraderVolume gauges
+	TraderVolumes []TraderVolume `protobuf:"bytes,7,rep,name=trader_volumes,json=traderVolumes,proto3" json:"trader_volumes"`
+	// reward_accumulators are the rewards per share accrued for locks by denom
+	// and duration
+	RewardAccumulators []RewardAccumulator `protobuf:"bytes,8,rep,name=reward_accumulators,json=rewardAccumulators,proto3" json:"reward_accumulators"`
+	// lock_reward_positions are the positions of locks in the reward
+	// accumulators as of their last reward settlement
+	LockRewardPositions []LockRewardPosition `protobuf:"bytes,9,rep,name=lock_reward_positions,json=lockRewardPositions,proto3" json:"lock_reward_positions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -103,6 +116,41 @@ func (m *GenesisState) GetLastGaugeId() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetGroupGauges() []GroupGauge {
+	if m != nil {
+		return m.GroupGauges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolVolumes() []PoolVolume {
+	if m != nil {
+		return m.PoolVolumes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTraderVolumes() []TraderVolume {
+	if m != nil {
+		return m.TraderVolumes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardAccumulators() []RewardAccumulator {
+	if m != nil {
+		return m.RewardAccumulators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLockRewardPositions() []LockRewardPosition {
+	if m != nil {
+		return m.LockRewardPositions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.incentives.GenesisState")
 }
@@ -110,29 +158,38 @@ func init() {
 func init() { proto.RegisterFile("osmosis/incentives/genesis.proto", fileDescriptor_a288ccc95d977d2d) }
 
 var fileDescriptor_a288ccc95d977d2d = []byte{
-	// 341 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xb1, 0x6e, 0xfa, 0x30,
-	0x10, 0xc6, 0x13, 0x40, 0x0c, 0xe1, 0xff, 0x1f, 0x6a, 0x75, 0x08, 0x0c, 0x4e, 0x14, 0xa9, 0x12,
-	0x4b, 0x6d, 0x15, 0xa4, 0xb6, 0xea, 0x88, 0x2a, 0xa1, 0x6e, 0x88, 0x6e, 0x5d, 0x90, 0x03, 0xae,
-	0x6b, 0x35, 0x89, 0x11, 0xe7, 0xa0, 0xf2, 0x16, 0x9d, 0xaa, 0x3e, 0x12, 0x23, 0x63, 0x27, 0x5a,
-	0xc1, 0x1b, 0xf4, 0x09, 0xaa, 0x38, 0xb1, 0x5a, 0x09, 0x36, 0x9f, 0xbf, 0xdf, 0x7d, 0xf7, 0xdd,
-	0x79, 0xa1, 0x82, 0x54, 0x81, 0x04, 0x2a, 0xb3, 0x29, 0xcf, 0xb4, 0x5c, 0x72, 0xa0, 0x82, 0x67,
-	0x1c, 0x24, 0x90, 0xf9, 0x42, 0x69, 0x85, 0x50, 0x45, 0x90, 0x5f, 0xa2, 0x73, 0x2a, 0x94, 0x50,
-	0x46, 0xa6, 0xc5, 0xab, 0x24, 0x3b, 0x58, 0x28, 0x25, 0x12, 0x4e, 0x4d, 0x15, 0xe7, 0x8f, 0x74,
-	0x96, 0x2f, 0x98, 0x96, 0x2a, 0xab, 0xf4, 0xe0, 0xc8, 0xac, 0x39, 0x5b, 0xb0, 0x14, 0xac, 0xc1,
-	0xb1, 0x30, 0x2c, 0x17, 0xbc, 0xd4, 0xa3, 0xb7, 0x9a, 0xf7, 0x6f, 0x58, 0x86, 0xbb, 0xd7, 0x4c,
-	0x73, 0x74, 0xed, 0x35, 0x4b, 0x03, 0xdf, 0x0d, 0xdd, 0x6e, 0xab, 0xd7, 0x21, 0x87, 0x61, 0xc9,
-	0xc8, 0x10, 0x83, 0xc6, 0x7a, 0x1b, 0x38, 0xe3, 0x8a, 0x47, 0x57, 0x5e, 0xd3, 0x38, 0x83, 0x5f,
-	0x0b, 0xeb, 0xdd, 0x56, 0xaf, 0x7d, 0xac, 0x73, 0x58, 0x10, 0xb6, 0xb1, 0xc4, 0x91, 0xf2, 0x50,
-	0xa2, 0xa6, 0xcf, 0x2c, 0x4e, 0xf8, 0xc4, 0xee, 0x07, 0x7e, 0xbd, 0x32, 0x29, 0x2f, 0x40, 0xec,
-	0x05, 0xc8, 0x6d, 0x45, 0x0c, 0xce, 0x0a, 0x93, 0xef, 0x6d, 0xd0, 0x5e, 0xb1, 0x34, 0xb9, 0x89,
-	0x0e, 0x2d, 0xa2, 0xf7, 0xcf, 0xc0, 0x1d, 0x9f, 0x58, 0xc1, 0x36, 0x02, 0x8a, 0xbc, 0xff, 0x09,
-	0x03, 0x3d, 0x31, 0xf3, 0x27, 0x72, 0xe6, 0x37, 0x42, 0xb7, 0xdb, 0x18, 0xb7, 0x8a, 0x4f, 0x13,
-	0xf0, 0x6e, 0x36, 0x18, 0xad, 0x77, 0xd8, 0xdd, 0xec, 0xb0, 0xfb, 0xb5, 0xc3, 0xee, 0xeb, 0x1e,
-	0x3b, 0x9b, 0x3d, 0x76, 0x3e, 0xf6, 0xd8, 0x79, 0xb8, 0x14, 0x52, 0x3f, 0xe5, 0x31, 0x99, 0xaa,
-	0x94, 0x56, 0x1b, 0x9e, 0x27, 0x2c, 0x06, 0x5b, 0xd0, 0xe5, 0x45, 0x9f, 0xbe, 0xfc, 0x3d, 0xb8,
-	0x5e, 0xcd, 0x39, 0xc4, 0x4d, 0xb3, 0x42, 0xff, 0x27, 0x00, 0x00, 0xff, 0xff, 0x77, 0xb3, 0x0e,
-	0x48, 0x20, 0x02, 0x00, 0x00,
+	// 485 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xcf, 0x8a, 0xd3, 0x40,
+	0x18, 0xc0, 0x1b, 0xb7, 0x5b, 0x75, 0xba, 0x2b, 0x38, 0xab, 0x90, 0xed, 0x21, 0x2d, 0x85, 0x95,
+	0x5e, 0x4c, 0x70, 0x17, 0x54, 0xbc, 0x59, 0x84, 0x22, 0x28, 0x94, 0x2a, 0x1e, 0x44, 0x88, 0x93,
+	0x64, 0x1c, 0xc3, 0x4e, 0xfa, 0x85, 0xf9, 0x26, 0xd5, 0x7d, 0x0b, 0x8f, 0xbe, 0x89, 0xaf, 0xb0,
+	0xc7, 0x3d, 0x7a, 0x5a, 0xa5, 0x7d, 0x03, 0x9f, 0x40, 0x32, 0x99, 0x74, 0xab, 0xcd, 0xde, 0x32,
+	0xdf, 0xf7, 0x9b, 0xdf, 0xf7, 0x27, 0x09, 0x19, 0x00, 0x66, 0x80, 0x29, 0x06, 0xe9, 0x3c, 0xe6,
+	0x73, 0x9d, 0x2e, 0x38, 0x06, 0x82, 0xcf, 0x39, 0xa6, 0xe8, 0xe7, 0x0a, 0x34, 0x50, 0x6a, 0x09,
+	0xff, 0x8a, 0xe8, 0xdd, 0x13, 0x20, 0xc0, 0xa4, 0x83, 0xf2, 0xa9, 0x22, 0x7b, 0x9e, 0x00, 0x10,
+	0x92, 0x07, 0xe6, 0x14, 0x15, 0x9f, 0x82, 0xa4, 0x50, 0x4c, 0xa7, 0x30, 0xb7, 0xf9, 0x7e, 0x43,
+	0xad, 0x9c, 0x29, 0x96, 0x61, 0x2d, 0x68, 0x6a, 0x86, 0x15, 0x82, 0x57, 0xf9, 0xe1, 0x8f, 0x5d,
+	0xb2, 0x37, 0xa9, 0x9a, 0x7b, 0xa3, 0x99, 0xe6, 0xf4, 0x29, 0xe9, 0x54, 0x02, 0xd7, 0x19, 0x38,
+	0xa3, 0xee, 0x71, 0xcf, 0xdf, 0x6e, 0xd6, 0x9f, 0x1a, 0x62, 0xdc, 0x3e, 0xbf, 0xec, 0xb7, 0x66,
+	0x96, 0xa7, 0x4f, 0x48, 0xc7, 0x98, 0xd1, 0xbd, 0x31, 0xd8, 0x19, 0x75, 0x8f, 0x0f, 0x9b, 0x6e,
+	0x4e, 0x4a, 0xa2, 0xbe, 0x58, 0xe1, 0x14, 0x08, 0x95, 0x10, 0x9f, 0xb2, 0x48, 0xf2, 0xb0, 0x9e,
+	0x0f, 0xdd, 0x1d, 0x2b, 0xa9, 0x36, 0xe0, 0xd7, 0x1b, 0xf0, 0x5f, 0x58, 0x62, 0x7c, 0x54, 0x4a,
+	0xfe, 0x5c, 0xf6, 0x0f, 0xcf, 0x58, 0x26, 0x9f, 0x0d, 0xb7, 0x15, 0xc3, 0xef, 0xbf, 0xfa, 0xce,
+	0xec, 0x6e, 0x9d, 0xa8, 0x2f, 0x22, 0x1d, 0x92, 0x7d, 0xc9, 0x50, 0x87, 0xa6, 0x7e, 0x98, 0x26,
+	0x6e, 0x7b, 0xe0, 0x8c, 0xda, 0xb3, 0x6e, 0x19, 0x34, 0x0d, 0xbe, 0x4c, 0xe8, 0x84, 0xec, 0x09,
+	0x05, 0x45, 0x1e, 0xda, 0x99, 0x76, 0x4d, 0x3b, 0x5e, 0xe3, 0x4c, 0x25, 0xb7, 0x39, 0x58, 0x57,
+	0xac, 0x23, 0x58, 0x8a, 0x72, 0x00, 0x19, 0x2e, 0x40, 0x16, 0x19, 0x47, 0xb7, 0x73, 0xbd, 0x68,
+	0x0a, 0x20, 0xdf, 0x19, 0xac, 0x16, 0xe5, 0xeb, 0x08, 0xd2, 0xd7, 0xe4, 0x8e, 0x56, 0x2c, 0xe1,
+	0x6a, 0xad, 0xba, 0x69, 0x54, 0x83, 0x26, 0xd5, 0x5b, 0x43, 0xfe, 0x23, 0xdb, 0xd7, 0x1b, 0x31,
+	0xa4, 0x1f, 0xc8, 0x81, 0xe2, 0x5f, 0x98, 0x4a, 0x42, 0x16, 0xc7, 0x45, 0x56, 0x48, 0xa6, 0x41,
+	0xa1, 0x7b, 0xcb, 0x38, 0x8f, 0x9a, 0x9c, 0x33, 0x83, 0x3f, 0xbf, 0xa2, 0xad, 0x98, 0xaa, 0xff,
+	0x13, 0x48, 0x3f, 0x92, 0xfb, 0xe5, 0xde, 0x43, 0x5b, 0x22, 0x07, 0x4c, 0xab, 0xd7, 0x7a, 0xdb,
+	0xf8, 0x1f, 0x34, 0xf9, 0x5f, 0x41, 0x7c, 0x5a, 0xd5, 0x98, 0x5a, 0xdc, 0x16, 0x38, 0x90, 0x5b,
+	0x19, 0x1c, 0x4f, 0xcf, 0x97, 0x9e, 0x73, 0xb1, 0xf4, 0x9c, 0xdf, 0x4b, 0xcf, 0xf9, 0xb6, 0xf2,
+	0x5a, 0x17, 0x2b, 0xaf, 0xf5, 0x73, 0xe5, 0xb5, 0xde, 0x3f, 0x16, 0xa9, 0xfe, 0x5c, 0x44, 0x7e,
+	0x0c, 0x59, 0x60, 0xcb, 0x3c, 0x94, 0x2c, 0xc2, 0xfa, 0x10, 0x2c, 0x1e, 0x9d, 0x04, 0x5f, 0x37,
+	0xff, 0x08, 0x7d, 0x96, 0x73, 0x8c, 0x3a, 0xe6, 0x1b, 0x3b, 0xf9, 0x3b, 0x00, 0xcf, 0x0c, 0x56,
+	0xe3, 0xc1, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -155,6 +212,76 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LockRewardPositions) > 0 {
+		for iNdEx := len(m.LockRewardPositions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockRewardPositions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.RewardAccumulators) > 0 {
+		for iNdEx := len(m.RewardAccumulators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardAccumulators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.TraderVolumes) > 0 {
+		for iNdEx := len(m.TraderVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TraderVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PoolVolumes) > 0 {
+		for iNdEx := len(m.PoolVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.GroupGauges) > 0 {
+		for iNdEx := len(m.GroupGauges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GroupGauges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.LastGaugeId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.LastGaugeId))
 		i--
@@ -233,6 +360,36 @@ func (m *GenesisState) Size() (n int) {
 	if m.LastGaugeId != 0 {
 		n += 1 + sovGenesis(uint64(m.LastGaugeId))
 	}
+	if len(m.GroupGauges) > 0 {
+		for _, e := range m.GroupGauges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolVolumes) > 0 {
+		for _, e := range m.PoolVolumes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TraderVolumes) > 0 {
+		for _, e := range m.TraderVolumes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardAccumulators) > 0 {
+		for _, e := range m.RewardAccumulators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LockRewardPositions) > 0 {
+		for _, e := range m.LockRewardPositions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -391,6 +548,176 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupGauges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupGauges = append(m.GroupGauges, GroupGauge{})
+			if err := m.GroupGauges[len(m.GroupGauges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolVolumes = append(m.PoolVolumes, PoolVolume{})
+			if err := m.PoolVolumes[len(m.PoolVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraderVolumes = append(m.TraderVolumes, TraderVolume{})
+			if err := m.TraderVolumes[len(m.TraderVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAccumulators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardAccumulators = append(m.RewardAccumulators, RewardAccumulator{})
+			if err := m.RewardAccumulators[len(m.RewardAccumulators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockRewardPositions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockRewardPositions = append(m.LockRewardPositions, LockRewardPosition{})
+			if err := m.LockRewardPositions[len(m.LockRewardPositions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
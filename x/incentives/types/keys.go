@@ -43,6 +43,24 @@ var (
 	// KeyIndexSeparator defines key for merging bytes.
 	KeyIndexSeparator = []byte{0x07}
 
+	// KeyPrefixGroupGauges defines prefix key for storing group gauge records by gauge ID.
+	KeyPrefixGroupGauges = []byte{0x08}
+
+	// KeyPrefixPoolVolumes defines prefix key for storing the cumulative volume of pools by pool ID.
+	KeyPrefixPoolVolumes = []byte{0x09}
+
+	// KeyPrefixTraderVolumes defines prefix key for storing the current epoch's volume of traders by pool ID and address.
+	KeyPrefixTraderVolumes = []byte{0x0A}
+
+	// KeyPrefixTraderGaugesByPool defines prefix key for storing indexes of ByTraderVolume gauge IDs by pool ID.
+	KeyPrefixTraderGaugesByPool = []byte{0x0B}
+
+	// KeyPrefixRewardAccumulators defines prefix key for storing reward accumulators by denom and duration.
+	KeyPrefixRewardAccumulators = []byte{0x0C}
+
+	// KeyPrefixLockRewardPositions defines prefix key for storing the reward positions of locks by lock ID and denom.
+	KeyPrefixLockRewardPositions = []byte{0x0D}
+
 	// LockableDurationsKey defines key for storing valid durations for giving incentives.
 	LockableDurationsKey = []byte("lockable_durations")
 )
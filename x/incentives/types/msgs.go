@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
@@ -12,6 +13,10 @@ import (
 const (
 	TypeMsgCreateGauge = "create_gauge"
 	TypeMsgAddToGauge  = "add_to_gauge"
+
+	TypeMsgCreateGroupGauge = "create_group_gauge"
+	TypeMsgCancelGauge      = "cancel_gauge"
+	TypeMsgClaimRewards     = "claim_rewards"
 )
 
 var _ sdk.Msg = &MsgCreateGauge{}
@@ -55,8 +60,17 @@ func (m MsgCreateGauge) ValidateBasic() error {
 		return errors.New("distribution period should be 1 epoch for perpetual gauge")
 	}
 
-	if lockuptypes.LockQueryType_name[int32(m.DistributeTo.LockQueryType)] != "ByDuration" {
-		return errors.New("only duration query condition is allowed. Start time distr conditions is an obsolete codepath slated for deletion")
+	if m.DistributeTo.LockQueryType != lockuptypes.ByDuration && m.DistributeTo.LockQueryType != lockuptypes.ByTraderVolume {
+		return errors.New("only duration and trader volume query conditions are allowed. Start time distr conditions is an obsolete codepath slated for deletion")
+	}
+
+	if len(m.BoostCurve) > 0 {
+		if m.DistributeTo.LockQueryType != lockuptypes.ByDuration || lockuptypes.IsSyntheticDenom(m.DistributeTo.Denom) {
+			return errors.New("boost curve is only allowed for gauges distributing to native locks by duration")
+		}
+		if err := ValidateBoostCurve(m.BoostCurve); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -112,3 +126,169 @@ func (m MsgAddToGauge) GetSigners() []sdk.AccAddress {
 	owner, _ := sdk.AccAddressFromBech32(m.Owner)
 	return []sdk.AccAddress{owner}
 }
+
+var _ sdk.Msg = &MsgCreateGroupGauge{}
+
+// NewMsgCreateGroupGauge creates a message to create a group gauge with the provided parameters.
+func NewMsgCreateGroupGauge(isPerpetual bool, owner sdk.AccAddress, coins sdk.Coins, startTime time.Time, numEpochsPaidOver uint64, gaugeIds []uint64, weights []sdk.Int, splittingPolicy SplittingPolicy) *MsgCreateGroupGauge {
+	return &MsgCreateGroupGauge{
+		IsPerpetual:       isPerpetual,
+		Owner:             owner.String(),
+		Coins:             coins,
+		StartTime:         startTime,
+		NumEpochsPaidOver: numEpochsPaidOver,
+		GaugeIds:          gaugeIds,
+		Weights:           weights,
+		SplittingPolicy:   splittingPolicy,
+	}
+}
+
+// Route takes a create group gauge message, then returns the RouterKey used for slashing.
+func (m MsgCreateGroupGauge) Route() string { return RouterKey }
+
+// Type takes a create group gauge message, then returns a create group gauge message type.
+func (m MsgCreateGroupGauge) Type() string { return TypeMsgCreateGroupGauge }
+
+// ValidateBasic checks that the create group gauge message is valid.
+func (m MsgCreateGroupGauge) ValidateBasic() error {
+	if m.Owner == "" {
+		return errors.New("owner should be set")
+	}
+	if m.StartTime.Equal(time.Time{}) {
+		return errors.New("distribution start time should be set")
+	}
+	if m.NumEpochsPaidOver == 0 {
+		return errors.New("distribution period should be at least 1 epoch")
+	}
+	if m.IsPerpetual && m.NumEpochsPaidOver != 1 {
+		return errors.New("distribution period should be 1 epoch for perpetual gauge")
+	}
+	if len(m.GaugeIds) < 2 {
+		return errors.New("group gauge should have at least 2 underlying gauges")
+	}
+	seen := make(map[uint64]bool, len(m.GaugeIds))
+	for _, gaugeId := range m.GaugeIds {
+		if seen[gaugeId] {
+			return fmt.Errorf("underlying gauge %d is duplicated", gaugeId)
+		}
+		seen[gaugeId] = true
+	}
+
+	switch m.SplittingPolicy {
+	case ByStaticWeight:
+		if len(m.Weights) != len(m.GaugeIds) {
+			return errors.New("there should be one weight per underlying gauge")
+		}
+		for _, weight := range m.Weights {
+			if weight.IsNil() || !weight.IsPositive() {
+				return errors.New("weights should be positive")
+			}
+		}
+	case ByVolume:
+		if len(m.Weights) != 0 {
+			return errors.New("weights should not be set for a group gauge splitting by volume")
+		}
+	default:
+		return fmt.Errorf("invalid splitting policy: %s", m.SplittingPolicy)
+	}
+
+	return nil
+}
+
+// GetSignBytes takes a create group gauge message and turns it into a byte array.
+func (m MsgCreateGroupGauge) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners takes a create group gauge message and returns the owner in a byte array.
+func (m MsgCreateGroupGauge) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgCancelGauge{}
+
+// NewMsgCancelGauge creates a message to cancel a specific gauge, refunding its undistributed coins to the recipient.
+// The refund goes to the owner if the recipient is empty.
+func NewMsgCancelGauge(owner sdk.AccAddress, gaugeId uint64, recipient sdk.AccAddress) *MsgCancelGauge {
+	return &MsgCancelGauge{
+		Owner:     owner.String(),
+		GaugeId:   gaugeId,
+		Recipient: recipient.String(),
+	}
+}
+
+// Route takes a cancel gauge message, then returns the RouterKey used for slashing.
+func (m MsgCancelGauge) Route() string { return RouterKey }
+
+// Type takes a cancel gauge message, then returns a cancel gauge message type.
+func (m MsgCancelGauge) Type() string { return TypeMsgCancelGauge }
+
+// ValidateBasic checks that the cancel gauge message is valid.
+func (m MsgCancelGauge) ValidateBasic() error {
+	if m.Owner == "" {
+		return errors.New("owner should be set")
+	}
+	if m.Recipient != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+			return fmt.Errorf("invalid recipient address: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes takes a cancel gauge message and turns it into a byte array.
+func (m MsgCancelGauge) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners takes a cancel gauge message and returns the owner in a byte array.
+func (m MsgCancelGauge) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgClaimRewards{}
+
+// NewMsgClaimRewards creates a message to claim the accrued rewards of the owner's locks with the provided IDs.
+// The rewards of all of the owner's locks are claimed if no lock IDs are provided.
+func NewMsgClaimRewards(owner sdk.AccAddress, lockIds []uint64) *MsgClaimRewards {
+	return &MsgClaimRewards{
+		Owner:   owner.String(),
+		LockIds: lockIds,
+	}
+}
+
+// Route takes a claim rewards message, then returns the RouterKey used for slashing.
+func (m MsgClaimRewards) Route() string { return RouterKey }
+
+// Type takes a claim rewards message, then returns a claim rewards message type.
+func (m MsgClaimRewards) Type() string { return TypeMsgClaimRewards }
+
+// ValidateBasic checks that the claim rewards message is valid.
+func (m MsgClaimRewards) ValidateBasic() error {
+	if m.Owner == "" {
+		return errors.New("owner should be set")
+	}
+	seenLockIds := make(map[uint64]bool, len(m.LockIds))
+	for _, lockId := range m.LockIds {
+		if seenLockIds[lockId] {
+			return fmt.Errorf("duplicate lock ID %d", lockId)
+		}
+		seenLockIds[lockId] = true
+	}
+
+	return nil
+}
+
+// GetSignBytes takes a claim rewards message and turns it into a byte array.
+func (m MsgClaimRewards) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners takes a claim rewards message and returns the owner in a byte array.
+func (m MsgClaimRewards) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
@@ -105,6 +105,41 @@ func TestMsgCreatePool(t *testing.T) {
 			}),
 			expectPass: false,
 		},
+		{
+			name: "trader volume lock query type",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.DistributeTo.LockQueryType = lockuptypes.ByTraderVolume
+				msg.DistributeTo.Duration = 0
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "boost curve",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.BoostCurve = []incentivestypes.BoostPoint{{Duration: 2 * time.Hour, Multiplier: sdk.NewDec(2)}}
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "boost curve with trader volume lock query type",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.DistributeTo.LockQueryType = lockuptypes.ByTraderVolume
+				msg.DistributeTo.Duration = 0
+				msg.BoostCurve = []incentivestypes.BoostPoint{{Duration: 2 * time.Hour, Multiplier: sdk.NewDec(2)}}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "boost curve with non-positive multiplier",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.BoostCurve = []incentivestypes.BoostPoint{{Duration: 2 * time.Hour, Multiplier: sdk.NewDec(-1)}}
+				return msg
+			}),
+			expectPass: false,
+		},
 		{
 			name: "invalid distribution start time",
 			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
@@ -216,6 +251,292 @@ func TestMsgAddToGauge(t *testing.T) {
 	}
 }
 
+// TestMsgCreateGroupGauge tests if valid/invalid create group gauge messages are properly validated/invalidated
+func TestMsgCreateGroupGauge(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	// make a proper createGroupGauge message
+	createMsg := func(after func(msg incentivestypes.MsgCreateGroupGauge) incentivestypes.MsgCreateGroupGauge) incentivestypes.MsgCreateGroupGauge {
+		properMsg := *incentivestypes.NewMsgCreateGroupGauge(
+			false,
+			addr1,
+			sdk.Coins{sdk.NewInt64Coin("stake", 10)},
+			time.Now(),
+			2,
+			[]uint64{1, 2},
+			[]sdk.Int{sdk.NewInt(1), sdk.NewInt(2)},
+			incentivestypes.ByStaticWeight,
+		)
+
+		return after(properMsg)
+	}
+
+	// validate createGroupGauge message was created as intended
+	msg := createMsg(func(msg incentivestypes.MsgCreateGroupGauge) incentivestypes.MsgCreateGroupGauge {
+		return msg
+	})
+	require.Equal(t, msg.Route(), incentivestypes.RouterKey)
+	require.Equal(t, msg.Type(), "create_group_gauge")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        incentivestypes.MsgCreateGroupGauge
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGroupGauge) incentivestypes.MsgCreateGroupGauge {
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "proper volume msg",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGroupGauge) incentivestypes.MsgCreateGroupGauge {
+				msg.SplittingPolicy = incentivestypes.ByVolume
+				msg.Weights = nil
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "empty owner",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGroupGauge) incentivestypes.MsgCreateGroupGauge {
+				msg.Owner = ""
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "empty start time",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGroupGauge) incentivestypes.MsgCreateGroupGauge {
+				msg.StartTime = time.Time{}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero distribution epoch",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGroupGauge) incentivestypes.MsgCreateGroupGauge {
+				msg.NumEpochsPaidOver = 0
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid num epochs paid over for perpetual gauge",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGroupGauge) incentivestypes.MsgCreateGroupGauge {
+				msg.IsPerpetual = true
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "single underlying gauge",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGroupGauge) incentivestypes.MsgCreateGroupGauge {
+				msg.GaugeIds = []uint64{1}
+				msg.Weights = []sdk.Int{sdk.NewInt(1)}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "duplicated underlying gauge",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGroupGauge) incentivestypes.MsgCreateGroupGauge {
+				msg.GaugeIds = []uint64{1, 1}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "missing weight",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGroupGauge) incentivestypes.MsgCreateGroupGauge {
+				msg.Weights = []sdk.Int{sdk.NewInt(1)}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero weight",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGroupGauge) incentivestypes.MsgCreateGroupGauge {
+				msg.Weights = []sdk.Int{sdk.NewInt(1), sdk.ZeroInt()}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "weights with volume splitting",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGroupGauge) incentivestypes.MsgCreateGroupGauge {
+				msg.SplittingPolicy = incentivestypes.ByVolume
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid splitting policy",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGroupGauge) incentivestypes.MsgCreateGroupGauge {
+				msg.SplittingPolicy = 2
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgCancelGauge tests if valid/invalid cancel gauge messages are properly validated/invalidated
+func TestMsgCancelGauge(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+
+	// make a proper cancelGauge message
+	createMsg := func(after func(msg incentivestypes.MsgCancelGauge) incentivestypes.MsgCancelGauge) incentivestypes.MsgCancelGauge {
+		properMsg := *incentivestypes.NewMsgCancelGauge(addr1, 1, addr2)
+
+		return after(properMsg)
+	}
+
+	// validate cancelGauge message was created as intended
+	msg := createMsg(func(msg incentivestypes.MsgCancelGauge) incentivestypes.MsgCancelGauge {
+		return msg
+	})
+	require.Equal(t, msg.Route(), incentivestypes.RouterKey)
+	require.Equal(t, msg.Type(), "cancel_gauge")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        incentivestypes.MsgCancelGauge
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg incentivestypes.MsgCancelGauge) incentivestypes.MsgCancelGauge {
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "empty recipient",
+			msg: createMsg(func(msg incentivestypes.MsgCancelGauge) incentivestypes.MsgCancelGauge {
+				msg.Recipient = ""
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "empty owner",
+			msg: createMsg(func(msg incentivestypes.MsgCancelGauge) incentivestypes.MsgCancelGauge {
+				msg.Owner = ""
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid recipient",
+			msg: createMsg(func(msg incentivestypes.MsgCancelGauge) incentivestypes.MsgCancelGauge {
+				msg.Recipient = "invalid"
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgClaimRewards(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	// make a proper claimRewards message
+	createMsg := func(after func(msg incentivestypes.MsgClaimRewards) incentivestypes.MsgClaimRewards) incentivestypes.MsgClaimRewards {
+		properMsg := *incentivestypes.NewMsgClaimRewards(addr1, []uint64{1, 2})
+
+		return after(properMsg)
+	}
+
+	// validate claimRewards message was created as intended
+	msg := createMsg(func(msg incentivestypes.MsgClaimRewards) incentivestypes.MsgClaimRewards {
+		return msg
+	})
+	require.Equal(t, msg.Route(), incentivestypes.RouterKey)
+	require.Equal(t, msg.Type(), "claim_rewards")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        incentivestypes.MsgClaimRewards
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg incentivestypes.MsgClaimRewards) incentivestypes.MsgClaimRewards {
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "no lock ids",
+			msg: createMsg(func(msg incentivestypes.MsgClaimRewards) incentivestypes.MsgClaimRewards {
+				msg.LockIds = nil
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "empty owner",
+			msg: createMsg(func(msg incentivestypes.MsgClaimRewards) incentivestypes.MsgClaimRewards {
+				msg.Owner = ""
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "duplicate lock ids",
+			msg: createMsg(func(msg incentivestypes.MsgClaimRewards) incentivestypes.MsgClaimRewards {
+				msg.LockIds = []uint64{1, 2, 1}
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
 // // Test authz serialize and de-serializes for incentives msg.
 func TestAuthzMsg(t *testing.T) {
 	appParams.SetAddressPrefixes()
@@ -256,6 +577,34 @@ func TestAuthzMsg(t *testing.T) {
 				NumEpochsPaidOver: 1,
 			},
 		},
+		{
+			name: "MsgCreateGroupGauge",
+			incentivesMsg: &incentivestypes.MsgCreateGroupGauge{
+				IsPerpetual:       false,
+				Owner:             addr1,
+				Coins:             sdk.NewCoins(coin),
+				StartTime:         someDate,
+				NumEpochsPaidOver: 1,
+				GaugeIds:          []uint64{1, 2},
+				Weights:           []sdk.Int{sdk.NewInt(1), sdk.NewInt(1)},
+				SplittingPolicy:   incentivestypes.ByStaticWeight,
+			},
+		},
+		{
+			name: "MsgCancelGauge",
+			incentivesMsg: &incentivestypes.MsgCancelGauge{
+				Owner:     addr1,
+				GaugeId:   1,
+				Recipient: addr1,
+			},
+		},
+		{
+			name: "MsgClaimRewards",
+			incentivesMsg: &incentivestypes.MsgClaimRewards{
+				Owner:   addr1,
+				LockIds: []uint64{1},
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -1,14 +1,32 @@
 package types
 
 import (
+	"fmt"
+
 	epochtypes "github.com/osmosis-labs/osmosis/v13/x/epochs/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // Incentives parameters key store.
 var (
-	KeyDistrEpochIdentifier = []byte("DistrEpochIdentifier")
+	KeyDistrEpochIdentifier  = []byte("DistrEpochIdentifier")
+	KeyCreateGaugeFee        = []byte("CreateGaugeFee")
+	KeyAddToGaugeFee         = []byte("AddToGaugeFee")
+	KeyAcceptedFeeDenoms     = []byte("AcceptedFeeDenoms")
+	KeyTraderVolumeCap       = []byte("TraderVolumeCap")
+	KeyMinTraderSwapValue    = []byte("MinTraderSwapValue")
+	KeyUseRewardAccumulators = []byte("UseRewardAccumulators")
+
+	// DefaultCreateGaugeFee is the default fee required to create a new gauge.
+	DefaultCreateGaugeFee = sdk.NewInt(50 * 1_000_000)
+	// DefaultAddToGaugeFee is the default fee required to add to gauge.
+	DefaultAddToGaugeFee = sdk.NewInt(25 * 1_000_000)
+	// DefaultTraderVolumeCap is the default cap on the volume counted per trader, pool and epoch, zero for no cap.
+	DefaultTraderVolumeCap = sdk.ZeroInt()
+	// DefaultMinTraderSwapValue is the default minimum value of a swap counted as trader volume.
+	DefaultMinTraderSwapValue = sdk.NewInt(1_000_000)
 )
 
 // ParamKeyTable returns the key table for the incentive module's parameters.
@@ -16,17 +34,30 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-// NewParams takes an epoch distribution identifier, then returns an incentives Params struct.
-func NewParams(distrEpochIdentifier string) Params {
+// NewParams takes an epoch distribution identifier, the gauge fees, the accepted fee denoms,
+// the trader volume limits and whether to use reward accumulators, then returns an incentives Params struct.
+func NewParams(distrEpochIdentifier string, createGaugeFee, addToGaugeFee sdk.Int, acceptedFeeDenoms []string, traderVolumeCap, minTraderSwapValue sdk.Int, useRewardAccumulators bool) Params {
 	return Params{
-		DistrEpochIdentifier: distrEpochIdentifier,
+		DistrEpochIdentifier:  distrEpochIdentifier,
+		CreateGaugeFee:        createGaugeFee,
+		AddToGaugeFee:         addToGaugeFee,
+		AcceptedFeeDenoms:     acceptedFeeDenoms,
+		TraderVolumeCap:       traderVolumeCap,
+		MinTraderSwapValue:    minTraderSwapValue,
+		UseRewardAccumulators: useRewardAccumulators,
 	}
 }
 
 // DefaultParams returns the default incentives module parameters.
 func DefaultParams() Params {
 	return Params{
-		DistrEpochIdentifier: "week",
+		DistrEpochIdentifier:  "week",
+		CreateGaugeFee:        DefaultCreateGaugeFee,
+		AddToGaugeFee:         DefaultAddToGaugeFee,
+		AcceptedFeeDenoms:     []string{},
+		TraderVolumeCap:       DefaultTraderVolumeCap,
+		MinTraderSwapValue:    DefaultMinTraderSwapValue,
+		UseRewardAccumulators: false,
 	}
 }
 
@@ -35,6 +66,24 @@ func (p Params) Validate() error {
 	if err := epochtypes.ValidateEpochIdentifierInterface(p.DistrEpochIdentifier); err != nil {
 		return err
 	}
+	if err := validateGaugeFee(p.CreateGaugeFee); err != nil {
+		return err
+	}
+	if err := validateGaugeFee(p.AddToGaugeFee); err != nil {
+		return err
+	}
+	if err := validateAcceptedFeeDenoms(p.AcceptedFeeDenoms); err != nil {
+		return err
+	}
+	if err := validateTraderVolumeLimit(p.TraderVolumeCap); err != nil {
+		return err
+	}
+	if err := validateTraderVolumeLimit(p.MinTraderSwapValue); err != nil {
+		return err
+	}
+	if err := validateUseRewardAccumulators(p.UseRewardAccumulators); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -42,5 +91,66 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyDistrEpochIdentifier, &p.DistrEpochIdentifier, epochtypes.ValidateEpochIdentifierInterface),
+		paramtypes.NewParamSetPair(KeyCreateGaugeFee, &p.CreateGaugeFee, validateGaugeFee),
+		paramtypes.NewParamSetPair(KeyAddToGaugeFee, &p.AddToGaugeFee, validateGaugeFee),
+		paramtypes.NewParamSetPair(KeyAcceptedFeeDenoms, &p.AcceptedFeeDenoms, validateAcceptedFeeDenoms),
+		paramtypes.NewParamSetPair(KeyTraderVolumeCap, &p.TraderVolumeCap, validateTraderVolumeLimit),
+		paramtypes.NewParamSetPair(KeyMinTraderSwapValue, &p.MinTraderSwapValue, validateTraderVolumeLimit),
+		paramtypes.NewParamSetPair(KeyUseRewardAccumulators, &p.UseRewardAccumulators, validateUseRewardAccumulators),
+	}
+}
+
+func validateGaugeFee(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("gauge fee must be non-negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateAcceptedFeeDenoms(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenDenoms := make(map[string]bool, len(v))
+	for _, denom := range v {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid accepted fee denom %s: %w", denom, err)
+		}
+		if seenDenoms[denom] {
+			return fmt.Errorf("duplicate accepted fee denom %s", denom)
+		}
+		seenDenoms[denom] = true
+	}
+
+	return nil
+}
+
+func validateTraderVolumeLimit(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("trader volume limit must be non-negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateUseRewardAccumulators(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
 	}
+
+	return nil
 }
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -28,6 +29,28 @@ type Params struct {
 	// distr_epoch_identifier is what epoch type distribution will be triggered by
 	// (day, week, etc.)
 	DistrEpochIdentifier string `protobuf:"bytes,1,opt,name=distr_epoch_identifier,json=distrEpochIdentifier,proto3" json:"distr_epoch_identifier,omitempty" yaml:"distr_epoch_identifier"`
+	// create_gauge_fee is the fee, in the base denom, charged for creating a
+	// gauge. It is sent to the community pool.
+	CreateGaugeFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=create_gauge_fee,json=createGaugeFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"create_gauge_fee" yaml:"create_gauge_fee"`
+	// add_to_gauge_fee is the fee, in the base denom, charged for adding rewards
+	// to a gauge. It is sent to the community pool.
+	AddToGaugeFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=add_to_gauge_fee,json=addToGaugeFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"add_to_gauge_fee" yaml:"add_to_gauge_fee"`
+	// accepted_fee_denoms are the txfees fee tokens, besides the base denom, the
+	// gauge fees can be paid in, worth the fees in the base denom.
+	AcceptedFeeDenoms []string `protobuf:"bytes,4,rep,name=accepted_fee_denoms,json=acceptedFeeDenoms,proto3" json:"accepted_fee_denoms,omitempty" yaml:"accepted_fee_denoms"`
+	// trader_volume_cap is the maximum swap volume, in the base denom, counted
+	// for a single trader on a pool per epoch by ByTraderVolume gauges. Zero
+	// means no cap.
+	TraderVolumeCap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=trader_volume_cap,json=traderVolumeCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"trader_volume_cap" yaml:"trader_volume_cap"`
+	// min_trader_swap_value is the minimum value, in the base denom, of a swap
+	// for it to be counted by ByTraderVolume gauges, which limits wash trading
+	// through many small swaps.
+	MinTraderSwapValue github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=min_trader_swap_value,json=minTraderSwapValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_trader_swap_value" yaml:"min_trader_swap_value"`
+	// use_reward_accumulators makes gauges distributing to native locks by
+	// duration accrue their rewards into per denom and duration reward
+	// accumulators, which lockers claim, instead of sending them to every lock
+	// owner each epoch.
+	UseRewardAccumulators bool `protobuf:"varint,7,opt,name=use_reward_accumulators,json=useRewardAccumulators,proto3" json:"use_reward_accumulators,omitempty" yaml:"use_reward_accumulators"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -70,6 +93,20 @@ func (m *Params) GetDistrEpochIdentifier() string {
 	return ""
 }
 
+func (m *Params) GetAcceptedFeeDenoms() []string {
+	if m != nil {
+		return m.AcceptedFeeDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetUseRewardAccumulators() bool {
+	if m != nil {
+		return m.UseRewardAccumulators
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.incentives.Params")
 }
@@ -77,20 +114,37 @@ func init() {
 func init() { proto.RegisterFile("osmosis/incentives/params.proto", fileDescriptor_1cc8b460d089f845) }
 
 var fileDescriptor_1cc8b460d089f845 = []byte{
-	// 207 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0xcf, 0xcc, 0x4b, 0x4e, 0xcd, 0x2b, 0xc9, 0x2c, 0x4b, 0x2d, 0xd6, 0x2f,
-	0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0x2a, 0xd0,
-	0x43, 0x28, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xeb, 0x83, 0x58, 0x10, 0x95, 0x4a,
-	0x89, 0x5c, 0x6c, 0x01, 0x60, 0x9d, 0x42, 0xe1, 0x5c, 0x62, 0x29, 0x99, 0xc5, 0x25, 0x45, 0xf1,
-	0xa9, 0x05, 0xf9, 0xc9, 0x19, 0xf1, 0x99, 0x29, 0x20, 0x8d, 0x69, 0x99, 0xa9, 0x45, 0x12, 0x8c,
-	0x0a, 0x8c, 0x1a, 0x9c, 0x4e, 0x8a, 0x9f, 0xee, 0xc9, 0xcb, 0x56, 0x26, 0xe6, 0xe6, 0x58, 0x29,
-	0x61, 0x57, 0xa7, 0x14, 0x24, 0x02, 0x96, 0x70, 0x05, 0x89, 0x7b, 0xc2, 0x85, 0x9d, 0x02, 0x4e,
-	0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18,
-	0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x2c, 0x3d, 0xb3, 0x24, 0xa3, 0x34,
-	0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0xea, 0x62, 0xdd, 0x9c, 0xc4, 0xa4, 0x62, 0x18, 0x47, 0xbf,
-	0xcc, 0xd0, 0x58, 0xbf, 0x02, 0xd9, 0x97, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0xb7,
-	0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xff, 0xaa, 0x50, 0x1a, 0x08, 0x01, 0x00, 0x00,
+	// 476 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x41, 0x4f, 0xd4, 0x40,
+	0x18, 0x86, 0xb7, 0x22, 0xab, 0x34, 0x51, 0xa1, 0x82, 0x34, 0x44, 0xdb, 0x75, 0x0e, 0x66, 0x2f,
+	0x6c, 0x63, 0x48, 0x3c, 0x78, 0x73, 0x55, 0xcc, 0x7a, 0x20, 0xa4, 0x12, 0x4c, 0xb8, 0x4c, 0xbe,
+	0x9d, 0xf9, 0x58, 0x1a, 0xdb, 0x4e, 0x33, 0x33, 0xed, 0xca, 0xd1, 0x7f, 0xe0, 0xcf, 0xe2, 0xc8,
+	0xd1, 0x78, 0x68, 0xcc, 0xee, 0x3f, 0xe8, 0x2f, 0x30, 0x9d, 0x16, 0xdc, 0x00, 0x1e, 0x08, 0xa7,
+	0x76, 0x9e, 0xef, 0x9d, 0xf7, 0x9d, 0xf7, 0xf0, 0xd9, 0xbe, 0x50, 0x89, 0x50, 0x91, 0x0a, 0xa2,
+	0x94, 0x61, 0xaa, 0xa3, 0x02, 0x55, 0x90, 0x81, 0x84, 0x44, 0x0d, 0x32, 0x29, 0xb4, 0x70, 0x9c,
+	0x56, 0x30, 0xf8, 0x27, 0xd8, 0x5a, 0x9f, 0x88, 0x89, 0x30, 0xe3, 0xa0, 0xfe, 0x6b, 0x94, 0xa4,
+	0x5a, 0xb6, 0xbb, 0xfb, 0xe6, 0xaa, 0xf3, 0xd5, 0x7e, 0xc6, 0x23, 0xa5, 0x25, 0xc5, 0x4c, 0xb0,
+	0x13, 0x1a, 0xf1, 0xfa, 0xe6, 0x71, 0x84, 0xd2, 0xb5, 0x7a, 0x56, 0x7f, 0x65, 0xf8, 0xb2, 0x2a,
+	0xfd, 0x17, 0xa7, 0x90, 0xc4, 0x6f, 0xc9, 0xcd, 0x3a, 0x12, 0xae, 0x9b, 0xc1, 0xc7, 0x9a, 0x8f,
+	0x2e, 0xb1, 0xa3, 0xec, 0x55, 0x26, 0x11, 0x34, 0xd2, 0x09, 0xe4, 0x13, 0xa4, 0xc7, 0x88, 0xee,
+	0x3d, 0x63, 0x39, 0x3a, 0x2b, 0xfd, 0xce, 0xef, 0xd2, 0x7f, 0x35, 0x89, 0xf4, 0x49, 0x3e, 0x1e,
+	0x30, 0x91, 0x04, 0xcc, 0xbc, 0xbd, 0xfd, 0x6c, 0x2b, 0xfe, 0x2d, 0xd0, 0xa7, 0x19, 0xaa, 0xc1,
+	0x28, 0xd5, 0x55, 0xe9, 0x6f, 0x36, 0x0f, 0xb8, 0xea, 0x47, 0xc2, 0xc7, 0x0d, 0xfa, 0x54, 0x93,
+	0x5d, 0x44, 0x47, 0xda, 0xab, 0xc0, 0x39, 0xd5, 0x62, 0x21, 0x74, 0xe9, 0x6e, 0xa1, 0x57, 0xfd,
+	0x48, 0xf8, 0x08, 0x38, 0x3f, 0x10, 0x97, 0x99, 0x7b, 0xf6, 0x53, 0x60, 0x0c, 0x33, 0x8d, 0xbc,
+	0x9e, 0x53, 0x8e, 0xa9, 0x48, 0x94, 0x7b, 0xbf, 0xb7, 0xd4, 0x5f, 0x19, 0x7a, 0x55, 0xe9, 0x6f,
+	0xb5, 0x46, 0xd7, 0x45, 0x24, 0x5c, 0xbb, 0xa0, 0xbb, 0x88, 0x1f, 0x0c, 0x73, 0x0a, 0x7b, 0x4d,
+	0x4b, 0xe0, 0x28, 0x69, 0x21, 0xe2, 0x3c, 0x41, 0xca, 0x20, 0x73, 0x97, 0x4d, 0x89, 0xcf, 0xb7,
+	0x2e, 0xe1, 0x36, 0xd9, 0xd7, 0x0c, 0x49, 0xf8, 0xa4, 0x61, 0x87, 0x06, 0xbd, 0x87, 0xcc, 0xf9,
+	0x61, 0xd9, 0x1b, 0x49, 0x94, 0xd2, 0x56, 0xab, 0xa6, 0x90, 0xd1, 0x02, 0xe2, 0x1c, 0xdd, 0xae,
+	0x09, 0xdf, 0xbb, 0x75, 0xf8, 0xf3, 0x26, 0xfc, 0x46, 0x53, 0x12, 0x3a, 0x49, 0x94, 0x1e, 0x18,
+	0xfc, 0x65, 0x0a, 0xd9, 0x61, 0x0d, 0x9d, 0x23, 0x7b, 0x33, 0x57, 0x48, 0x25, 0x4e, 0x41, 0x72,
+	0x0a, 0x8c, 0xe5, 0x49, 0x1e, 0x83, 0x16, 0x52, 0xb9, 0x0f, 0x7a, 0x56, 0xff, 0xe1, 0x90, 0x54,
+	0xa5, 0xef, 0x35, 0xb6, 0xff, 0x11, 0x92, 0x70, 0x23, 0x57, 0x18, 0x9a, 0xc1, 0xbb, 0x05, 0x3e,
+	0xdc, 0x3f, 0x9b, 0x79, 0xd6, 0xf9, 0xcc, 0xb3, 0xfe, 0xcc, 0x3c, 0xeb, 0xe7, 0xdc, 0xeb, 0x9c,
+	0xcf, 0xbd, 0xce, 0xaf, 0xb9, 0xd7, 0x39, 0x7a, 0xb3, 0xd0, 0xa8, 0xdd, 0xa1, 0xed, 0x18, 0xc6,
+	0xea, 0xe2, 0x10, 0x14, 0xaf, 0x77, 0x82, 0xef, 0x8b, 0x7b, 0x67, 0x5a, 0x8e, 0xbb, 0x66, 0x9b,
+	0x76, 0xfe, 0x0e, 0x00, 0x55, 0x22, 0xeb, 0x6d, 0x9a, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -113,6 +167,65 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UseRewardAccumulators {
+		i--
+		if m.UseRewardAccumulators {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.MinTraderSwapValue.Size()
+		i -= size
+		if _, err := m.MinTraderSwapValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TraderVolumeCap.Size()
+		i -= size
+		if _, err := m.TraderVolumeCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.AcceptedFeeDenoms) > 0 {
+		for iNdEx := len(m.AcceptedFeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AcceptedFeeDenoms[iNdEx])
+			copy(dAtA[i:], m.AcceptedFeeDenoms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AcceptedFeeDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.AddToGaugeFee.Size()
+		i -= size
+		if _, err := m.AddToGaugeFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CreateGaugeFee.Size()
+		i -= size
+		if _, err := m.CreateGaugeFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.DistrEpochIdentifier) > 0 {
 		i -= len(m.DistrEpochIdentifier)
 		copy(dAtA[i:], m.DistrEpochIdentifier)
@@ -144,6 +257,23 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	l = m.CreateGaugeFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.AddToGaugeFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.AcceptedFeeDenoms) > 0 {
+		for _, s := range m.AcceptedFeeDenoms {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.TraderVolumeCap.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MinTraderSwapValue.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.UseRewardAccumulators {
+		n += 2
+	}
 	return n
 }
 
@@ -214,6 +344,194 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.DistrEpochIdentifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateGaugeFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CreateGaugeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddToGaugeFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AddToGaugeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedFeeDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptedFeeDenoms = append(m.AcceptedFeeDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderVolumeCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TraderVolumeCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTraderSwapValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinTraderSwapValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseRewardAccumulators", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseRewardAccumulators = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
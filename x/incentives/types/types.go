@@ -1 +1,16 @@
 package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TwapValuationWindow is the window of the arithmetic twaps that value coins in the base denom,
+// so that the values can not be moved by manipulating a pool's spot price within a block.
+const TwapValuationWindow = time.Hour
+
+// RewardsPerShareUnit is the amount of locked tokens the reward accumulators count rewards per.
+// Rewards per share have 18 decimals, as do gamm shares, so rewards per single locked unit of a pool's
+// shares would truncate to zero. Counting them per 10^18 units keeps 18 decimals per whole share.
+var RewardsPerShareUnit = sdk.NewIntWithDecimal(1, 18)
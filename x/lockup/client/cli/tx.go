@@ -16,6 +16,7 @@ func GetTxCmd() *cobra.Command {
 		NewBeginUnlockingAllCmd(),
 		NewBeginUnlockByIDCmd(),
 		NewForceUnlockByIdCmd(),
+		NewSetRewardReceiverAddressCmd(),
 	)
 
 	return cmd
@@ -64,3 +65,12 @@ func NewForceUnlockByIdCmd() *cobra.Command {
 		Flags: osmocli.FlagDesc{OptionalFlags: []*pflag.FlagSet{FlagSetUnlockTokens()}},
 	})
 }
+
+// NewSetRewardReceiverAddressCmd sets the address that receives the incentives distributed to a lock.
+func NewSetRewardReceiverAddressCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgSetRewardReceiverAddress](&osmocli.TxCliDesc{
+		Use:     "set-reward-receiver-address [lock-id] [reward-receiver]",
+		Short:   "sets the address that receives the rewards of the lock",
+		Example: "osmosisd tx lockup set-reward-receiver-address 1 osmo1...",
+	})
+}
@@ -456,6 +456,82 @@ func (k Keeper) ExtendLockup(ctx sdk.Context, lockID uint64, owner sdk.AccAddres
 	return nil
 }
 
+// SetLockRewardReceiverAddress sets the address that receives the incentives distributed to the given lock.
+// Only the lock owner can change the reward receiver. Setting the reward receiver to the lock owner
+// clears the stored address, so that rewards are paid to the owner as by default.
+func (k Keeper) SetLockRewardReceiverAddress(ctx sdk.Context, lockID uint64, owner sdk.AccAddress, newReceiverAddress string) error {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return err
+	}
+
+	if lock.GetOwner() != owner.String() {
+		return types.ErrNotLockOwner
+	}
+
+	// an empty reward receiver address denotes the lock owner.
+	if newReceiverAddress == lock.Owner {
+		newReceiverAddress = ""
+	}
+
+	if lock.RewardReceiverAddress == newReceiverAddress {
+		return types.ErrRewardReceiverIsSame
+	}
+
+	lock.RewardReceiverAddress = newReceiverAddress
+	return k.setLock(ctx, *lock)
+}
+
+// SplitLock splits the given coins out of the lock into a new lock with the same owner, duration and
+// reward receiver, and returns the new lock. The remaining coins stay in the original lock.
+// Only the lock owner can split a lock, and locks that are unlocking or that have synthetic lockups
+// can not be split.
+func (k Keeper) SplitLock(ctx sdk.Context, lockID uint64, owner sdk.AccAddress, coins sdk.Coins) (types.PeriodLock, error) {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	if lock.GetOwner() != owner.String() {
+		return types.PeriodLock{}, types.ErrNotLockOwner
+	}
+	if lock.IsUnlocking() {
+		return types.PeriodLock{}, fmt.Errorf("cannot split unlocking lock")
+	}
+	if k.HasAnySyntheticLockups(ctx, lock.ID) {
+		return types.PeriodLock{}, fmt.Errorf("cannot split a lock with synthetic lockup")
+	}
+	if coins.Empty() || !coins.IsValid() {
+		return types.PeriodLock{}, fmt.Errorf("invalid coins to split: %s", coins)
+	}
+	if !coins.IsAllLTE(lock.Coins) || coins.IsEqual(lock.Coins) {
+		return types.PeriodLock{}, fmt.Errorf("requested amount to split (%s) must be less than the locked tokens (%s)", coins, lock.Coins)
+	}
+
+	// the split can remove a denom from the original lock, so its refs are reset.
+	err = k.deleteLockRefs(ctx, types.KeyPrefixNotUnlocking, *lock)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	newLock, err := k.splitLock(ctx, *lock, coins, false)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	lock.Coins = lock.Coins.Sub(coins)
+	err = k.addLockRefs(ctx, *lock)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+	err = k.addLockRefs(ctx, newLock)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	return newLock, nil
+}
+
 // InitializeAllLocks takes a set of locks, and initializes state to be storing
 // them all correctly. This utilizes batch optimizations to improve efficiency,
 // as this becomes a bottleneck at chain initialization & upgrades.
@@ -471,6 +547,11 @@ func (k Keeper) InitializeAllLocks(ctx sdk.Context, locks []types.PeriodLock) er
 			msg := fmt.Sprintf("Reset %d lock refs, cur lock ID %d", i, lock.ID)
 			ctx.Logger().Info(msg)
 		}
+		// locks exported before reward receivers were introduced have no reward receiver set,
+		// which defaults to the owner. We store the owner as an empty reward receiver as well.
+		if lock.RewardReceiverAddress == lock.Owner {
+			lock.RewardReceiverAddress = ""
+		}
 		err := k.setLockAndAddLockRefs(ctx, lock)
 		if err != nil {
 			return err
@@ -701,9 +782,17 @@ func (k Keeper) splitLock(ctx sdk.Context, lock types.PeriodLock, coins sdk.Coin
 	k.SetLastLockID(ctx, splitLockID)
 
 	splitLock := types.NewPeriodLock(splitLockID, lock.OwnerAddress(), lock.Duration, lock.EndTime, coins)
+	splitLock.RewardReceiverAddress = lock.RewardReceiverAddress
 
 	err = k.setLock(ctx, splitLock)
-	return splitLock, err
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	if k.hooks != nil {
+		k.hooks.AfterLockSplit(ctx, lock.ID, splitLock.ID, coins)
+	}
+	return splitLock, nil
 }
 
 func (k Keeper) getCoinsFromLocks(locks []types.PeriodLock) sdk.Coins {
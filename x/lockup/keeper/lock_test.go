@@ -991,3 +991,210 @@ func (suite *KeeperTestSuite) TestPartialForceUnlock() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestSetLockRewardReceiverAddress() {
+	owner := sdk.AccAddress([]byte("addr1---------------"))
+	receiver := sdk.AccAddress([]byte("addr2---------------"))
+
+	testCases := []struct {
+		name                   string
+		sender                 sdk.AccAddress
+		preSetReceiver         string
+		newReceiver            string
+		expectedStoredReceiver string
+		expectedRewardReceiver string
+		expectedErr            error
+	}{
+		{
+			name:                   "set reward receiver to a different address",
+			sender:                 owner,
+			newReceiver:            receiver.String(),
+			expectedStoredReceiver: receiver.String(),
+			expectedRewardReceiver: receiver.String(),
+		},
+		{
+			name:                   "set reward receiver back to the owner",
+			sender:                 owner,
+			preSetReceiver:         receiver.String(),
+			newReceiver:            owner.String(),
+			expectedStoredReceiver: "",
+			expectedRewardReceiver: owner.String(),
+		},
+		{
+			name:        "error: sender is not the lock owner",
+			sender:      receiver,
+			newReceiver: receiver.String(),
+			expectedErr: types.ErrNotLockOwner,
+		},
+		{
+			name:        "error: reward receiver is already the owner",
+			sender:      owner,
+			newReceiver: owner.String(),
+			expectedErr: types.ErrRewardReceiverIsSame,
+		},
+		{
+			name:           "error: reward receiver is unchanged",
+			sender:         owner,
+			preSetReceiver: receiver.String(),
+			newReceiver:    receiver.String(),
+			expectedErr:    types.ErrRewardReceiverIsSame,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+			suite.FundAcc(owner, coins)
+			lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, owner, coins, time.Second)
+			suite.Require().NoError(err)
+
+			if tc.preSetReceiver != "" {
+				err := suite.App.LockupKeeper.SetLockRewardReceiverAddress(suite.Ctx, lock.ID, owner, tc.preSetReceiver)
+				suite.Require().NoError(err)
+			}
+
+			err = suite.App.LockupKeeper.SetLockRewardReceiverAddress(suite.Ctx, lock.ID, tc.sender, tc.newReceiver)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			updatedLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lock.ID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedStoredReceiver, updatedLock.RewardReceiverAddress)
+			suite.Require().Equal(tc.expectedRewardReceiver, updatedLock.RewardReceiver())
+			suite.Require().Equal(owner.String(), updatedLock.Owner)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSplitLockKeepsRewardReceiver() {
+	suite.SetupTest()
+	owner := sdk.AccAddress([]byte("addr1---------------"))
+	receiver := sdk.AccAddress([]byte("addr2---------------"))
+
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	suite.FundAcc(owner, coins)
+	lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, owner, coins, time.Second)
+	suite.Require().NoError(err)
+	err = suite.App.LockupKeeper.SetLockRewardReceiverAddress(suite.Ctx, lock.ID, owner, receiver.String())
+	suite.Require().NoError(err)
+
+	// partially unlocking the lock splits it into a new lock
+	err = suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lock.ID, sdk.Coins{sdk.NewInt64Coin("stake", 4)})
+	suite.Require().NoError(err)
+
+	locks, err := suite.App.LockupKeeper.GetPeriodLocks(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(locks, 2)
+	for _, l := range locks {
+		suite.Require().Equal(receiver.String(), l.RewardReceiverAddress)
+	}
+}
+
+func (suite *KeeperTestSuite) TestSplitLock() {
+	owner := sdk.AccAddress([]byte("addr1---------------"))
+	defaultCoins := sdk.Coins{sdk.NewInt64Coin("foo", 10), sdk.NewInt64Coin("stake", 10)}
+
+	testCases := []struct {
+		name          string
+		sender        sdk.AccAddress
+		splitCoins    sdk.Coins
+		unlocking     bool
+		expectedError bool
+	}{
+		{
+			name:       "split part of a denom",
+			sender:     owner,
+			splitCoins: sdk.Coins{sdk.NewInt64Coin("stake", 4)},
+		},
+		{
+			name:       "split a whole denom out of the lock",
+			sender:     owner,
+			splitCoins: sdk.Coins{sdk.NewInt64Coin("stake", 10)},
+		},
+		{
+			name:          "split all coins of the lock",
+			sender:        owner,
+			splitCoins:    defaultCoins,
+			expectedError: true,
+		},
+		{
+			name:          "split more than the lock",
+			sender:        owner,
+			splitCoins:    sdk.Coins{sdk.NewInt64Coin("stake", 11)},
+			expectedError: true,
+		},
+		{
+			name:          "split denom not in the lock",
+			sender:        owner,
+			splitCoins:    sdk.Coins{sdk.NewInt64Coin("bar", 1)},
+			expectedError: true,
+		},
+		{
+			name:          "split by non owner",
+			sender:        sdk.AccAddress([]byte("addr2---------------")),
+			splitCoins:    sdk.Coins{sdk.NewInt64Coin("stake", 4)},
+			expectedError: true,
+		},
+		{
+			name:          "split unlocking lock",
+			sender:        owner,
+			splitCoins:    sdk.Coins{sdk.NewInt64Coin("stake", 4)},
+			unlocking:     true,
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.FundAcc(owner, defaultCoins)
+			lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, owner, defaultCoins, time.Second)
+			suite.Require().NoError(err)
+			if tc.unlocking {
+				err = suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lock.ID, nil)
+				suite.Require().NoError(err)
+			}
+
+			newLock, err := suite.App.LockupKeeper.SplitLock(suite.Ctx, lock.ID, tc.sender, tc.splitCoins)
+			if tc.expectedError {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// check the split lock
+			suite.Require().Equal(lock.ID+1, newLock.ID)
+			suite.Require().Equal(tc.splitCoins, newLock.Coins)
+			suite.Require().Equal(lock.Owner, newLock.Owner)
+			suite.Require().Equal(lock.Duration, newLock.Duration)
+
+			// check the remaining lock
+			oldLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lock.ID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(defaultCoins.Sub(tc.splitCoins), oldLock.Coins)
+
+			// check lock refs are updated for both locks
+			for _, coin := range defaultCoins {
+				locks := suite.App.LockupKeeper.GetAccountLockedLongerDurationDenom(suite.Ctx, owner, coin.Denom, 0)
+				lockedAmount := sdk.ZeroInt()
+				for _, l := range locks {
+					lockedAmount = lockedAmount.Add(l.Coins.AmountOf(coin.Denom))
+					suite.Require().True(l.Coins.AmountOf(coin.Denom).IsPositive())
+				}
+				suite.Require().Equal(coin.Amount, lockedAmount)
+			}
+
+			// the accumulation store is unchanged
+			accum := suite.App.LockupKeeper.GetPeriodLocksAccumulation(suite.Ctx, types.QueryCondition{
+				LockQueryType: types.ByDuration,
+				Denom:         "stake",
+				Duration:      time.Second,
+			})
+			suite.Require().Equal(int64(10), accum.Int64())
+		})
+	}
+}
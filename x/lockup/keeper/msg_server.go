@@ -222,3 +222,30 @@ func (server msgServer) ForceUnlock(goCtx context.Context, msg *types.MsgForceUn
 
 	return &types.MsgForceUnlockResponse{Success: true}, nil
 }
+
+// SetRewardReceiverAddress sets the address that receives the incentives distributed to the lock.
+// Only the owner of the lock can set the reward receiver address.
+func (server msgServer) SetRewardReceiverAddress(goCtx context.Context, msg *types.MsgSetRewardReceiverAddress) (*types.MsgSetRewardReceiverAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return &types.MsgSetRewardReceiverAddressResponse{Success: false}, err
+	}
+
+	err = server.keeper.SetLockRewardReceiverAddress(ctx, msg.ID, owner, msg.RewardReceiver)
+	if err != nil {
+		return &types.MsgSetRewardReceiverAddressResponse{Success: false}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtSetRewardReceiver,
+			sdk.NewAttribute(types.AttributePeriodLockID, utils.Uint64ToString(msg.ID)),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributeRewardReceiver, msg.RewardReceiver),
+		),
+	})
+
+	return &types.MsgSetRewardReceiverAddressResponse{Success: true}, nil
+}
@@ -235,6 +235,11 @@ func (k Keeper) GetLocksLongerThanDurationDenom(ctx sdk.Context, denom string, d
 	return combineLocks(notUnlockings, unlockings)
 }
 
+// GetLocksLongerThanDurationDenomUnlockingOnly Returns the unlocking locks whose unlock duration is longer than duration.
+func (k Keeper) GetLocksLongerThanDurationDenomUnlockingOnly(ctx sdk.Context, denom string, duration time.Duration) []types.PeriodLock {
+	return k.getLocksFromIterator(ctx, k.LockIteratorLongerThanDurationDenom(ctx, true, denom, duration))
+}
+
 // GetLockByID Returns lock from lockID.
 func (k Keeper) GetLockByID(ctx sdk.Context, lockID uint64) (*types.PeriodLock, error) {
 	lock := types.PeriodLock{}
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // DefaultIndex is the default capability global index.
 const DefaultIndex uint64 = 1
 
@@ -11,5 +17,13 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	for _, lock := range gs.Locks {
+		if lock.RewardReceiverAddress == "" {
+			continue
+		}
+		if _, err := sdk.AccAddressFromBech32(lock.RewardReceiverAddress); err != nil {
+			return fmt.Errorf("invalid reward receiver address for lock %d: %w", lock.ID, err)
+		}
+	}
 	return nil
 }
@@ -38,6 +38,15 @@ func (p PeriodLock) OwnerAddress() sdk.AccAddress {
 	return addr
 }
 
+// RewardReceiver returns the address that receives the incentives distributed to the lock.
+// This is the lock owner, unless a separate reward receiver address has been set.
+func (p PeriodLock) RewardReceiver() string {
+	if p.RewardReceiverAddress == "" {
+		return p.Owner
+	}
+	return p.RewardReceiverAddress
+}
+
 func (p PeriodLock) SingleCoin() (sdk.Coin, error) {
 	if len(p.Coins) != 1 {
 		return sdk.Coin{}, fmt.Errorf("PeriodLock %d has no single coin: %s", p.ID, p.Coins)
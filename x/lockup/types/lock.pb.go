@@ -36,16 +36,26 @@ type LockQueryType int32
 const (
 	ByDuration LockQueryType = 0
 	ByTime     LockQueryType = 1
+	// ByGroup is used by incentives group gauges, which distribute to other
+	// gauges rather than to locks.
+	ByGroup LockQueryType = 2
+	// ByTraderVolume is used by incentives gauges which distribute to the
+	// traders of a pool in proportion to their swap volume rather than to locks.
+	ByTraderVolume LockQueryType = 3
 )
 
 var LockQueryType_name = map[int32]string{
 	0: "ByDuration",
 	1: "ByTime",
+	2: "ByGroup",
+	3: "ByTraderVolume",
 }
 
 var LockQueryType_value = map[string]int32{
-	"ByDuration": 0,
-	"ByTime":     1,
+	"ByDuration":     0,
+	"ByTime":         1,
+	"ByGroup":        2,
+	"ByTraderVolume": 3,
 }
 
 func (x LockQueryType) String() string {
@@ -78,6 +88,10 @@ type PeriodLock struct {
 	EndTime time.Time `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
 	// Coins are the tokens locked within the lock, kept in the module account.
 	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// RewardReceiverAddress is the account address that receives the
+	// incentives distributed to this lock. An empty value means that rewards
+	// are paid to the lock owner. Only the owner can change this value.
+	RewardReceiverAddress string `protobuf:"bytes,6,opt,name=reward_receiver_address,json=rewardReceiverAddress,proto3" json:"reward_receiver_address,omitempty" yaml:"reward_receiver_address"`
 }
 
 func (m *PeriodLock) Reset()         { *m = PeriodLock{} }
@@ -148,6 +162,13 @@ func (m *PeriodLock) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *PeriodLock) GetRewardReceiverAddress() string {
+	if m != nil {
+		return m.RewardReceiverAddress
+	}
+	return ""
+}
+
 // QueryCondition is a struct used for querying locks upon different conditions.
 // Duration field and timestamp fields could be optional, depending on the
 // LockQueryType.
@@ -317,45 +338,49 @@ func init() {
 func init() { proto.RegisterFile("osmosis/lockup/lock.proto", fileDescriptor_7e9d7527a237b489) }
 
 var fileDescriptor_7e9d7527a237b489 = []byte{
-	// 595 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xb6, 0xdd, 0xa4, 0xb4, 0x57, 0x92, 0x46, 0xa7, 0x0e, 0x69, 0x00, 0x3b, 0xf2, 0x80, 0x22,
-	0xd4, 0xda, 0x24, 0xdd, 0x18, 0xdd, 0x30, 0x44, 0xea, 0x00, 0xa6, 0x62, 0x60, 0x89, 0xfc, 0xe3,
-	0x70, 0x4e, 0xb1, 0x7d, 0xc6, 0x3f, 0x0a, 0xfe, 0x0f, 0x18, 0x3b, 0x82, 0xc4, 0xc6, 0xc6, 0x5f,
-	0xd2, 0xb1, 0x23, 0x53, 0x8a, 0x12, 0xb1, 0x30, 0xf6, 0x2f, 0x40, 0x77, 0xe7, 0x4b, 0xd2, 0x22,
-	0xa4, 0x0e, 0x30, 0x9d, 0xdf, 0x7d, 0xef, 0x7d, 0xef, 0xdd, 0xf7, 0x3e, 0x19, 0xec, 0x93, 0x2c,
-	0x22, 0x19, 0xce, 0xcc, 0x90, 0x78, 0xd3, 0x22, 0x61, 0x87, 0x91, 0xa4, 0x24, 0x27, 0xb0, 0x59,
-	0x41, 0x06, 0x87, 0x3a, 0x7b, 0x01, 0x09, 0x08, 0x83, 0x4c, 0xfa, 0xc5, 0xb3, 0x3a, 0x6a, 0x40,
-	0x48, 0x10, 0x22, 0x93, 0x45, 0x6e, 0xf1, 0xd6, 0xf4, 0x8b, 0xd4, 0xc9, 0x31, 0x89, 0x2b, 0x5c,
-	0xbb, 0x8d, 0xe7, 0x38, 0x42, 0x59, 0xee, 0x44, 0x89, 0x20, 0xf0, 0x58, 0x1f, 0xd3, 0x75, 0x32,
-	0x64, 0x9e, 0xf5, 0x5d, 0x94, 0x3b, 0x7d, 0xd3, 0x23, 0xb8, 0x22, 0xd0, 0x7f, 0x2a, 0x00, 0xbc,
-	0x40, 0x29, 0x26, 0xfe, 0x09, 0xf1, 0xa6, 0xb0, 0x09, 0x94, 0xd1, 0xb0, 0x2d, 0x77, 0xe5, 0x5e,
-	0xcd, 0x56, 0x46, 0x43, 0xf8, 0x18, 0xd4, 0xc9, 0xfb, 0x18, 0xa5, 0x6d, 0xa5, 0x2b, 0xf7, 0xb6,
-	0xad, 0xd6, 0xf5, 0x4c, 0xbb, 0x5f, 0x3a, 0x51, 0xf8, 0x4c, 0x67, 0xd7, 0xba, 0xcd, 0x61, 0x38,
-	0x01, 0x5b, 0x62, 0xb2, 0xf6, 0x46, 0x57, 0xee, 0xed, 0x0c, 0xf6, 0x0d, 0x3e, 0x9a, 0x21, 0x46,
-	0x33, 0x86, 0x55, 0x82, 0xd5, 0xbf, 0x98, 0x69, 0xd2, 0xaf, 0x99, 0x06, 0x45, 0xc9, 0x01, 0x89,
-	0x70, 0x8e, 0xa2, 0x24, 0x2f, 0xaf, 0x67, 0xda, 0x2e, 0xe7, 0x17, 0x98, 0xfe, 0xe9, 0x4a, 0x93,
-	0xed, 0x25, 0x3b, 0xb4, 0xc1, 0x16, 0x8a, 0xfd, 0x31, 0x7d, 0x67, 0xbb, 0xc6, 0x3a, 0x75, 0xfe,
-	0xe8, 0x74, 0x2a, 0x44, 0xb0, 0x1e, 0xd0, 0x56, 0x2b, 0x52, 0x51, 0xa9, 0x9f, 0x53, 0xd2, 0x7b,
-	0x28, 0xf6, 0x69, 0x2a, 0x74, 0x40, 0x9d, 0x4a, 0x92, 0xb5, 0xeb, 0xdd, 0x0d, 0x36, 0x3a, 0x17,
-	0xcd, 0xa0, 0xa2, 0x19, 0x95, 0x68, 0xc6, 0x31, 0xc1, 0xb1, 0xf5, 0x94, 0xf2, 0x7d, 0xbb, 0xd2,
-	0x7a, 0x01, 0xce, 0x27, 0x85, 0x6b, 0x78, 0x24, 0x32, 0x2b, 0x85, 0xf9, 0x71, 0x98, 0xf9, 0x53,
-	0x33, 0x2f, 0x13, 0x94, 0xb1, 0x82, 0xcc, 0xe6, 0xcc, 0xfa, 0x67, 0x05, 0x34, 0x5f, 0x16, 0x28,
-	0x2d, 0x8f, 0x49, 0xec, 0x63, 0xf6, 0x92, 0xe7, 0x60, 0x97, 0xee, 0x7e, 0xfc, 0x8e, 0x5e, 0x8f,
-	0x69, 0x0d, 0x13, 0xbe, 0x39, 0x78, 0x64, 0xdc, 0xf4, 0x86, 0x41, 0x57, 0xc3, 0x8a, 0x4f, 0xcb,
-	0x04, 0xd9, 0x8d, 0x70, 0x3d, 0x84, 0x7b, 0xa0, 0xee, 0xa3, 0x98, 0x44, 0x7c, 0x45, 0x36, 0x0f,
-	0xa8, 0x4c, 0x77, 0x5f, 0xc8, 0x2d, 0x95, 0xfe, 0x26, 0xfd, 0x6b, 0xb0, 0xbd, 0xb4, 0xd7, 0x1d,
-	0xb4, 0x7f, 0x58, 0xb1, 0xb6, 0x38, 0xeb, 0xb2, 0x94, 0x8b, 0xbf, 0xa2, 0xd2, 0xbf, 0x28, 0xa0,
-	0xf1, 0xaa, 0x8c, 0xf3, 0x09, 0xca, 0xb1, 0xc7, 0x6c, 0x78, 0x00, 0x60, 0x11, 0xfb, 0x28, 0x0d,
-	0x4b, 0x1c, 0x07, 0x63, 0xa6, 0x12, 0xf6, 0x2b, 0x5b, 0xb6, 0x56, 0x08, 0xcd, 0x1d, 0xf9, 0x50,
-	0x03, 0x3b, 0x19, 0x2d, 0x1f, 0xaf, 0xeb, 0x00, 0xd8, 0xd5, 0x50, 0x88, 0xb1, 0xf4, 0xcc, 0xc6,
-	0x3f, 0xf2, 0xcc, 0xba, 0xe3, 0x6b, 0xff, 0xd3, 0xf1, 0x4f, 0xfa, 0xa0, 0x71, 0xc3, 0x00, 0xb0,
-	0x09, 0x80, 0x55, 0x0a, 0xee, 0x96, 0x04, 0x01, 0xd8, 0xb4, 0x4a, 0x3a, 0x54, 0x4b, 0xee, 0xd4,
-	0x3e, 0x7e, 0x55, 0x25, 0xeb, 0xe4, 0x62, 0xae, 0xca, 0x97, 0x73, 0x55, 0xfe, 0x31, 0x57, 0xe5,
-	0xf3, 0x85, 0x2a, 0x5d, 0x2e, 0x54, 0xe9, 0xfb, 0x42, 0x95, 0xde, 0x0c, 0xd6, 0x8c, 0x5b, 0xb9,
-	0xec, 0x30, 0x74, 0xdc, 0x4c, 0x04, 0xe6, 0x59, 0xff, 0xc8, 0xfc, 0x20, 0xfe, 0x57, 0xcc, 0xc8,
-	0xee, 0x26, 0x7b, 0xd0, 0xd1, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x55, 0x7f, 0xcc, 0xd3, 0xce,
-	0x04, 0x00, 0x00,
+	// 665 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xbd, 0x4e, 0xdc, 0x4a,
+	0x18, 0x5d, 0xef, 0x0f, 0x3f, 0xc3, 0x65, 0xb1, 0x46, 0x5c, 0xdd, 0x65, 0xef, 0xbd, 0xf6, 0xca,
+	0x45, 0xb4, 0x8a, 0xc0, 0xce, 0x42, 0x97, 0x2e, 0x66, 0xa3, 0x08, 0x89, 0x22, 0x71, 0x10, 0x05,
+	0x8d, 0xe5, 0xf5, 0x4c, 0x96, 0x11, 0xb6, 0xc7, 0x99, 0xb1, 0x21, 0x7e, 0x83, 0x94, 0x94, 0x89,
+	0x94, 0x2e, 0x5d, 0xde, 0x22, 0x1d, 0x25, 0x65, 0xaa, 0x25, 0x82, 0x2e, 0x25, 0x4f, 0x10, 0xcd,
+	0x8c, 0xbd, 0x2c, 0x44, 0x48, 0x14, 0x49, 0xe5, 0x9d, 0x39, 0xdf, 0x77, 0xe6, 0x9b, 0x73, 0xce,
+	0x2c, 0x58, 0xa3, 0x3c, 0xa6, 0x9c, 0x70, 0x27, 0xa2, 0xe1, 0x51, 0x9e, 0xca, 0x8f, 0x9d, 0x32,
+	0x9a, 0x51, 0xd8, 0x2e, 0x21, 0x5b, 0x41, 0xdd, 0xd5, 0x31, 0x1d, 0x53, 0x09, 0x39, 0xe2, 0x97,
+	0xaa, 0xea, 0x1a, 0x63, 0x4a, 0xc7, 0x11, 0x76, 0xe4, 0x6a, 0x94, 0xbf, 0x71, 0x50, 0xce, 0x82,
+	0x8c, 0xd0, 0xa4, 0xc4, 0xcd, 0xbb, 0x78, 0x46, 0x62, 0xcc, 0xb3, 0x20, 0x4e, 0x2b, 0x82, 0x50,
+	0x9e, 0xe3, 0x8c, 0x02, 0x8e, 0x9d, 0xe3, 0xc1, 0x08, 0x67, 0xc1, 0xc0, 0x09, 0x29, 0x29, 0x09,
+	0xac, 0xaf, 0x0d, 0x00, 0x5e, 0x62, 0x46, 0x28, 0xda, 0xa5, 0xe1, 0x11, 0x6c, 0x83, 0xfa, 0xce,
+	0xb0, 0xa3, 0xf5, 0xb4, 0x7e, 0xd3, 0xab, 0xef, 0x0c, 0xe1, 0x23, 0xd0, 0xa2, 0x27, 0x09, 0x66,
+	0x9d, 0x7a, 0x4f, 0xeb, 0x2f, 0xba, 0xfa, 0xf5, 0xc4, 0xfc, 0xab, 0x08, 0xe2, 0xe8, 0xa9, 0x25,
+	0xb7, 0x2d, 0x4f, 0xc1, 0xf0, 0x10, 0x2c, 0x54, 0x93, 0x75, 0x1a, 0x3d, 0xad, 0xbf, 0xb4, 0xb9,
+	0x66, 0xab, 0xd1, 0xec, 0x6a, 0x34, 0x7b, 0x58, 0x16, 0xb8, 0x83, 0xb3, 0x89, 0x59, 0xfb, 0x31,
+	0x31, 0x61, 0xd5, 0xb2, 0x4e, 0x63, 0x92, 0xe1, 0x38, 0xcd, 0x8a, 0xeb, 0x89, 0xb9, 0xa2, 0xf8,
+	0x2b, 0xcc, 0xfa, 0x70, 0x61, 0x6a, 0xde, 0x94, 0x1d, 0x7a, 0x60, 0x01, 0x27, 0xc8, 0x17, 0xf7,
+	0xec, 0x34, 0xe5, 0x49, 0xdd, 0x5f, 0x4e, 0xda, 0xab, 0x44, 0x70, 0xff, 0x15, 0x47, 0xdd, 0x90,
+	0x56, 0x9d, 0xd6, 0xa9, 0x20, 0x9d, 0xc7, 0x09, 0x12, 0xa5, 0x30, 0x00, 0x2d, 0x21, 0x09, 0xef,
+	0xb4, 0x7a, 0x0d, 0x39, 0xba, 0x12, 0xcd, 0x16, 0xa2, 0xd9, 0xa5, 0x68, 0xf6, 0x36, 0x25, 0x89,
+	0xfb, 0x44, 0xf0, 0x7d, 0xb9, 0x30, 0xfb, 0x63, 0x92, 0x1d, 0xe6, 0x23, 0x3b, 0xa4, 0xb1, 0x53,
+	0x2a, 0xac, 0x3e, 0x1b, 0x1c, 0x1d, 0x39, 0x59, 0x91, 0x62, 0x2e, 0x1b, 0xb8, 0xa7, 0x98, 0xe1,
+	0x01, 0xf8, 0x87, 0xe1, 0x93, 0x80, 0x21, 0x9f, 0xe1, 0x10, 0x93, 0x63, 0xcc, 0xfc, 0x00, 0x21,
+	0x86, 0x39, 0xef, 0xcc, 0x49, 0x69, 0xad, 0xeb, 0x89, 0x69, 0xa8, 0x29, 0xef, 0x29, 0xb4, 0xbc,
+	0xbf, 0x15, 0xe2, 0x95, 0xc0, 0xb3, 0x72, 0xff, 0x63, 0x1d, 0xb4, 0x5f, 0xe5, 0x98, 0x15, 0xdb,
+	0x34, 0x41, 0x44, 0xaa, 0xf4, 0x1c, 0xac, 0x88, 0x5c, 0xf9, 0x6f, 0xc5, 0xb6, 0x2f, 0xe6, 0x91,
+	0xa6, 0xb6, 0x37, 0xff, 0xb7, 0x6f, 0xe7, 0xce, 0x16, 0xb6, 0xcb, 0xe6, 0xbd, 0x22, 0xc5, 0xde,
+	0x72, 0x34, 0xbb, 0x84, 0xab, 0xa0, 0x85, 0x70, 0x42, 0x63, 0x65, 0xbf, 0xa7, 0x16, 0xc2, 0x82,
+	0x87, 0x9b, 0x7d, 0xc7, 0x81, 0xfb, 0x6c, 0xdd, 0x07, 0x8b, 0xd3, 0xe8, 0x3e, 0xc0, 0xd7, 0xff,
+	0x4a, 0x56, 0x5d, 0xb1, 0x4e, 0x5b, 0x95, 0xb1, 0x37, 0x54, 0xd6, 0xa7, 0x3a, 0x58, 0x7e, 0x5d,
+	0x24, 0xd9, 0x21, 0xce, 0x48, 0x28, 0x23, 0xbe, 0x0e, 0x60, 0x9e, 0x20, 0xcc, 0xa2, 0x82, 0x24,
+	0x63, 0x5f, 0xaa, 0x44, 0x50, 0x19, 0x79, 0xfd, 0x06, 0x11, 0xb5, 0x3b, 0x08, 0x9a, 0x60, 0x89,
+	0x8b, 0x76, 0x7f, 0x56, 0x07, 0x20, 0xb7, 0x86, 0x95, 0x18, 0xd3, 0x3c, 0x36, 0x7e, 0x53, 0x1e,
+	0x67, 0x5f, 0x53, 0xf3, 0x4f, 0xbe, 0xa6, 0xc7, 0x1e, 0x58, 0xbe, 0x15, 0x00, 0xd8, 0x06, 0xc0,
+	0x2d, 0x2a, 0x6e, 0xbd, 0x06, 0x01, 0x98, 0x73, 0x0b, 0x31, 0x94, 0xae, 0xc1, 0x25, 0x30, 0xef,
+	0x16, 0x2f, 0x18, 0xcd, 0x53, 0xbd, 0x0e, 0x21, 0x68, 0xbb, 0xc5, 0x1e, 0x0b, 0x10, 0x66, 0xfb,
+	0x34, 0xca, 0x63, 0xac, 0x37, 0xba, 0xcd, 0xf7, 0x9f, 0x8d, 0x9a, 0xbb, 0x7b, 0x76, 0x69, 0x68,
+	0xe7, 0x97, 0x86, 0xf6, 0xfd, 0xd2, 0xd0, 0x4e, 0xaf, 0x8c, 0xda, 0xf9, 0x95, 0x51, 0xfb, 0x76,
+	0x65, 0xd4, 0x0e, 0x36, 0x67, 0x5e, 0x4d, 0x19, 0xc3, 0x8d, 0x28, 0x18, 0xf1, 0x6a, 0xe1, 0x1c,
+	0x0f, 0xb6, 0x9c, 0x77, 0xd5, 0x9f, 0xa5, 0x7c, 0x45, 0xa3, 0x39, 0x79, 0xe3, 0xad, 0x9f, 0x03,
+	0x00, 0x6b, 0xf5, 0x9e, 0x8a, 0x4b, 0x05, 0x00, 0x00,
 }
 
 func (m *PeriodLock) Marshal() (dAtA []byte, err error) {
@@ -378,6 +403,13 @@ func (m *PeriodLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RewardReceiverAddress) > 0 {
+		i -= len(m.RewardReceiverAddress)
+		copy(dAtA[i:], m.RewardReceiverAddress)
+		i = encodeVarintLock(dAtA, i, uint64(len(m.RewardReceiverAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Coins) > 0 {
 		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -559,6 +591,10 @@ func (m *PeriodLock) Size() (n int) {
 			n += 1 + l + sovLock(uint64(l))
 		}
 	}
+	l = len(m.RewardReceiverAddress)
+	if l > 0 {
+		n += 1 + l + sovLock(uint64(l))
+	}
 	return n
 }
 
@@ -788,6 +824,38 @@ func (m *PeriodLock) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardReceiverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardReceiverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLock(dAtA[iNdEx:])
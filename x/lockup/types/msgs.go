@@ -10,11 +10,12 @@ import (
 
 // constants.
 const (
-	TypeMsgLockTokens        = "lock_tokens"
-	TypeMsgBeginUnlockingAll = "begin_unlocking_all"
-	TypeMsgBeginUnlocking    = "begin_unlocking"
-	TypeMsgExtendLockup      = "edit_lockup"
-	TypeForceUnlock          = "force_unlock"
+	TypeMsgLockTokens            = "lock_tokens"
+	TypeMsgBeginUnlockingAll     = "begin_unlocking_all"
+	TypeMsgBeginUnlocking        = "begin_unlocking"
+	TypeMsgExtendLockup          = "edit_lockup"
+	TypeForceUnlock              = "force_unlock"
+	TypeSetRewardReceiverAddress = "set_reward_receiver_address"
 )
 
 var _ sdk.Msg = &MsgLockTokens{}
@@ -204,3 +205,42 @@ func (m MsgForceUnlock) GetSigners() []sdk.AccAddress {
 	owner, _ := sdk.AccAddressFromBech32(m.Owner)
 	return []sdk.AccAddress{owner}
 }
+
+var _ sdk.Msg = &MsgSetRewardReceiverAddress{}
+
+// NewMsgSetRewardReceiverAddress creates a message to set the reward receiver address of a lock.
+func NewMsgSetRewardReceiverAddress(owner sdk.AccAddress, id uint64, rewardReceiver sdk.AccAddress) *MsgSetRewardReceiverAddress {
+	return &MsgSetRewardReceiverAddress{
+		Owner:          owner.String(),
+		ID:             id,
+		RewardReceiver: rewardReceiver.String(),
+	}
+}
+
+func (m MsgSetRewardReceiverAddress) Route() string { return RouterKey }
+func (m MsgSetRewardReceiverAddress) Type() string  { return TypeSetRewardReceiverAddress }
+func (m MsgSetRewardReceiverAddress) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.RewardReceiver)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid reward receiver address (%s)", err)
+	}
+
+	if m.ID == 0 {
+		return fmt.Errorf("invalid lockup ID, got %v", m.ID)
+	}
+	return nil
+}
+
+func (m MsgSetRewardReceiverAddress) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetRewardReceiverAddress) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
@@ -262,6 +262,67 @@ func TestMsgExtendLockup(t *testing.T) {
 	}
 }
 
+func TestMsgSetRewardReceiverAddress(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1, invalidAddr := apptesting.GenerateTestAddrs()
+	addr2, _ := apptesting.GenerateTestAddrs()
+
+	tests := []struct {
+		name       string
+		msg        types.MsgSetRewardReceiverAddress
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgSetRewardReceiverAddress{
+				Owner:          addr1,
+				ID:             1,
+				RewardReceiver: addr2,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid owner",
+			msg: types.MsgSetRewardReceiverAddress{
+				Owner:          invalidAddr,
+				ID:             1,
+				RewardReceiver: addr2,
+			},
+		},
+		{
+			name: "invalid reward receiver",
+			msg: types.MsgSetRewardReceiverAddress{
+				Owner:          addr1,
+				ID:             1,
+				RewardReceiver: invalidAddr,
+			},
+		},
+		{
+			name: "invalid lockup ID",
+			msg: types.MsgSetRewardReceiverAddress{
+				Owner:          addr1,
+				ID:             0,
+				RewardReceiver: addr2,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.expectPass {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Route(), types.RouterKey)
+				require.Equal(t, test.msg.Type(), "set_reward_receiver_address")
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), addr1)
+			} else {
+				require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			}
+		})
+	}
+}
+
 // // Test authz serialize and de-serializes for lockup msg.
 func TestAuthzMsg(t *testing.T) {
 	pk1 := ed25519.GenPrivKey().PubKey()
@@ -299,6 +360,14 @@ func TestAuthzMsg(t *testing.T) {
 				Owner: addr1,
 			},
 		},
+		{
+			name: "MsgSetRewardReceiverAddress",
+			msg: &types.MsgSetRewardReceiverAddress{
+				Owner:          addr1,
+				ID:             1,
+				RewardReceiver: addr1,
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -546,6 +546,113 @@ func (m *MsgForceUnlockResponse) GetSuccess() bool {
 	return false
 }
 
+// MsgSetRewardReceiverAddress sets the address that receives the incentives
+// distributed to the given lock. Setting the receiver to the lock owner resets
+// the lock to the default behavior of paying rewards to the owner.
+type MsgSetRewardReceiverAddress struct {
+	Owner          string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	ID             uint64 `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	RewardReceiver string `protobuf:"bytes,3,opt,name=reward_receiver,json=rewardReceiver,proto3" json:"reward_receiver,omitempty" yaml:"reward_receiver"`
+}
+
+func (m *MsgSetRewardReceiverAddress) Reset()         { *m = MsgSetRewardReceiverAddress{} }
+func (m *MsgSetRewardReceiverAddress) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRewardReceiverAddress) ProtoMessage()    {}
+func (*MsgSetRewardReceiverAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{10}
+}
+func (m *MsgSetRewardReceiverAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRewardReceiverAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRewardReceiverAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRewardReceiverAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRewardReceiverAddress.Merge(m, src)
+}
+func (m *MsgSetRewardReceiverAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRewardReceiverAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRewardReceiverAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRewardReceiverAddress proto.InternalMessageInfo
+
+func (m *MsgSetRewardReceiverAddress) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgSetRewardReceiverAddress) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *MsgSetRewardReceiverAddress) GetRewardReceiver() string {
+	if m != nil {
+		return m.RewardReceiver
+	}
+	return ""
+}
+
+type MsgSetRewardReceiverAddressResponse struct {
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (m *MsgSetRewardReceiverAddressResponse) Reset()         { *m = MsgSetRewardReceiverAddressResponse{} }
+func (m *MsgSetRewardReceiverAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRewardReceiverAddressResponse) ProtoMessage()    {}
+func (*MsgSetRewardReceiverAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{11}
+}
+func (m *MsgSetRewardReceiverAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRewardReceiverAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRewardReceiverAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRewardReceiverAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRewardReceiverAddressResponse.Merge(m, src)
+}
+func (m *MsgSetRewardReceiverAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRewardReceiverAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRewardReceiverAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRewardReceiverAddressResponse proto.InternalMessageInfo
+
+func (m *MsgSetRewardReceiverAddressResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*MsgLockTokens)(nil), "osmosis.lockup.MsgLockTokens")
 	proto.RegisterType((*MsgLockTokensResponse)(nil), "osmosis.lockup.MsgLockTokensResponse")
@@ -557,52 +664,59 @@ func init() {
 	proto.RegisterType((*MsgExtendLockupResponse)(nil), "osmosis.lockup.MsgExtendLockupResponse")
 	proto.RegisterType((*MsgForceUnlock)(nil), "osmosis.lockup.MsgForceUnlock")
 	proto.RegisterType((*MsgForceUnlockResponse)(nil), "osmosis.lockup.MsgForceUnlockResponse")
+	proto.RegisterType((*MsgSetRewardReceiverAddress)(nil), "osmosis.lockup.MsgSetRewardReceiverAddress")
+	proto.RegisterType((*MsgSetRewardReceiverAddressResponse)(nil), "osmosis.lockup.MsgSetRewardReceiverAddressResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/lockup/tx.proto", fileDescriptor_bcdad5af0d24735f) }
 
 var fileDescriptor_bcdad5af0d24735f = []byte{
-	// 631 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0x13, 0x4a, 0xcb, 0xb4, 0xa4, 0xd4, 0x2a, 0x34, 0xb5, 0xc0, 0x2e, 0x16, 0xb4, 0x41,
-	0x6a, 0xbd, 0x24, 0x81, 0x0b, 0x07, 0x24, 0x42, 0x40, 0xaa, 0xd4, 0x48, 0xc8, 0x6a, 0x25, 0xc4,
-	0x01, 0xc9, 0x76, 0x96, 0xad, 0x15, 0xc7, 0x6b, 0x65, 0xed, 0x92, 0xdc, 0x79, 0x00, 0x8e, 0x3c,
-	0x03, 0x48, 0x5c, 0x78, 0x89, 0x1e, 0x2b, 0x71, 0xe1, 0x94, 0xa2, 0xe4, 0xc6, 0xb1, 0x4f, 0x80,
-	0xbc, 0x8e, 0x2d, 0xe7, 0x47, 0x4d, 0x84, 0x04, 0xe2, 0xe4, 0xac, 0xbf, 0x99, 0x6f, 0xe6, 0xfb,
-	0x76, 0x26, 0x86, 0x0d, 0xca, 0x5a, 0x94, 0xd9, 0x0c, 0x39, 0xd4, 0x6a, 0x06, 0x1e, 0xf2, 0x3b,
-	0x9a, 0xd7, 0xa6, 0x3e, 0x15, 0xf3, 0x43, 0x40, 0x8b, 0x00, 0x69, 0x9d, 0x50, 0x42, 0x39, 0x84,
-	0xc2, 0x5f, 0x51, 0x94, 0x24, 0x13, 0x4a, 0x89, 0x83, 0x11, 0x3f, 0x99, 0xc1, 0x3b, 0xd4, 0x08,
-	0xda, 0x86, 0x6f, 0x53, 0x37, 0xc6, 0x2d, 0x4e, 0x83, 0x4c, 0x83, 0x61, 0x74, 0x52, 0x32, 0xb1,
-	0x6f, 0x94, 0x90, 0x45, 0xed, 0x18, 0xdf, 0x1c, 0x2b, 0x1f, 0x3e, 0x22, 0x48, 0xfd, 0x90, 0x85,
-	0xeb, 0x75, 0x46, 0x0e, 0xa8, 0xd5, 0x3c, 0xa4, 0x4d, 0xec, 0x32, 0x71, 0x1b, 0x16, 0xe8, 0x7b,
-	0x17, 0xb7, 0x0b, 0xc2, 0x96, 0x50, 0xbc, 0x56, 0xbd, 0x71, 0xd1, 0x53, 0x56, 0xba, 0x46, 0xcb,
-	0x79, 0xa2, 0xf2, 0xd7, 0xaa, 0x1e, 0xc1, 0xe2, 0x31, 0x2c, 0xc5, 0x6d, 0x14, 0xb2, 0x5b, 0x42,
-	0x71, 0xb9, 0xbc, 0xa9, 0x45, 0x7d, 0x6a, 0x71, 0x9f, 0x5a, 0x6d, 0x18, 0x50, 0x2d, 0x9d, 0xf6,
-	0x94, 0xcc, 0xaf, 0x9e, 0x22, 0xc6, 0x29, 0xbb, 0xb4, 0x65, 0xfb, 0xb8, 0xe5, 0xf9, 0xdd, 0x8b,
-	0x9e, 0xb2, 0x1a, 0xf1, 0xc7, 0x98, 0xfa, 0xe9, 0x5c, 0x11, 0xf4, 0x84, 0x5d, 0x34, 0x60, 0x21,
-	0x14, 0xc3, 0x0a, 0xb9, 0xad, 0x1c, 0x2f, 0x13, 0xc9, 0xd5, 0x42, 0xb9, 0xda, 0x50, 0xae, 0xf6,
-	0x9c, 0xda, 0x6e, 0xf5, 0x61, 0x58, 0xe6, 0xf3, 0xb9, 0x52, 0x24, 0xb6, 0x7f, 0x1c, 0x98, 0x9a,
-	0x45, 0x5b, 0x68, 0xe8, 0x4d, 0xf4, 0xd8, 0x63, 0x8d, 0x26, 0xf2, 0xbb, 0x1e, 0x66, 0x3c, 0x81,
-	0xe9, 0x11, 0xb3, 0xba, 0x03, 0x37, 0x47, 0x5c, 0xd0, 0x31, 0xf3, 0xa8, 0xcb, 0xb0, 0x98, 0x87,
-	0xec, 0x7e, 0x8d, 0x5b, 0x71, 0x45, 0xcf, 0xee, 0xd7, 0xd4, 0xa7, 0xb0, 0x5e, 0x67, 0xa4, 0x8a,
-	0x89, 0xed, 0x1e, 0xb9, 0xa1, 0x8f, 0xb6, 0x4b, 0x9e, 0x39, 0xce, 0xbc, 0xae, 0xa9, 0x87, 0x70,
-	0x7b, 0x5a, 0x7e, 0x52, 0xef, 0x11, 0x2c, 0x06, 0xfc, 0x3d, 0x2b, 0x08, 0x5c, 0xad, 0xa4, 0x8d,
-	0x8e, 0x88, 0xf6, 0x0a, 0xb7, 0x6d, 0xda, 0x08, 0x5b, 0xd5, 0xe3, 0x50, 0xf5, 0xab, 0x00, 0x6b,
-	0x13, 0xb4, 0x73, 0xdf, 0x64, 0xa4, 0x31, 0x1b, 0x6b, 0xfc, 0x17, 0x7e, 0x3f, 0x86, 0xcd, 0x89,
-	0x7e, 0x13, 0x0f, 0x0a, 0xb0, 0xc8, 0x02, 0xcb, 0xc2, 0x8c, 0xf1, 0xce, 0x97, 0xf4, 0xf8, 0xa8,
-	0x7e, 0x13, 0x60, 0xb5, 0xce, 0xc8, 0x8b, 0x8e, 0x8f, 0x5d, 0x6e, 0x41, 0xe0, 0xfd, 0xb1, 0xca,
-	0xf4, 0xfc, 0xe6, 0xfe, 0xe6, 0xfc, 0xaa, 0x15, 0xd8, 0x18, 0x6b, 0x7a, 0x0e, 0xa9, 0x5f, 0x04,
-	0xc8, 0xd7, 0x19, 0x79, 0x49, 0xdb, 0x16, 0x8e, 0x2c, 0xfa, 0x9f, 0xef, 0xb3, 0x0c, 0xb7, 0x46,
-	0x9b, 0x9d, 0xad, 0xb0, 0xfc, 0x3d, 0x07, 0xb9, 0x3a, 0x23, 0xa2, 0x0e, 0x90, 0xfa, 0xfb, 0xb9,
-	0x33, 0x3e, 0xef, 0x23, 0x7b, 0x29, 0xdd, 0xbf, 0x14, 0x4e, 0xaa, 0x12, 0x58, 0x9b, 0xdc, 0xd1,
-	0x7b, 0x53, 0x72, 0x27, 0xa2, 0xa4, 0xdd, 0x79, 0xa2, 0x92, 0x42, 0x6f, 0x21, 0x3f, 0xb6, 0x75,
-	0x77, 0x67, 0xe6, 0x4b, 0x0f, 0x66, 0x86, 0x24, 0xfc, 0xaf, 0x61, 0x65, 0x64, 0xda, 0x95, 0x29,
-	0xa9, 0xe9, 0x00, 0x69, 0x67, 0x46, 0x40, 0xc2, 0x7c, 0x04, 0xcb, 0xe9, 0xe1, 0x92, 0xa7, 0xe4,
-	0xa5, 0x70, 0x69, 0xfb, 0x72, 0x3c, 0xa6, 0xad, 0x1e, 0x9c, 0xf6, 0x65, 0xe1, 0xac, 0x2f, 0x0b,
-	0x3f, 0xfb, 0xb2, 0xf0, 0x71, 0x20, 0x67, 0xce, 0x06, 0x72, 0xe6, 0xc7, 0x40, 0xce, 0xbc, 0x29,
-	0xa7, 0x86, 0x6a, 0xc8, 0xb5, 0xe7, 0x18, 0x26, 0x8b, 0x0f, 0xe8, 0xa4, 0x54, 0x41, 0x9d, 0xe4,
-	0x13, 0x19, 0x0e, 0x99, 0x79, 0x95, 0xaf, 0x62, 0xe5, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf5,
-	0x2c, 0xd8, 0xaf, 0x41, 0x07, 0x00, 0x00,
+	// 709 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x5d, 0x4f, 0xd3, 0x50,
+	0x18, 0x5e, 0x37, 0x10, 0x78, 0xc1, 0x21, 0x0d, 0xc2, 0xa8, 0xda, 0x62, 0x55, 0xc0, 0x08, 0xad,
+	0xdb, 0xf4, 0xc6, 0x0b, 0x0d, 0x03, 0x4d, 0x48, 0x58, 0x62, 0x2a, 0x24, 0xc6, 0x0b, 0x4d, 0xd7,
+	0x1d, 0x0f, 0xcd, 0xb6, 0x9e, 0xa5, 0xa7, 0xe5, 0x23, 0xf1, 0xd2, 0x1f, 0xe0, 0xa5, 0xf1, 0x27,
+	0x68, 0xe2, 0x8d, 0x7f, 0x82, 0x4b, 0xbc, 0xf3, 0x6a, 0x18, 0xb8, 0xf3, 0x92, 0x5f, 0x60, 0x7a,
+	0xce, 0xda, 0xac, 0xdb, 0xd8, 0x26, 0x89, 0xc6, 0xab, 0xae, 0x7d, 0x9e, 0xf7, 0x79, 0x9f, 0xe7,
+	0x3d, 0x1f, 0x19, 0xcc, 0x12, 0x5a, 0x23, 0xd4, 0xa6, 0x7a, 0x95, 0x58, 0x15, 0xbf, 0xae, 0x7b,
+	0xfb, 0x5a, 0xdd, 0x25, 0x1e, 0x11, 0xd3, 0x4d, 0x40, 0xe3, 0x80, 0x34, 0x8d, 0x09, 0x26, 0x0c,
+	0xd2, 0x83, 0x5f, 0x9c, 0x25, 0xc9, 0x98, 0x10, 0x5c, 0x45, 0x3a, 0x7b, 0x2b, 0xf9, 0x6f, 0xf5,
+	0xb2, 0xef, 0x9a, 0x9e, 0x4d, 0x9c, 0x10, 0xb7, 0x98, 0x8c, 0x5e, 0x32, 0x29, 0xd2, 0x77, 0xb3,
+	0x25, 0xe4, 0x99, 0x59, 0xdd, 0x22, 0x76, 0x88, 0xcf, 0xb5, 0xb5, 0x0f, 0x1e, 0x1c, 0x52, 0xdf,
+	0x27, 0xe1, 0x72, 0x91, 0xe2, 0x4d, 0x62, 0x55, 0xb6, 0x48, 0x05, 0x39, 0x54, 0x5c, 0x80, 0x61,
+	0xb2, 0xe7, 0x20, 0x37, 0x23, 0xcc, 0x0b, 0x4b, 0x63, 0x85, 0x2b, 0x67, 0x0d, 0x65, 0xe2, 0xc0,
+	0xac, 0x55, 0x1f, 0xa9, 0xec, 0xb3, 0x6a, 0x70, 0x58, 0xdc, 0x81, 0xd1, 0xd0, 0x46, 0x26, 0x39,
+	0x2f, 0x2c, 0x8d, 0xe7, 0xe6, 0x34, 0xee, 0x53, 0x0b, 0x7d, 0x6a, 0xeb, 0x4d, 0x42, 0x21, 0x7b,
+	0xd8, 0x50, 0x12, 0xbf, 0x1a, 0x8a, 0x18, 0x96, 0x2c, 0x93, 0x9a, 0xed, 0xa1, 0x5a, 0xdd, 0x3b,
+	0x38, 0x6b, 0x28, 0x93, 0x5c, 0x3f, 0xc4, 0xd4, 0x8f, 0xc7, 0x8a, 0x60, 0x44, 0xea, 0xa2, 0x09,
+	0xc3, 0x41, 0x18, 0x9a, 0x49, 0xcd, 0xa7, 0x58, 0x1b, 0x1e, 0x57, 0x0b, 0xe2, 0x6a, 0xcd, 0xb8,
+	0xda, 0x1a, 0xb1, 0x9d, 0xc2, 0xfd, 0xa0, 0xcd, 0xe7, 0x63, 0x65, 0x09, 0xdb, 0xde, 0x8e, 0x5f,
+	0xd2, 0x2c, 0x52, 0xd3, 0x9b, 0xb3, 0xe1, 0x8f, 0x15, 0x5a, 0xae, 0xe8, 0xde, 0x41, 0x1d, 0x51,
+	0x56, 0x40, 0x0d, 0xae, 0xac, 0x2e, 0xc2, 0xd5, 0xd8, 0x14, 0x0c, 0x44, 0xeb, 0xc4, 0xa1, 0x48,
+	0x4c, 0x43, 0x72, 0x63, 0x9d, 0x8d, 0x62, 0xc8, 0x48, 0x6e, 0xac, 0xab, 0x8f, 0x61, 0xba, 0x48,
+	0x71, 0x01, 0x61, 0xdb, 0xd9, 0x76, 0x82, 0x39, 0xda, 0x0e, 0x5e, 0xad, 0x56, 0x07, 0x9d, 0x9a,
+	0xba, 0x05, 0xd7, 0xbb, 0xd5, 0x47, 0xfd, 0x1e, 0xc0, 0x88, 0xcf, 0xbe, 0xd3, 0x8c, 0xc0, 0xd2,
+	0x4a, 0x5a, 0x7c, 0x8b, 0x68, 0xcf, 0x91, 0x6b, 0x93, 0x72, 0x60, 0xd5, 0x08, 0xa9, 0xea, 0x57,
+	0x01, 0xa6, 0x3a, 0x64, 0x07, 0x5e, 0x49, 0x9e, 0x31, 0x19, 0x66, 0xfc, 0x17, 0xf3, 0x7e, 0x08,
+	0x73, 0x1d, 0x7e, 0xa3, 0x19, 0x64, 0x60, 0x84, 0xfa, 0x96, 0x85, 0x28, 0x65, 0xce, 0x47, 0x8d,
+	0xf0, 0x55, 0xfd, 0x26, 0xc0, 0x64, 0x91, 0xe2, 0xa7, 0xfb, 0x1e, 0x72, 0xd8, 0x08, 0xfc, 0xfa,
+	0x85, 0x53, 0xb6, 0xee, 0xdf, 0xd4, 0xdf, 0xdc, 0xbf, 0x6a, 0x1e, 0x66, 0xdb, 0x4c, 0x0f, 0x10,
+	0xf5, 0x8b, 0x00, 0xe9, 0x22, 0xc5, 0xcf, 0x88, 0x6b, 0x21, 0x3e, 0xa2, 0xff, 0x79, 0x3d, 0x73,
+	0x30, 0x13, 0x37, 0x3b, 0x40, 0xc2, 0x4f, 0x02, 0x5c, 0x2b, 0x52, 0xfc, 0x02, 0x79, 0x06, 0xda,
+	0x33, 0xdd, 0xb2, 0x81, 0x2c, 0x64, 0xef, 0x22, 0x77, 0xb5, 0x5c, 0x76, 0x11, 0xa5, 0x17, 0x8e,
+	0xbb, 0x06, 0x93, 0x2e, 0x13, 0x7c, 0xe3, 0x36, 0x15, 0xd9, 0xfa, 0x8e, 0x15, 0xa4, 0xb3, 0x86,
+	0x32, 0xc3, 0x15, 0xda, 0x08, 0xaa, 0x91, 0x76, 0x63, 0x1e, 0xd4, 0x27, 0x70, 0xab, 0x87, 0xb7,
+	0xfe, 0xe9, 0x72, 0xdf, 0x87, 0x20, 0x55, 0xa4, 0x58, 0x34, 0x00, 0x5a, 0x2e, 0xd7, 0x1b, 0xed,
+	0xa7, 0x39, 0x76, 0xeb, 0x48, 0x77, 0x7a, 0xc2, 0x51, 0x57, 0x0c, 0x53, 0x9d, 0x37, 0xd0, 0xed,
+	0x2e, 0xb5, 0x1d, 0x2c, 0x69, 0x79, 0x10, 0x56, 0xd4, 0xe8, 0x35, 0xa4, 0xe3, 0xa0, 0x78, 0xb3,
+	0x6f, 0xbd, 0x74, 0xb7, 0x2f, 0x25, 0xd2, 0x7f, 0x09, 0x13, 0xb1, 0xb3, 0xac, 0x74, 0x29, 0x6d,
+	0x25, 0x48, 0x8b, 0x7d, 0x08, 0x91, 0xf2, 0x36, 0x8c, 0xb7, 0x1e, 0x1d, 0xb9, 0x4b, 0x5d, 0x0b,
+	0x2e, 0x2d, 0xf4, 0xc6, 0x23, 0xd9, 0x77, 0x90, 0x39, 0x77, 0xbf, 0xde, 0xeb, 0xa2, 0x71, 0x1e,
+	0x59, 0xca, 0xff, 0x01, 0x39, 0xec, 0x5e, 0xd8, 0x3c, 0x3c, 0x91, 0x85, 0xa3, 0x13, 0x59, 0xf8,
+	0x79, 0x22, 0x0b, 0x1f, 0x4e, 0xe5, 0xc4, 0xd1, 0xa9, 0x9c, 0xf8, 0x71, 0x2a, 0x27, 0x5e, 0xe5,
+	0x5a, 0x0e, 0x6c, 0x53, 0x78, 0xa5, 0x6a, 0x96, 0x68, 0xf8, 0xa2, 0xef, 0x66, 0xf3, 0xfa, 0x7e,
+	0xf4, 0xf7, 0x23, 0x38, 0xc0, 0xa5, 0x4b, 0xec, 0x9a, 0xcb, 0xff, 0x1e, 0x00, 0x61, 0x9c, 0x2a,
+	0x96, 0x9d, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -626,6 +740,9 @@ type MsgClient interface {
 	// MsgEditLockup edits the existing lockups by lock ID
 	ExtendLockup(ctx context.Context, in *MsgExtendLockup, opts ...grpc.CallOption) (*MsgExtendLockupResponse, error)
 	ForceUnlock(ctx context.Context, in *MsgForceUnlock, opts ...grpc.CallOption) (*MsgForceUnlockResponse, error)
+	// SetRewardReceiverAddress sets the address that receives the incentives
+	// distributed to the lock.
+	SetRewardReceiverAddress(ctx context.Context, in *MsgSetRewardReceiverAddress, opts ...grpc.CallOption) (*MsgSetRewardReceiverAddressResponse, error)
 }
 
 type msgClient struct {
@@ -681,6 +798,15 @@ func (c *msgClient) ForceUnlock(ctx context.Context, in *MsgForceUnlock, opts ..
 	return out, nil
 }
 
+func (c *msgClient) SetRewardReceiverAddress(ctx context.Context, in *MsgSetRewardReceiverAddress, opts ...grpc.CallOption) (*MsgSetRewardReceiverAddressResponse, error) {
+	out := new(MsgSetRewardReceiverAddressResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Msg/SetRewardReceiverAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// LockTokens lock tokens
@@ -692,6 +818,9 @@ type MsgServer interface {
 	// MsgEditLockup edits the existing lockups by lock ID
 	ExtendLockup(context.Context, *MsgExtendLockup) (*MsgExtendLockupResponse, error)
 	ForceUnlock(context.Context, *MsgForceUnlock) (*MsgForceUnlockResponse, error)
+	// SetRewardReceiverAddress sets the address that receives the incentives
+	// distributed to the lock.
+	SetRewardReceiverAddress(context.Context, *MsgSetRewardReceiverAddress) (*MsgSetRewardReceiverAddressResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -713,6 +842,9 @@ func (*UnimplementedMsgServer) ExtendLockup(ctx context.Context, req *MsgExtendL
 func (*UnimplementedMsgServer) ForceUnlock(ctx context.Context, req *MsgForceUnlock) (*MsgForceUnlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForceUnlock not implemented")
 }
+func (*UnimplementedMsgServer) SetRewardReceiverAddress(ctx context.Context, req *MsgSetRewardReceiverAddress) (*MsgSetRewardReceiverAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRewardReceiverAddress not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -808,6 +940,24 @@ func _Msg_ForceUnlock_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetRewardReceiverAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRewardReceiverAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRewardReceiverAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Msg/SetRewardReceiverAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRewardReceiverAddress(ctx, req.(*MsgSetRewardReceiverAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.lockup.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -832,6 +982,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ForceUnlock",
 			Handler:    _Msg_ForceUnlock_Handler,
 		},
+		{
+			MethodName: "SetRewardReceiverAddress",
+			Handler:    _Msg_SetRewardReceiverAddress_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/lockup/tx.proto",
@@ -1224,6 +1378,81 @@ func (m *MsgForceUnlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetRewardReceiverAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRewardReceiverAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRewardReceiverAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardReceiver) > 0 {
+		i -= len(m.RewardReceiver)
+		copy(dAtA[i:], m.RewardReceiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardReceiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRewardReceiverAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRewardReceiverAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRewardReceiverAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1394,6 +1623,38 @@ func (m *MsgForceUnlockResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetRewardReceiverAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ID != 0 {
+		n += 1 + sovTx(uint64(m.ID))
+	}
+	l = len(m.RewardReceiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetRewardReceiverAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2398,6 +2659,209 @@ func (m *MsgForceUnlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetRewardReceiverAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRewardReceiverAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRewardReceiverAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRewardReceiverAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRewardReceiverAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRewardReceiverAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -19,6 +19,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdLockableDurations(),
 		GetCmdIncentivizedPools(),
 		GetCmdExternalIncentiveGauges(),
+		GetCmdPoolIncentiveStats(),
 	)
 
 	return cmd
@@ -75,3 +76,13 @@ func GetCmdExternalIncentiveGauges() *cobra.Command {
 {{.CommandPrefix}} external-incentivized-gauges
 `, types.ModuleName, types.NewQueryClient)
 }
+
+// GetCmdPoolIncentiveStats takes the pool id and returns the incentives of its LP shares for each lockable duration.
+func GetCmdPoolIncentiveStats() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.QueryPoolIncentiveStatsRequest](
+		"pool-incentive-stats [pool-id]",
+		"Query the incentives per epoch and locked shares of a pool for each lockable duration",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} pool-incentive-stats 1
+`, types.ModuleName, types.NewQueryClient)
+}
@@ -61,6 +61,12 @@ func (s *QueryTestSuite) TestQueriesNeverAlterState() {
 			&types.QueryLockableDurationsRequest{},
 			&types.QueryLockableDurationsResponse{},
 		},
+		{
+			"Query pool incentive stats",
+			"/osmosis.poolincentives.v1beta1.Query/PoolIncentiveStats",
+			&types.QueryPoolIncentiveStatsRequest{PoolId: 1},
+			&types.QueryPoolIncentiveStatsResponse{},
+		},
 		{
 			"Query params",
 			"/osmosis.poolincentives.v1beta1.Query/Params",
@@ -1,7 +1,10 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client/tx"
 
@@ -16,6 +19,12 @@ import (
 	"github.com/osmosis-labs/osmosis/v13/x/pool-incentives/types"
 )
 
+const (
+	FlagDuration  = "duration"
+	FlagStartTime = "start-time"
+	FlagEpochs    = "epochs"
+)
+
 func NewCmdSubmitUpdatePoolIncentivesProposal() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update-pool-incentives [gaugeIds] [weights]",
@@ -159,3 +168,89 @@ func NewCmdSubmitReplacePoolIncentivesProposal() *cobra.Command {
 
 	return cmd
 }
+
+func NewCmdSubmitCreateExternalIncentiveGaugeProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-external-incentive-gauge [poolId] [coins]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to create a gauge for a pool's LP shares funded from the community pool",
+		Example: `osmosisd tx gov submit-proposal create-external-incentive-gauge 1 10000uosmo --duration 168h --epochs 14 --start-time 2022-12-01T00:00:00Z \
+	--title "Incentivize pool 1" --description "..." --deposit 1600000000uosmo --from mykey`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			duration, err := cmd.Flags().GetDuration(FlagDuration)
+			if err != nil {
+				return err
+			}
+
+			var startTime time.Time
+			timeStr, err := cmd.Flags().GetString(FlagStartTime)
+			if err != nil {
+				return err
+			}
+			if timeStr == "" { // empty start time, start when the proposal executes
+				startTime = time.Time{}
+			} else if timeUnix, err := strconv.ParseInt(timeStr, 10, 64); err == nil { // unix time
+				startTime = time.Unix(timeUnix, 0)
+			} else if timeRFC, err := time.Parse(time.RFC3339, timeStr); err == nil { // RFC time
+				startTime = timeRFC
+			} else { // invalid input
+				return errors.New("invalid start time format")
+			}
+
+			epochs, err := cmd.Flags().GetUint64(FlagEpochs)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			proposal, err := osmoutils.ParseProposalFlags(cmd.Flags())
+			if err != nil {
+				return fmt.Errorf("failed to parse proposal: %w", err)
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewCreateExternalIncentiveGaugeProposal(proposal.Title, proposal.Description, poolId, duration, coins, startTime, epochs)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "The proposal title")
+	cmd.Flags().String(govcli.FlagDescription, "", "The proposal description")
+	cmd.Flags().String(govcli.FlagDeposit, "", "The proposal deposit")
+	cmd.Flags().String(govcli.FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
+	cmd.Flags().Duration(FlagDuration, 0, "The lockable duration of the pool's LP share locks the gauge distributes to")
+	cmd.Flags().String(FlagStartTime, "", "Timestamp to begin distribution, defaults to when the proposal executes")
+	cmd.Flags().Uint64(FlagEpochs, 0, "Total epochs to distribute tokens")
+
+	return cmd
+}
@@ -8,6 +8,7 @@ import (
 )
 
 var (
-	UpdatePoolIncentivesHandler  = govclient.NewProposalHandler(cli.NewCmdSubmitUpdatePoolIncentivesProposal, rest.ProposalUpdatePoolIncentivesRESTHandler)
-	ReplacePoolIncentivesHandler = govclient.NewProposalHandler(cli.NewCmdSubmitReplacePoolIncentivesProposal, rest.ProposalReplacePoolIncentivesRESTHandler)
+	UpdatePoolIncentivesHandler         = govclient.NewProposalHandler(cli.NewCmdSubmitUpdatePoolIncentivesProposal, rest.ProposalUpdatePoolIncentivesRESTHandler)
+	ReplacePoolIncentivesHandler        = govclient.NewProposalHandler(cli.NewCmdSubmitReplacePoolIncentivesProposal, rest.ProposalReplacePoolIncentivesRESTHandler)
+	CreateExternalIncentiveGaugeHandler = govclient.NewProposalHandler(cli.NewCmdSubmitCreateExternalIncentiveGaugeProposal, rest.ProposalCreateExternalIncentiveGaugeRESTHandler)
 )
@@ -2,6 +2,7 @@ package rest
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -107,3 +108,55 @@ func newReplacePoolIncentivesHandler(clientCtx client.Context) http.HandlerFunc
 		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
 	}
 }
+
+type CreateExternalIncentiveGaugeRequest struct {
+	BaseReq           rest.BaseReq  `json:"base_req" yaml:"base_req"`
+	Title             string        `json:"title" yaml:"title"`
+	Description       string        `json:"description" yaml:"description"`
+	Deposit           sdk.Coins     `json:"deposit" yaml:"deposit"`
+	PoolId            uint64        `json:"pool_id" yaml:"pool_id"`
+	LockableDuration  time.Duration `json:"lockable_duration" yaml:"lockable_duration"`
+	Coins             sdk.Coins     `json:"coins" yaml:"coins"`
+	StartTime         time.Time     `json:"start_time" yaml:"start_time"`
+	NumEpochsPaidOver uint64        `json:"num_epochs_paid_over" yaml:"num_epochs_paid_over"`
+}
+
+// ProposalCreateExternalIncentiveGaugeRESTHandler returns the create external incentive gauge governance proposal handler.
+func ProposalCreateExternalIncentiveGaugeRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "create-external-incentive-gauge",
+		Handler:  newCreateExternalIncentiveGaugeHandler(clientCtx),
+	}
+}
+
+// newCreateExternalIncentiveGaugeHandler creates a handler for external incentive gauge creations.
+func newCreateExternalIncentiveGaugeHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CreateExternalIncentiveGaugeRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewCreateExternalIncentiveGaugeProposal(req.Title, req.Description, req.PoolId, req.LockableDuration, req.Coins, req.StartTime, req.NumEpochsPaidOver)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
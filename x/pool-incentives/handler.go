@@ -17,6 +17,8 @@ func NewPoolIncentivesProposalHandler(k keeper.Keeper) govtypes.Handler {
 			return handleUpdatePoolIncentivesProposal(ctx, k, c)
 		case *types.ReplacePoolIncentivesProposal:
 			return handleReplacePoolIncentivesProposal(ctx, k, c)
+		case *types.CreateExternalIncentiveGaugeProposal:
+			return handleCreateExternalIncentiveGaugeProposal(ctx, k, c)
 
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized pool incentives proposal content type: %T", c)
@@ -33,3 +35,8 @@ func handleReplacePoolIncentivesProposal(ctx sdk.Context, k keeper.Keeper, p *ty
 func handleUpdatePoolIncentivesProposal(ctx sdk.Context, k keeper.Keeper, p *types.UpdatePoolIncentivesProposal) error {
 	return k.HandleUpdatePoolIncentivesProposal(ctx, p)
 }
+
+// handleCreateExternalIncentiveGaugeProposal is a handler for governance proposals creating external incentive gauges
+func handleCreateExternalIncentiveGaugeProposal(ctx sdk.Context, k keeper.Keeper, p *types.CreateExternalIncentiveGaugeProposal) error {
+	return k.HandleCreateExternalIncentiveGaugeProposal(ctx, p)
+}
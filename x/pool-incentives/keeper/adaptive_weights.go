@@ -0,0 +1,229 @@
+package keeper
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/osmosis/v13/osmoutils"
+	"github.com/osmosis-labs/osmosis/v13/x/pool-incentives/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetPoolVolumeSnapshot returns the cumulative swap fees of the provided pool at the last allocation
+// of the minted denom, and whether the pool has a snapshot.
+func (k Keeper) GetPoolVolumeSnapshot(ctx sdk.Context, poolId uint64) (sdk.Int, bool) {
+	store := ctx.KVStore(k.storeKey)
+	snapshot := types.PoolVolumeSnapshot{}
+	found, err := osmoutils.Get(store, types.GetPoolVolumeSnapshotStoreKey(poolId), &snapshot)
+	if err != nil {
+		panic(err)
+	}
+	if !found {
+		return sdk.ZeroInt(), false
+	}
+	return snapshot.CumulativeSwapFees, true
+}
+
+// SetPoolVolumeSnapshot sets the volume snapshot of a pool.
+func (k Keeper) SetPoolVolumeSnapshot(ctx sdk.Context, snapshot types.PoolVolumeSnapshot) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.GetPoolVolumeSnapshotStoreKey(snapshot.PoolId), &snapshot)
+}
+
+// GetAllPoolVolumeSnapshots returns the volume snapshots of all pools, in increasing pool ID order.
+func (k Keeper) GetAllPoolVolumeSnapshots(ctx sdk.Context) []types.PoolVolumeSnapshot {
+	store := ctx.KVStore(k.storeKey)
+	snapshots, err := osmoutils.GatherValuesFromStorePrefix(store, types.PoolVolumeSnapshotPrefix, func(bz []byte) (types.PoolVolumeSnapshot, error) {
+		snapshot := types.PoolVolumeSnapshot{}
+		err := proto.Unmarshal(bz, &snapshot)
+		return snapshot, err
+	})
+	if err != nil {
+		panic(err)
+	}
+	return snapshots
+}
+
+// GetAdaptiveDistrInfo returns the provided distr info with the AdaptiveWeightFraction of the weight of its pool gauges
+// redistributed among their pools, in proportion to the swap fees each pool earned since its volume snapshot.
+// Each pool's share of the redistributed weight is bounded by the AdaptivePoolShareBounds param,
+// and is split among the pool's gauges in proportion to their weights.
+// Gauges that are not pool gauges, and the gauges of pools in AdaptiveWeightExcludedPools, keep their weights.
+// The distr info is returned unchanged if adaptive weights are disabled or none of the pools earned swap fees.
+func (k Keeper) GetAdaptiveDistrInfo(ctx sdk.Context, distrInfo types.DistrInfo) types.DistrInfo {
+	params := k.GetParams(ctx)
+	if !params.IsAdaptiveWeightsEnabled() {
+		return distrInfo
+	}
+
+	excludedPools := make(map[uint64]bool)
+	for _, poolId := range params.AdaptiveWeightExcludedPools {
+		excludedPools[poolId] = true
+	}
+
+	// sum the weights of the gauges of each pool, and only adapt the pools that are incentivized.
+	recordPoolIds := k.getRecordPoolIds(ctx, distrInfo.Records)
+	poolWeights := make(map[uint64]sdk.Int)
+	for _, record := range distrInfo.Records {
+		poolId, ok := recordPoolIds[record.GaugeId]
+		if !ok || excludedPools[poolId] {
+			continue
+		}
+		if _, ok := poolWeights[poolId]; !ok {
+			poolWeights[poolId] = sdk.ZeroInt()
+		}
+		poolWeights[poolId] = poolWeights[poolId].Add(record.Weight)
+	}
+	poolIds := []uint64{}
+	totalPoolWeight := sdk.ZeroInt()
+	for poolId, poolWeight := range poolWeights {
+		if poolWeight.IsPositive() {
+			poolIds = append(poolIds, poolId)
+			totalPoolWeight = totalPoolWeight.Add(poolWeight)
+		}
+	}
+	osmoutils.SortSlice(poolIds)
+
+	poolFees := make([]sdk.Dec, len(poolIds))
+	totalFees := sdk.ZeroDec()
+	for i, poolId := range poolIds {
+		poolFees[i] = k.getTrailingSwapFees(ctx, poolId)
+		totalFees = totalFees.Add(poolFees[i])
+	}
+	if !totalFees.IsPositive() {
+		return distrInfo
+	}
+
+	adaptiveWeight := totalPoolWeight.ToDec().Mul(params.AdaptiveWeightFraction)
+	poolAdaptiveWeights := make(map[uint64]sdk.Dec)
+	for i, share := range boundedProportionalShares(poolFees, params.AdaptivePoolShareBounds.Min, params.AdaptivePoolShareBounds.Max) {
+		poolAdaptiveWeights[poolIds[i]] = adaptiveWeight.Mul(share)
+	}
+
+	staticFraction := sdk.OneDec().Sub(params.AdaptiveWeightFraction)
+	records := make([]types.DistrRecord, 0, len(distrInfo.Records))
+	totalWeight := sdk.ZeroInt()
+	for _, record := range distrInfo.Records {
+		weight := record.Weight
+		poolId, isPoolGauge := recordPoolIds[record.GaugeId]
+		if poolAdaptiveWeight, ok := poolAdaptiveWeights[poolId]; isPoolGauge && ok {
+			weight = record.Weight.ToDec().Mul(staticFraction).
+				Add(poolAdaptiveWeight.MulInt(record.Weight).Quo(poolWeights[poolId].ToDec())).
+				TruncateInt()
+		}
+		records = append(records, types.DistrRecord{GaugeId: record.GaugeId, Weight: weight})
+		totalWeight = totalWeight.Add(weight)
+	}
+
+	return types.DistrInfo{
+		TotalWeight: totalWeight,
+		Records:     records,
+	}
+}
+
+// updatePoolVolumeSnapshots sets the volume snapshots of the pools of the provided records
+// to their current cumulative swap fees.
+func (k Keeper) updatePoolVolumeSnapshots(ctx sdk.Context, records []types.DistrRecord) {
+	poolIds := []uint64{}
+	for _, poolId := range k.getRecordPoolIds(ctx, records) {
+		poolIds = append(poolIds, poolId)
+	}
+	osmoutils.SortSlice(poolIds)
+
+	for _, poolId := range poolIds {
+		k.SetPoolVolumeSnapshot(ctx, types.PoolVolumeSnapshot{
+			PoolId:             poolId,
+			CumulativeSwapFees: k.incentivesKeeper.GetPoolSwapFees(ctx, poolId),
+		})
+	}
+}
+
+// getRecordPoolIds returns the pool IDs of the provided records' gauges, keyed by gauge ID.
+// Records of the community pool and of gauges that are not pool gauges are omitted.
+func (k Keeper) getRecordPoolIds(ctx sdk.Context, records []types.DistrRecord) map[uint64]uint64 {
+	lockableDurations := k.GetLockableDurations(ctx)
+	recordPoolIds := make(map[uint64]uint64)
+	for _, record := range records {
+		if record.GaugeId == 0 {
+			continue
+		}
+		for _, lockableDuration := range lockableDurations {
+			poolId, err := k.GetPoolIdFromGaugeId(ctx, record.GaugeId, lockableDuration)
+			if err == nil {
+				recordPoolIds[record.GaugeId] = poolId
+				break
+			}
+		}
+	}
+	return recordPoolIds
+}
+
+// getTrailingSwapFees returns the swap fees the provided pool earned since its volume snapshot,
+// as tracked by the incentives module and valued in the base denom at the twap.
+// Returns zero if the pool has no snapshot yet.
+func (k Keeper) getTrailingSwapFees(ctx sdk.Context, poolId uint64) sdk.Dec {
+	snapshot, found := k.GetPoolVolumeSnapshot(ctx, poolId)
+	if !found {
+		return sdk.ZeroDec()
+	}
+	// the cumulative swap fees only decrease if they were reset, e.g. through genesis.
+	swapFees := k.incentivesKeeper.GetPoolSwapFees(ctx, poolId).Sub(snapshot)
+	if !swapFees.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	return swapFees.ToDec()
+}
+
+// boundedProportionalShares splits one into shares in proportion to the provided weights, with each share
+// between minShare and maxShare. Every share is at least minShare, or an equal split if minShare can not be
+// given to all, and the rest is split in proportion to the weights, capping the shares that reach maxShare
+// and splitting their excess among the others. The shares sum to less than one if all shares are capped.
+func boundedProportionalShares(weights []sdk.Dec, minShare, maxShare sdk.Dec) []sdk.Dec {
+	if len(weights) == 0 {
+		return []sdk.Dec{}
+	}
+
+	floor := sdk.MinDec(minShare, sdk.OneDec().QuoInt64(int64(len(weights))))
+	shareCap := sdk.MaxDec(maxShare, floor)
+	remaining := sdk.OneDec().Sub(floor.MulInt64(int64(len(weights))))
+
+	shares := make([]sdk.Dec, len(weights))
+	uncapped := []int{}
+	for i, weight := range weights {
+		shares[i] = floor
+		if weight.IsPositive() {
+			uncapped = append(uncapped, i)
+		}
+	}
+
+	for remaining.IsPositive() && len(uncapped) > 0 {
+		totalWeight := sdk.ZeroDec()
+		for _, i := range uncapped {
+			totalWeight = totalWeight.Add(weights[i])
+		}
+
+		capped, stillUncapped := []int{}, []int{}
+		for _, i := range uncapped {
+			if shares[i].Add(remaining.Mul(weights[i]).Quo(totalWeight)).GTE(shareCap) {
+				capped = append(capped, i)
+			} else {
+				stillUncapped = append(stillUncapped, i)
+			}
+		}
+
+		// if no share reaches the cap, the rest is split in proportion to the weights.
+		if len(capped) == 0 {
+			for _, i := range uncapped {
+				shares[i] = shares[i].Add(remaining.Mul(weights[i]).Quo(totalWeight))
+			}
+			break
+		}
+
+		for _, i := range capped {
+			remaining = remaining.Sub(shareCap.Sub(shares[i]))
+			shares[i] = shareCap
+		}
+		uncapped = stillUncapped
+	}
+	return shares
+}
@@ -0,0 +1,256 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v13/x/pool-incentives/keeper"
+	"github.com/osmosis-labs/osmosis/v13/x/pool-incentives/types"
+)
+
+// setupAdaptiveWeights creates three pools of the base denom with a 1% swap fee, and sets the DistrRecords to give
+// weight 1000 to the gauge of each pool at the shortest lockable duration and to the community pool.
+// The pools' volume snapshots are taken after the records are set.
+func (suite *KeeperTestSuite) setupAdaptiveWeights() ([]uint64, []uint64) {
+	poolIds := []uint64{}
+	gaugeIds := []uint64{}
+	records := []types.DistrRecord{{GaugeId: 0, Weight: sdk.NewInt(1000)}}
+	lockableDuration := suite.App.PoolIncentivesKeeper.GetLockableDurations(suite.Ctx)[0]
+	for i := 0; i < 3; i++ {
+		poolAssets := []balancer.PoolAsset{
+			{Weight: sdk.NewInt(1), Token: sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000)},
+			{Weight: sdk.NewInt(1), Token: sdk.NewInt64Coin("foo", 1000000)},
+		}
+		suite.FundAcc(suite.TestAccs[0], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000), sdk.NewInt64Coin("foo", 1000000)))
+		suite.FundAcc(suite.TestAccs[0], suite.App.GAMMKeeper.GetParams(suite.Ctx).PoolCreationFee)
+		msg := balancer.NewMsgCreateBalancerPool(suite.TestAccs[0], balancer.PoolParams{
+			SwapFee: sdk.NewDecWithPrec(1, 2),
+			ExitFee: sdk.ZeroDec(),
+		}, poolAssets, "")
+		poolId, err := suite.App.GAMMKeeper.CreatePool(suite.Ctx, msg)
+		suite.Require().NoError(err)
+		gaugeId, err := suite.App.PoolIncentivesKeeper.GetPoolGaugeId(suite.Ctx, poolId, lockableDuration)
+		suite.Require().NoError(err)
+		poolIds = append(poolIds, poolId)
+		gaugeIds = append(gaugeIds, gaugeId)
+		records = append(records, types.DistrRecord{GaugeId: gaugeId, Weight: sdk.NewInt(1000)})
+	}
+	err := suite.App.PoolIncentivesKeeper.ReplaceDistrRecords(suite.Ctx, records...)
+	suite.Require().NoError(err)
+
+	suite.App.PoolIncentivesKeeper.UpdatePoolVolumeSnapshots(suite.Ctx, records)
+	return poolIds, gaugeIds
+}
+
+func (suite *KeeperTestSuite) TestGetAdaptiveDistrInfo() {
+	tests := []struct {
+		name            string
+		fraction        sdk.Dec
+		minShare        sdk.Dec
+		maxShare        sdk.Dec
+		excludedPools   []uint64
+		volumes         []int64
+		expectedWeights []int64
+	}{
+		{
+			name:            "adaptive weights disabled",
+			fraction:        sdk.ZeroDec(),
+			volumes:         []int64{1000, 3000, 0},
+			expectedWeights: []int64{1000, 1000, 1000},
+		},
+		{
+			name:            "no pool earned swap fees",
+			fraction:        sdk.NewDecWithPrec(5, 1),
+			volumes:         []int64{0, 0, 0},
+			expectedWeights: []int64{1000, 1000, 1000},
+		},
+		{
+			// the adaptive weight of 1500 is split 1:3 between pools 1 and 2.
+			name:            "weights proportional to swap fees",
+			fraction:        sdk.NewDecWithPrec(5, 1),
+			volumes:         []int64{1000, 3000, 0},
+			expectedWeights: []int64{500 + 375, 500 + 1125, 500},
+		},
+		{
+			// pool 2 is capped at 60% of the adaptive weight, and the rest goes to pool 1.
+			name:            "max pool share",
+			fraction:        sdk.NewDecWithPrec(5, 1),
+			maxShare:        sdk.NewDecWithPrec(6, 1),
+			volumes:         []int64{1000, 3000, 0},
+			expectedWeights: []int64{500 + 600, 500 + 900, 500},
+		},
+		{
+			// every pool gets 10% of the adaptive weight, and the remaining 70% is split 1:3 between pools 1 and 2.
+			name:            "min pool share",
+			fraction:        sdk.NewDecWithPrec(5, 1),
+			minShare:        sdk.NewDecWithPrec(1, 1),
+			volumes:         []int64{1000, 3000, 0},
+			expectedWeights: []int64{500 + 412, 500 + 937, 500 + 150},
+		},
+		{
+			// pool 2 keeps its weight, and pools 1 and 3 share an adaptive weight of 1000.
+			name:            "excluded pool",
+			fraction:        sdk.NewDecWithPrec(5, 1),
+			excludedPools:   []uint64{2},
+			volumes:         []int64{1000, 3000, 0},
+			expectedWeights: []int64{500 + 1000, 1000, 500},
+		},
+		{
+			name:            "all weights adaptive",
+			fraction:        sdk.OneDec(),
+			volumes:         []int64{1000, 3000, 0},
+			expectedWeights: []int64{750, 2250, 0},
+		},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			suite.SetupTest()
+			keeper := suite.App.PoolIncentivesKeeper
+
+			poolIds, gaugeIds := suite.setupAdaptiveWeights()
+			for i, poolId := range poolIds {
+				if test.volumes[i] > 0 {
+					suite.swap(poolId, sdk.NewInt64Coin(sdk.DefaultBondDenom, test.volumes[i]), "foo")
+				}
+			}
+
+			params := keeper.GetParams(suite.Ctx)
+			params.AdaptiveWeightFraction = test.fraction
+			if !test.minShare.IsNil() {
+				params.AdaptivePoolShareBounds.Min = test.minShare
+			}
+			if !test.maxShare.IsNil() {
+				params.AdaptivePoolShareBounds.Max = test.maxShare
+			}
+			params.AdaptiveWeightExcludedPools = test.excludedPools
+			keeper.SetParams(suite.Ctx, params)
+
+			distrInfo := keeper.GetAdaptiveDistrInfo(suite.Ctx, keeper.GetDistrInfo(suite.Ctx))
+
+			expectedRecords := []types.DistrRecord{{GaugeId: 0, Weight: sdk.NewInt(1000)}}
+			expectedTotalWeight := sdk.NewInt(1000)
+			for i, gaugeId := range gaugeIds {
+				expectedRecords = append(expectedRecords, types.DistrRecord{GaugeId: gaugeId, Weight: sdk.NewInt(test.expectedWeights[i])})
+				expectedTotalWeight = expectedTotalWeight.Add(sdk.NewInt(test.expectedWeights[i]))
+			}
+			suite.Require().Equal(expectedRecords, distrInfo.Records)
+			suite.Require().Equal(expectedTotalWeight, distrInfo.TotalWeight)
+
+			// the governance set weights are not changed.
+			for _, record := range keeper.GetDistrInfo(suite.Ctx).Records {
+				suite.Require().Equal(sdk.NewInt(1000), record.Weight)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAllocateAssetWithAdaptiveWeights() {
+	suite.SetupTest()
+	keeper := suite.App.PoolIncentivesKeeper
+
+	poolIds, gaugeIds := suite.setupAdaptiveWeights()
+	suite.swap(poolIds[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), "foo")
+	suite.swap(poolIds[1], sdk.NewInt64Coin(sdk.DefaultBondDenom, 3000), "foo")
+
+	params := keeper.GetParams(suite.Ctx)
+	params.MintedDenom = "uosmo"
+	params.AdaptiveWeightFraction = sdk.NewDecWithPrec(5, 1)
+	keeper.SetParams(suite.Ctx, params)
+
+	// the weights are 1000 for the community pool, and 875, 1625 and 500 for the pool gauges.
+	mintedCoins := sdk.NewCoins(sdk.NewInt64Coin("uosmo", 40000))
+	suite.FundModuleAcc(types.ModuleName, mintedCoins)
+	err := keeper.AllocateAsset(suite.Ctx)
+	suite.Require().NoError(err)
+
+	for i, expectedAmount := range []int64{8750, 16250, 5000} {
+		gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeIds[i])
+		suite.Require().NoError(err)
+		suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uosmo", expectedAmount)), gauge.Coins)
+	}
+
+	// the volume snapshots are taken at the allocation, so the next allocation uses the swap fees since then.
+	for _, poolId := range poolIds {
+		snapshot, found := keeper.GetPoolVolumeSnapshot(suite.Ctx, poolId)
+		suite.Require().True(found)
+		suite.Require().Equal(suite.App.IncentivesKeeper.GetPoolSwapFees(suite.Ctx, poolId), snapshot)
+	}
+	distrInfo := keeper.GetAdaptiveDistrInfo(suite.Ctx, keeper.GetDistrInfo(suite.Ctx))
+	suite.Require().Equal(keeper.GetDistrInfo(suite.Ctx), distrInfo)
+}
+
+func (suite *KeeperTestSuite) TestBoundedProportionalShares() {
+	tests := []struct {
+		name           string
+		weights        []sdk.Dec
+		minShare       sdk.Dec
+		maxShare       sdk.Dec
+		expectedShares []sdk.Dec
+	}{
+		{
+			name:           "no weights",
+			weights:        []sdk.Dec{},
+			minShare:       sdk.ZeroDec(),
+			maxShare:       sdk.OneDec(),
+			expectedShares: []sdk.Dec{},
+		},
+		{
+			name:           "unbounded",
+			weights:        []sdk.Dec{sdk.NewDec(1), sdk.NewDec(3), sdk.ZeroDec()},
+			minShare:       sdk.ZeroDec(),
+			maxShare:       sdk.OneDec(),
+			expectedShares: []sdk.Dec{sdk.NewDecWithPrec(25, 2), sdk.NewDecWithPrec(75, 2), sdk.ZeroDec()},
+		},
+		{
+			name:           "capped share excess is split among the others",
+			weights:        []sdk.Dec{sdk.NewDec(1), sdk.NewDec(1), sdk.NewDec(8)},
+			minShare:       sdk.ZeroDec(),
+			maxShare:       sdk.NewDecWithPrec(5, 1),
+			expectedShares: []sdk.Dec{sdk.NewDecWithPrec(25, 2), sdk.NewDecWithPrec(25, 2), sdk.NewDecWithPrec(5, 1)},
+		},
+		{
+			name:           "excess of a capped share caps another share",
+			weights:        []sdk.Dec{sdk.NewDec(1), sdk.NewDec(3), sdk.NewDec(6)},
+			minShare:       sdk.ZeroDec(),
+			maxShare:       sdk.NewDecWithPrec(4, 1),
+			expectedShares: []sdk.Dec{sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(4, 1), sdk.NewDecWithPrec(4, 1)},
+		},
+		{
+			name:           "all shares capped",
+			weights:        []sdk.Dec{sdk.NewDec(1), sdk.NewDec(3)},
+			minShare:       sdk.ZeroDec(),
+			maxShare:       sdk.NewDecWithPrec(3, 1),
+			expectedShares: []sdk.Dec{sdk.NewDecWithPrec(3, 1), sdk.NewDecWithPrec(3, 1)},
+		},
+		{
+			name:           "min share",
+			weights:        []sdk.Dec{sdk.NewDec(1), sdk.NewDec(3), sdk.ZeroDec(), sdk.ZeroDec()},
+			minShare:       sdk.NewDecWithPrec(1, 1),
+			maxShare:       sdk.OneDec(),
+			expectedShares: []sdk.Dec{sdk.NewDecWithPrec(25, 2), sdk.NewDecWithPrec(55, 2), sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(1, 1)},
+		},
+		{
+			name:           "min share that can not be given to all is an equal split",
+			weights:        []sdk.Dec{sdk.NewDec(1), sdk.NewDec(3)},
+			minShare:       sdk.NewDecWithPrec(8, 1),
+			maxShare:       sdk.OneDec(),
+			expectedShares: []sdk.Dec{sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1)},
+		},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			shares := keeper.BoundedProportionalShares(test.weights, test.minShare, test.maxShare)
+			suite.Require().Equal(test.expectedShares, shares)
+		})
+	}
+}
+
+// swap funds a test account with tokenIn and swaps it for tokenOutDenom on the provided pool.
+func (suite *KeeperTestSuite) swap(poolId uint64, tokenIn sdk.Coin, tokenOutDenom string) {
+	sender := suite.TestAccs[2]
+	suite.FundAcc(sender, sdk.NewCoins(tokenIn))
+	_, err := suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, sender, poolId, tokenIn, tokenOutDenom, sdk.OneInt())
+	suite.Require().NoError(err)
+}
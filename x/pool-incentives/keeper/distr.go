@@ -32,7 +32,10 @@ func (k Keeper) AllocateAsset(ctx sdk.Context) error {
 		return nil
 	}
 
-	distrInfo := k.GetDistrInfo(ctx)
+	// adapt the weights to the swap fees the pools earned since the previous allocation,
+	// then snapshot the pools' volumes for the next allocation.
+	distrInfo := k.GetAdaptiveDistrInfo(ctx, k.GetDistrInfo(ctx))
+	k.updatePoolVolumeSnapshots(ctx, distrInfo.Records)
 
 	if distrInfo.TotalWeight.IsZero() {
 		// If there are no records, put the asset to the community pool
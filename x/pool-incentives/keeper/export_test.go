@@ -0,0 +1,15 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/pool-incentives/types"
+)
+
+func BoundedProportionalShares(weights []sdk.Dec, minShare, maxShare sdk.Dec) []sdk.Dec {
+	return boundedProportionalShares(weights, minShare, maxShare)
+}
+
+func (k Keeper) UpdatePoolVolumeSnapshots(ctx sdk.Context, records []types.DistrRecord) {
+	k.updatePoolVolumeSnapshots(ctx, records)
+}
@@ -22,6 +22,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 			k.SetPoolGaugeId(ctx, record.PoolId, record.Duration, record.GaugeId)
 		}
 	}
+	for _, snapshot := range genState.PoolVolumeSnapshots {
+		k.SetPoolVolumeSnapshot(ctx, snapshot)
+	}
 }
 
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
@@ -44,9 +47,10 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	}
 
 	return &types.GenesisState{
-		Params:            k.GetParams(ctx),
-		LockableDurations: k.GetLockableDurations(ctx),
-		DistrInfo:         &distrInfo,
-		PoolToGauges:      &poolToGauges,
+		Params:              k.GetParams(ctx),
+		LockableDurations:   k.GetLockableDurations(ctx),
+		DistrInfo:           &distrInfo,
+		PoolToGauges:        &poolToGauges,
+		PoolVolumeSnapshots: k.GetAllPoolVolumeSnapshots(ctx),
 	}
 }
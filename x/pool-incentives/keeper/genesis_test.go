@@ -20,7 +20,13 @@ var (
 	now         = time.Now().UTC()
 	testGenesis = types.GenesisState{
 		Params: types.Params{
-			MintedDenom: "uosmo",
+			MintedDenom:            "uosmo",
+			AdaptiveWeightFraction: sdk.NewDecWithPrec(3, 1),
+			AdaptivePoolShareBounds: types.AdaptivePoolShareBounds{
+				Min: sdk.NewDecWithPrec(1, 2),
+				Max: sdk.NewDecWithPrec(2, 1),
+			},
+			AdaptiveWeightExcludedPools: []uint64{2},
 		},
 		LockableDurations: []time.Duration{
 			time.Second,
@@ -36,6 +42,16 @@ var (
 				},
 			},
 		},
+		PoolVolumeSnapshots: []types.PoolVolumeSnapshot{
+			{
+				PoolId:             1,
+				CumulativeSwapFees: sdk.NewInt(1000),
+			},
+			{
+				PoolId:             3,
+				CumulativeSwapFees: sdk.NewInt(0),
+			},
+		},
 	}
 )
 
@@ -77,6 +93,9 @@ func TestInitGenesis(t *testing.T) {
 
 	distrInfo := app.PoolIncentivesKeeper.GetDistrInfo(ctx)
 	require.Equal(t, distrInfo, *genesis.DistrInfo)
+
+	snapshots := app.PoolIncentivesKeeper.GetAllPoolVolumeSnapshots(ctx)
+	require.Equal(t, snapshots, genesis.PoolVolumeSnapshots)
 }
 
 func (suite *KeeperTestSuite) TestExportGenesis() {
@@ -113,4 +132,5 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	suite.Equal(genesisExported.LockableDurations, durations)
 	suite.Equal(genesisExported.DistrInfo, genesis.DistrInfo)
 	suite.Equal(genesisExported.PoolToGauges, &expectedPoolToGauges)
+	suite.Equal(genesisExported.PoolVolumeSnapshots, genesis.PoolVolumeSnapshots)
 }
@@ -1,8 +1,14 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
+	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
 	"github.com/osmosis-labs/osmosis/v13/x/pool-incentives/types"
 )
 
@@ -13,3 +19,66 @@ func (k Keeper) HandleReplacePoolIncentivesProposal(ctx sdk.Context, p *types.Re
 func (k Keeper) HandleUpdatePoolIncentivesProposal(ctx sdk.Context, p *types.UpdatePoolIncentivesProposal) error {
 	return k.UpdateDistrRecords(ctx, p.Records...)
 }
+
+// HandleCreateExternalIncentiveGaugeProposal withdraws the proposal's coins from the community pool
+// and creates a non-perpetual gauge with them, owned by the pool-incentives module account, that
+// distributes to the pool's LP shares locked for at least the proposal's lockable duration.
+// The gauge starts distributing at the proposal's start time, or when the proposal executes if unset.
+func (k Keeper) HandleCreateExternalIncentiveGaugeProposal(ctx sdk.Context, p *types.CreateExternalIncentiveGaugeProposal) error {
+	if p.PoolId == 0 || p.PoolId >= k.gammKeeper.GetNextPoolId(ctx) {
+		return sdkerrors.Wrapf(types.ErrNoPoolExist, "pool id (%d)", p.PoolId)
+	}
+	if !k.isLockableDuration(ctx, p.LockableDuration) {
+		return sdkerrors.Wrapf(types.ErrNotLockableDuration, "duration (%s)", p.LockableDuration)
+	}
+
+	if err := k.withdrawFromCommunityPool(ctx, p.Coins); err != nil {
+		return err
+	}
+
+	startTime := p.StartTime
+	if startTime.IsZero() {
+		startTime = ctx.BlockTime()
+	}
+	gaugeId, err := k.incentivesKeeper.CreateGauge(
+		ctx,
+		false,
+		k.accountKeeper.GetModuleAddress(types.ModuleName),
+		p.Coins,
+		lockuptypes.QueryCondition{
+			LockQueryType: lockuptypes.ByDuration,
+			Denom:         gammtypes.GetPoolShareDenom(p.PoolId),
+			Duration:      p.LockableDuration,
+		},
+		startTime,
+		p.NumEpochsPaidOver,
+	)
+	if err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("created external incentive gauge from the community pool", "gauge_id", gaugeId, "pool_id", p.PoolId, "coins", p.Coins.String())
+	return nil
+}
+
+// withdrawFromCommunityPool moves coins from the community pool to the pool-incentives module account.
+func (k Keeper) withdrawFromCommunityPool(ctx sdk.Context, coins sdk.Coins) error {
+	feePool := k.distrKeeper.GetFeePool(ctx)
+	newPool, negative := feePool.CommunityPool.SafeSub(sdk.NewDecCoinsFromCoins(coins...))
+	if negative {
+		return sdkerrors.Wrapf(types.ErrInsufficientCommunityPool, "requested %s, community pool has %s", coins, feePool.CommunityPool)
+	}
+	feePool.CommunityPool = newPool
+	k.distrKeeper.SetFeePool(ctx, feePool)
+
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, distrtypes.ModuleName, types.ModuleName, coins)
+}
+
+func (k Keeper) isLockableDuration(ctx sdk.Context, duration time.Duration) bool {
+	for _, lockableDuration := range k.GetLockableDurations(ctx) {
+		if lockableDuration == duration {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
+	"github.com/osmosis-labs/osmosis/v13/x/pool-incentives/types"
+)
+
+func (suite *KeeperTestSuite) TestHandleCreateExternalIncentiveGaugeProposal() {
+	communityPoolFunds := sdk.NewCoins(sdk.NewInt64Coin("uosmo", 10000), sdk.NewInt64Coin("foo", 10000))
+	gaugeCoins := sdk.NewCoins(sdk.NewInt64Coin("uosmo", 4000))
+	defaultStartTime := time.Unix(1700000000, 0).UTC()
+
+	tests := []struct {
+		name             string
+		poolId           uint64
+		durationIndex    int
+		lockableDuration time.Duration
+		coins            sdk.Coins
+		startTime        time.Time
+		expectedErr      error
+	}{
+		{
+			name:      "creates gauge starting at the given start time",
+			poolId:    1,
+			coins:     gaugeCoins,
+			startTime: defaultStartTime,
+		},
+		{
+			name:          "creates gauge starting when the proposal executes if start time is unset",
+			poolId:        1,
+			durationIndex: 2,
+			coins:         gaugeCoins,
+		},
+		{
+			name:      "creates gauge with multiple denoms",
+			poolId:    1,
+			coins:     communityPoolFunds,
+			startTime: defaultStartTime,
+		},
+		{
+			name:        "pool does not exist",
+			poolId:      2,
+			coins:       gaugeCoins,
+			startTime:   defaultStartTime,
+			expectedErr: types.ErrNoPoolExist,
+		},
+		{
+			name:             "duration is not a lockable duration",
+			poolId:           1,
+			lockableDuration: time.Hour * 2,
+			coins:            gaugeCoins,
+			startTime:        defaultStartTime,
+			expectedErr:      types.ErrNotLockableDuration,
+		},
+		{
+			name:        "community pool does not have enough funds",
+			poolId:      1,
+			coins:       sdk.NewCoins(sdk.NewInt64Coin("foo", 10001)),
+			startTime:   defaultStartTime,
+			expectedErr: types.ErrInsufficientCommunityPool,
+		},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			suite.SetupTest()
+			keeper := suite.App.PoolIncentivesKeeper
+
+			poolId := suite.PrepareBalancerPool()
+			suite.Require().Equal(uint64(1), poolId)
+
+			funder := suite.TestAccs[0]
+			suite.FundAcc(funder, communityPoolFunds)
+			err := suite.App.DistrKeeper.FundCommunityPool(suite.Ctx, communityPoolFunds, funder)
+			suite.Require().NoError(err)
+			communityPoolBefore := suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx)
+
+			lockableDuration := test.lockableDuration
+			if lockableDuration == 0 {
+				lockableDuration = keeper.GetLockableDurations(suite.Ctx)[test.durationIndex]
+			}
+			nextGaugeId := suite.App.IncentivesKeeper.GetLastGaugeID(suite.Ctx) + 1
+
+			proposal := types.NewCreateExternalIncentiveGaugeProposal("title", "description", test.poolId, lockableDuration, test.coins, test.startTime, 7)
+			err = keeper.HandleCreateExternalIncentiveGaugeProposal(suite.Ctx, proposal.(*types.CreateExternalIncentiveGaugeProposal))
+			if test.expectedErr != nil {
+				suite.Require().ErrorIs(err, test.expectedErr)
+				suite.Require().Equal(communityPoolBefore, suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx))
+				return
+			}
+			suite.Require().NoError(err)
+
+			// the coins are withdrawn from the community pool
+			communityPoolAfter := suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx)
+			suite.Require().Equal(communityPoolBefore.Sub(sdk.NewDecCoinsFromCoins(test.coins...)), communityPoolAfter)
+
+			expectedStartTime := test.startTime
+			if expectedStartTime.IsZero() {
+				expectedStartTime = suite.Ctx.BlockTime()
+			}
+			gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, nextGaugeId)
+			suite.Require().NoError(err)
+			suite.Require().False(gauge.IsPerpetual)
+			suite.Require().Equal(test.coins, gauge.Coins)
+			suite.Require().Equal(uint64(7), gauge.NumEpochsPaidOver)
+			suite.Require().Equal(expectedStartTime, gauge.StartTime)
+			suite.Require().Equal(lockuptypes.QueryCondition{
+				LockQueryType: lockuptypes.ByDuration,
+				Denom:         gammtypes.GetPoolShareDenom(test.poolId),
+				Duration:      lockableDuration,
+			}, gauge.DistributeTo)
+
+			// the gauge is not a pool gauge, so it is returned as an external incentive gauge
+			res, err := suite.queryClient.ExternalIncentiveGauges(context.Background(), &types.QueryExternalIncentiveGaugesRequest{})
+			suite.Require().NoError(err)
+			suite.Require().Len(res.Data, 1)
+			suite.Require().Equal(nextGaugeId, res.Data[0].Id)
+		})
+	}
+}
@@ -142,3 +142,18 @@ func (q Querier) ExternalIncentiveGauges(ctx context.Context, req *types.QueryEx
 
 	return &types.QueryExternalIncentiveGaugesResponse{Data: gauges}, nil
 }
+
+// PoolIncentiveStats returns the incentives of a pool's LP shares for each lockable duration.
+func (q Querier) PoolIncentiveStats(ctx context.Context, req *types.QueryPoolIncentiveStatsRequest) (*types.QueryPoolIncentiveStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	stats, err := q.Keeper.GetPoolIncentiveStats(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPoolIncentiveStatsResponse{Stats: stats}, nil
+}
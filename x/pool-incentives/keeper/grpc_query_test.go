@@ -7,6 +7,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
 	"github.com/osmosis-labs/osmosis/v13/x/pool-incentives/types"
 )
@@ -290,3 +291,86 @@ func (suite *KeeperTestSuite) TestGaugeIncentivePercentage() {
 	suite.Require().Equal("33.333333333333333300", res.GaugeIdsWithDuration[1].GaugeIncentivePercentage)
 	suite.Require().Equal("50.000000000000000000", res.GaugeIdsWithDuration[2].GaugeIncentivePercentage)
 }
+
+func (suite *KeeperTestSuite) TestPoolIncentiveStats() {
+	suite.SetupTest()
+
+	keeper := suite.App.PoolIncentivesKeeper
+	queryClient := suite.queryClient
+
+	// the pool does not exist yet
+	_, err := queryClient.PoolIncentiveStats(context.Background(), &types.QueryPoolIncentiveStatsRequest{PoolId: 1})
+	suite.Require().Error(err)
+
+	poolId := suite.PrepareBalancerPool()
+	// LockableDurations should be 1, 3, 7 hours from the default genesis state.
+	lockableDurations := keeper.GetLockableDurations(suite.Ctx)
+	suite.Require().Equal(3, len(lockableDurations))
+
+	gaugeIds := make([]uint64, len(lockableDurations))
+	// the community pool gets a weight of 400
+	records := []types.DistrRecord{{GaugeId: 0, Weight: sdk.NewInt(400)}}
+	for i, duration := range lockableDurations {
+		gaugeIds[i], err = keeper.GetPoolGaugeId(suite.Ctx, poolId, duration)
+		suite.Require().NoError(err)
+		records = append(records, types.DistrRecord{GaugeId: gaugeIds[i], Weight: sdk.NewInt(int64(100 * (i + 1)))})
+	}
+	err = keeper.UpdateDistrRecords(suite.Ctx, records...)
+	suite.Require().NoError(err)
+
+	// 1000000 minted per epoch, of which 30% go to pool incentives
+	mintParams := suite.App.MintKeeper.GetParams(suite.Ctx)
+	mintParams.DistributionProportions.PoolIncentives = sdk.NewDecWithPrec(3, 1)
+	suite.App.MintKeeper.SetParams(suite.Ctx, mintParams)
+	minter := suite.App.MintKeeper.GetMinter(suite.Ctx)
+	minter.EpochProvisions = sdk.NewDec(1000000)
+	suite.App.MintKeeper.SetMinter(suite.Ctx, minter)
+
+	// external gauges of the pool's LP shares
+	shareDenom := gammtypes.GetPoolShareDenom(poolId)
+	owner := suite.TestAccs[0]
+	externalCoins := sdk.NewCoins(sdk.NewInt64Coin("foo", 3000), sdk.NewInt64Coin("bar", 500), sdk.NewInt64Coin("baz", 700))
+	suite.FundAcc(owner, externalCoins)
+	distrTo := func(duration time.Duration) lockuptypes.QueryCondition {
+		return lockuptypes.QueryCondition{LockQueryType: lockuptypes.ByDuration, Denom: shareDenom, Duration: duration}
+	}
+	// 3000foo over 3 epochs at the second duration
+	_, err = suite.App.IncentivesKeeper.CreateGauge(suite.Ctx, notPerpetual, owner, sdk.NewCoins(sdk.NewInt64Coin("foo", 3000)), distrTo(lockableDurations[1]), suite.Ctx.BlockTime(), 3)
+	suite.Require().NoError(err)
+	// 500bar in the next epoch at the third duration
+	_, err = suite.App.IncentivesKeeper.CreateGauge(suite.Ctx, isPerpetual, owner, sdk.NewCoins(sdk.NewInt64Coin("bar", 500)), distrTo(lockableDurations[2]), suite.Ctx.BlockTime(), 1)
+	suite.Require().NoError(err)
+	// a gauge that has not started yet is not counted
+	_, err = suite.App.IncentivesKeeper.CreateGauge(suite.Ctx, isPerpetual, owner, sdk.NewCoins(sdk.NewInt64Coin("baz", 700)), distrTo(lockableDurations[2]), suite.Ctx.BlockTime().Add(time.Hour), 1)
+	suite.Require().NoError(err)
+
+	// locks of the pool's LP shares
+	suite.LockTokens(owner, sdk.NewCoins(sdk.NewInt64Coin(shareDenom, 10)), lockableDurations[0])
+	suite.LockTokens(owner, sdk.NewCoins(sdk.NewInt64Coin(shareDenom, 20)), lockableDurations[2])
+
+	res, err := queryClient.PoolIncentiveStats(context.Background(), &types.QueryPoolIncentiveStatsRequest{PoolId: poolId})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.DurationIncentiveStats{
+		{
+			Duration:              lockableDurations[0],
+			GaugeId:               gaugeIds[0],
+			MintedCoinsPerEpoch:   sdk.NewInt64Coin(mintParams.MintDenom, 30000),
+			ExternalCoinsPerEpoch: nil,
+			TotalLockedShares:     sdk.NewInt(30),
+		},
+		{
+			Duration:              lockableDurations[1],
+			GaugeId:               gaugeIds[1],
+			MintedCoinsPerEpoch:   sdk.NewInt64Coin(mintParams.MintDenom, 60000),
+			ExternalCoinsPerEpoch: sdk.NewCoins(sdk.NewInt64Coin("foo", 1000)),
+			TotalLockedShares:     sdk.NewInt(20),
+		},
+		{
+			Duration:              lockableDurations[2],
+			GaugeId:               gaugeIds[2],
+			MintedCoinsPerEpoch:   sdk.NewInt64Coin(mintParams.MintDenom, 90000),
+			ExternalCoinsPerEpoch: sdk.NewCoins(sdk.NewInt64Coin("bar", 500)),
+			TotalLockedShares:     sdk.NewInt(20),
+		},
+	}, res.Stats)
+}
@@ -36,7 +36,11 @@ func (h Hooks) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint
 }
 
 // AfterSwap hook is a noop.
-func (h Hooks) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+func (h Hooks) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins, swapFee sdk.Dec) {
+}
+
+// AfterPoolAssetsChanged hook is a noop.
+func (h Hooks) AfterPoolAssetsChanged(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
 }
 
 // Distribute coins after minter module allocate assets to pool-incentives module.
@@ -27,9 +27,11 @@ type Keeper struct {
 	incentivesKeeper types.IncentivesKeeper
 	distrKeeper      types.DistrKeeper
 	gammKeeper       types.GAMMKeeper
+	lockupKeeper     types.LockupKeeper
+	mintKeeper       types.MintKeeper
 }
 
-func NewKeeper(storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, incentivesKeeper types.IncentivesKeeper, distrKeeper types.DistrKeeper, gammKeeper types.GAMMKeeper) Keeper {
+func NewKeeper(storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, incentivesKeeper types.IncentivesKeeper, distrKeeper types.DistrKeeper, gammKeeper types.GAMMKeeper, lockupKeeper types.LockupKeeper, mintKeeper types.MintKeeper) Keeper {
 	// ensure pool-incentives module account is set
 	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
@@ -50,6 +52,8 @@ func NewKeeper(storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, accountKee
 		incentivesKeeper: incentivesKeeper,
 		distrKeeper:      distrKeeper,
 		gammKeeper:       gammKeeper,
+		lockupKeeper:     lockupKeeper,
+		mintKeeper:       mintKeeper,
 	}
 }
 
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	incentivestypes "github.com/osmosis-labs/osmosis/v13/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
+	"github.com/osmosis-labs/osmosis/v13/x/pool-incentives/types"
+)
+
+// GetPoolIncentiveStats returns the incentives of the provided pool's LP shares for each lockable duration.
+// The minted coins per epoch are projected from the current epoch provisions of the mint module, and the weights
+// the next allocation would use, which are adapted to the swap fees the pools earned so far if adaptive weights are enabled.
+// The external coins per epoch are the coins the started external gauges of the pool's LP shares at the duration
+// distribute in the next epoch, which is all of their remaining coins for perpetual gauges.
+// Returns an error if the pool has no gauge for a lockable duration.
+func (k Keeper) GetPoolIncentiveStats(ctx sdk.Context, poolId uint64) ([]types.DurationIncentiveStats, error) {
+	lockableDurations := k.GetLockableDurations(ctx)
+	gaugeIds := make(map[uint64]bool, len(lockableDurations))
+	for _, duration := range lockableDurations {
+		gaugeId, err := k.GetPoolGaugeId(ctx, poolId, duration)
+		if err != nil {
+			return nil, err
+		}
+		gaugeIds[gaugeId] = true
+	}
+
+	mintParams := k.mintKeeper.GetParams(ctx)
+	epochProvision := k.mintKeeper.GetMinter(ctx).EpochProvision(mintParams)
+	poolIncentivesAmount := epochProvision.Amount.ToDec().Mul(mintParams.DistributionProportions.PoolIncentives)
+	distrInfo := k.GetAdaptiveDistrInfo(ctx, k.GetDistrInfo(ctx))
+
+	shareDenom := gammtypes.GetPoolShareDenom(poolId)
+	externalGauges := []incentivestypes.Gauge{}
+	// upcoming gauges that already started begin distributing at the next epoch end.
+	gauges := k.incentivesKeeper.GetActiveGauges(ctx)
+	for _, gauge := range k.incentivesKeeper.GetUpcomingGauges(ctx) {
+		if !gauge.StartTime.After(ctx.BlockTime()) {
+			gauges = append(gauges, gauge)
+		}
+	}
+	for _, gauge := range gauges {
+		if gauge.DistributeTo.LockQueryType == lockuptypes.ByDuration && gauge.DistributeTo.Denom == shareDenom && !gaugeIds[gauge.Id] {
+			externalGauges = append(externalGauges, gauge)
+		}
+	}
+
+	stats := make([]types.DurationIncentiveStats, 0, len(lockableDurations))
+	for _, duration := range lockableDurations {
+		gaugeId, err := k.GetPoolGaugeId(ctx, poolId, duration)
+		if err != nil {
+			return nil, err
+		}
+
+		mintedAmount := sdk.ZeroInt()
+		if distrInfo.TotalWeight.IsPositive() {
+			for _, record := range distrInfo.Records {
+				if record.GaugeId == gaugeId {
+					mintedAmount = poolIncentivesAmount.Mul(record.Weight.ToDec().Quo(distrInfo.TotalWeight.ToDec())).TruncateInt()
+				}
+			}
+		}
+
+		externalCoins := sdk.Coins{}
+		for _, gauge := range externalGauges {
+			if gauge.DistributeTo.Duration == duration {
+				externalCoins = externalCoins.Add(getGaugeCoinsPerEpoch(gauge)...)
+			}
+		}
+
+		stats = append(stats, types.DurationIncentiveStats{
+			Duration:              duration,
+			GaugeId:               gaugeId,
+			MintedCoinsPerEpoch:   sdk.NewCoin(epochProvision.Denom, mintedAmount),
+			ExternalCoinsPerEpoch: externalCoins,
+			TotalLockedShares: k.lockupKeeper.GetPeriodLocksAccumulation(ctx, lockuptypes.QueryCondition{
+				LockQueryType: lockuptypes.ByDuration,
+				Denom:         shareDenom,
+				Duration:      duration,
+			}),
+		})
+	}
+	return stats, nil
+}
+
+// getGaugeCoinsPerEpoch returns the coins the gauge distributes in the next epoch, which are its remaining
+// coins split over its remaining epochs, or all of its remaining coins if the gauge is perpetual.
+func getGaugeCoinsPerEpoch(gauge incentivestypes.Gauge) sdk.Coins {
+	remainEpochs := gauge.RemainEpochs()
+	if remainEpochs == 0 {
+		return sdk.Coins{}
+	}
+
+	coinsPerEpoch := sdk.Coins{}
+	for _, coin := range gauge.Coins.Sub(gauge.DistributedCoins) {
+		coinsPerEpoch = coinsPerEpoch.Add(sdk.NewCoin(coin.Denom, coin.Amount.QuoRaw(int64(remainEpochs))))
+	}
+	return coinsPerEpoch
+}
@@ -8,11 +8,13 @@ import (
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&UpdatePoolIncentivesProposal{}, "osmosis/UpdatePoolIncentivesProposal", nil)
+	cdc.RegisterConcrete(&CreateExternalIncentiveGaugeProposal{}, "osmosis/CreateExternalIncentiveGaugeProposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
 		&UpdatePoolIncentivesProposal{},
+		&CreateExternalIncentiveGaugeProposal{},
 	)
 }
@@ -12,6 +12,13 @@ var (
 	ErrDistrRecordRegisteredGauge    = sdkerrors.Register(ModuleName, 4, "gauge was already registered")
 	ErrDistrRecordNotSorted          = sdkerrors.Register(ModuleName, 5, "gauges are not sorted")
 
-	ErrEmptyProposalRecords  = sdkerrors.Register(ModuleName, 10, "records are empty")
-	ErrEmptyProposalGaugeIds = sdkerrors.Register(ModuleName, 11, "gauge ids are empty")
+	ErrEmptyProposalRecords     = sdkerrors.Register(ModuleName, 10, "records are empty")
+	ErrEmptyProposalGaugeIds    = sdkerrors.Register(ModuleName, 11, "gauge ids are empty")
+	ErrEmptyProposalCoins       = sdkerrors.Register(ModuleName, 12, "coins are empty")
+	ErrInvalidProposalPoolId    = sdkerrors.Register(ModuleName, 13, "pool id should be positive")
+	ErrInvalidProposalNumEpochs = sdkerrors.Register(ModuleName, 14, "num epochs paid over should be positive")
+
+	ErrNoPoolExist               = sdkerrors.Register(ModuleName, 20, "pool does not exist")
+	ErrNotLockableDuration       = sdkerrors.Register(ModuleName, 21, "duration is not a lockable duration")
+	ErrInsufficientCommunityPool = sdkerrors.Register(ModuleName, 22, "community pool does not have enough funds")
 )
@@ -9,6 +9,7 @@ import (
 
 	incentivestypes "github.com/osmosis-labs/osmosis/v13/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
+	minttypes "github.com/osmosis-labs/osmosis/v13/x/mint/types"
 )
 
 // AccountKeeper interface contains functions for getting accounts and the module address
@@ -20,6 +21,7 @@ type AccountKeeper interface {
 // BankKeeper sends tokens across modules and is able to get account balances.
 type BankKeeper interface {
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 }
 
 // GAMMKeeper gets the pool interface from poolID.
@@ -32,12 +34,27 @@ type IncentivesKeeper interface {
 	CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddress, coins sdk.Coins, distrTo lockuptypes.QueryCondition, startTime time.Time, numEpochsPaidOver uint64) (uint64, error)
 	GetGaugeByID(ctx sdk.Context, gaugeID uint64) (*incentivestypes.Gauge, error)
 	GetGauges(ctx sdk.Context) []incentivestypes.Gauge
+	GetActiveGauges(ctx sdk.Context) []incentivestypes.Gauge
+	GetUpcomingGauges(ctx sdk.Context) []incentivestypes.Gauge
+	GetPoolSwapFees(ctx sdk.Context, poolId uint64) sdk.Int
 
 	AddToGaugeRewards(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins, gaugeID uint64) error
 }
 
 // DistrKeeper handles pool-fees functionality - setting / getting fees and funding the community pool.
 type DistrKeeper interface {
+	GetFeePool(ctx sdk.Context) (feePool distrtypes.FeePool)
 	SetFeePool(ctx sdk.Context, feePool distrtypes.FeePool)
 	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
+
+// LockupKeeper gets the amounts locked for at least a duration.
+type LockupKeeper interface {
+	GetPeriodLocksAccumulation(ctx sdk.Context, query lockuptypes.QueryCondition) sdk.Int
+}
+
+// MintKeeper gets the epoch provisions and the proportion of them allocated to pool incentives.
+type MintKeeper interface {
+	GetMinter(ctx sdk.Context) minttypes.Minter
+	GetParams(ctx sdk.Context) minttypes.Params
+}
@@ -30,10 +30,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the pool incentives module's genesis state.
 type GenesisState struct {
 	// params defines all the paramaters of the module.
-	Params            Params          `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	LockableDurations []time.Duration `protobuf:"bytes,2,rep,name=lockable_durations,json=lockableDurations,proto3,stdduration" json:"lockable_durations" yaml:"lockable_durations"`
-	DistrInfo         *DistrInfo      `protobuf:"bytes,3,opt,name=distr_info,json=distrInfo,proto3" json:"distr_info,omitempty" yaml:"distr_info"`
-	PoolToGauges      *PoolToGauges   `protobuf:"bytes,4,opt,name=pool_to_gauges,json=poolToGauges,proto3" json:"pool_to_gauges,omitempty" yaml:"pool_to_gauges"`
+	Params              Params               `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	LockableDurations   []time.Duration      `protobuf:"bytes,2,rep,name=lockable_durations,json=lockableDurations,proto3,stdduration" json:"lockable_durations" yaml:"lockable_durations"`
+	DistrInfo           *DistrInfo           `protobuf:"bytes,3,opt,name=distr_info,json=distrInfo,proto3" json:"distr_info,omitempty" yaml:"distr_info"`
+	PoolToGauges        *PoolToGauges        `protobuf:"bytes,4,opt,name=pool_to_gauges,json=poolToGauges,proto3" json:"pool_to_gauges,omitempty" yaml:"pool_to_gauges"`
+	PoolVolumeSnapshots []PoolVolumeSnapshot `protobuf:"bytes,5,rep,name=pool_volume_snapshots,json=poolVolumeSnapshots,proto3" json:"pool_volume_snapshots" yaml:"pool_volume_snapshots"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -97,6 +98,13 @@ func (m *GenesisState) GetPoolToGauges() *PoolToGauges {
 	return nil
 }
 
+func (m *GenesisState) GetPoolVolumeSnapshots() []PoolVolumeSnapshot {
+	if m != nil {
+		return m.PoolVolumeSnapshots
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.poolincentives.v1beta1.GenesisState")
 }
@@ -106,32 +114,35 @@ func init() {
 }
 
 var fileDescriptor_cc1f078212600632 = []byte{
-	// 392 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcd, 0xaa, 0xd3, 0x40,
-	0x14, 0xc7, 0x13, 0x7b, 0xb9, 0x60, 0xee, 0x45, 0xb8, 0x41, 0x21, 0xbd, 0xe0, 0xa4, 0x04, 0x94,
-	0x0a, 0x76, 0xc6, 0xb6, 0x3b, 0xdd, 0x85, 0x42, 0x71, 0x27, 0x51, 0x37, 0x6e, 0xc2, 0x24, 0x9d,
-	0x8e, 0x83, 0x49, 0x4e, 0xcc, 0x4c, 0x8a, 0x7d, 0x0b, 0x97, 0x6e, 0x7c, 0x9f, 0x2e, 0xbb, 0x74,
-	0x15, 0xa5, 0x7d, 0x83, 0x3e, 0x81, 0x64, 0x92, 0xd0, 0x4a, 0xc1, 0xee, 0x32, 0x9c, 0xdf, 0xff,
-	0xe3, 0x1c, 0x62, 0x8d, 0x40, 0xa6, 0x20, 0x85, 0x24, 0x39, 0x40, 0x32, 0x12, 0x59, 0xcc, 0x32,
-	0x25, 0x56, 0x4c, 0x92, 0xd5, 0x38, 0x62, 0x8a, 0x8e, 0x09, 0x67, 0x19, 0x93, 0x42, 0xe2, 0xbc,
-	0x00, 0x05, 0x36, 0x6a, 0x71, 0x5c, 0xe3, 0x47, 0x1a, 0xb7, 0xf4, 0xfd, 0x63, 0x0e, 0x1c, 0x34,
-	0x4a, 0xea, 0xaf, 0x46, 0x75, 0x8f, 0x38, 0x00, 0x4f, 0x18, 0xd1, 0xaf, 0xa8, 0x5c, 0x92, 0x45,
-	0x59, 0x50, 0x25, 0x20, 0x6b, 0xe7, 0xaf, 0x2e, 0x95, 0x38, 0x49, 0xd2, 0x0a, 0xef, 0x67, 0xcf,
-	0xba, 0x9d, 0x37, 0xcd, 0xde, 0x2b, 0xaa, 0x98, 0x3d, 0xb3, 0xae, 0x73, 0x5a, 0xd0, 0x54, 0x3a,
-	0xe6, 0xc0, 0x1c, 0xde, 0x4c, 0x9e, 0xe3, 0xff, 0x37, 0xc5, 0xef, 0x34, 0xed, 0x5f, 0x6d, 0x2a,
-	0xd7, 0x08, 0x5a, 0xad, 0x0d, 0x96, 0x9d, 0x40, 0xfc, 0x85, 0x46, 0x09, 0x0b, 0xbb, 0x8e, 0xd2,
-	0x79, 0x30, 0xe8, 0x0d, 0x6f, 0x26, 0x7d, 0xdc, 0x6c, 0x81, 0xbb, 0x2d, 0xf0, 0xac, 0x25, 0xfc,
-	0x67, 0xb5, 0xc9, 0xa1, 0x72, 0xfb, 0x6b, 0x9a, 0x26, 0xaf, 0xbd, 0x73, 0x0b, 0xef, 0xc7, 0x6f,
-	0xd7, 0x0c, 0xee, 0xba, 0x41, 0x27, 0x94, 0x76, 0x6c, 0x59, 0x0b, 0x21, 0x55, 0x11, 0x8a, 0x6c,
-	0x09, 0x4e, 0x4f, 0x57, 0x7f, 0x71, 0xa9, 0xfa, 0xac, 0x56, 0xbc, 0xcd, 0x96, 0xe0, 0xf7, 0x37,
-	0x95, 0x6b, 0x1e, 0x2a, 0xf7, 0xae, 0x09, 0x3e, 0x5a, 0x79, 0xc1, 0xc3, 0x45, 0x47, 0xd9, 0x5f,
-	0xad, 0x47, 0xb5, 0x53, 0xa8, 0x20, 0xe4, 0xb4, 0xe4, 0x4c, 0x3a, 0x57, 0x3a, 0xe8, 0xe5, 0xc5,
-	0x1b, 0x01, 0x24, 0x1f, 0x60, 0xae, 0x35, 0xfe, 0xd3, 0x36, 0xeb, 0x49, 0x93, 0xf5, 0xaf, 0xa3,
-	0x17, 0xdc, 0xe6, 0xa7, 0xf0, 0xc7, 0xcd, 0x0e, 0x99, 0xdb, 0x1d, 0x32, 0xff, 0xec, 0x90, 0xf9,
-	0x7d, 0x8f, 0x8c, 0xed, 0x1e, 0x19, 0xbf, 0xf6, 0xc8, 0xf8, 0xf4, 0x86, 0x0b, 0xf5, 0xb9, 0x8c,
-	0x70, 0x0c, 0x29, 0x69, 0xe3, 0x47, 0x09, 0x8d, 0x64, 0xf7, 0x20, 0xab, 0xf1, 0x94, 0x7c, 0x3b,
-	0xfb, 0x13, 0xd4, 0x3a, 0x67, 0x32, 0xba, 0xd6, 0xb7, 0x9f, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff,
-	0x85, 0x09, 0x3b, 0xfd, 0xb6, 0x02, 0x00, 0x00,
+	// 440 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4d, 0x6e, 0xd3, 0x40,
+	0x14, 0xc7, 0x63, 0x1a, 0x2a, 0xe1, 0x56, 0x48, 0x35, 0x54, 0x72, 0x2a, 0xb0, 0x2b, 0x0b, 0x50,
+	0x91, 0xc8, 0x0c, 0x49, 0x77, 0xb0, 0xb3, 0x22, 0x55, 0xec, 0x90, 0x0b, 0x2c, 0xd8, 0x58, 0xe3,
+	0x64, 0xe2, 0x8e, 0x18, 0xfb, 0x99, 0xbc, 0x71, 0x44, 0xef, 0xc0, 0x82, 0x25, 0x87, 0xe0, 0x20,
+	0x59, 0x76, 0xc9, 0xca, 0xa0, 0xe4, 0x06, 0x3d, 0x01, 0xf2, 0x78, 0xac, 0x06, 0x22, 0x91, 0xee,
+	0x66, 0x34, 0xbf, 0xff, 0xc7, 0x3c, 0x3d, 0xbb, 0x0f, 0x98, 0x01, 0x0a, 0xa4, 0x05, 0x80, 0xec,
+	0x8b, 0x7c, 0xcc, 0x73, 0x25, 0xe6, 0x1c, 0xe9, 0x7c, 0x90, 0x70, 0xc5, 0x06, 0x34, 0xe5, 0x39,
+	0x47, 0x81, 0xa4, 0x98, 0x81, 0x02, 0xc7, 0x33, 0x38, 0xa9, 0xf1, 0x1b, 0x9a, 0x18, 0xfa, 0xe8,
+	0x61, 0x0a, 0x29, 0x68, 0x94, 0xd6, 0xa7, 0x46, 0x75, 0xe4, 0xa5, 0x00, 0xa9, 0xe4, 0x54, 0xdf,
+	0x92, 0x72, 0x4a, 0x27, 0xe5, 0x8c, 0x29, 0x01, 0xb9, 0x79, 0x7f, 0xb9, 0xad, 0xc4, 0x5a, 0x92,
+	0x56, 0x04, 0x3f, 0xba, 0xf6, 0xfe, 0x59, 0xd3, 0xec, 0x5c, 0x31, 0xc5, 0x9d, 0x91, 0xbd, 0x5b,
+	0xb0, 0x19, 0xcb, 0xd0, 0xb5, 0x8e, 0xad, 0x93, 0xbd, 0xe1, 0x33, 0xf2, 0xff, 0xa6, 0xe4, 0xad,
+	0xa6, 0xc3, 0xee, 0xa2, 0xf2, 0x3b, 0x91, 0xd1, 0x3a, 0x60, 0x3b, 0x12, 0xc6, 0x9f, 0x58, 0x22,
+	0x79, 0xdc, 0x76, 0x44, 0xf7, 0xce, 0xf1, 0xce, 0xc9, 0xde, 0xb0, 0x47, 0x9a, 0x5f, 0x90, 0xf6,
+	0x17, 0x64, 0x64, 0x88, 0xf0, 0x69, 0x6d, 0x72, 0x5d, 0xf9, 0xbd, 0x4b, 0x96, 0xc9, 0x57, 0xc1,
+	0xa6, 0x45, 0xf0, 0xfd, 0x97, 0x6f, 0x45, 0x07, 0xed, 0x43, 0x2b, 0x44, 0x67, 0x6c, 0xdb, 0x13,
+	0x81, 0x6a, 0x16, 0x8b, 0x7c, 0x0a, 0xee, 0x8e, 0xae, 0xfe, 0x7c, 0x5b, 0xf5, 0x51, 0xad, 0x78,
+	0x93, 0x4f, 0x21, 0xec, 0x2d, 0x2a, 0xdf, 0xba, 0xae, 0xfc, 0x83, 0x26, 0xf8, 0xc6, 0x2a, 0x88,
+	0xee, 0x4d, 0x5a, 0xca, 0xf9, 0x6c, 0xdf, 0xaf, 0x9d, 0x62, 0x05, 0x71, 0xca, 0xca, 0x94, 0xa3,
+	0xdb, 0xd5, 0x41, 0x2f, 0xb6, 0xce, 0x08, 0x40, 0xbe, 0x83, 0x33, 0xad, 0x09, 0x1f, 0x9b, 0xac,
+	0xc3, 0x26, 0xeb, 0x6f, 0xc7, 0x20, 0xda, 0x2f, 0xd6, 0x60, 0xe7, 0xab, 0x65, 0x1f, 0x6a, 0x62,
+	0x0e, 0xb2, 0xcc, 0x78, 0x8c, 0x39, 0x2b, 0xf0, 0x02, 0x14, 0xba, 0x77, 0xf5, 0x30, 0x87, 0xb7,
+	0x89, 0xfe, 0xa0, 0xb5, 0xe7, 0x46, 0x1a, 0x3e, 0x31, 0x53, 0x7e, 0xb4, 0x56, 0xe0, 0x5f, 0xfb,
+	0x20, 0x7a, 0x50, 0x6c, 0x28, 0x31, 0x7c, 0xbf, 0x58, 0x7a, 0xd6, 0xd5, 0xd2, 0xb3, 0x7e, 0x2f,
+	0x3d, 0xeb, 0xdb, 0xca, 0xeb, 0x5c, 0xad, 0xbc, 0xce, 0xcf, 0x95, 0xd7, 0xf9, 0xf8, 0x3a, 0x15,
+	0xea, 0xa2, 0x4c, 0xc8, 0x18, 0x32, 0x6a, 0x2a, 0xf5, 0x25, 0x4b, 0xb0, 0xbd, 0xd0, 0xf9, 0xe0,
+	0x94, 0x7e, 0xd9, 0x58, 0x4c, 0x75, 0x59, 0x70, 0x4c, 0x76, 0xf5, 0x2a, 0x9c, 0xfe, 0x19, 0x00,
+	0x9d, 0xe5, 0x0a, 0xe7, 0x45, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -154,6 +165,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PoolVolumeSnapshots) > 0 {
+		for iNdEx := len(m.PoolVolumeSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolVolumeSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.PoolToGauges != nil {
 		{
 			size, err := m.PoolToGauges.MarshalToSizedBuffer(dAtA[:i])
@@ -236,6 +261,12 @@ func (m *GenesisState) Size() (n int) {
 		l = m.PoolToGauges.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.PoolVolumeSnapshots) > 0 {
+		for _, e := range m.PoolVolumeSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -413,6 +444,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolVolumeSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolVolumeSnapshots = append(m.PoolVolumeSnapshots, PoolVolumeSnapshot{})
+			if err := m.PoolVolumeSnapshots[len(m.PoolVolumeSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
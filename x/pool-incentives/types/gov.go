@@ -3,26 +3,33 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 const (
-	ProposalTypeUpdatePoolIncentives  = "UpdatePoolIncentives"
-	ProposalTypeReplacePoolIncentives = "ReplacePoolIncentives"
+	ProposalTypeUpdatePoolIncentives         = "UpdatePoolIncentives"
+	ProposalTypeReplacePoolIncentives        = "ReplacePoolIncentives"
+	ProposalTypeCreateExternalIncentiveGauge = "CreateExternalIncentiveGauge"
 )
 
-// Init registers proposals to update and replace pool incentives.
+// Init registers proposals to update and replace pool incentives, and to create external incentive gauges.
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeUpdatePoolIncentives)
 	govtypes.RegisterProposalTypeCodec(&UpdatePoolIncentivesProposal{}, "osmosis/UpdatePoolIncentivesProposal")
 	govtypes.RegisterProposalType(ProposalTypeReplacePoolIncentives)
 	govtypes.RegisterProposalTypeCodec(&ReplacePoolIncentivesProposal{}, "osmosis/ReplacePoolIncentivesProposal")
+	govtypes.RegisterProposalType(ProposalTypeCreateExternalIncentiveGauge)
+	govtypes.RegisterProposalTypeCodec(&CreateExternalIncentiveGaugeProposal{}, "osmosis/CreateExternalIncentiveGaugeProposal")
 }
 
 var (
 	_ govtypes.Content = &UpdatePoolIncentivesProposal{}
 	_ govtypes.Content = &ReplacePoolIncentivesProposal{}
+	_ govtypes.Content = &CreateExternalIncentiveGaugeProposal{}
 )
 
 // NewReplacePoolIncentivesProposal returns a new instance of a replace pool incentives proposal struct.
@@ -140,3 +147,70 @@ func (p UpdatePoolIncentivesProposal) String() string {
 `, p.Title, p.Description, recordsStr))
 	return b.String()
 }
+
+// NewCreateExternalIncentiveGaugeProposal returns a new instance of a create external incentive gauge proposal struct.
+func NewCreateExternalIncentiveGaugeProposal(title, description string, poolId uint64, lockableDuration time.Duration, coins sdk.Coins, startTime time.Time, numEpochsPaidOver uint64) govtypes.Content {
+	return &CreateExternalIncentiveGaugeProposal{
+		Title:             title,
+		Description:       description,
+		PoolId:            poolId,
+		LockableDuration:  lockableDuration,
+		Coins:             coins,
+		StartTime:         startTime,
+		NumEpochsPaidOver: numEpochsPaidOver,
+	}
+}
+
+// GetTitle gets the title of the proposal
+func (p *CreateExternalIncentiveGaugeProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *CreateExternalIncentiveGaugeProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *CreateExternalIncentiveGaugeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *CreateExternalIncentiveGaugeProposal) ProposalType() string {
+	return ProposalTypeCreateExternalIncentiveGauge
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents.
+func (p *CreateExternalIncentiveGaugeProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if p.PoolId == 0 {
+		return ErrInvalidProposalPoolId
+	}
+	if p.LockableDuration <= 0 {
+		return sdkerrors.Wrapf(ErrNotLockableDuration, "lockable duration should be positive, got %s", p.LockableDuration)
+	}
+	if p.Coins.Empty() {
+		return ErrEmptyProposalCoins
+	}
+	if err := p.Coins.Validate(); err != nil {
+		return err
+	}
+	if p.NumEpochsPaidOver == 0 {
+		return ErrInvalidProposalNumEpochs
+	}
+
+	return nil
+}
+
+// String returns a string containing the create external incentive gauge proposal.
+func (p CreateExternalIncentiveGaugeProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Create External Incentive Gauge Proposal:
+  Title:                %s
+  Description:          %s
+  Pool Id:              %d
+  Lockable Duration:    %s
+  Coins:                %s
+  Start Time:           %s
+  Num Epochs Paid Over: %d
+`, p.Title, p.Description, p.PoolId, p.LockableDuration, p.Coins, p.StartTime, p.NumEpochsPaidOver))
+	return b.String()
+}
@@ -5,17 +5,23 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -113,9 +119,61 @@ func (m *UpdatePoolIncentivesProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdatePoolIncentivesProposal proto.InternalMessageInfo
 
+// CreateExternalIncentiveGaugeProposal is a gov Content type for incentivizing
+// a pool from the community pool. If a CreateExternalIncentiveGaugeProposal
+// passes, the coins are withdrawn from the community pool and a non-perpetual
+// gauge is created for the pool's LP shares locked for at least the lockable
+// duration, distributing the coins over num_epochs_paid_over epochs starting
+// from start_time. The gauge is not part of the DistrRecords and is returned
+// by the ExternalIncentiveGauges query.
+type CreateExternalIncentiveGaugeProposal struct {
+	Title            string                                   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description      string                                   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	PoolId           uint64                                   `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	LockableDuration time.Duration                            `protobuf:"bytes,4,opt,name=lockable_duration,json=lockableDuration,proto3,stdduration" json:"lockable_duration" yaml:"lockable_duration"`
+	Coins            github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// start_time is the time the gauge starts distributing. If unset, the gauge
+	// starts distributing at the time the proposal is executed.
+	StartTime         time.Time `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	NumEpochsPaidOver uint64    `protobuf:"varint,7,opt,name=num_epochs_paid_over,json=numEpochsPaidOver,proto3" json:"num_epochs_paid_over,omitempty"`
+}
+
+func (m *CreateExternalIncentiveGaugeProposal) Reset()      { *m = CreateExternalIncentiveGaugeProposal{} }
+func (*CreateExternalIncentiveGaugeProposal) ProtoMessage() {}
+func (*CreateExternalIncentiveGaugeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96caede426ba9516, []int{2}
+}
+func (m *CreateExternalIncentiveGaugeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateExternalIncentiveGaugeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateExternalIncentiveGaugeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateExternalIncentiveGaugeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateExternalIncentiveGaugeProposal.Merge(m, src)
+}
+func (m *CreateExternalIncentiveGaugeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateExternalIncentiveGaugeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateExternalIncentiveGaugeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateExternalIncentiveGaugeProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ReplacePoolIncentivesProposal)(nil), "osmosis.poolincentives.v1beta1.ReplacePoolIncentivesProposal")
 	proto.RegisterType((*UpdatePoolIncentivesProposal)(nil), "osmosis.poolincentives.v1beta1.UpdatePoolIncentivesProposal")
+	proto.RegisterType((*CreateExternalIncentiveGaugeProposal)(nil), "osmosis.poolincentives.v1beta1.CreateExternalIncentiveGaugeProposal")
 }
 
 func init() {
@@ -123,26 +181,43 @@ func init() {
 }
 
 var fileDescriptor_96caede426ba9516 = []byte{
-	// 303 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xcc, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xc8, 0xcf, 0xcf, 0xd1, 0xcd, 0xcc, 0x4b, 0x4e, 0xcd, 0x2b, 0xc9,
-	0x2c, 0x4b, 0x2d, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0xcf, 0x2f, 0xd3,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x83, 0x2a, 0xd5, 0x03, 0x29, 0x45, 0xa8, 0xd4, 0x83,
-	0xaa, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd5, 0x07, 0xb1, 0x20, 0xba, 0xa4, 0x0c,
-	0x08, 0x59, 0x80, 0x64, 0x12, 0x58, 0x87, 0xd2, 0x26, 0x46, 0x2e, 0xd9, 0xa0, 0xd4, 0x82, 0x9c,
-	0xc4, 0xe4, 0xd4, 0x80, 0xfc, 0xfc, 0x1c, 0x4f, 0xb8, 0x7c, 0x40, 0x51, 0x7e, 0x41, 0x7e, 0x71,
-	0x62, 0x8e, 0x90, 0x08, 0x17, 0x6b, 0x49, 0x66, 0x49, 0x4e, 0xaa, 0x04, 0xa3, 0x02, 0xa3, 0x06,
-	0x67, 0x10, 0x84, 0x23, 0xa4, 0xc0, 0xc5, 0x9d, 0x92, 0x5a, 0x9c, 0x5c, 0x94, 0x59, 0x50, 0x92,
-	0x99, 0x9f, 0x27, 0xc1, 0x04, 0x96, 0x43, 0x16, 0x12, 0xf2, 0xe6, 0x62, 0x2f, 0x4a, 0x4d, 0xce,
-	0x2f, 0x4a, 0x29, 0x96, 0x60, 0x56, 0x60, 0xd6, 0xe0, 0x36, 0xd2, 0xd6, 0xc3, 0xef, 0x27, 0x3d,
-	0x97, 0xcc, 0xe2, 0x92, 0xa2, 0x20, 0xb0, 0x1e, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0x60,
-	0x26, 0x58, 0xf1, 0x74, 0x2c, 0x90, 0x67, 0x98, 0xb1, 0x40, 0x9e, 0xe1, 0xc5, 0x02, 0x79, 0x46,
-	0xa5, 0x8d, 0x8c, 0x5c, 0x32, 0xa1, 0x05, 0x29, 0x89, 0x25, 0x43, 0xc7, 0xcd, 0x4e, 0xa1, 0x27,
-	0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c,
-	0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x9d, 0x9e, 0x59, 0x92, 0x51, 0x9a,
-	0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0xb5, 0x4d, 0x37, 0x27, 0x31, 0xa9, 0x18, 0xc6, 0xd1, 0x2f,
-	0x33, 0x34, 0xd6, 0xaf, 0xc0, 0x88, 0xd2, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0x34,
-	0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x79, 0xb6, 0xa1, 0xb7, 0x5b, 0x02, 0x00, 0x00,
+	// 570 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x53, 0x3f, 0x6f, 0xd3, 0x4e,
+	0x18, 0xb6, 0x7f, 0xe9, 0x1f, 0xf5, 0xf2, 0x1b, 0x88, 0x15, 0x09, 0x37, 0xa2, 0x76, 0x14, 0x75,
+	0x08, 0x42, 0xf1, 0x35, 0xed, 0x56, 0xb6, 0xb4, 0x15, 0xaa, 0x18, 0x88, 0x2c, 0x2a, 0x21, 0x16,
+	0xeb, 0x6c, 0x1f, 0xee, 0xa9, 0xb6, 0xcf, 0xba, 0x3b, 0x5b, 0xed, 0x37, 0x60, 0xec, 0xd8, 0x31,
+	0x33, 0x1b, 0x7c, 0x8a, 0x8e, 0x1d, 0x99, 0x5a, 0x48, 0x16, 0x66, 0x3e, 0x01, 0xba, 0xb3, 0x9d,
+	0x04, 0x22, 0xc1, 0xc0, 0xc4, 0x94, 0xbc, 0xf7, 0x3e, 0xcf, 0x7b, 0xcf, 0xf3, 0xfa, 0x39, 0xf0,
+	0x94, 0xf2, 0x84, 0x72, 0xc2, 0x61, 0x46, 0x69, 0x3c, 0x20, 0x69, 0x80, 0x53, 0x41, 0x0a, 0xcc,
+	0x61, 0x31, 0xf4, 0xb1, 0x40, 0x43, 0x18, 0xd1, 0xc2, 0xc9, 0x18, 0x15, 0xd4, 0xb0, 0x2a, 0xa8,
+	0x23, 0xa1, 0x0b, 0xa4, 0x53, 0x21, 0x3b, 0xed, 0x88, 0x46, 0x54, 0x41, 0xa1, 0xfc, 0x57, 0xb2,
+	0x3a, 0x56, 0x44, 0x69, 0x14, 0x63, 0xa8, 0x2a, 0x3f, 0x7f, 0x07, 0xc3, 0x9c, 0x21, 0x41, 0x68,
+	0x5a, 0xf5, 0xed, 0x5f, 0xfb, 0x82, 0x24, 0x98, 0x0b, 0x94, 0x64, 0xf5, 0x80, 0x40, 0xdd, 0x0b,
+	0x7d, 0xc4, 0xf1, 0x5c, 0x55, 0x40, 0x49, 0x3d, 0x60, 0xef, 0x4f, 0x0e, 0x96, 0xa4, 0x2a, 0x46,
+	0xef, 0x93, 0x0e, 0x76, 0x5c, 0x9c, 0xc5, 0x28, 0xc0, 0x63, 0x4a, 0xe3, 0xd3, 0x79, 0x7f, 0xcc,
+	0x68, 0x46, 0x39, 0x8a, 0x8d, 0x36, 0x58, 0x17, 0x44, 0xc4, 0xd8, 0xd4, 0xbb, 0x7a, 0x7f, 0xcb,
+	0x2d, 0x0b, 0xa3, 0x0b, 0x9a, 0x21, 0xe6, 0x01, 0x23, 0x99, 0xd4, 0x6f, 0xfe, 0xa7, 0x7a, 0xcb,
+	0x47, 0xc6, 0x4b, 0xb0, 0xc9, 0x70, 0x40, 0x59, 0xc8, 0xcd, 0x46, 0xb7, 0xd1, 0x6f, 0xee, 0x3f,
+	0x73, 0x7e, 0xbf, 0x34, 0xe7, 0x98, 0x70, 0xc1, 0x5c, 0xc5, 0x19, 0xad, 0xdd, 0xde, 0xdb, 0x9a,
+	0x5b, 0x4f, 0x38, 0xfc, 0xff, 0xfd, 0xc4, 0xd6, 0x6e, 0x26, 0xb6, 0xf6, 0x6d, 0x62, 0xeb, 0xbd,
+	0x8f, 0x3a, 0x78, 0x72, 0x96, 0x85, 0x48, 0xfc, 0x43, 0x9a, 0xbf, 0x36, 0xc0, 0xee, 0x11, 0xc3,
+	0x48, 0xe0, 0x93, 0x4b, 0x81, 0x59, 0x8a, 0x16, 0xba, 0x5f, 0xa0, 0x3c, 0xc2, 0x7f, 0xad, 0xfd,
+	0x31, 0xd8, 0x94, 0x1a, 0x3d, 0x12, 0x9a, 0x8d, 0xae, 0xde, 0x5f, 0x73, 0x37, 0x64, 0x79, 0x1a,
+	0x1a, 0x31, 0x68, 0xc5, 0x34, 0xb8, 0x40, 0x7e, 0x8c, 0xbd, 0x3a, 0x70, 0xe6, 0x5a, 0x57, 0xef,
+	0x37, 0xf7, 0xb7, 0x9d, 0x32, 0x71, 0x4e, 0x9d, 0x38, 0xe7, 0xb8, 0x02, 0x8c, 0x76, 0xa5, 0x99,
+	0xef, 0xf7, 0xb6, 0x79, 0x85, 0x92, 0xf8, 0xb0, 0xb7, 0x32, 0xa1, 0x77, 0xf3, 0x60, 0xeb, 0xee,
+	0xa3, 0xfa, 0xbc, 0xe6, 0x19, 0x08, 0xac, 0xcb, 0x40, 0x72, 0x73, 0x5d, 0x2d, 0x70, 0xdb, 0x29,
+	0x23, 0xeb, 0xc8, 0xc8, 0xce, 0xb7, 0x76, 0x44, 0x49, 0x3a, 0xda, 0x93, 0x37, 0x7c, 0x78, 0xb0,
+	0xfb, 0x11, 0x11, 0xe7, 0xb9, 0xef, 0x04, 0x34, 0x81, 0x55, 0xbe, 0xcb, 0x9f, 0x01, 0x0f, 0x2f,
+	0xa0, 0xb8, 0xca, 0x30, 0x57, 0x04, 0xee, 0x96, 0x93, 0x8d, 0x37, 0x00, 0x70, 0x81, 0x98, 0xf0,
+	0xe4, 0xf3, 0x30, 0x37, 0x94, 0x93, 0xce, 0x8a, 0x93, 0xd7, 0xf5, 0xdb, 0x19, 0xed, 0x54, 0x56,
+	0x5a, 0xa5, 0x95, 0x05, 0xb7, 0x77, 0x2d, 0x3d, 0x6c, 0xa9, 0x03, 0x09, 0x37, 0x20, 0x68, 0xa7,
+	0x79, 0xe2, 0xe1, 0x8c, 0x06, 0xe7, 0xdc, 0xcb, 0x10, 0x09, 0x3d, 0x5a, 0x60, 0x66, 0x6e, 0xaa,
+	0x85, 0xb6, 0xd2, 0x3c, 0x39, 0x51, 0xad, 0x31, 0x22, 0xe1, 0xab, 0x02, 0xb3, 0x9f, 0xbf, 0xf1,
+	0xe8, 0xec, 0x76, 0x6a, 0xe9, 0x77, 0x53, 0x4b, 0xff, 0x32, 0xb5, 0xf4, 0xeb, 0x99, 0xa5, 0xdd,
+	0xcd, 0x2c, 0xed, 0xf3, 0xcc, 0xd2, 0xde, 0x3e, 0x5f, 0xf2, 0x58, 0x25, 0x6a, 0x10, 0x23, 0x9f,
+	0xd7, 0x05, 0x2c, 0x86, 0x07, 0xf0, 0x72, 0xe5, 0xd9, 0x2a, 0xf3, 0xfe, 0x86, 0xf2, 0x74, 0xf0,
+	0x63, 0x00, 0xdb, 0x06, 0xd2, 0xd2, 0xa0, 0x04, 0x00, 0x00,
 }
 
 func (this *ReplacePoolIncentivesProposal) Equal(that interface{}) bool {
@@ -215,6 +290,53 @@ func (this *UpdatePoolIncentivesProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *CreateExternalIncentiveGaugeProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CreateExternalIncentiveGaugeProposal)
+	if !ok {
+		that2, ok := that.(CreateExternalIncentiveGaugeProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if this.LockableDuration != that1.LockableDuration {
+		return false
+	}
+	if len(this.Coins) != len(that1.Coins) {
+		return false
+	}
+	for i := range this.Coins {
+		if !this.Coins[i].Equal(&that1.Coins[i]) {
+			return false
+		}
+	}
+	if !this.StartTime.Equal(that1.StartTime) {
+		return false
+	}
+	if this.NumEpochsPaidOver != that1.NumEpochsPaidOver {
+		return false
+	}
+	return true
+}
 func (m *ReplacePoolIncentivesProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -317,6 +439,83 @@ func (m *UpdatePoolIncentivesProposal) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *CreateExternalIncentiveGaugeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateExternalIncentiveGaugeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateExternalIncentiveGaugeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumEpochsPaidOver != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.NumEpochsPaidOver))
+		i--
+		dAtA[i] = 0x38
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGov(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.LockableDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.LockableDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGov(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if m.PoolId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -374,6 +573,39 @@ func (m *UpdatePoolIncentivesProposal) Size() (n int) {
 	return n
 }
 
+func (m *CreateExternalIncentiveGaugeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovGov(uint64(m.PoolId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.LockableDuration)
+	n += 1 + l + sovGov(uint64(l))
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovGov(uint64(l))
+	if m.NumEpochsPaidOver != 0 {
+		n += 1 + sovGov(uint64(m.NumEpochsPaidOver))
+	}
+	return n
+}
+
 func sovGov(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -676,6 +908,258 @@ func (m *UpdatePoolIncentivesProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CreateExternalIncentiveGaugeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateExternalIncentiveGaugeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateExternalIncentiveGaugeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockableDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.LockableDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types1.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEpochsPaidOver", wireType)
+			}
+			m.NumEpochsPaidOver = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumEpochsPaidOver |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGov(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
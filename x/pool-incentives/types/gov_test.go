@@ -2,12 +2,14 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	proto "github.com/gogo/protobuf/proto"
 	"github.com/osmosis-labs/osmosis/v13/x/pool-incentives/types"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func TestUpdatePoolIncentivesProposalMarshalUnmarshal(t *testing.T) {
@@ -86,3 +88,78 @@ func TestUpdatePoolIncentivesProposalMarshalUnmarshal(t *testing.T) {
 		require.Equal(t, *test.proposal, decoded)
 	}
 }
+
+func TestCreateExternalIncentiveGaugeProposalValidateBasic(t *testing.T) {
+	validProposal := func() *types.CreateExternalIncentiveGaugeProposal {
+		return &types.CreateExternalIncentiveGaugeProposal{
+			Title:             "title",
+			Description:       "proposal to create an external incentive gauge",
+			PoolId:            1,
+			LockableDuration:  time.Hour,
+			Coins:             sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1000)),
+			StartTime:         time.Unix(1700000000, 0).UTC(),
+			NumEpochsPaidOver: 7,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		modify      func(p *types.CreateExternalIncentiveGaugeProposal)
+		expectedErr error
+	}{
+		{
+			name:   "valid proposal",
+			modify: func(p *types.CreateExternalIncentiveGaugeProposal) {},
+		},
+		{
+			name:   "valid proposal without start time",
+			modify: func(p *types.CreateExternalIncentiveGaugeProposal) { p.StartTime = time.Time{} },
+		},
+		{
+			name:        "empty title",
+			modify:      func(p *types.CreateExternalIncentiveGaugeProposal) { p.Title = "" },
+			expectedErr: govtypes.ErrInvalidProposalContent,
+		},
+		{
+			name:        "zero pool id",
+			modify:      func(p *types.CreateExternalIncentiveGaugeProposal) { p.PoolId = 0 },
+			expectedErr: types.ErrInvalidProposalPoolId,
+		},
+		{
+			name:        "zero lockable duration",
+			modify:      func(p *types.CreateExternalIncentiveGaugeProposal) { p.LockableDuration = 0 },
+			expectedErr: types.ErrNotLockableDuration,
+		},
+		{
+			name:        "empty coins",
+			modify:      func(p *types.CreateExternalIncentiveGaugeProposal) { p.Coins = sdk.Coins{} },
+			expectedErr: types.ErrEmptyProposalCoins,
+		},
+		{
+			name:        "zero num epochs paid over",
+			modify:      func(p *types.CreateExternalIncentiveGaugeProposal) { p.NumEpochsPaidOver = 0 },
+			expectedErr: types.ErrInvalidProposalNumEpochs,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			proposal := validProposal()
+			test.modify(proposal)
+
+			err := proposal.ValidateBasic()
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			bz, err := proto.Marshal(proposal)
+			require.NoError(t, err)
+			decoded := types.CreateExternalIncentiveGaugeProposal{}
+			err = proto.Unmarshal(bz, &decoded)
+			require.NoError(t, err)
+			require.Equal(t, *proposal, decoded)
+		})
+	}
+}
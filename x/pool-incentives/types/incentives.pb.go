@@ -34,6 +34,18 @@ type Params struct {
 	// itself, but rather manages the distribution of coins that matches the
 	// defined minted_denom.
 	MintedDenom string `protobuf:"bytes,1,opt,name=minted_denom,json=mintedDenom,proto3" json:"minted_denom,omitempty" yaml:"minted_denom"`
+	// adaptive_weight_fraction is the fraction of the weight of the pool gauges
+	// in the DistrRecords that is redistributed among their pools every epoch,
+	// in proportion to the swap fees each pool earned since the previous epoch.
+	// Zero disables adaptive weights.
+	AdaptiveWeightFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=adaptive_weight_fraction,json=adaptiveWeightFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"adaptive_weight_fraction" yaml:"adaptive_weight_fraction"`
+	// adaptive_pool_share_bounds are the minimum and maximum shares of the
+	// redistributed weight each pool receives, regardless of the swap fees it
+	// earned.
+	AdaptivePoolShareBounds AdaptivePoolShareBounds `protobuf:"bytes,3,opt,name=adaptive_pool_share_bounds,json=adaptivePoolShareBounds,proto3" json:"adaptive_pool_share_bounds" yaml:"adaptive_pool_share_bounds"`
+	// adaptive_weight_excluded_pools are the pools whose gauges keep the weights
+	// set in the DistrRecords.
+	AdaptiveWeightExcludedPools []uint64 `protobuf:"varint,4,rep,packed,name=adaptive_weight_excluded_pools,json=adaptiveWeightExcludedPools,proto3" json:"adaptive_weight_excluded_pools,omitempty" yaml:"adaptive_weight_excluded_pools"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -75,6 +87,63 @@ func (m *Params) GetMintedDenom() string {
 	return ""
 }
 
+func (m *Params) GetAdaptivePoolShareBounds() AdaptivePoolShareBounds {
+	if m != nil {
+		return m.AdaptivePoolShareBounds
+	}
+	return AdaptivePoolShareBounds{}
+}
+
+func (m *Params) GetAdaptiveWeightExcludedPools() []uint64 {
+	if m != nil {
+		return m.AdaptiveWeightExcludedPools
+	}
+	return nil
+}
+
+// AdaptivePoolShareBounds bound the share of the redistributed weight each
+// pool receives. They are a single param so that min can be validated against
+// max when either is changed.
+type AdaptivePoolShareBounds struct {
+	// min is the minimum share of the redistributed weight each pool receives.
+	Min github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=min,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min" yaml:"min"`
+	// max is the maximum share of the redistributed weight each pool receives.
+	Max github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max" yaml:"max"`
+}
+
+func (m *AdaptivePoolShareBounds) Reset()         { *m = AdaptivePoolShareBounds{} }
+func (m *AdaptivePoolShareBounds) String() string { return proto.CompactTextString(m) }
+func (*AdaptivePoolShareBounds) ProtoMessage()    {}
+func (*AdaptivePoolShareBounds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8153bad03e553d1, []int{1}
+}
+func (m *AdaptivePoolShareBounds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdaptivePoolShareBounds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdaptivePoolShareBounds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdaptivePoolShareBounds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdaptivePoolShareBounds.Merge(m, src)
+}
+func (m *AdaptivePoolShareBounds) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdaptivePoolShareBounds) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdaptivePoolShareBounds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdaptivePoolShareBounds proto.InternalMessageInfo
+
 type LockableDurationsInfo struct {
 	LockableDurations []time.Duration `protobuf:"bytes,1,rep,name=lockable_durations,json=lockableDurations,proto3,stdduration" json:"lockable_durations" yaml:"lockable_durations"`
 }
@@ -83,7 +152,7 @@ func (m *LockableDurationsInfo) Reset()         { *m = LockableDurationsInfo{} }
 func (m *LockableDurationsInfo) String() string { return proto.CompactTextString(m) }
 func (*LockableDurationsInfo) ProtoMessage()    {}
 func (*LockableDurationsInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8153bad03e553d1, []int{1}
+	return fileDescriptor_a8153bad03e553d1, []int{2}
 }
 func (m *LockableDurationsInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -128,7 +197,7 @@ func (m *DistrInfo) Reset()         { *m = DistrInfo{} }
 func (m *DistrInfo) String() string { return proto.CompactTextString(m) }
 func (*DistrInfo) ProtoMessage()    {}
 func (*DistrInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8153bad03e553d1, []int{2}
+	return fileDescriptor_a8153bad03e553d1, []int{3}
 }
 func (m *DistrInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -173,7 +242,7 @@ func (m *DistrRecord) Reset()         { *m = DistrRecord{} }
 func (m *DistrRecord) String() string { return proto.CompactTextString(m) }
 func (*DistrRecord) ProtoMessage()    {}
 func (*DistrRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8153bad03e553d1, []int{3}
+	return fileDescriptor_a8153bad03e553d1, []int{4}
 }
 func (m *DistrRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -219,7 +288,7 @@ func (m *PoolToGauge) Reset()         { *m = PoolToGauge{} }
 func (m *PoolToGauge) String() string { return proto.CompactTextString(m) }
 func (*PoolToGauge) ProtoMessage()    {}
 func (*PoolToGauge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8153bad03e553d1, []int{4}
+	return fileDescriptor_a8153bad03e553d1, []int{5}
 }
 func (m *PoolToGauge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -277,7 +346,7 @@ func (m *PoolToGauges) Reset()         { *m = PoolToGauges{} }
 func (m *PoolToGauges) String() string { return proto.CompactTextString(m) }
 func (*PoolToGauges) ProtoMessage()    {}
 func (*PoolToGauges) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8153bad03e553d1, []int{5}
+	return fileDescriptor_a8153bad03e553d1, []int{6}
 }
 func (m *PoolToGauges) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -313,13 +382,64 @@ func (m *PoolToGauges) GetPoolToGauge() []PoolToGauge {
 	return nil
 }
 
+// PoolVolumeSnapshot is the cumulative swap fees of a pool, tracked by the
+// incentives module and valued at the twap, at the last allocation of the
+// minted denom. The swap fees since the snapshot are used to compute adaptive
+// weights.
+type PoolVolumeSnapshot struct {
+	PoolId             uint64                                 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	CumulativeSwapFees github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=cumulative_swap_fees,json=cumulativeSwapFees,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"cumulative_swap_fees" yaml:"cumulative_swap_fees"`
+}
+
+func (m *PoolVolumeSnapshot) Reset()         { *m = PoolVolumeSnapshot{} }
+func (m *PoolVolumeSnapshot) String() string { return proto.CompactTextString(m) }
+func (*PoolVolumeSnapshot) ProtoMessage()    {}
+func (*PoolVolumeSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8153bad03e553d1, []int{7}
+}
+func (m *PoolVolumeSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolVolumeSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolVolumeSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolVolumeSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolVolumeSnapshot.Merge(m, src)
+}
+func (m *PoolVolumeSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolVolumeSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolVolumeSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolVolumeSnapshot proto.InternalMessageInfo
+
+func (m *PoolVolumeSnapshot) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.poolincentives.v1beta1.Params")
+	proto.RegisterType((*AdaptivePoolShareBounds)(nil), "osmosis.poolincentives.v1beta1.AdaptivePoolShareBounds")
 	proto.RegisterType((*LockableDurationsInfo)(nil), "osmosis.poolincentives.v1beta1.LockableDurationsInfo")
 	proto.RegisterType((*DistrInfo)(nil), "osmosis.poolincentives.v1beta1.DistrInfo")
 	proto.RegisterType((*DistrRecord)(nil), "osmosis.poolincentives.v1beta1.DistrRecord")
 	proto.RegisterType((*PoolToGauge)(nil), "osmosis.poolincentives.v1beta1.PoolToGauge")
 	proto.RegisterType((*PoolToGauges)(nil), "osmosis.poolincentives.v1beta1.PoolToGauges")
+	proto.RegisterType((*PoolVolumeSnapshot)(nil), "osmosis.poolincentives.v1beta1.PoolVolumeSnapshot")
 }
 
 func init() {
@@ -327,43 +447,58 @@ func init() {
 }
 
 var fileDescriptor_a8153bad03e553d1 = []byte{
-	// 565 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x73, 0x6d, 0x94, 0xb4, 0xe7, 0xf0, 0xcb, 0x05, 0x91, 0x16, 0xc9, 0x8e, 0x4e, 0x02,
-	0x55, 0x8a, 0x62, 0x13, 0xba, 0x85, 0x2d, 0x0a, 0xa0, 0x00, 0x43, 0x65, 0x51, 0x21, 0xb1, 0x44,
-	0x76, 0x7c, 0x75, 0xac, 0xda, 0x7e, 0x91, 0xef, 0x12, 0xe8, 0x7f, 0x80, 0xc4, 0xc2, 0xd8, 0xb1,
-	0xff, 0x07, 0x1b, 0x53, 0xc7, 0x8e, 0x88, 0xc1, 0xa0, 0x64, 0x61, 0xce, 0x5f, 0x80, 0x7c, 0x3e,
-	0x93, 0x13, 0x95, 0x2a, 0x98, 0x72, 0xcf, 0xef, 0xbe, 0xef, 0x7d, 0xbe, 0xef, 0x9d, 0x82, 0x1f,
-	0x03, 0x8b, 0x81, 0x85, 0xcc, 0x9e, 0x02, 0x44, 0x9d, 0x30, 0x19, 0xd3, 0x84, 0x87, 0x73, 0xca,
-	0xec, 0x79, 0xd7, 0xa3, 0xdc, 0xed, 0xda, 0xeb, 0x4f, 0xd6, 0x34, 0x05, 0x0e, 0xba, 0x21, 0x15,
-	0x56, 0xae, 0x50, 0xb2, 0x52, 0xb0, 0x77, 0x37, 0x80, 0x00, 0xc4, 0x55, 0x3b, 0x3f, 0x15, 0xaa,
-	0x3d, 0x23, 0x00, 0x08, 0x22, 0x6a, 0x8b, 0xc8, 0x9b, 0x1d, 0xdb, 0xfe, 0x2c, 0x75, 0x79, 0x08,
-	0x49, 0x91, 0x27, 0x2f, 0x71, 0xed, 0xd0, 0x4d, 0xdd, 0x98, 0xe9, 0x3d, 0xdc, 0x88, 0xc3, 0x84,
-	0x53, 0x7f, 0xe4, 0xd3, 0x04, 0xe2, 0x26, 0x6a, 0xa1, 0xfd, 0xed, 0xfe, 0xfd, 0x55, 0x66, 0xee,
-	0x9c, 0xba, 0x71, 0xd4, 0x23, 0x6a, 0x96, 0x38, 0x5a, 0x11, 0x0e, 0xf2, 0xa8, 0x57, 0x3d, 0x3b,
-	0x37, 0x2b, 0xe4, 0x23, 0xc2, 0xf7, 0x5e, 0xc3, 0xf8, 0xc4, 0xf5, 0x22, 0x3a, 0x90, 0x6d, 0xd8,
-	0x30, 0x39, 0x06, 0x1d, 0xb0, 0x1e, 0xc9, 0xc4, 0xa8, 0x04, 0x60, 0x4d, 0xd4, 0xda, 0xdc, 0xd7,
-	0x9e, 0xec, 0x5a, 0x05, 0xa2, 0x55, 0x22, 0x5a, 0xa5, 0xb6, 0xff, 0xf0, 0x22, 0x33, 0x2b, 0xab,
-	0xcc, 0xdc, 0x2d, 0x00, 0xae, 0x96, 0x20, 0x67, 0x3f, 0x4c, 0xe4, 0xdc, 0x89, 0xfe, 0x6e, 0x4a,
-	0xbe, 0x22, 0xbc, 0x3d, 0x08, 0x19, 0x4f, 0x45, 0xfb, 0x09, 0x6e, 0x70, 0xe0, 0x6e, 0x34, 0x7a,
-	0x4f, 0xc3, 0x60, 0xc2, 0xa5, 0xb5, 0x67, 0x79, 0xf5, 0xef, 0x99, 0xf9, 0x28, 0x08, 0xf9, 0x64,
-	0xe6, 0x59, 0x63, 0x88, 0xed, 0xb1, 0x18, 0xb2, 0xfc, 0xe9, 0x30, 0xff, 0xc4, 0xe6, 0xa7, 0x53,
-	0xca, 0xac, 0x61, 0xc2, 0xd7, 0x83, 0x50, 0x6b, 0x11, 0x47, 0x13, 0xe1, 0x5b, 0x11, 0xe9, 0xaf,
-	0x70, 0x3d, 0xa5, 0x63, 0x48, 0x7d, 0xd6, 0xdc, 0x10, 0xee, 0xda, 0xd6, 0xf5, 0x6b, 0xb3, 0x04,
-	0xa5, 0x23, 0x34, 0xfd, 0x6a, 0x4e, 0xe4, 0x94, 0x15, 0xc8, 0x27, 0x84, 0x35, 0x25, 0xad, 0x5b,
-	0x78, 0x2b, 0x70, 0x67, 0x01, 0x1d, 0x85, 0xbe, 0xb0, 0x50, 0xed, 0xef, 0xac, 0x32, 0xf3, 0x56,
-	0x01, 0x55, 0x66, 0x88, 0x53, 0x17, 0xc7, 0xa1, 0xaf, 0x3f, 0xc7, 0x35, 0x69, 0x78, 0x43, 0x18,
-	0xb6, 0xfe, 0xcf, 0xb0, 0x23, 0xd5, 0xbd, 0xea, 0xaf, 0x73, 0x13, 0x91, 0x2f, 0x08, 0x6b, 0x87,
-	0x00, 0xd1, 0x1b, 0x78, 0x91, 0xd7, 0xd7, 0xdb, 0xb8, 0x9e, 0x5b, 0x5a, 0xc3, 0xe8, 0xab, 0xcc,
-	0xbc, 0x59, 0xc0, 0xc8, 0x04, 0x71, 0x6a, 0xf9, 0x69, 0xe8, 0xeb, 0x6d, 0x05, 0x7d, 0x43, 0xdc,
-	0xbe, 0xbd, 0xca, 0xcc, 0x86, 0x82, 0xae, 0x70, 0x3b, 0x78, 0xab, 0xdc, 0x70, 0x73, 0xb3, 0x85,
-	0xae, 0x7f, 0x23, 0x0f, 0xe4, 0x1b, 0x91, 0x63, 0x28, 0x85, 0xc5, 0xcb, 0xf8, 0x53, 0x87, 0x50,
-	0xdc, 0x50, 0xe0, 0x99, 0x7e, 0x84, 0x6f, 0x08, 0x48, 0x0e, 0x23, 0xd1, 0xf6, 0x5f, 0xd7, 0xa5,
-	0x14, 0x91, 0xeb, 0xd2, 0xa6, 0xca, 0xa7, 0xa3, 0x8b, 0x85, 0x81, 0x2e, 0x17, 0x06, 0xfa, 0xb9,
-	0x30, 0xd0, 0xe7, 0xa5, 0x51, 0xb9, 0x5c, 0x1a, 0x95, 0x6f, 0x4b, 0xa3, 0xf2, 0xee, 0xa9, 0x32,
-	0x74, 0xd9, 0xa3, 0x13, 0xb9, 0x1e, 0x2b, 0x03, 0x7b, 0xde, 0x3d, 0xb0, 0x3f, 0x5c, 0xf9, 0x3b,
-	0x10, 0xdb, 0xf0, 0x6a, 0xc2, 0xf7, 0xc1, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd0, 0x38, 0xb1,
-	0x8d, 0x36, 0x04, 0x00, 0x00,
+	// 806 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xbf, 0x6f, 0xdb, 0x46,
+	0x14, 0xd6, 0x59, 0x82, 0x9d, 0x9c, 0xd4, 0x5f, 0x97, 0xb4, 0x66, 0x6c, 0x80, 0x54, 0x0f, 0x48,
+	0xa1, 0xc0, 0x30, 0x59, 0x27, 0x43, 0x01, 0xb5, 0x4b, 0x09, 0xc5, 0x85, 0xd0, 0x1f, 0x48, 0xe9,
+	0xa6, 0x05, 0xba, 0x10, 0x27, 0xf2, 0x44, 0x11, 0x21, 0x79, 0x04, 0x8f, 0xb4, 0x95, 0xa9, 0x6b,
+	0x81, 0x2c, 0x1d, 0xb3, 0x35, 0xff, 0x46, 0xd1, 0xad, 0x53, 0xc6, 0x8c, 0x45, 0x07, 0xb6, 0xb0,
+	0x97, 0xa2, 0xa3, 0xfe, 0x82, 0xe2, 0x8e, 0x47, 0x89, 0xb1, 0x1d, 0xb7, 0x42, 0x26, 0xf1, 0xdd,
+	0xbb, 0xf7, 0xbd, 0xef, 0xbd, 0xef, 0x23, 0x05, 0x3f, 0x64, 0x3c, 0x66, 0x3c, 0xe4, 0x56, 0xca,
+	0x58, 0xb4, 0x1f, 0x26, 0x1e, 0x4d, 0xf2, 0xf0, 0x98, 0x72, 0xeb, 0xf8, 0x60, 0x42, 0x73, 0x72,
+	0x60, 0xad, 0x8e, 0xcc, 0x34, 0x63, 0x39, 0x43, 0xba, 0xaa, 0x30, 0x45, 0x45, 0x23, 0xab, 0x0a,
+	0x76, 0x6e, 0x06, 0x2c, 0x60, 0xf2, 0xaa, 0x25, 0x9e, 0xaa, 0xaa, 0x1d, 0x3d, 0x60, 0x2c, 0x88,
+	0xa8, 0x25, 0xa3, 0x49, 0x31, 0xb5, 0xfc, 0x22, 0x23, 0x79, 0xc8, 0x92, 0x2a, 0x8f, 0xff, 0x69,
+	0xc3, 0xcd, 0x07, 0x24, 0x23, 0x31, 0x47, 0x43, 0xd8, 0x8b, 0xc3, 0x24, 0xa7, 0xbe, 0xeb, 0xd3,
+	0x84, 0xc5, 0x1a, 0xe8, 0x83, 0xc1, 0x75, 0x7b, 0x7b, 0x51, 0x1a, 0x37, 0x1e, 0x93, 0x38, 0x1a,
+	0xe2, 0x66, 0x16, 0x3b, 0xdd, 0x2a, 0x1c, 0x89, 0x08, 0x3d, 0x01, 0x50, 0x23, 0x3e, 0x49, 0x05,
+	0x25, 0xf7, 0x84, 0x86, 0xc1, 0x2c, 0x77, 0xa7, 0x19, 0xf1, 0x44, 0x27, 0x6d, 0x43, 0x02, 0x7d,
+	0xfd, 0xbc, 0x34, 0x5a, 0x7f, 0x94, 0xc6, 0x07, 0x41, 0x98, 0xcf, 0x8a, 0x89, 0xe9, 0xb1, 0xd8,
+	0xf2, 0xe4, 0x4c, 0xea, 0x67, 0x9f, 0xfb, 0x8f, 0xac, 0xfc, 0x71, 0x4a, 0xb9, 0x39, 0xa2, 0xde,
+	0xa2, 0x34, 0x8c, 0xaa, 0xed, 0xab, 0x70, 0xb1, 0xf3, 0x5e, 0x9d, 0xfa, 0x4e, 0x66, 0x0e, 0x55,
+	0x02, 0xfd, 0x0c, 0xe0, 0xce, 0xb2, 0x4a, 0xac, 0xcb, 0xe5, 0x33, 0x92, 0x51, 0x77, 0xc2, 0x8a,
+	0xc4, 0xe7, 0x5a, 0xbb, 0x0f, 0x06, 0xdd, 0xbb, 0x1f, 0x99, 0x57, 0x2f, 0xd4, 0xfc, 0x54, 0x21,
+	0x3c, 0x60, 0x2c, 0x3a, 0x12, 0xf5, 0xb6, 0x2c, 0xb7, 0xef, 0x88, 0x41, 0x16, 0xa5, 0xf1, 0xfe,
+	0x39, 0x7a, 0x17, 0x1a, 0x61, 0x67, 0x9b, 0x5c, 0x8e, 0x81, 0x12, 0xa8, 0x9f, 0x1f, 0x8b, 0xce,
+	0xbd, 0xa8, 0xf0, 0xa9, 0x2f, 0x71, 0xb8, 0xd6, 0xe9, 0xb7, 0x07, 0x1d, 0xfb, 0xce, 0xa2, 0x34,
+	0x6e, 0x5f, 0xbe, 0x86, 0x97, 0xef, 0x63, 0x67, 0xf7, 0xe5, 0x65, 0xdc, 0x57, 0x69, 0xd1, 0x99,
+	0x0f, 0x3b, 0x4f, 0x9f, 0x19, 0x2d, 0xfc, 0x0b, 0x80, 0xdb, 0xaf, 0x98, 0x0a, 0x7d, 0x05, 0xdb,
+	0x71, 0x98, 0x28, 0xd1, 0x3f, 0x59, 0x5b, 0x2b, 0xb8, 0xb4, 0x08, 0x76, 0x04, 0x90, 0xc4, 0x23,
+	0x73, 0x6d, 0xe3, 0x35, 0xf1, 0xc8, 0x5c, 0xe0, 0x91, 0x39, 0xfe, 0x11, 0xc0, 0x77, 0xbf, 0x60,
+	0xde, 0x23, 0x32, 0x89, 0xe8, 0x48, 0x79, 0x98, 0x8f, 0x93, 0x29, 0x43, 0x0c, 0xa2, 0x48, 0x25,
+	0xdc, 0xda, 0xdd, 0x5c, 0x03, 0xfd, 0xf6, 0xa0, 0x7b, 0xf7, 0x96, 0x59, 0xf9, 0xdf, 0xac, 0xfd,
+	0x6f, 0xd6, 0xb5, 0xf6, 0x6d, 0x25, 0xe3, 0xad, 0xaa, 0xd3, 0x45, 0x08, 0xfc, 0xf4, 0x4f, 0x03,
+	0x38, 0xef, 0x44, 0xe7, 0x9b, 0xe2, 0xdf, 0x00, 0xbc, 0x3e, 0x0a, 0x79, 0x9e, 0xc9, 0xf6, 0x33,
+	0xd8, 0xcb, 0x59, 0x4e, 0x22, 0xa5, 0x8b, 0xda, 0xe0, 0xfd, 0x35, 0x26, 0x1e, 0x27, 0xf9, 0xea,
+	0x25, 0x6b, 0x62, 0x61, 0xa7, 0x2b, 0xc3, 0x4a, 0x51, 0xf4, 0x39, 0xdc, 0xca, 0xa8, 0xc7, 0x32,
+	0x9f, 0x6b, 0x1b, 0x72, 0xba, 0xbd, 0xff, 0xb2, 0xb0, 0x64, 0xe9, 0xc8, 0x1a, 0xbb, 0x23, 0x18,
+	0x39, 0x35, 0x02, 0x7e, 0x02, 0x60, 0xb7, 0x91, 0x46, 0x26, 0xbc, 0x16, 0x90, 0x22, 0xa0, 0x6e,
+	0xe8, 0xcb, 0x11, 0x3a, 0xf6, 0x8d, 0x45, 0x69, 0xbc, 0x55, 0x91, 0xaa, 0x33, 0xd8, 0xd9, 0x92,
+	0x8f, 0x63, 0x1f, 0x1d, 0xc2, 0x4d, 0x35, 0x70, 0x25, 0xb1, 0xb9, 0xde, 0xc0, 0x8e, 0xaa, 0x1e,
+	0x76, 0xfe, 0x7e, 0x66, 0x00, 0xfc, 0x2b, 0x80, 0x5d, 0xe1, 0xc8, 0x6f, 0xd8, 0x67, 0x02, 0x1f,
+	0xed, 0xc1, 0x2d, 0xf9, 0x3a, 0x2d, 0xc9, 0xa0, 0x45, 0x69, 0xbc, 0x59, 0x91, 0x51, 0x09, 0xec,
+	0x6c, 0x8a, 0xa7, 0xb1, 0x8f, 0xf6, 0x1a, 0xd4, 0x37, 0xe4, 0xed, 0xb7, 0x17, 0xa5, 0xd1, 0x6b,
+	0x50, 0x6f, 0xf0, 0x76, 0xe0, 0xb5, 0x5a, 0x61, 0xf5, 0x21, 0xb8, 0xc2, 0x23, 0xbb, 0xca, 0x23,
+	0x6a, 0x0d, 0x75, 0x61, 0xe5, 0x8c, 0x25, 0x0e, 0xa6, 0xb0, 0xd7, 0x20, 0xcf, 0xd1, 0x43, 0xf8,
+	0x86, 0x24, 0x99, 0x33, 0x57, 0xb6, 0xfd, 0xbf, 0x72, 0x35, 0x40, 0x94, 0x5c, 0xdd, 0x74, 0x75,
+	0x24, 0x7c, 0x87, 0xc4, 0x95, 0x6f, 0x59, 0x54, 0xc4, 0xf4, 0x28, 0x21, 0x29, 0x9f, 0xb1, 0x7c,
+	0xbd, 0x5d, 0xfd, 0x00, 0x6f, 0x7a, 0x45, 0x5c, 0x44, 0x44, 0x7e, 0x4a, 0xf8, 0x09, 0x49, 0xdd,
+	0x29, 0xa5, 0x5c, 0x89, 0xf8, 0xe5, 0xda, 0xae, 0xdd, 0xad, 0xfa, 0x5c, 0x86, 0x89, 0x1d, 0xb4,
+	0x3a, 0x3e, 0x3a, 0x21, 0xe9, 0x21, 0xa5, 0xdc, 0x7e, 0xf8, 0xfc, 0x54, 0x07, 0x2f, 0x4e, 0x75,
+	0xf0, 0xd7, 0xa9, 0x0e, 0x7e, 0x3a, 0xd3, 0x5b, 0x2f, 0xce, 0xf4, 0xd6, 0xef, 0x67, 0x7a, 0xeb,
+	0xfb, 0x8f, 0x1b, 0x4d, 0xd5, 0xa2, 0xf6, 0x23, 0x32, 0xe1, 0x75, 0x60, 0x1d, 0x1f, 0xdc, 0xb3,
+	0xe6, 0x17, 0xfe, 0x30, 0x25, 0x9b, 0xc9, 0xa6, 0x14, 0xef, 0xde, 0xbf, 0x03, 0x00, 0x16, 0x4f,
+	0x16, 0xfc, 0x58, 0x07, 0x00, 0x00,
 }
 
 func (this *DistrRecord) Equal(that interface{}) bool {
@@ -413,6 +548,44 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AdaptiveWeightExcludedPools) > 0 {
+		dAtA2 := make([]byte, len(m.AdaptiveWeightExcludedPools)*10)
+		var j1 int
+		for _, num := range m.AdaptiveWeightExcludedPools {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintIncentives(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.AdaptivePoolShareBounds.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIncentives(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.AdaptiveWeightFraction.Size()
+		i -= size
+		if _, err := m.AdaptiveWeightFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIncentives(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.MintedDenom) > 0 {
 		i -= len(m.MintedDenom)
 		copy(dAtA[i:], m.MintedDenom)
@@ -423,6 +596,49 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AdaptivePoolShareBounds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdaptivePoolShareBounds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdaptivePoolShareBounds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Max.Size()
+		i -= size
+		if _, err := m.Max.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIncentives(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Min.Size()
+		i -= size
+		if _, err := m.Min.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIncentives(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *LockableDurationsInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -563,12 +779,12 @@ func (m *PoolToGauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
-	if err1 != nil {
-		return 0, err1
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n1
-	i = encodeVarintIncentives(dAtA, i, uint64(n1))
+	i -= n4
+	i = encodeVarintIncentives(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x1a
 	if m.GaugeId != 0 {
@@ -621,6 +837,44 @@ func (m *PoolToGauges) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PoolVolumeSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolVolumeSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolVolumeSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CumulativeSwapFees.Size()
+		i -= size
+		if _, err := m.CumulativeSwapFees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIncentives(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintIncentives(dAtA []byte, offset int, v uint64) int {
 	offset -= sovIncentives(v)
 	base := offset
@@ -642,6 +896,30 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovIncentives(uint64(l))
 	}
+	l = m.AdaptiveWeightFraction.Size()
+	n += 1 + l + sovIncentives(uint64(l))
+	l = m.AdaptivePoolShareBounds.Size()
+	n += 1 + l + sovIncentives(uint64(l))
+	if len(m.AdaptiveWeightExcludedPools) > 0 {
+		l = 0
+		for _, e := range m.AdaptiveWeightExcludedPools {
+			l += sovIncentives(uint64(e))
+		}
+		n += 1 + sovIncentives(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *AdaptivePoolShareBounds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Min.Size()
+	n += 1 + l + sovIncentives(uint64(l))
+	l = m.Max.Size()
+	n += 1 + l + sovIncentives(uint64(l))
 	return n
 }
 
@@ -723,6 +1001,20 @@ func (m *PoolToGauges) Size() (n int) {
 	return n
 }
 
+func (m *PoolVolumeSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovIncentives(uint64(m.PoolId))
+	}
+	l = m.CumulativeSwapFees.Size()
+	n += 1 + l + sovIncentives(uint64(l))
+	return n
+}
+
 func sovIncentives(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -790,6 +1082,267 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.MintedDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdaptiveWeightFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AdaptiveWeightFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdaptivePoolShareBounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AdaptivePoolShareBounds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowIncentives
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AdaptiveWeightExcludedPools = append(m.AdaptiveWeightExcludedPools, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowIncentives
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthIncentives
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthIncentives
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.AdaptiveWeightExcludedPools) == 0 {
+					m.AdaptiveWeightExcludedPools = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowIncentives
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AdaptiveWeightExcludedPools = append(m.AdaptiveWeightExcludedPools, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdaptiveWeightExcludedPools", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentives(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdaptivePoolShareBounds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentives
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdaptivePoolShareBounds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdaptivePoolShareBounds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Min.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Max.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIncentives(dAtA[iNdEx:])
@@ -1321,6 +1874,109 @@ func (m *PoolToGauges) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PoolVolumeSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentives
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolVolumeSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolVolumeSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeSwapFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativeSwapFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentives(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipIncentives(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 func TestParamsMarshalUnmarshal(t *testing.T) {
@@ -138,3 +139,101 @@ func TestDistrRecordMarshalUnmarshal(t *testing.T) {
 		require.Equal(t, *test.info, decoded)
 	}
 }
+
+func TestParamsValidate(t *testing.T) {
+	validParams := func() types.Params {
+		params := types.DefaultParams()
+		params.AdaptiveWeightFraction = sdk.NewDecWithPrec(3, 1)
+		params.AdaptivePoolShareBounds.Min = sdk.NewDecWithPrec(1, 2)
+		params.AdaptivePoolShareBounds.Max = sdk.NewDecWithPrec(2, 1)
+		params.AdaptiveWeightExcludedPools = []uint64{1, 5}
+		return params
+	}
+
+	tests := []struct {
+		name      string
+		modify    func(p *types.Params)
+		expectErr bool
+	}{
+		{
+			name:   "valid params",
+			modify: func(p *types.Params) {},
+		},
+		{
+			name:   "default params",
+			modify: func(p *types.Params) { *p = types.DefaultParams() },
+		},
+		{
+			name:      "blank minted denom",
+			modify:    func(p *types.Params) { p.MintedDenom = "" },
+			expectErr: true,
+		},
+		{
+			name:      "negative adaptive weight fraction",
+			modify:    func(p *types.Params) { p.AdaptiveWeightFraction = sdk.NewDec(-1) },
+			expectErr: true,
+		},
+		{
+			name:      "adaptive weight fraction above one",
+			modify:    func(p *types.Params) { p.AdaptiveWeightFraction = sdk.NewDecWithPrec(11, 1) },
+			expectErr: true,
+		},
+		{
+			name:      "nil min adaptive pool share",
+			modify:    func(p *types.Params) { p.AdaptivePoolShareBounds.Min = sdk.Dec{} },
+			expectErr: true,
+		},
+		{
+			name:      "max adaptive pool share above one",
+			modify:    func(p *types.Params) { p.AdaptivePoolShareBounds.Max = sdk.NewDec(2) },
+			expectErr: true,
+		},
+		{
+			name:      "min adaptive pool share greater than max",
+			modify:    func(p *types.Params) { p.AdaptivePoolShareBounds.Min = sdk.NewDecWithPrec(3, 1) },
+			expectErr: true,
+		},
+		{
+			name:      "zero excluded pool id",
+			modify:    func(p *types.Params) { p.AdaptiveWeightExcludedPools = []uint64{0} },
+			expectErr: true,
+		},
+		{
+			name:      "duplicate excluded pool id",
+			modify:    func(p *types.Params) { p.AdaptiveWeightExcludedPools = []uint64{1, 1} },
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := validParams()
+			test.modify(&params)
+			err := params.Validate()
+			if test.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAdaptivePoolShareBoundsParamValidation(t *testing.T) {
+	params := types.DefaultParams()
+	var validate paramtypes.ValueValidatorFn
+	for _, pair := range params.ParamSetPairs() {
+		if string(pair.Key) == string(types.KeyAdaptivePoolShareBounds) {
+			validate = pair.ValidatorFn
+		}
+	}
+	require.NotNil(t, validate)
+
+	// a param change proposal only validates the changed key, so min is checked against max there too.
+	err := validate(types.AdaptivePoolShareBounds{Min: sdk.NewDecWithPrec(1, 1), Max: sdk.NewDecWithPrec(2, 1)})
+	require.NoError(t, err)
+	err = validate(types.AdaptivePoolShareBounds{Min: sdk.NewDecWithPrec(3, 1), Max: sdk.NewDecWithPrec(2, 1)})
+	require.Error(t, err)
+	err = validate(types.AdaptivePoolShareBounds{Min: sdk.ZeroDec(), Max: sdk.NewDec(2)})
+	require.Error(t, err)
+}
@@ -3,6 +3,8 @@ package types
 import (
 	"fmt"
 	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -18,6 +20,8 @@ const (
 var (
 	LockableDurationsKey = []byte("lockable_durations")
 	DistrInfoKey         = []byte("distr_info")
+
+	PoolVolumeSnapshotPrefix = []byte("pool_volume_snapshots/")
 )
 
 // GetPoolGaugeIdStoreKey returns a StoreKey with pool ID and its duration as inputs
@@ -29,3 +33,8 @@ func GetPoolGaugeIdStoreKey(poolId uint64, duration time.Duration) []byte {
 func GetPoolIdFromGaugeIdStoreKey(gaugeId uint64, duration time.Duration) []byte {
 	return []byte(fmt.Sprintf("pool-incentives-pool-id/%d/%s", gaugeId, duration.String()))
 }
+
+// GetPoolVolumeSnapshotStoreKey returns the StoreKey of the provided pool's volume snapshot.
+func GetPoolVolumeSnapshotStoreKey(poolId uint64) []byte {
+	return append(append([]byte{}, PoolVolumeSnapshotPrefix...), sdk.Uint64ToBigEndian(poolId)...)
+}
@@ -12,30 +12,56 @@ import (
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
-var KeyMintedDenom = []byte("MintedDenom")
+var (
+	KeyMintedDenom                 = []byte("MintedDenom")
+	KeyAdaptiveWeightFraction      = []byte("AdaptiveWeightFraction")
+	KeyAdaptivePoolShareBounds     = []byte("AdaptivePoolShareBounds")
+	KeyAdaptiveWeightExcludedPools = []byte("AdaptiveWeightExcludedPools")
+)
 
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(mintedDenom string) Params {
+func NewParams(mintedDenom string, adaptiveWeightFraction, minAdaptivePoolShare, maxAdaptivePoolShare sdk.Dec, adaptiveWeightExcludedPools []uint64) Params {
 	return Params{
-		MintedDenom: mintedDenom,
+		MintedDenom:            mintedDenom,
+		AdaptiveWeightFraction: adaptiveWeightFraction,
+		AdaptivePoolShareBounds: AdaptivePoolShareBounds{
+			Min: minAdaptivePoolShare,
+			Max: maxAdaptivePoolShare,
+		},
+		AdaptiveWeightExcludedPools: adaptiveWeightExcludedPools,
 	}
 }
 
 // DefaultParams is the default parameter configuration for the pool-incentives module.
+// Adaptive weights are disabled by default.
 func DefaultParams() Params {
-	return NewParams(sdk.DefaultBondDenom)
+	return NewParams(sdk.DefaultBondDenom, sdk.ZeroDec(), sdk.ZeroDec(), sdk.OneDec(), nil)
 }
 
 func (p Params) Validate() error {
 	if err := validateMintedDenom(p.MintedDenom); err != nil {
 		return err
 	}
+	if err := validateFraction(p.AdaptiveWeightFraction); err != nil {
+		return err
+	}
+	if err := validateAdaptivePoolShareBounds(p.AdaptivePoolShareBounds); err != nil {
+		return err
+	}
+	if err := validatePoolIds(p.AdaptiveWeightExcludedPools); err != nil {
+		return err
+	}
 	return nil
 }
 
+// IsAdaptiveWeightsEnabled returns true if a positive fraction of the pool gauge weights is redistributed every epoch.
+func (p Params) IsAdaptiveWeightsEnabled() bool {
+	return p.AdaptiveWeightFraction.IsPositive()
+}
+
 // String implements the Stringer interface.
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
@@ -58,8 +84,70 @@ func validateMintedDenom(i interface{}) error {
 	return nil
 }
 
+// validateFraction ensures the provided parameter is a decimal between zero and one.
+func validateFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return errors.New("fraction cannot be nil")
+	}
+	if v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("fraction should be between 0 and 1, got %s", v)
+	}
+
+	return nil
+}
+
+// validateAdaptivePoolShareBounds ensures the provided parameter is a min and max share between zero and one,
+// with min not greater than max.
+func validateAdaptivePoolShareBounds(i interface{}) error {
+	v, ok := i.(AdaptivePoolShareBounds)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := validateFraction(v.Min); err != nil {
+		return err
+	}
+	if err := validateFraction(v.Max); err != nil {
+		return err
+	}
+	if v.Min.GT(v.Max) {
+		return fmt.Errorf("min adaptive pool share %s is greater than max adaptive pool share %s", v.Min, v.Max)
+	}
+
+	return nil
+}
+
+// validatePoolIds ensures the provided parameter is a list of distinct, positive pool IDs.
+func validatePoolIds(i interface{}) error {
+	v, ok := i.([]uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[uint64]bool, len(v))
+	for _, poolId := range v {
+		if poolId == 0 {
+			return errors.New("pool id should be positive")
+		}
+		if seen[poolId] {
+			return fmt.Errorf("duplicate pool id %d", poolId)
+		}
+		seen[poolId] = true
+	}
+
+	return nil
+}
+
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyMintedDenom, &p.MintedDenom, validateMintedDenom),
+		paramtypes.NewParamSetPair(KeyAdaptiveWeightFraction, &p.AdaptiveWeightFraction, validateFraction),
+		paramtypes.NewParamSetPair(KeyAdaptivePoolShareBounds, &p.AdaptivePoolShareBounds, validateAdaptivePoolShareBounds),
+		paramtypes.NewParamSetPair(KeyAdaptiveWeightExcludedPools, &p.AdaptiveWeightExcludedPools, validatePoolIds),
 	}
 }
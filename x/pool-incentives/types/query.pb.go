@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types2 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -646,6 +648,173 @@ func (m *QueryExternalIncentiveGaugesResponse) GetData() []types1.Gauge {
 	return nil
 }
 
+type QueryPoolIncentiveStatsRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+}
+
+func (m *QueryPoolIncentiveStatsRequest) Reset()         { *m = QueryPoolIncentiveStatsRequest{} }
+func (m *QueryPoolIncentiveStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolIncentiveStatsRequest) ProtoMessage()    {}
+func (*QueryPoolIncentiveStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_302873ecccbc7636, []int{13}
+}
+func (m *QueryPoolIncentiveStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolIncentiveStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolIncentiveStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolIncentiveStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolIncentiveStatsRequest.Merge(m, src)
+}
+func (m *QueryPoolIncentiveStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolIncentiveStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolIncentiveStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolIncentiveStatsRequest proto.InternalMessageInfo
+
+func (m *QueryPoolIncentiveStatsRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+type QueryPoolIncentiveStatsResponse struct {
+	Stats []DurationIncentiveStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats" yaml:"stats"`
+}
+
+func (m *QueryPoolIncentiveStatsResponse) Reset()         { *m = QueryPoolIncentiveStatsResponse{} }
+func (m *QueryPoolIncentiveStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolIncentiveStatsResponse) ProtoMessage()    {}
+func (*QueryPoolIncentiveStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_302873ecccbc7636, []int{14}
+}
+func (m *QueryPoolIncentiveStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolIncentiveStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolIncentiveStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolIncentiveStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolIncentiveStatsResponse.Merge(m, src)
+}
+func (m *QueryPoolIncentiveStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolIncentiveStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolIncentiveStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolIncentiveStatsResponse proto.InternalMessageInfo
+
+func (m *QueryPoolIncentiveStatsResponse) GetStats() []DurationIncentiveStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+// DurationIncentiveStats are the incentives of a pool's LP shares locked for
+// at least a lockable duration.
+type DurationIncentiveStats struct {
+	Duration time.Duration `protobuf:"bytes,1,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
+	// gauge_id is the id of the pool's gauge for the duration.
+	GaugeId uint64 `protobuf:"varint,2,opt,name=gauge_id,json=gaugeId,proto3" json:"gauge_id,omitempty" yaml:"gauge_id"`
+	// minted_coins_per_epoch is the projected amount of the minted denom the
+	// gauge receives each epoch, from the current epoch provisions, the
+	// proportion of them allocated to pool incentives and the gauge's weight.
+	MintedCoinsPerEpoch types2.Coin `protobuf:"bytes,3,opt,name=minted_coins_per_epoch,json=mintedCoinsPerEpoch,proto3" json:"minted_coins_per_epoch" yaml:"minted_coins_per_epoch"`
+	// external_coins_per_epoch are the coins the active external gauges of the
+	// pool's LP shares at the duration distribute in the next epoch.
+	ExternalCoinsPerEpoch github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=external_coins_per_epoch,json=externalCoinsPerEpoch,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"external_coins_per_epoch" yaml:"external_coins_per_epoch"`
+	// total_locked_shares is the amount of the pool's LP shares locked for at
+	// least the duration.
+	TotalLockedShares github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=total_locked_shares,json=totalLockedShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_locked_shares" yaml:"total_locked_shares"`
+}
+
+func (m *DurationIncentiveStats) Reset()         { *m = DurationIncentiveStats{} }
+func (m *DurationIncentiveStats) String() string { return proto.CompactTextString(m) }
+func (*DurationIncentiveStats) ProtoMessage()    {}
+func (*DurationIncentiveStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_302873ecccbc7636, []int{15}
+}
+func (m *DurationIncentiveStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DurationIncentiveStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DurationIncentiveStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DurationIncentiveStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DurationIncentiveStats.Merge(m, src)
+}
+func (m *DurationIncentiveStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *DurationIncentiveStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_DurationIncentiveStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DurationIncentiveStats proto.InternalMessageInfo
+
+func (m *DurationIncentiveStats) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *DurationIncentiveStats) GetGaugeId() uint64 {
+	if m != nil {
+		return m.GaugeId
+	}
+	return 0
+}
+
+func (m *DurationIncentiveStats) GetMintedCoinsPerEpoch() types2.Coin {
+	if m != nil {
+		return m.MintedCoinsPerEpoch
+	}
+	return types2.Coin{}
+}
+
+func (m *DurationIncentiveStats) GetExternalCoinsPerEpoch() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ExternalCoinsPerEpoch
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryGaugeIdsRequest)(nil), "osmosis.poolincentives.v1beta1.QueryGaugeIdsRequest")
 	proto.RegisterType((*QueryGaugeIdsResponse)(nil), "osmosis.poolincentives.v1beta1.QueryGaugeIdsResponse")
@@ -661,6 +830,9 @@ func init() {
 	proto.RegisterType((*QueryIncentivizedPoolsResponse)(nil), "osmosis.poolincentives.v1beta1.QueryIncentivizedPoolsResponse")
 	proto.RegisterType((*QueryExternalIncentiveGaugesRequest)(nil), "osmosis.poolincentives.v1beta1.QueryExternalIncentiveGaugesRequest")
 	proto.RegisterType((*QueryExternalIncentiveGaugesResponse)(nil), "osmosis.poolincentives.v1beta1.QueryExternalIncentiveGaugesResponse")
+	proto.RegisterType((*QueryPoolIncentiveStatsRequest)(nil), "osmosis.poolincentives.v1beta1.QueryPoolIncentiveStatsRequest")
+	proto.RegisterType((*QueryPoolIncentiveStatsResponse)(nil), "osmosis.poolincentives.v1beta1.QueryPoolIncentiveStatsResponse")
+	proto.RegisterType((*DurationIncentiveStats)(nil), "osmosis.poolincentives.v1beta1.DurationIncentiveStats")
 }
 
 func init() {
@@ -668,65 +840,83 @@ func init() {
 }
 
 var fileDescriptor_302873ecccbc7636 = []byte{
-	// 923 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0x34, 0x21, 0x4d, 0x26, 0x12, 0xd4, 0x93, 0x40, 0x9c, 0x15, 0xac, 0xc3, 0xd0, 0x42,
-	0xaa, 0x28, 0xbb, 0x8d, 0xdd, 0xf6, 0xd0, 0x06, 0x90, 0xdc, 0x20, 0x14, 0x89, 0x43, 0x58, 0x09,
-	0x21, 0xc1, 0xc1, 0x5a, 0x7b, 0x27, 0x9b, 0x11, 0xeb, 0x1d, 0xd7, 0xb3, 0x0e, 0x0d, 0xa8, 0x97,
-	0x4a, 0xdc, 0x41, 0x5c, 0x38, 0x23, 0x7a, 0xe6, 0xc4, 0x3f, 0xe0, 0xd0, 0x1b, 0x95, 0xb8, 0x70,
-	0xc1, 0xa0, 0x84, 0x03, 0x67, 0xff, 0x02, 0xb4, 0xb3, 0x6f, 0x36, 0xf6, 0x6e, 0xec, 0xb5, 0xcb,
-	0x6d, 0x33, 0xef, 0xbd, 0xef, 0x7d, 0xdf, 0xbc, 0x37, 0x9f, 0x83, 0xb7, 0x85, 0x6c, 0x0b, 0xc9,
-	0xa5, 0xdd, 0x11, 0x22, 0xd8, 0xe1, 0x61, 0x8b, 0x85, 0x11, 0x3f, 0x61, 0xd2, 0x3e, 0xd9, 0x6d,
-	0xb2, 0xc8, 0xdd, 0xb5, 0x1f, 0xf6, 0x58, 0xf7, 0xd4, 0xea, 0x74, 0x45, 0x24, 0x88, 0x09, 0xc9,
-	0x56, 0x9c, 0x7c, 0x91, 0x6b, 0x41, 0xae, 0xb1, 0xe6, 0x0b, 0x5f, 0xa8, 0x54, 0x3b, 0xfe, 0x4a,
-	0xaa, 0x8c, 0xd7, 0x7d, 0x21, 0xfc, 0x80, 0xd9, 0x6e, 0x87, 0xdb, 0x6e, 0x18, 0x8a, 0xc8, 0x8d,
-	0xb8, 0x08, 0x25, 0x44, 0x4d, 0x88, 0xaa, 0xbf, 0x9a, 0xbd, 0x23, 0xdb, 0xeb, 0x75, 0x55, 0x82,
-	0x8e, 0x6b, 0x82, 0x43, 0xdc, 0x7c, 0xb7, 0xe7, 0x33, 0x88, 0xdf, 0x2a, 0x12, 0x30, 0xc4, 0x53,
-	0x55, 0xd0, 0x07, 0x78, 0xed, 0xe3, 0x58, 0xd4, 0x87, 0x31, 0xca, 0x81, 0x27, 0x1d, 0xf6, 0xb0,
-	0xc7, 0x64, 0x44, 0xb6, 0xf1, 0xd5, 0x18, 0xa3, 0xc1, 0xbd, 0x32, 0xda, 0x44, 0x5b, 0x0b, 0x75,
-	0x32, 0xe8, 0x57, 0x5e, 0x3e, 0x75, 0xdb, 0xc1, 0x3d, 0x0a, 0x01, 0xea, 0x2c, 0xc6, 0x5f, 0x07,
-	0x1e, 0xfd, 0x66, 0x1e, 0xbf, 0x9a, 0x41, 0x91, 0x1d, 0x11, 0x4a, 0x46, 0x7e, 0x42, 0x78, 0x5d,
-	0x11, 0x6c, 0x70, 0x4f, 0x36, 0xbe, 0xe4, 0xd1, 0x71, 0x43, 0x4b, 0x2a, 0xa3, 0xcd, 0xf9, 0xad,
-	0x95, 0xea, 0x81, 0x35, 0xf9, 0x1e, 0xad, 0x4b, 0x81, 0x2d, 0x38, 0xf8, 0x94, 0x47, 0xc7, 0xfb,
-	0x00, 0x58, 0xa7, 0x83, 0x7e, 0xc5, 0x4c, 0x28, 0x8e, 0xe9, 0x49, 0x9d, 0x35, 0x1f, 0x90, 0x86,
-	0x2b, 0x8d, 0x5f, 0x11, 0x5e, 0xbd, 0x04, 0x91, 0x58, 0x78, 0x49, 0x23, 0xc1, 0x35, 0xac, 0x0e,
-	0xfa, 0x95, 0x57, 0x46, 0x7b, 0x50, 0xe7, 0x2a, 0x80, 0x92, 0xf7, 0xf1, 0x52, 0x2a, 0xef, 0xca,
-	0x26, 0xda, 0x5a, 0xa9, 0x6e, 0x58, 0xc9, 0x48, 0x2d, 0x3d, 0x52, 0x2b, 0xa5, 0xbb, 0xf4, 0xac,
-	0x5f, 0x99, 0xfb, 0xe1, 0xaf, 0x0a, 0x72, 0xd2, 0x22, 0xb2, 0x87, 0x0d, 0x80, 0xd5, 0x17, 0xd1,
-	0xe8, 0xb0, 0x6e, 0xfc, 0xe9, 0xfa, 0xac, 0x3c, 0xbf, 0x89, 0xb6, 0x96, 0x9d, 0x72, 0xd2, 0x4d,
-	0x27, 0x1c, 0xa6, 0x71, 0xba, 0x0e, 0x63, 0xd8, 0xe7, 0x32, 0xea, 0x1e, 0x84, 0x47, 0x02, 0xa6,
-	0x49, 0x1f, 0xe3, 0xd7, 0xb2, 0x01, 0x18, 0x50, 0x0b, 0x63, 0x2f, 0x3e, 0x6c, 0xf0, 0xf0, 0x48,
-	0x28, 0x8d, 0x2b, 0xd5, 0x9b, 0x45, 0x23, 0x49, 0x61, 0xea, 0x1b, 0xb1, 0x86, 0x41, 0xbf, 0x52,
-	0x4a, 0xae, 0xe4, 0x02, 0x8a, 0x3a, 0xcb, 0x9e, 0xce, 0xa2, 0x6b, 0x98, 0xa8, 0xf6, 0x87, 0x6e,
-	0xd7, 0x6d, 0xeb, 0x15, 0xa3, 0x9f, 0xe3, 0xd5, 0x91, 0x53, 0x60, 0xb4, 0x8f, 0x17, 0x3b, 0xea,
-	0x04, 0xd8, 0xbc, 0x5d, 0xc4, 0x26, 0xa9, 0xaf, 0x2f, 0xc4, 0x54, 0x1c, 0xa8, 0xa5, 0x15, 0xfc,
-	0x86, 0x02, 0xff, 0x48, 0xb4, 0xbe, 0x70, 0x9b, 0x01, 0xd3, 0xb7, 0x9e, 0x76, 0xff, 0x0e, 0x61,
-	0x73, 0x5c, 0x06, 0x30, 0x11, 0x98, 0x04, 0x10, 0x4c, 0x37, 0x48, 0xc2, 0xda, 0x4e, 0x98, 0xeb,
-	0x0d, 0xb8, 0x93, 0x8d, 0xe4, 0x4e, 0xf2, 0x10, 0x54, 0x0d, 0xbd, 0x14, 0x64, 0x1b, 0xa7, 0xa4,
-	0xf5, 0x6c, 0xf9, 0x57, 0xcc, 0x3b, 0x14, 0x22, 0x48, 0x49, 0xff, 0x89, 0xf0, 0xb5, 0x6c, 0x70,
-	0xa6, 0xa7, 0x4a, 0x02, 0x5c, 0xca, 0x11, 0x2a, 0x5e, 0xd5, 0xeb, 0x20, 0xa9, 0x3c, 0x46, 0x52,
-	0xa2, 0xe8, 0x5a, 0x56, 0xd1, 0xc8, 0xfb, 0x99, 0x2f, 0x7e, 0x3f, 0xf4, 0xa9, 0x1e, 0xca, 0x25,
-	0x37, 0x00, 0x43, 0x79, 0x82, 0x30, 0xe1, 0x43, 0xd1, 0x46, 0x2c, 0x4c, 0x4f, 0xe5, 0x56, 0xd1,
-	0xae, 0x64, 0x71, 0xeb, 0x6f, 0x8e, 0x0e, 0x2b, 0x8f, 0x4c, 0x9d, 0x12, 0xcf, 0x92, 0xa1, 0x37,
-	0xf0, 0x5b, 0x8a, 0xe6, 0x07, 0x8f, 0x22, 0xd6, 0x0d, 0xdd, 0x20, 0x7d, 0x8c, 0xca, 0x44, 0x86,
-	0x36, 0xfc, 0xfa, 0xe4, 0x34, 0xd0, 0x54, 0xc3, 0x0b, 0x9e, 0x1b, 0xb9, 0xe9, 0x6a, 0x69, 0x11,
-	0x43, 0x02, 0x54, 0x05, 0xec, 0xb8, 0x4a, 0xae, 0x3e, 0x5d, 0xc6, 0x2f, 0x29, 0x74, 0xf2, 0x0b,
-	0xc2, 0x4b, 0xda, 0x20, 0xc9, 0xed, 0x19, 0xfd, 0x54, 0x31, 0x35, 0xee, 0xbc, 0x90, 0x0b, 0xd3,
-	0xbd, 0x27, 0xbf, 0xff, 0xf3, 0xfd, 0x95, 0xbb, 0xe4, 0xb6, 0x5d, 0xf4, 0xc3, 0xa3, 0x26, 0xbc,
-	0xc3, 0x3d, 0x69, 0x7f, 0x0d, 0x3b, 0xf9, 0x98, 0xfc, 0x8c, 0xf0, 0x72, 0x6a, 0x25, 0x64, 0x3a,
-	0x0a, 0x59, 0x6b, 0x33, 0xee, 0xce, 0x5a, 0x06, 0xd4, 0x6b, 0x8a, 0xfa, 0x0e, 0xd9, 0x2e, 0xa4,
-	0x7e, 0x61, 0x6a, 0xe4, 0x47, 0x84, 0x17, 0x13, 0xbb, 0x21, 0xd5, 0xa9, 0xfa, 0x8e, 0x38, 0x9e,
-	0x51, 0x9b, 0xa9, 0x06, 0x88, 0xda, 0x8a, 0xe8, 0x4d, 0xf2, 0x4e, 0x21, 0xd1, 0xc4, 0xfa, 0xc8,
-	0x6f, 0x08, 0x97, 0x72, 0xa6, 0x46, 0xde, 0x9d, 0xaa, 0xf7, 0x38, 0xbb, 0x34, 0xde, 0x7b, 0xd1,
-	0x72, 0x50, 0x71, 0x5f, 0xa9, 0xb8, 0x43, 0x6a, 0x85, 0x2a, 0xf2, 0x7e, 0xa9, 0x14, 0xe5, 0x1c,
-	0x61, 0x4a, 0x45, 0xe3, 0xbc, 0x74, 0x4a, 0x45, 0x63, 0x8d, 0x68, 0x06, 0x45, 0x79, 0x53, 0x21,
-	0xff, 0x22, 0xbc, 0x3e, 0xc6, 0x15, 0xc8, 0x83, 0xa9, 0x88, 0x4d, 0xb6, 0x1e, 0x63, 0xff, 0xff,
-	0x81, 0x80, 0xc6, 0xba, 0xd2, 0xb8, 0x47, 0xee, 0x15, 0x6a, 0x64, 0x80, 0x34, 0xf4, 0x8f, 0x8b,
-	0x7a, 0xf2, 0xb2, 0xfe, 0xc9, 0xb3, 0x33, 0x13, 0x3d, 0x3f, 0x33, 0xd1, 0xdf, 0x67, 0x26, 0xfa,
-	0xf6, 0xdc, 0x9c, 0x7b, 0x7e, 0x6e, 0xce, 0xfd, 0x71, 0x6e, 0xce, 0x7d, 0x76, 0xdf, 0xe7, 0xd1,
-	0x71, 0xaf, 0x69, 0xb5, 0x44, 0x5b, 0xe3, 0xef, 0x04, 0x6e, 0x53, 0xa6, 0xcd, 0x4e, 0x76, 0x6b,
-	0xf6, 0xa3, 0x5c, 0xcb, 0xe8, 0xb4, 0xc3, 0x64, 0x73, 0x51, 0xfd, 0x4a, 0xd5, 0xfe, 0x0b, 0x00,
-	0x00, 0xff, 0xff, 0xc5, 0x25, 0x29, 0x4b, 0xb4, 0x0b, 0x00, 0x00,
+	// 1204 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x4f, 0x24, 0xc5,
+	0x17, 0xa7, 0x18, 0x60, 0xa1, 0xf8, 0xe6, 0xeb, 0x4e, 0xc1, 0xc2, 0xd0, 0xba, 0xd3, 0x58, 0xee,
+	0xae, 0x6c, 0x08, 0xdd, 0x0b, 0xb3, 0xcb, 0x61, 0x17, 0x97, 0x38, 0xb0, 0xd9, 0x90, 0x60, 0x82,
+	0x4d, 0x8c, 0x89, 0x1e, 0x3a, 0x3d, 0xd3, 0xc5, 0xd0, 0xd9, 0xa6, 0x6b, 0x76, 0xaa, 0x07, 0x17,
+	0x75, 0x2f, 0x9b, 0xe8, 0x59, 0xe3, 0xc5, 0xb3, 0xd1, 0x98, 0x78, 0xf1, 0xe4, 0xc9, 0xab, 0x87,
+	0xbd, 0xb9, 0x89, 0x17, 0x63, 0xe2, 0xac, 0x01, 0x0f, 0x9e, 0xf9, 0x0b, 0x4c, 0x55, 0xbf, 0x6e,
+	0xe6, 0x27, 0x3d, 0x8c, 0x27, 0x86, 0xaa, 0x7a, 0x9f, 0xf7, 0xf9, 0xbc, 0xf7, 0xfa, 0xbd, 0x87,
+	0x17, 0xb9, 0x38, 0xe0, 0xc2, 0x13, 0x66, 0x95, 0x73, 0x7f, 0xc9, 0x0b, 0xca, 0x2c, 0x08, 0xbd,
+	0x43, 0x26, 0xcc, 0xc3, 0xe5, 0x12, 0x0b, 0x9d, 0x65, 0xf3, 0x71, 0x9d, 0xd5, 0x8e, 0x8c, 0x6a,
+	0x8d, 0x87, 0x9c, 0xe4, 0xe1, 0xb1, 0x21, 0x1f, 0x9f, 0xbd, 0x35, 0xe0, 0xad, 0x36, 0x5d, 0xe1,
+	0x15, 0xae, 0x9e, 0x9a, 0xf2, 0x57, 0x64, 0xa5, 0xe5, 0xcb, 0xca, 0xcc, 0x2c, 0x39, 0x82, 0x25,
+	0xb0, 0x65, 0xee, 0x05, 0x70, 0xff, 0x5a, 0x85, 0xf3, 0x8a, 0xcf, 0x4c, 0xa7, 0xea, 0x99, 0x4e,
+	0x10, 0xf0, 0xd0, 0x09, 0x3d, 0x1e, 0x88, 0xd8, 0x1a, 0x6e, 0xd5, 0x7f, 0xa5, 0xfa, 0x9e, 0xe9,
+	0xd6, 0x6b, 0xea, 0x41, 0x7c, 0x1f, 0x0b, 0x68, 0xe2, 0x5e, 0x71, 0xea, 0x15, 0x06, 0xf7, 0xb7,
+	0xd2, 0x04, 0x36, 0xe9, 0x50, 0x16, 0x74, 0x03, 0x4f, 0xbf, 0x2b, 0x45, 0x3f, 0x94, 0x28, 0x5b,
+	0xae, 0xb0, 0xd8, 0xe3, 0x3a, 0x13, 0x21, 0x59, 0xc4, 0x97, 0x24, 0x86, 0xed, 0xb9, 0x39, 0x34,
+	0x8f, 0x16, 0x46, 0x8a, 0xe4, 0xb4, 0xa1, 0xff, 0xff, 0xc8, 0x39, 0xf0, 0xef, 0x52, 0xb8, 0xa0,
+	0xd6, 0x98, 0xfc, 0xb5, 0xe5, 0xd2, 0xcf, 0x32, 0xf8, 0x4a, 0x1b, 0x8a, 0xa8, 0xf2, 0x40, 0x30,
+	0xf2, 0x2d, 0xc2, 0xb3, 0x8a, 0xa0, 0xed, 0xb9, 0xc2, 0xfe, 0xc8, 0x0b, 0xf7, 0xed, 0x58, 0x52,
+	0x0e, 0xcd, 0x67, 0x16, 0x26, 0x57, 0xb6, 0x8c, 0xf3, 0xe3, 0x6c, 0x74, 0x05, 0x36, 0xe0, 0xe0,
+	0x7d, 0x2f, 0xdc, 0xdf, 0x04, 0xc0, 0x22, 0x3d, 0x6d, 0xe8, 0xf9, 0x88, 0x62, 0x0f, 0x9f, 0xd4,
+	0x9a, 0xae, 0x00, 0x52, 0xb3, 0xa5, 0xf6, 0x0b, 0xc2, 0x53, 0x5d, 0x10, 0x89, 0x81, 0xc7, 0x63,
+	0x24, 0x08, 0xc3, 0xd4, 0x69, 0x43, 0x7f, 0xa5, 0xd5, 0x07, 0xb5, 0x2e, 0x01, 0x28, 0x59, 0xc7,
+	0xe3, 0x89, 0xbc, 0xe1, 0x79, 0xb4, 0x30, 0xb9, 0x32, 0x67, 0x44, 0x29, 0x35, 0xe2, 0x94, 0x1a,
+	0x09, 0xdd, 0xf1, 0xe7, 0x0d, 0x7d, 0xe8, 0xeb, 0x97, 0x3a, 0xb2, 0x12, 0x23, 0xb2, 0x86, 0x35,
+	0x80, 0x8d, 0x03, 0x61, 0x57, 0x59, 0x4d, 0xfe, 0x74, 0x2a, 0x2c, 0x97, 0x99, 0x47, 0x0b, 0x13,
+	0x56, 0x2e, 0xf2, 0x16, 0x3f, 0xd8, 0x49, 0xee, 0xe9, 0x2c, 0xa4, 0x61, 0xd3, 0x13, 0x61, 0x6d,
+	0x2b, 0xd8, 0xe3, 0x90, 0x4d, 0xfa, 0x14, 0xcf, 0xb4, 0x5f, 0x40, 0x82, 0xca, 0x18, 0xbb, 0xf2,
+	0xd0, 0xf6, 0x82, 0x3d, 0xae, 0x34, 0x4e, 0xae, 0xdc, 0x4c, 0x4b, 0x49, 0x02, 0x53, 0x9c, 0x93,
+	0x1a, 0x4e, 0x1b, 0x7a, 0x36, 0x0a, 0xc9, 0x19, 0x14, 0xb5, 0x26, 0xdc, 0xf8, 0x15, 0x9d, 0xc6,
+	0x44, 0xb9, 0xdf, 0x71, 0x6a, 0xce, 0x41, 0x5c, 0x62, 0xf4, 0x43, 0x3c, 0xd5, 0x72, 0x0a, 0x8c,
+	0x36, 0xf1, 0x58, 0x55, 0x9d, 0x00, 0x9b, 0x1b, 0x69, 0x6c, 0x22, 0xfb, 0xe2, 0x88, 0xa4, 0x62,
+	0x81, 0x2d, 0xd5, 0xf1, 0x55, 0x05, 0xbe, 0xcd, 0xcb, 0x8f, 0x9c, 0x92, 0xcf, 0xe2, 0xa8, 0x27,
+	0xde, 0xbf, 0x44, 0x38, 0xdf, 0xeb, 0x05, 0x30, 0xe1, 0x98, 0xf8, 0x70, 0x99, 0x54, 0x90, 0x80,
+	0xb2, 0x3d, 0x27, 0xaf, 0xd7, 0x21, 0x26, 0x73, 0x51, 0x4c, 0x3a, 0x21, 0xa8, 0x4a, 0x7a, 0xd6,
+	0x6f, 0x77, 0x9c, 0x90, 0x8e, 0x73, 0xeb, 0x7d, 0xcc, 0xdc, 0x1d, 0xce, 0xfd, 0x84, 0xf4, 0x9f,
+	0x08, 0x5f, 0x6e, 0xbf, 0xbc, 0xd0, 0xa7, 0x4a, 0x7c, 0x9c, 0xed, 0x20, 0x94, 0x5e, 0xaa, 0xd7,
+	0x40, 0x52, 0xae, 0x87, 0xa4, 0x48, 0xd1, 0xe5, 0x76, 0x45, 0x2d, 0xdf, 0x4f, 0x26, 0xfd, 0xfb,
+	0xa1, 0xdf, 0xc5, 0x49, 0xe9, 0x12, 0x01, 0x48, 0xca, 0x33, 0x84, 0x89, 0xd7, 0x74, 0x6b, 0x4b,
+	0x61, 0x71, 0x56, 0x6e, 0xa5, 0xd5, 0x4a, 0x3b, 0x6e, 0xf1, 0xf5, 0xd6, 0x64, 0x75, 0x22, 0x53,
+	0x2b, 0xeb, 0xb5, 0x93, 0xa1, 0xd7, 0xf1, 0x1b, 0x8a, 0xe6, 0x83, 0x27, 0x21, 0xab, 0x05, 0x8e,
+	0x9f, 0x7c, 0x8c, 0xaa, 0x89, 0x34, 0x55, 0xf8, 0xb5, 0xf3, 0x9f, 0x81, 0xa6, 0x02, 0x1e, 0x71,
+	0x9d, 0xd0, 0x49, 0x4a, 0x2b, 0x16, 0xd1, 0x24, 0x40, 0x59, 0x40, 0x8d, 0xab, 0xc7, 0xf4, 0x1d,
+	0x08, 0x95, 0x64, 0x94, 0x00, 0xef, 0x86, 0x4e, 0x38, 0x60, 0x0f, 0x47, 0x58, 0xef, 0x89, 0x07,
+	0x3c, 0x4b, 0x78, 0x54, 0xc8, 0x03, 0x20, 0xba, 0x9a, 0xda, 0x27, 0xa0, 0x0e, 0x5a, 0xe1, 0x8a,
+	0xd3, 0x10, 0xf3, 0xff, 0x45, 0x54, 0x14, 0x24, 0xb5, 0x22, 0x68, 0xfa, 0xf3, 0x08, 0x9e, 0xe9,
+	0x6e, 0x47, 0xac, 0xa6, 0xee, 0x8a, 0xd2, 0x4a, 0xf6, 0x55, 0x70, 0x02, 0xc5, 0xd6, 0x5a, 0xa9,
+	0xe3, 0x6e, 0xb7, 0x0a, 0x1d, 0xee, 0xa3, 0xc3, 0xd7, 0xf1, 0xcc, 0x81, 0x17, 0x84, 0xcc, 0xb5,
+	0xe5, 0x50, 0x17, 0xb2, 0x3b, 0xdb, 0xac, 0xca, 0xcb, 0xfb, 0xb9, 0x0c, 0x30, 0x8a, 0x16, 0x00,
+	0x43, 0x2e, 0x00, 0x49, 0x20, 0x36, 0xb8, 0x77, 0xd6, 0x17, 0xae, 0x46, 0xe0, 0xdd, 0x61, 0xa8,
+	0x35, 0x15, 0x5d, 0x48, 0x13, 0xb1, 0xc3, 0x6a, 0x0f, 0xe4, 0x29, 0xf9, 0x1e, 0xe1, 0x1c, 0x83,
+	0x2a, 0xea, 0xf0, 0x3c, 0x32, 0x9f, 0x39, 0xdf, 0xf3, 0x2e, 0x78, 0xd6, 0x23, 0xcf, 0xbd, 0x80,
+	0xe8, 0x0f, 0x2f, 0xf5, 0x85, 0x8a, 0x17, 0xee, 0xd7, 0x4b, 0x46, 0x99, 0x1f, 0x98, 0xb0, 0xca,
+	0x44, 0x7f, 0x96, 0x84, 0xfb, 0xc8, 0x0c, 0x8f, 0xaa, 0x4c, 0x28, 0x4c, 0x61, 0x5d, 0x89, 0x61,
+	0x5a, 0x99, 0x7e, 0x8a, 0xa7, 0x42, 0x1e, 0x3a, 0xbe, 0x2d, 0x9b, 0x01, 0x73, 0x6d, 0xb1, 0xef,
+	0xd4, 0x98, 0xc8, 0x8d, 0xca, 0xd1, 0x55, 0xdc, 0x96, 0x44, 0xfe, 0x68, 0xe8, 0x37, 0xfa, 0xf0,
+	0xb2, 0x15, 0x84, 0xa7, 0x0d, 0x5d, 0x8b, 0x28, 0x77, 0x81, 0xa4, 0x56, 0x56, 0x9d, 0x6e, 0xab,
+	0xc3, 0x5d, 0x75, 0xb6, 0xf2, 0xf9, 0x24, 0x1e, 0x55, 0x55, 0x4c, 0x7e, 0x42, 0x78, 0x3c, 0xde,
+	0x1a, 0xc8, 0xed, 0x0b, 0x2e, 0x19, 0xea, 0xfb, 0xd1, 0xee, 0x0c, 0xb4, 0x9a, 0xd0, 0xb5, 0x67,
+	0xbf, 0xfd, 0xfd, 0xd5, 0xf0, 0x2a, 0xb9, 0x6d, 0xa6, 0x6d, 0x63, 0xaa, 0xa8, 0x96, 0x3c, 0x57,
+	0x98, 0x9f, 0xc0, 0xf7, 0xf8, 0x94, 0xfc, 0x88, 0xf0, 0x44, 0x32, 0x5f, 0x49, 0x7f, 0x14, 0xda,
+	0xe7, 0xbd, 0xb6, 0x7a, 0x51, 0x33, 0xa0, 0x5e, 0x50, 0xd4, 0x97, 0xc8, 0x62, 0x2a, 0xf5, 0xb3,
+	0x49, 0x4f, 0xbe, 0x41, 0x78, 0x2c, 0x9a, 0xc1, 0x64, 0xa5, 0x2f, 0xbf, 0x2d, 0x6b, 0x80, 0x56,
+	0xb8, 0x90, 0x0d, 0x10, 0x35, 0x15, 0xd1, 0x9b, 0xe4, 0xcd, 0x54, 0xa2, 0xd1, 0x3e, 0x40, 0x7e,
+	0x45, 0x38, 0xdb, 0x31, 0xe9, 0xc9, 0x5b, 0x7d, 0xf9, 0xee, 0xb5, 0x43, 0x68, 0xf7, 0x07, 0x35,
+	0x07, 0x15, 0xf7, 0x94, 0x8a, 0x3b, 0xa4, 0x90, 0xaa, 0xa2, 0x73, 0x89, 0x50, 0x8a, 0x3a, 0xc6,
+	0x64, 0x9f, 0x8a, 0x7a, 0x2d, 0x18, 0xda, 0xfd, 0x41, 0xcd, 0x2f, 0xac, 0xa8, 0x73, 0xd2, 0x92,
+	0x7f, 0x10, 0x9e, 0xed, 0x31, 0x2a, 0xc9, 0x46, 0x5f, 0xc4, 0xce, 0x9f, 0xc7, 0xda, 0xe6, 0x7f,
+	0x03, 0x01, 0x8d, 0x45, 0xa5, 0x71, 0x8d, 0xdc, 0x4d, 0xd5, 0x98, 0x34, 0xda, 0xe4, 0xce, 0xae,
+	0x44, 0x72, 0x1a, 0x08, 0x93, 0xce, 0x41, 0x4b, 0xfa, 0x0b, 0x7f, 0xcf, 0x89, 0xaf, 0xad, 0x0f,
+	0x6c, 0x0f, 0xda, 0x1e, 0x2a, 0x6d, 0x6f, 0x93, 0xf5, 0xf4, 0xef, 0x4a, 0x35, 0xac, 0x44, 0x97,
+	0x1a, 0xde, 0x67, 0x6d, 0xac, 0xf8, 0xde, 0xf3, 0xe3, 0x3c, 0x7a, 0x71, 0x9c, 0x47, 0x7f, 0x1d,
+	0xe7, 0xd1, 0x17, 0x27, 0xf9, 0xa1, 0x17, 0x27, 0xf9, 0xa1, 0xdf, 0x4f, 0xf2, 0x43, 0x1f, 0xdc,
+	0x6b, 0xea, 0xfd, 0xe0, 0x64, 0xc9, 0x77, 0x4a, 0x22, 0xf1, 0x78, 0xb8, 0x5c, 0x30, 0x9f, 0x74,
+	0xf8, 0x55, 0x43, 0xa1, 0x34, 0xa6, 0x06, 0x7d, 0xe1, 0xdf, 0x01, 0x00, 0x26, 0x6a, 0x98, 0x11,
+	0xca, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -753,6 +943,10 @@ type QueryClient interface {
 	IncentivizedPools(ctx context.Context, in *QueryIncentivizedPoolsRequest, opts ...grpc.CallOption) (*QueryIncentivizedPoolsResponse, error)
 	// ExternalIncentiveGauges returns external incentive gauges.
 	ExternalIncentiveGauges(ctx context.Context, in *QueryExternalIncentiveGaugesRequest, opts ...grpc.CallOption) (*QueryExternalIncentiveGaugesResponse, error)
+	// PoolIncentiveStats returns, for each lockable duration of a pool, the
+	// incentives its gauges are projected to distribute per epoch and the pool
+	// shares locked for at least the duration, from which APRs are computed.
+	PoolIncentiveStats(ctx context.Context, in *QueryPoolIncentiveStatsRequest, opts ...grpc.CallOption) (*QueryPoolIncentiveStatsResponse, error)
 }
 
 type queryClient struct {
@@ -817,6 +1011,15 @@ func (c *queryClient) ExternalIncentiveGauges(ctx context.Context, in *QueryExte
 	return out, nil
 }
 
+func (c *queryClient) PoolIncentiveStats(ctx context.Context, in *QueryPoolIncentiveStatsRequest, opts ...grpc.CallOption) (*QueryPoolIncentiveStatsResponse, error) {
+	out := new(QueryPoolIncentiveStatsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolincentives.v1beta1.Query/PoolIncentiveStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// GaugeIds takes the pool id and returns the matching gauge ids and durations
@@ -831,6 +1034,10 @@ type QueryServer interface {
 	IncentivizedPools(context.Context, *QueryIncentivizedPoolsRequest) (*QueryIncentivizedPoolsResponse, error)
 	// ExternalIncentiveGauges returns external incentive gauges.
 	ExternalIncentiveGauges(context.Context, *QueryExternalIncentiveGaugesRequest) (*QueryExternalIncentiveGaugesResponse, error)
+	// PoolIncentiveStats returns, for each lockable duration of a pool, the
+	// incentives its gauges are projected to distribute per epoch and the pool
+	// shares locked for at least the duration, from which APRs are computed.
+	PoolIncentiveStats(context.Context, *QueryPoolIncentiveStatsRequest) (*QueryPoolIncentiveStatsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -855,6 +1062,9 @@ func (*UnimplementedQueryServer) IncentivizedPools(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) ExternalIncentiveGauges(ctx context.Context, req *QueryExternalIncentiveGaugesRequest) (*QueryExternalIncentiveGaugesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExternalIncentiveGauges not implemented")
 }
+func (*UnimplementedQueryServer) PoolIncentiveStats(ctx context.Context, req *QueryPoolIncentiveStatsRequest) (*QueryPoolIncentiveStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolIncentiveStats not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -968,6 +1178,24 @@ func _Query_ExternalIncentiveGauges_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PoolIncentiveStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolIncentiveStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolIncentiveStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolincentives.v1beta1.Query/PoolIncentiveStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolIncentiveStats(ctx, req.(*QueryPoolIncentiveStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.poolincentives.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -996,6 +1224,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExternalIncentiveGauges",
 			Handler:    _Query_ExternalIncentiveGauges_Handler,
 		},
+		{
+			MethodName: "PoolIncentiveStats",
+			Handler:    _Query_PoolIncentiveStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/pool-incentives/v1beta1/query.proto",
@@ -1440,6 +1672,141 @@ func (m *QueryExternalIncentiveGaugesResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPoolIncentiveStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolIncentiveStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolIncentiveStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolIncentiveStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolIncentiveStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolIncentiveStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DurationIncentiveStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DurationIncentiveStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DurationIncentiveStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalLockedShares.Size()
+		i -= size
+		if _, err := m.TotalLockedShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ExternalCoinsPerEpoch) > 0 {
+		for iNdEx := len(m.ExternalCoinsPerEpoch) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExternalCoinsPerEpoch[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.MintedCoinsPerEpoch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.GaugeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GaugeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintQuery(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1625,21 +1992,72 @@ func (m *QueryExternalIncentiveGaugesResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryPoolIncentiveStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryPoolIncentiveStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
 }
-func (m *QueryGaugeIdsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+
+func (m *DurationIncentiveStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.GaugeId != 0 {
+		n += 1 + sovQuery(uint64(m.GaugeId))
+	}
+	l = m.MintedCoinsPerEpoch.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.ExternalCoinsPerEpoch) > 0 {
+		for _, e := range m.ExternalCoinsPerEpoch {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TotalLockedShares.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryGaugeIdsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
@@ -2707,6 +3125,362 @@ func (m *QueryExternalIncentiveGaugesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPoolIncentiveStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolIncentiveStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolIncentiveStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolIncentiveStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolIncentiveStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolIncentiveStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stats = append(m.Stats, DurationIncentiveStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DurationIncentiveStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DurationIncentiveStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DurationIncentiveStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
+			}
+			m.GaugeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GaugeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedCoinsPerEpoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintedCoinsPerEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalCoinsPerEpoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalCoinsPerEpoch = append(m.ExternalCoinsPerEpoch, types2.Coin{})
+			if err := m.ExternalCoinsPerEpoch[len(m.ExternalCoinsPerEpoch)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLockedShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalLockedShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
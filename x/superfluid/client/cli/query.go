@@ -28,6 +28,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdTotalSuperfluidDelegations(),
 		GetCmdTotalDelegationByDelegator(),
 		GetCmdUnpoolWhitelist(),
+		GetCmdSuperfluidDelegationHeadroom(),
 	)
 
 	return cmd
@@ -157,3 +158,16 @@ func GetCmdUnpoolWhitelist() *cobra.Command {
 		types.ModuleName, types.NewQueryClient,
 	)
 }
+
+// GetCmdSuperfluidDelegationHeadroom returns the osmo equivalent that can still be superfluid
+// delegated to a validator with a denom.
+func GetCmdSuperfluidDelegationHeadroom() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.QuerySuperfluidDelegationHeadroomRequest](
+		"superfluid-delegation-headroom [validator_address] [denom]",
+		"Query the osmo equivalent that can still be superfluid delegated to a validator with a denom",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} superfluid-delegation-headroom osmovaloper1... gamm/pool/1
+`,
+		types.ModuleName, types.NewQueryClient,
+	)
+}
@@ -138,6 +138,12 @@ func (s *QueryTestSuite) TestQueriesNeverAlterState() {
 			&types.TotalSuperfluidDelegationsRequest{},
 			&types.TotalSuperfluidDelegationsResponse{},
 		},
+		{
+			"Query sfs delegation headroom",
+			"/osmosis.superfluid.Query/SuperfluidDelegationHeadroom",
+			&types.QuerySuperfluidDelegationHeadroomRequest{ValidatorAddress: s.val.String(), Denom: "gamm/pool/1"},
+			&types.QuerySuperfluidDelegationHeadroomResponse{},
+		},
 	}
 
 	for _, tc := range testCases {
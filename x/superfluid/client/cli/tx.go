@@ -26,8 +26,10 @@ func GetTxCmd() *cobra.Command {
 		NewSuperfluidDelegateCmd(),
 		NewSuperfluidUndelegateCmd(),
 		NewSuperfluidUnbondLockCmd(),
-		// NewSuperfluidRedelegateCmd(),
+		NewSuperfluidRedelegateCmd(),
 		NewCmdLockAndSuperfluidDelegate(),
+		NewSuperfluidDelegatePartialCmd(),
+		NewCmdLockAndSuperfluidDelegatePartial(),
 		NewCmdUnPoolWhitelistedPool(),
 	)
 
@@ -79,6 +81,13 @@ func NewSuperfluidUndelegateCmd() *cobra.Command {
 	})
 }
 
+func NewSuperfluidRedelegateCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgSuperfluidRedelegate](&osmocli.TxCliDesc{
+		Use:   "redelegate [lock_id] [new_val_addr] [flags]",
+		Short: "superfluid redelegate a lock to a new validator",
+	})
+}
+
 func NewSuperfluidUnbondLockCmd() *cobra.Command {
 	return osmocli.BuildTxCli[*types.MsgSuperfluidUnbondLock](&osmocli.TxCliDesc{
 		Use:   "unbond-lock [lock_id] [flags]",
@@ -280,6 +289,23 @@ func NewCmdLockAndSuperfluidDelegate() *cobra.Command {
 	return cmd
 }
 
+func NewSuperfluidDelegatePartialCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgSuperfluidDelegatePartial](&osmocli.TxCliDesc{
+		Use:     "delegate-partial [lock_id] [coin] [val_addr] [flags]",
+		Short:   "superfluid delegate part of a lock to a validator",
+		Long:    "superfluid delegate the given coin out of a lock to a validator. The coin is split out of the lock into a new lock, and the rest of the lock stays plain locked.",
+		Example: "osmosisd tx superfluid delegate-partial 1 500gamm/pool/1 osmovaloper1...",
+	})
+}
+
+func NewCmdLockAndSuperfluidDelegatePartial() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgLockAndSuperfluidDelegatePartial](&osmocli.TxCliDesc{
+		Use:     "lock-and-superfluid-delegate-partial [tokens] [superfluid_coin] [val_addr] [flags]",
+		Short:   "lock and superfluid delegate part of the lock",
+		Example: "osmosisd tx superfluid lock-and-superfluid-delegate-partial 1000gamm/pool/1 500gamm/pool/1 osmovaloper1...",
+	})
+}
+
 func NewCmdUnPoolWhitelistedPool() *cobra.Command {
 	return osmocli.BuildTxCli[*types.MsgUnPoolWhitelistedPool](&osmocli.TxCliDesc{
 		Use:   "unpool-whitelisted-pool [pool_id] [flags]",
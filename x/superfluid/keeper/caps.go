@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v13/x/superfluid/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// getIntermediaryAccountDelegation returns the amount of osmo the intermediary account has delegated to the validator.
+func (k Keeper) getIntermediaryAccountDelegation(ctx sdk.Context, acc types.SuperfluidIntermediaryAccount, validator stakingtypes.Validator) sdk.Int {
+	delegation, found := k.sk.GetDelegation(ctx, acc.GetAccAddress(), validator.GetOperator())
+	if !found {
+		return sdk.ZeroInt()
+	}
+	return validator.TokensFromShares(delegation.Shares).TruncateInt()
+}
+
+// GetValidatorSuperfluidDelegation returns the total amount of osmo superfluid delegated to the validator,
+// over all superfluid assets.
+func (k Keeper) GetValidatorSuperfluidDelegation(ctx sdk.Context, validator stakingtypes.Validator) sdk.Int {
+	total := sdk.ZeroInt()
+	for _, acc := range k.GetIntermediaryAccountsForVal(ctx, validator.GetOperator()) {
+		total = total.Add(k.getIntermediaryAccountDelegation(ctx, acc, validator))
+	}
+	return total
+}
+
+// GetAssetSuperfluidDelegation returns the total amount of osmo superfluid delegated with the denom,
+// over all validators.
+func (k Keeper) GetAssetSuperfluidDelegation(ctx sdk.Context, denom string) sdk.Int {
+	total := sdk.ZeroInt()
+	for _, acc := range k.GetAllIntermediaryAccounts(ctx) {
+		if acc.Denom != denom {
+			continue
+		}
+		valAddr, err := sdk.ValAddressFromBech32(acc.ValAddr)
+		if err != nil {
+			panic(err)
+		}
+		validator, found := k.sk.GetValidator(ctx, valAddr)
+		if !found {
+			continue
+		}
+		total = total.Add(k.getIntermediaryAccountDelegation(ctx, acc, validator))
+	}
+	return total
+}
+
+// GetValidatorSuperfluidHeadroom returns the amount of osmo that can still be superfluid delegated to the
+// validator before the superfluid stake exceeds the max validator superfluid share of its total stake.
+// As new superfluid stake also increases the total stake, the headroom x is the largest amount satisfying
+// superfluid + x <= share * (tokens + x). Returns false if the share does not cap the validator.
+func (k Keeper) GetValidatorSuperfluidHeadroom(ctx sdk.Context, validator stakingtypes.Validator) (sdk.Int, bool) {
+	maxShare := k.GetParams(ctx).MaxValidatorSuperfluidShare
+	if maxShare.GTE(sdk.OneDec()) {
+		return sdk.Int{}, false
+	}
+
+	superfluid := k.GetValidatorSuperfluidDelegation(ctx, validator)
+	maxSuperfluid := maxShare.MulInt(validator.Tokens)
+	if maxSuperfluid.LTE(superfluid.ToDec()) {
+		return sdk.ZeroInt(), true
+	}
+	return maxSuperfluid.Sub(superfluid.ToDec()).Quo(sdk.OneDec().Sub(maxShare)).TruncateInt(), true
+}
+
+// GetAssetSuperfluidHeadroom returns the amount of osmo that can still be superfluid delegated with the
+// denom before reaching its asset cap. Returns false if the denom has no asset cap.
+func (k Keeper) GetAssetSuperfluidHeadroom(ctx sdk.Context, denom string) (sdk.Int, bool) {
+	assetCap, found := k.GetParams(ctx).GetAssetCap(denom)
+	if !found {
+		return sdk.Int{}, false
+	}
+
+	superfluid := k.GetAssetSuperfluidDelegation(ctx, denom)
+	if assetCap.LTE(superfluid) {
+		return sdk.ZeroInt(), true
+	}
+	return assetCap.Sub(superfluid), true
+}
+
+// validateValidatorSuperfluidCap returns an error if superfluid delegating the osmo amount to the validator
+// would exceed the max validator superfluid share.
+func (k Keeper) validateValidatorSuperfluidCap(ctx sdk.Context, validator stakingtypes.Validator, amount sdk.Int) error {
+	headroom, capped := k.GetValidatorSuperfluidHeadroom(ctx, validator)
+	if capped && amount.GT(headroom) {
+		return sdkerrors.Wrapf(types.ErrValidatorSuperfluidCapExceeded,
+			"validator: %s, amount: %s, headroom: %s", validator.OperatorAddress, amount, headroom)
+	}
+	return nil
+}
+
+// validateAssetSuperfluidCap returns an error if superfluid delegating the osmo amount with the denom
+// would exceed the asset cap of the denom.
+func (k Keeper) validateAssetSuperfluidCap(ctx sdk.Context, denom string, amount sdk.Int) error {
+	headroom, capped := k.GetAssetSuperfluidHeadroom(ctx, denom)
+	if capped && amount.GT(headroom) {
+		return sdkerrors.Wrapf(types.ErrAssetSuperfluidCapExceeded,
+			"denom: %s, amount: %s, headroom: %s", denom, amount, headroom)
+	}
+	return nil
+}
+
+// validateSuperfluidCaps runs both the validator and asset cap checks for superfluid delegating
+// the osmo amount with the denom to the validator.
+func (k Keeper) validateSuperfluidCaps(ctx sdk.Context, denom string, validator stakingtypes.Validator, amount sdk.Int) error {
+	if err := k.validateValidatorSuperfluidCap(ctx, validator, amount); err != nil {
+		return err
+	}
+	return k.validateAssetSuperfluidCap(ctx, denom, amount)
+}
+
+// clampToSuperfluidCaps returns the largest part of the osmo amount that can be superfluid delegated
+// with the denom to the validator without exceeding the validator or asset superfluid caps.
+func (k Keeper) clampToSuperfluidCaps(ctx sdk.Context, denom string, validator stakingtypes.Validator, amount sdk.Int) sdk.Int {
+	if headroom, capped := k.GetValidatorSuperfluidHeadroom(ctx, validator); capped {
+		amount = sdk.MinInt(amount, headroom)
+	}
+	if headroom, capped := k.GetAssetSuperfluidHeadroom(ctx, denom); capped {
+		amount = sdk.MinInt(amount, headroom)
+	}
+	return amount
+}
@@ -0,0 +1,192 @@
+package keeper_test
+
+import (
+	"github.com/osmosis-labs/osmosis/v13/x/superfluid/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (suite *KeeperTestSuite) setSuperfluidCaps(maxShare sdk.Dec, assetCaps []types.SuperfluidAssetCap) {
+	params := suite.App.SuperfluidKeeper.GetParams(suite.Ctx)
+	params.MaxValidatorSuperfluidShare = maxShare
+	params.AssetCaps = assetCaps
+	suite.App.SuperfluidKeeper.SetParams(suite.Ctx, params)
+}
+
+func (suite *KeeperTestSuite) TestSuperfluidDelegateCaps() {
+	// validators are set up with a self bond of 100 uosmo, and each lp share is worth
+	// 50% x 20 = 10 uosmo of superfluid stake.
+	testCases := []struct {
+		name      string
+		maxShare  sdk.Dec
+		assetCaps []types.SuperfluidAssetCap
+		lpAmount  int64
+		expErr    error
+	}{
+		{
+			name:     "no caps",
+			maxShare: sdk.OneDec(),
+			lpAmount: 1000000,
+		},
+		{
+			name:     "validator cap reached exactly",
+			maxShare: sdk.NewDecWithPrec(5, 1),
+			lpAmount: 10, // 100 superfluid out of 200 total stake
+		},
+		{
+			name:     "validator cap exceeded",
+			maxShare: sdk.NewDecWithPrec(5, 1),
+			lpAmount: 11, // 110 superfluid out of 210 total stake
+			expErr:   types.ErrValidatorSuperfluidCapExceeded,
+		},
+		{
+			name:      "asset cap reached exactly",
+			maxShare:  sdk.OneDec(),
+			assetCaps: []types.SuperfluidAssetCap{{Denom: "gamm/pool/1", MaxOsmoEquivalent: sdk.NewInt(100)}},
+			lpAmount:  10,
+		},
+		{
+			name:      "asset cap exceeded",
+			maxShare:  sdk.OneDec(),
+			assetCaps: []types.SuperfluidAssetCap{{Denom: "gamm/pool/1", MaxOsmoEquivalent: sdk.NewInt(99)}},
+			lpAmount:  10,
+			expErr:    types.ErrAssetSuperfluidCapExceeded,
+		},
+		{
+			name:      "cap of another asset",
+			maxShare:  sdk.OneDec(),
+			assetCaps: []types.SuperfluidAssetCap{{Denom: "gamm/pool/2", MaxOsmoEquivalent: sdk.NewInt(99)}},
+			lpAmount:  10,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+			denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+			suite.setSuperfluidCaps(tc.maxShare, tc.assetCaps)
+
+			lockOwner := suite.TestAccs[0]
+			coins := sdk.NewCoins(sdk.NewInt64Coin(denoms[0], tc.lpAmount))
+			suite.FundAcc(lockOwner, coins)
+			unbondingDuration := suite.App.StakingKeeper.GetParams(suite.Ctx).UnbondingTime
+			lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, lockOwner, coins, unbondingDuration)
+			suite.Require().NoError(err)
+
+			err = suite.App.SuperfluidKeeper.SuperfluidDelegate(suite.Ctx, lockOwner.String(), lock.ID, valAddrs[0].String())
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Empty(suite.App.SuperfluidKeeper.GetAllIntermediaryAccounts(suite.Ctx))
+				return
+			}
+			suite.Require().NoError(err)
+
+			// adding tokens to the lock is rejected once a cap is reached.
+			err = suite.App.SuperfluidKeeper.IncreaseSuperfluidDelegation(suite.Ctx, lock.ID, sdk.NewCoins(sdk.NewInt64Coin(denoms[0], 1)))
+			if tc.maxShare.LT(sdk.OneDec()) {
+				suite.Require().ErrorIs(err, types.ErrValidatorSuperfluidCapExceeded)
+			} else if len(tc.assetCaps) > 0 && tc.assetCaps[0].Denom == denoms[0] {
+				suite.Require().ErrorIs(err, types.ErrAssetSuperfluidCapExceeded)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSuperfluidRedelegateValidatorCap() {
+	suite.SetupTest()
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded})
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+	suite.setSuperfluidCaps(sdk.NewDecWithPrec(5, 1), nil)
+
+	// fill the cap of both validators with 100 uosmo of superfluid stake each.
+	_, _, locks := suite.setupSuperfluidDelegations(valAddrs, []superfluidDelegation{{0, 0, 0, 10}, {1, 1, 0, 10}}, denoms)
+
+	_, err := suite.App.SuperfluidKeeper.SuperfluidRedelegate(suite.Ctx, locks[0].Owner, locks[0].ID, valAddrs[1].String())
+	suite.Require().ErrorIs(err, types.ErrValidatorSuperfluidCapExceeded)
+
+	// raising the cap leaves room for the redelegation.
+	suite.setSuperfluidCaps(sdk.NewDecWithPrec(75, 2), nil)
+	_, err = suite.App.SuperfluidKeeper.SuperfluidRedelegate(suite.Ctx, locks[0].Owner, locks[0].ID, valAddrs[1].String())
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestSuperfluidHeadroom() {
+	suite.SetupTest()
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+	validator, found := suite.App.StakingKeeper.GetValidator(suite.Ctx, valAddrs[0])
+	suite.Require().True(found)
+
+	// no caps
+	_, capped := suite.App.SuperfluidKeeper.GetValidatorSuperfluidHeadroom(suite.Ctx, validator)
+	suite.Require().False(capped)
+	_, capped = suite.App.SuperfluidKeeper.GetAssetSuperfluidHeadroom(suite.Ctx, denoms[0])
+	suite.Require().False(capped)
+
+	suite.setSuperfluidCaps(sdk.NewDecWithPrec(75, 2), []types.SuperfluidAssetCap{{Denom: denoms[0], MaxOsmoEquivalent: sdk.NewInt(1000)}})
+
+	// 0.75 x (100 + x) >= x  =>  x <= 300
+	headroom, capped := suite.App.SuperfluidKeeper.GetValidatorSuperfluidHeadroom(suite.Ctx, validator)
+	suite.Require().True(capped)
+	suite.Require().Equal(sdk.NewInt(300), headroom)
+	headroom, capped = suite.App.SuperfluidKeeper.GetAssetSuperfluidHeadroom(suite.Ctx, denoms[0])
+	suite.Require().True(capped)
+	suite.Require().Equal(sdk.NewInt(1000), headroom)
+
+	// superfluid delegate 100 uosmo: 0.75 x (200 + x) >= 100 + x  =>  x <= 200
+	suite.setupSuperfluidDelegations(valAddrs, []superfluidDelegation{{0, 0, 0, 10}}, denoms)
+	validator, found = suite.App.StakingKeeper.GetValidator(suite.Ctx, valAddrs[0])
+	suite.Require().True(found)
+
+	headroom, _ = suite.App.SuperfluidKeeper.GetValidatorSuperfluidHeadroom(suite.Ctx, validator)
+	suite.Require().Equal(sdk.NewInt(200), headroom)
+	headroom, _ = suite.App.SuperfluidKeeper.GetAssetSuperfluidHeadroom(suite.Ctx, denoms[0])
+	suite.Require().Equal(sdk.NewInt(900), headroom)
+}
+
+func (suite *KeeperTestSuite) TestRefreshIntermediaryDelegationAmountsCaps() {
+	testCases := []struct {
+		name     string
+		maxShare sdk.Dec
+		assetCap int64
+	}{
+		{
+			name:     "validator cap",
+			maxShare: sdk.NewDecWithPrec(6, 1), // 0.6 x (200 + x) >= 100 + x  =>  x <= 50
+		},
+		{
+			name:     "asset cap",
+			maxShare: sdk.OneDec(),
+			assetCap: 150,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+			denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+			delAddrs, _, locks := suite.setupSuperfluidDelegations(valAddrs, []superfluidDelegation{{0, 0, 0, 10}}, denoms)
+			assetCaps := []types.SuperfluidAssetCap{}
+			if tc.assetCap > 0 {
+				assetCaps = append(assetCaps, types.SuperfluidAssetCap{Denom: denoms[0], MaxOsmoEquivalent: sdk.NewInt(tc.assetCap)})
+			}
+			suite.setSuperfluidCaps(tc.maxShare, assetCaps)
+
+			// adding 100 uosmo worth of lp shares to the lock is not rejected by the lockup module.
+			unbondingDuration := suite.App.StakingKeeper.GetParams(suite.Ctx).UnbondingTime
+			lockID := suite.LockTokens(delAddrs[0], sdk.NewCoins(sdk.NewInt64Coin(denoms[0], 10)), unbondingDuration)
+			suite.Require().Equal(locks[0].ID, lockID)
+
+			// System under test.
+			suite.App.SuperfluidKeeper.RefreshIntermediaryDelegationAmounts(suite.Ctx)
+
+			suite.Require().Equal(sdk.NewInt(150), suite.App.SuperfluidKeeper.GetAssetSuperfluidDelegation(suite.Ctx, denoms[0]))
+		})
+	}
+}
@@ -533,3 +533,38 @@ func (q Querier) UnpoolWhitelist(goCtx context.Context, req *types.QueryUnpoolWh
 		PoolIds: allowedPools,
 	}, nil
 }
+
+// SuperfluidDelegationHeadroom returns the amount of osmo that can still be superfluid delegated
+// to the validator with the denom, before reaching the validator and asset superfluid caps.
+func (q Querier) SuperfluidDelegationHeadroom(goCtx context.Context, req *types.QuerySuperfluidDelegationHeadroomRequest) (*types.QuerySuperfluidDelegationHeadroomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Denom) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	validator, found := q.sk.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, stakingtypes.ErrNoValidatorFound
+	}
+
+	res := &types.QuerySuperfluidDelegationHeadroomResponse{
+		ValidatorHeadroom: sdk.ZeroInt(),
+		AssetHeadroom:     sdk.ZeroInt(),
+	}
+	if headroom, capped := q.Keeper.GetValidatorSuperfluidHeadroom(ctx, validator); capped {
+		res.ValidatorCapped = true
+		res.ValidatorHeadroom = headroom
+	}
+	if headroom, capped := q.Keeper.GetAssetSuperfluidHeadroom(ctx, req.Denom); capped {
+		res.AssetCapped = true
+		res.AssetHeadroom = headroom
+	}
+	return res, nil
+}
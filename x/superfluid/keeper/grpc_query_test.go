@@ -305,3 +305,29 @@ func (suite *KeeperTestSuite) TestGRPCQueryTotalDelegationByDelegator() {
 		suite.Require().True(res.TotalEquivalentStakedAmount.IsEqual(total_osmo_equivalent))
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQuerySuperfluidDelegationHeadroom() {
+	suite.SetupTest()
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+	req := &types.QuerySuperfluidDelegationHeadroomRequest{ValidatorAddress: valAddrs[0].String(), Denom: denoms[0]}
+
+	res, err := suite.querier.SuperfluidDelegationHeadroom(sdk.WrapSDKContext(suite.Ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().False(res.ValidatorCapped)
+	suite.Require().False(res.AssetCapped)
+
+	suite.setSuperfluidCaps(sdk.NewDecWithPrec(5, 1), []types.SuperfluidAssetCap{{Denom: denoms[0], MaxOsmoEquivalent: sdk.NewInt(1000)}})
+	res, err = suite.querier.SuperfluidDelegationHeadroom(sdk.WrapSDKContext(suite.Ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().True(res.ValidatorCapped)
+	suite.Require().Equal(sdk.NewInt(100), res.ValidatorHeadroom)
+	suite.Require().True(res.AssetCapped)
+	suite.Require().Equal(sdk.NewInt(1000), res.AssetHeadroom)
+
+	// invalid requests
+	_, err = suite.querier.SuperfluidDelegationHeadroom(sdk.WrapSDKContext(suite.Ctx), &types.QuerySuperfluidDelegationHeadroomRequest{ValidatorAddress: valAddrs[0].String()})
+	suite.Require().Error(err)
+	_, err = suite.querier.SuperfluidDelegationHeadroom(sdk.WrapSDKContext(suite.Ctx), &types.QuerySuperfluidDelegationHeadroomRequest{ValidatorAddress: "invalid", Denom: denoms[0]})
+	suite.Require().Error(err)
+}
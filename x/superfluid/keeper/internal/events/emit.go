@@ -97,6 +97,25 @@ func newSuperfluidUndelegateEvent(lockId uint64) sdk.Event {
 	)
 }
 
+func EmitSuperfluidRedelegateEvent(ctx sdk.Context, lockId uint64, srcValAddr, dstValAddr string) {
+	if ctx.EventManager() == nil {
+		return
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newSuperfluidRedelegateEvent(lockId, srcValAddr, dstValAddr),
+	})
+}
+
+func newSuperfluidRedelegateEvent(lockId uint64, srcValAddr, dstValAddr string) sdk.Event {
+	return sdk.NewEvent(
+		types.TypeEvtSuperfluidRedelegate,
+		sdk.NewAttribute(types.AttributeLockId, fmt.Sprintf("%d", lockId)),
+		sdk.NewAttribute(types.AttributeSrcValidator, srcValAddr),
+		sdk.NewAttribute(types.AttributeDstValidator, dstValAddr),
+	)
+}
+
 func EmitSuperfluidUnbondLockEvent(ctx sdk.Context, lockId uint64) {
 	if ctx.EventManager() == nil {
 		return
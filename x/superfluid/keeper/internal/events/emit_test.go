@@ -246,6 +246,53 @@ func (suite *SuperfluidEventsTestSuite) TestEmitSuperfluidUndelegateEvent() {
 	}
 }
 
+func (suite *SuperfluidEventsTestSuite) TestEmitSuperfluidRedelegateEvent() {
+	testcases := map[string]struct {
+		ctx        sdk.Context
+		lockID     uint64
+		srcValAddr string
+		dstValAddr string
+	}{
+		"basic valid": {
+			ctx:        suite.CreateTestContext(),
+			lockID:     1,
+			srcValAddr: sdk.ValAddress([]byte("src_validator_______")).String(),
+			dstValAddr: sdk.ValAddress([]byte("dst_validator_______")).String(),
+		},
+		"context with no event manager": {
+			ctx: sdk.Context{},
+		},
+	}
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			expectedEvents := sdk.Events{
+				sdk.NewEvent(
+					types.TypeEvtSuperfluidRedelegate,
+					sdk.NewAttribute(types.AttributeLockId, fmt.Sprintf("%d", tc.lockID)),
+					sdk.NewAttribute(types.AttributeSrcValidator, tc.srcValAddr),
+					sdk.NewAttribute(types.AttributeDstValidator, tc.dstValAddr),
+				),
+			}
+
+			hasNoEventManager := tc.ctx.EventManager() == nil
+
+			// System under test.
+			events.EmitSuperfluidRedelegateEvent(tc.ctx, tc.lockID, tc.srcValAddr, tc.dstValAddr)
+
+			// Assertions
+			if hasNoEventManager {
+				// If there is no event manager on context, this is a no-op.
+				return
+			}
+
+			eventManager := tc.ctx.EventManager()
+			actualEvents := eventManager.Events()
+			suite.Equal(expectedEvents, actualEvents)
+		})
+	}
+}
+
 func (suite *SuperfluidEventsTestSuite) TestEmitSuperfluidUnbondLockEvent() {
 	testcases := map[string]struct {
 		ctx    sdk.Context
@@ -65,14 +65,20 @@ func (server msgServer) SuperfluidUndelegate(goCtx context.Context, msg *types.M
 	return &types.MsgSuperfluidUndelegateResponse{}, err
 }
 
-// SuperfluidRedelegate is a method to redelegate superfluid staked asset into a different validator.
-// Currently this feature is not supported.
-// func (server msgServer) SuperfluidRedelegate(goCtx context.Context, msg *types.MsgSuperfluidRedelegate) (*types.MsgSuperfluidRedelegateResponse, error) {
-// 	ctx := sdk.UnwrapSDKContext(goCtx)
+// SuperfluidRedelegate redelegates a currently superfluid delegated position into a different validator.
+// The delegation of the old intermediary account is instantly undelegated, and the same amount is delegated
+// from the intermediary account of the new validator. An unbonding synthetic lock is created on the old validator
+// so that the lock remains slashable for the old validator during the unbonding period.
+// Like x/staking redelegations, a lock that has an unbonding synthetic lock can not be redelegated.
+func (server msgServer) SuperfluidRedelegate(goCtx context.Context, msg *types.MsgSuperfluidRedelegate) (*types.MsgSuperfluidRedelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
 
-// 	err := server.keeper.SuperfluidRedelegate(ctx, msg.Sender, msg.LockId, msg.NewValAddr)
-// 	return &types.MsgSuperfluidRedelegateResponse{}, err
-// }
+	srcValAddr, err := server.keeper.SuperfluidRedelegate(ctx, msg.Sender, msg.LockId, msg.NewValAddr)
+	if err == nil {
+		events.EmitSuperfluidRedelegateEvent(ctx, msg.LockId, srcValAddr, msg.NewValAddr)
+	}
+	return &types.MsgSuperfluidRedelegateResponse{}, err
+}
 
 // SuperfluidUnbondLock starts unbonding for currently superfluid undelegating lock.
 // This method would return an error when the underlying lock is not in an superfluid undelegating state,
@@ -118,6 +124,53 @@ func (server msgServer) LockAndSuperfluidDelegate(goCtx context.Context, msg *ty
 	}, err
 }
 
+// SuperfluidDelegatePartial creates a delegation for the given coin out of the lock, and the validator to delegate to.
+// If the coin is less than the locked tokens, the coin is split out of the lock into a new lock that is
+// superfluid delegated, and the remaining tokens stay in the original lock.
+// The split lock has to satisfy the same pre-requisites as in SuperfluidDelegate.
+func (server msgServer) SuperfluidDelegatePartial(goCtx context.Context, msg *types.MsgSuperfluidDelegatePartial) (*types.MsgSuperfluidDelegatePartialResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	superfluidLockId, err := server.keeper.SuperfluidDelegatePartial(ctx, msg.Sender, msg.LockId, msg.Coin, msg.ValAddr)
+	if err != nil {
+		return &types.MsgSuperfluidDelegatePartialResponse{}, err
+	}
+
+	events.EmitSuperfluidDelegateEvent(ctx, superfluidLockId, msg.ValAddr)
+	return &types.MsgSuperfluidDelegatePartialResponse{SuperfluidLockId: superfluidLockId}, nil
+}
+
+// LockAndSuperfluidDelegatePartial locks the given tokens and superfluid delegates msg.SuperfluidCoin out
+// of the lock in a single message. This method consists of `LockTokens` from the lockup module msg server,
+// and `SuperfluidDelegatePartial` from the superfluid module msg server.
+func (server msgServer) LockAndSuperfluidDelegatePartial(goCtx context.Context, msg *types.MsgLockAndSuperfluidDelegatePartial) (*types.MsgLockAndSuperfluidDelegatePartialResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	lockupMsg := lockuptypes.MsgLockTokens{
+		Owner:    msg.Sender,
+		Duration: server.keeper.sk.GetParams(ctx).UnbondingTime,
+		Coins:    msg.Coins,
+	}
+
+	lockupRes, err := server.keeper.lms.LockTokens(goCtx, &lockupMsg)
+	if err != nil {
+		return &types.MsgLockAndSuperfluidDelegatePartialResponse{}, err
+	}
+
+	superfluidDelegateMsg := types.MsgSuperfluidDelegatePartial{
+		Sender:  msg.Sender,
+		LockId:  lockupRes.GetID(),
+		Coin:    msg.SuperfluidCoin,
+		ValAddr: msg.ValAddr,
+	}
+
+	superfluidRes, err := server.SuperfluidDelegatePartial(goCtx, &superfluidDelegateMsg)
+	return &types.MsgLockAndSuperfluidDelegatePartialResponse{
+		ID:               lockupRes.ID,
+		SuperfluidLockId: superfluidRes.SuperfluidLockId,
+	}, err
+}
+
 func (server msgServer) UnPoolWhitelistedPool(goCtx context.Context, msg *types.MsgUnPoolWhitelistedPool) (*types.MsgUnPoolWhitelistedPoolResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -217,6 +217,60 @@ func (suite *KeeperTestSuite) TestMsgLockAndSuperfluidDelegate() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgLockAndSuperfluidDelegatePartial() {
+	tests := []struct {
+		name             string
+		lockAmount       int64
+		superfluidAmount int64
+	}{
+		{
+			name:             "superfluid delegate part of the lock",
+			lockAmount:       20,
+			superfluidAmount: 5,
+		},
+		{
+			name:             "superfluid delegate the whole lock",
+			lockAmount:       20,
+			superfluidAmount: 20,
+		},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			suite.SetupTest()
+			lockOwner := sdk.AccAddress([]byte("addr1---------------"))
+			denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+			coinsToLock := sdk.NewCoins(sdk.NewInt64Coin(denoms[0], test.lockAmount))
+			superfluidCoin := sdk.NewInt64Coin(denoms[0], test.superfluidAmount)
+			suite.FundAcc(lockOwner, coinsToLock)
+
+			c := sdk.WrapSDKContext(suite.Ctx)
+			valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+
+			msgServer := keeper.NewMsgServerImpl(suite.App.SuperfluidKeeper)
+			resp, err := msgServer.LockAndSuperfluidDelegatePartial(c, types.NewMsgLockAndSuperfluidDelegatePartial(lockOwner, coinsToLock, superfluidCoin, valAddrs[0]))
+			suite.Require().NoError(err)
+
+			// the superfluid delegated lock holds the superfluid coin
+			sfLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, resp.SuperfluidLockId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(sdk.NewCoins(superfluidCoin), sfLock.Coins)
+			_, err = suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, resp.SuperfluidLockId, keeper.StakingSyntheticDenom(denoms[0], valAddrs[0].String()))
+			suite.Require().NoError(err)
+
+			// the rest of the tokens stay plain locked
+			lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, resp.ID)
+			suite.Require().NoError(err)
+			if test.superfluidAmount == test.lockAmount {
+				suite.Require().Equal(resp.ID, resp.SuperfluidLockId)
+			} else {
+				suite.Require().Equal(coinsToLock.Sub(sdk.NewCoins(superfluidCoin)), lock.Coins)
+				suite.Require().Empty(suite.App.LockupKeeper.GetAllSyntheticLockupsByLockup(suite.Ctx, resp.ID))
+			}
+		})
+	}
+}
+
 // TestMsgSuperfluidUndelegate_Event tests that events are correctly emitted
 // when calling SuperfluidUndelegate.
 func (suite *KeeperTestSuite) TestMsgSuperfluidUndelegate_Event() {
@@ -38,6 +38,7 @@ func (k Keeper) GetExpectedDelegationAmount(ctx sdk.Context, acc types.Superflui
 // RefreshIntermediaryDelegationAmounts refreshes the amount of delegation for all intermediary accounts.
 // This method includes minting new osmo if the refreshed delegation amount has increased, and
 // instantly undelegating and burning if the refreshed delgation has decreased.
+// Increases are clamped to the validator and asset superfluid caps.
 func (k Keeper) RefreshIntermediaryDelegationAmounts(ctx sdk.Context) {
 	// iterate over all intermedairy accounts - every (denom, validator) pair
 	accs := k.GetAllIntermediaryAccounts(ctx)
@@ -69,7 +70,13 @@ func (k Keeper) RefreshIntermediaryDelegationAmounts(ctx sdk.Context) {
 		refreshedAmount := k.GetExpectedDelegationAmount(ctx, acc)
 
 		if refreshedAmount.GT(currentAmount) {
-			adjustment := refreshedAmount.Sub(currentAmount)
+			// tokens added to superfluid locks past a cap are not rejected by the lockup module,
+			// so the increase is clamped to what the caps still allow.
+			adjustment := k.clampToSuperfluidCaps(ctx, acc.Denom, validator, refreshedAmount.Sub(currentAmount))
+			if !adjustment.IsPositive() {
+				k.Logger(ctx).Info(fmt.Sprintf("Superfluid caps reached, not increasing delegation of %s to %s.", mAddr.String(), acc.ValAddr))
+				continue
+			}
 			err = k.mintOsmoTokensAndDelegate(ctx, adjustment, acc)
 			if err != nil {
 				ctx.Logger().Error("Error in forceUndelegateAndBurnOsmoTokens, state update reverted", err)
@@ -94,7 +101,8 @@ func (k Keeper) RefreshIntermediaryDelegationAmounts(ctx sdk.Context) {
 }
 
 // IncreaseSuperfluidDelegation increases the amount of existing superfluid delegation.
-// This method would return an error if the lock has not been superfluid delegated before.
+// This method would return an error if the lock has not been superfluid delegated before,
+// or if the increase exceeds the validator or asset superfluid caps.
 func (k Keeper) IncreaseSuperfluidDelegation(ctx sdk.Context, lockID uint64, amount sdk.Coins) error {
 	acc, found := k.GetIntermediaryAccountFromLockId(ctx, lockID)
 	if !found {
@@ -108,7 +116,16 @@ func (k Keeper) IncreaseSuperfluidDelegation(ctx sdk.Context, lockID uint64, amo
 		return nil
 	}
 
-	err := k.mintOsmoTokensAndDelegate(ctx, osmoAmt, acc)
+	validator, err := k.validateValAddrForDelegate(ctx, acc.ValAddr)
+	if err != nil {
+		return err
+	}
+	err = k.validateSuperfluidCaps(ctx, acc.Denom, validator, osmoAmt)
+	if err != nil {
+		return err
+	}
+
+	err = k.mintOsmoTokensAndDelegate(ctx, osmoAmt, acc)
 	if err != nil {
 		return err
 	}
@@ -185,6 +202,7 @@ func (k Keeper) validateValAddrForDelegate(ctx sdk.Context, valAddr string) (sta
 // and the intermediary account, as an intermediary account does not serve for delegations from a single delegator.
 // The actual amount of delegation is not equal to the equivalent amount of osmo the lock has. That is,
 // the actual amount of delegation is amount * osmo equivalent multiplier * (1 - k.RiskFactor(asset)).
+// The delegation is rejected if it exceeds the max validator superfluid share or the asset cap set in the params.
 func (k Keeper) SuperfluidDelegate(ctx sdk.Context, sender string, lockID uint64, valAddr string) error {
 	lock, err := k.lk.GetLockByID(ctx, lockID)
 	if err != nil {
@@ -200,6 +218,23 @@ func (k Keeper) SuperfluidDelegate(ctx sdk.Context, sender string, lockID uint64
 	}
 	lockedCoin := lock.Coins[0]
 
+	// Find how many new osmo tokens this delegation is worth at superfluids current risk adjustment
+	// and twap of the denom.
+	amount := k.GetSuperfluidOSMOTokens(ctx, lockedCoin.Denom, lockedCoin.Amount)
+	if amount.IsZero() {
+		return types.ErrOsmoEquivalentZeroNotAllowed
+	}
+
+	// ensure the delegation stays within the validator and asset superfluid caps.
+	validator, err := k.validateValAddrForDelegate(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+	err = k.validateSuperfluidCaps(ctx, lockedCoin.Denom, validator, amount)
+	if err != nil {
+		return err
+	}
+
 	// get the intermediate account for this (denom, validator) pair.
 	// This account tracks the amount of osmo being considered as staked.
 	// If an intermediary account doesn't exist, then create it + a perpetual gauge.
@@ -216,48 +251,92 @@ func (k Keeper) SuperfluidDelegate(ctx sdk.Context, sender string, lockID uint64
 		return err
 	}
 
-	// Find how many new osmo tokens this delegation is worth at superfluids current risk adjustment
-	// and twap of the denom.
-	amount := k.GetSuperfluidOSMOTokens(ctx, acc.Denom, lockedCoin.Amount)
-	if amount.IsZero() {
-		return types.ErrOsmoEquivalentZeroNotAllowed
+	return k.mintOsmoTokensAndDelegate(ctx, amount, acc)
+}
+
+// SuperfluidDelegatePartial superfluid delegates the given coin out of the lock, and returns the ID of the
+// lock that is superfluid delegated. If the coin is less than the locked tokens, it is split out of the lock
+// into a new lock with the same owner and duration, which is then superfluid delegated, while the rest of the
+// tokens stay plain locked. As the split lock only holds the given coin, the original lock may hold multiple coins.
+func (k Keeper) SuperfluidDelegatePartial(ctx sdk.Context, sender string, lockID uint64, coin sdk.Coin, valAddr string) (uint64, error) {
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return 0, err
 	}
 
-	return k.mintOsmoTokensAndDelegate(ctx, amount, acc)
+	coins := sdk.NewCoins(coin)
+	if coins.IsAllLTE(lock.Coins) && lock.Coins.IsAllLTE(coins) {
+		return lockID, k.SuperfluidDelegate(ctx, sender, lockID, valAddr)
+	}
+
+	// validate the lock that would be split out before modifying the original lock.
+	splitLock := *lock
+	splitLock.Coins = coins
+	err = k.validateLockForSFDelegate(ctx, &splitLock, sender)
+	if err != nil {
+		return 0, err
+	}
+	_, err = k.validateValAddrForDelegate(ctx, valAddr)
+	if err != nil {
+		return 0, err
+	}
+
+	splitLock, err = k.lk.SplitLock(ctx, lockID, lock.OwnerAddress(), coins)
+	if err != nil {
+		return 0, err
+	}
+
+	return splitLock.ID, k.SuperfluidDelegate(ctx, sender, splitLock.ID, valAddr)
 }
 
-// SuperfluidUndelegate starts undelegating superfluid delegated position for the given lock.
-// Undelegation is done instantly and the equivalent amount is sent to the module account
-// where it is burnt instantly. Note that this method does not include unbonding the lock
-// itself.
-func (k Keeper) SuperfluidUndelegate(ctx sdk.Context, sender string, lockID uint64) error {
+// undelegateCommon is a helper function for SuperfluidUndelegate and SuperfluidRedelegate.
+// It performs the following tasks:
+// - checks that the lock is valid for superfluid staking
+// - gets the intermediary account associated with the lock id
+// - deletes the connection between the lock id and the intermediary account
+// - deletes the synthetic lockup associated with the lock id
+// - undelegates the superfluid staking position associated with the lock id and burns the underlying osmo tokens
+// - returns the intermediary account
+func (k Keeper) undelegateCommon(ctx sdk.Context, sender string, lockID uint64) (types.SuperfluidIntermediaryAccount, error) {
 	lock, err := k.lk.GetLockByID(ctx, lockID)
 	if err != nil {
-		return err
+		return types.SuperfluidIntermediaryAccount{}, err
 	}
 	err = k.validateLockForSF(ctx, lock, sender)
 	if err != nil {
-		return err
+		return types.SuperfluidIntermediaryAccount{}, err
 	}
 	lockedCoin := lock.Coins[0]
 
 	// get the intermediate acct asscd. with lock id, and delete the connection.
 	intermediaryAcc, found := k.GetIntermediaryAccountFromLockId(ctx, lockID)
 	if !found {
-		return types.ErrNotSuperfluidUsedLockup
+		return types.SuperfluidIntermediaryAccount{}, types.ErrNotSuperfluidUsedLockup
 	}
 	k.DeleteLockIdIntermediaryAccountConnection(ctx, lockID)
 
-	// Delete the old synthetic lockup, and create a new synthetic lockup representing the unstaking
+	// Delete the old synthetic lockup
 	synthdenom := stakingSyntheticDenom(lockedCoin.Denom, intermediaryAcc.ValAddr)
 	err = k.lk.DeleteSyntheticLockup(ctx, lockID, synthdenom)
 	if err != nil {
-		return err
+		return types.SuperfluidIntermediaryAccount{}, err
 	}
 
 	// undelegate this lock's delegation amount, and burn the minted osmo.
 	amount := k.GetSuperfluidOSMOTokens(ctx, intermediaryAcc.Denom, lockedCoin.Amount)
 	err = k.forceUndelegateAndBurnOsmoTokens(ctx, amount, intermediaryAcc)
+	if err != nil {
+		return types.SuperfluidIntermediaryAccount{}, err
+	}
+	return intermediaryAcc, nil
+}
+
+// SuperfluidUndelegate starts undelegating superfluid delegated position for the given lock.
+// Undelegation is done instantly and the equivalent amount is sent to the module account
+// where it is burnt instantly. Note that this method does not include unbonding the lock
+// itself.
+func (k Keeper) SuperfluidUndelegate(ctx sdk.Context, sender string, lockID uint64) error {
+	intermediaryAcc, err := k.undelegateCommon(ctx, sender, lockID)
 	if err != nil {
 		return err
 	}
@@ -266,8 +345,88 @@ func (k Keeper) SuperfluidUndelegate(ctx sdk.Context, sender string, lockID uint
 	return k.createSyntheticLockup(ctx, lockID, intermediaryAcc, unlockingStatus)
 }
 
+// SuperfluidRedelegate moves the superfluid delegated position of the given lock to a new validator.
+// The delegation of the old intermediary account is instantly undelegated and burnt, and the same
+// amount is minted and delegated by the intermediary account of the new validator.
+//
+// sk.BeginRedelegation is not used, as an x/staking redelegation keeps its delegator: the old
+// intermediary account would end up delegating to the new validator. Intermediary accounts are
+// per (denom, validator) pair and pool the delegations of many locks, and the epoch refresh,
+// slashing and rewards all assume an intermediary account only delegates to its own validator.
+// Instead, the limits of x/staking redelegations are enforced on the lock:
+//   - the lock can not be redelegated to its current validator.
+//   - an unbonding synthetic lockup is created on the old validator, so that the lock remains
+//     slashable for infractions of the old validator during the unbonding period.
+//   - transitive redelegations are not allowed: a lock that still has an unbonding synthetic
+//     lockup can not be redelegated, which also limits every lock to a single redelegation entry.
+//   - the osmo amount moved can not exceed the superfluid cap of the new validator.
+//
+// Returns the source validator address.
+func (k Keeper) SuperfluidRedelegate(ctx sdk.Context, sender string, lockID uint64, newValAddr string) (string, error) {
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return "", err
+	}
+	err = k.validateLockForSF(ctx, lock, sender)
+	if err != nil {
+		return "", err
+	}
+
+	oldAcc, found := k.GetIntermediaryAccountFromLockId(ctx, lockID)
+	if !found {
+		return "", types.ErrNotSuperfluidUsedLockup
+	}
+	if oldAcc.ValAddr == newValAddr {
+		return "", types.ErrSameValidatorRedelegation
+	}
+	newValidator, err := k.validateValAddrForDelegate(ctx, newValAddr)
+	if err != nil {
+		return "", err
+	}
+	for _, synthLock := range k.lk.GetAllSyntheticLockupsByLockup(ctx, lockID) {
+		if synthLock.IsUnlocking() {
+			return "", types.ErrUnbondingSyntheticLockupExists
+		}
+	}
+	amount := k.GetSuperfluidOSMOTokens(ctx, oldAcc.Denom, lock.Coins[0].Amount)
+	if amount.IsZero() {
+		return "", types.ErrOsmoEquivalentZeroNotAllowed
+	}
+	// the asset total is unchanged by a redelegation, so only the cap of the new validator applies.
+	err = k.validateValidatorSuperfluidCap(ctx, newValidator, amount)
+	if err != nil {
+		return "", err
+	}
+
+	// undelegate from the old validator, and leave an unbonding synthetic lockup behind.
+	oldAcc, err = k.undelegateCommon(ctx, sender, lockID)
+	if err != nil {
+		return "", err
+	}
+	err = k.createSyntheticLockup(ctx, lockID, oldAcc, unlockingStatus)
+	if err != nil {
+		return "", err
+	}
+
+	// delegate to the new validator through its intermediary account.
+	newAcc, err := k.GetOrCreateIntermediaryAccount(ctx, oldAcc.Denom, newValAddr)
+	if err != nil {
+		return "", err
+	}
+	k.SetLockIdIntermediaryAccountConnection(ctx, lockID, newAcc)
+
+	err = k.createSyntheticLockup(ctx, lockID, newAcc, bondedStatus)
+	if err != nil {
+		return "", err
+	}
+
+	return oldAcc.ValAddr, k.mintOsmoTokensAndDelegate(ctx, amount, newAcc)
+}
+
 // SuperfluidUnbondLock unbonds the lock that has been used for superfluid staking.
 // This method would return an error if the underlying lock is not superfluid undelegating.
+// A lock that has been redelegated keeps an unbonding synthetic lockup per source validator,
+// so every synthetic lockup of the lock is required to be unbonding.
 func (k Keeper) SuperfluidUnbondLock(ctx sdk.Context, underlyingLockId uint64, sender string) error {
 	lock, err := k.lk.GetLockByID(ctx, underlyingLockId)
 	if err != nil {
@@ -278,11 +437,13 @@ func (k Keeper) SuperfluidUnbondLock(ctx sdk.Context, underlyingLockId uint64, s
 		return err
 	}
 	synthLocks := k.lk.GetAllSyntheticLockupsByLockup(ctx, underlyingLockId)
-	if len(synthLocks) != 1 {
+	if len(synthLocks) == 0 {
 		return types.ErrNotSuperfluidUsedLockup
 	}
-	if !synthLocks[0].IsUnlocking() {
-		return types.ErrBondingLockupNotSupported
+	for _, synthLock := range synthLocks {
+		if !synthLock.IsUnlocking() {
+			return types.ErrBondingLockupNotSupported
+		}
 	}
 	return k.lk.BeginForceUnlock(ctx, underlyingLockId, sdk.Coins{})
 }
@@ -399,16 +560,31 @@ func (k Keeper) TotalBondedTokens(ctx sdk.Context) sdk.Int {
 
 // IterateDelegations implements govtypes.StakingKeeper
 // Iterates through staking keeper's delegations, and then all of the superfluid delegations.
+// This is what lets superfluid stakers override the vote of their validator in governance:
+// gov tallies the delegations of every voter, and deducts their shares from the vote of the validator.
+// Superfluid delegations are done by intermediary accounts, so for every bonded synthetic lockup of the
+// delegator a delegation of its osmo equivalent amount to the validator is passed to the callback.
 func (k Keeper) IterateDelegations(ctx sdk.Context, delegator sdk.AccAddress, fn func(int64, stakingtypes.DelegationI) bool) {
 	// call the callback with the non-superfluid delegations
 	var index int64
-	k.sk.IterateDelegations(ctx, delegator, func(i int64, delegation stakingtypes.DelegationI) (stop bool) {
-		index = i
-		return fn(i, delegation)
+	stopped := false
+	k.sk.IterateDelegations(ctx, delegator, func(_ int64, delegation stakingtypes.DelegationI) (stop bool) {
+		stopped = fn(index, delegation)
+		index++
+		return stopped
 	})
+	if stopped {
+		return
+	}
 
 	synthlocks := k.lk.GetAllSyntheticLockupsByAddr(ctx, delegator)
-	for i, lock := range synthlocks {
+	for _, lock := range synthlocks {
+		// unbonding synthetic lockups left behind by a redelegation do not carry voting power,
+		// the delegation is accounted for by the bonded synthetic lockup of the same lock.
+		if lock.IsUnlocking() {
+			continue
+		}
+
 		// get locked coin from the lock ID
 		interim, ok := k.GetIntermediaryAccountFromLockId(ctx, lock.UnderlyingLockId)
 		if !ok {
@@ -457,6 +633,9 @@ func (k Keeper) IterateDelegations(ctx sdk.Context, delegator sdk.AccAddress, fn
 		}
 
 		// if valid delegation has been found, increment delegation index
-		fn(index+int64(i), delegation)
+		if fn(index, delegation) {
+			return
+		}
+		index++
 	}
 }
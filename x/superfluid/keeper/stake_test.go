@@ -10,6 +10,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v13/x/superfluid/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -148,6 +149,110 @@ func (suite *KeeperTestSuite) TestSuperfluidDelegate() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSuperfluidDelegatePartial() {
+	testCases := []struct {
+		name          string
+		lockCoins     func(denoms []string) sdk.Coins
+		delegateCoin  func(denoms []string) sdk.Coin
+		nonOwner      bool
+		expectedError bool
+	}{
+		{
+			name:         "superfluid delegate part of the lock",
+			lockCoins:    func(denoms []string) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(denoms[0], 1000000)) },
+			delegateCoin: func(denoms []string) sdk.Coin { return sdk.NewInt64Coin(denoms[0], 400000) },
+		},
+		{
+			name:         "superfluid delegate the whole lock",
+			lockCoins:    func(denoms []string) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(denoms[0], 1000000)) },
+			delegateCoin: func(denoms []string) sdk.Coin { return sdk.NewInt64Coin(denoms[0], 1000000) },
+		},
+		{
+			name: "superfluid delegate one denom out of a multi coin lock",
+			lockCoins: func(denoms []string) sdk.Coins {
+				return sdk.NewCoins(sdk.NewInt64Coin(denoms[0], 1000000), sdk.NewInt64Coin(denoms[1], 1000000))
+			},
+			delegateCoin: func(denoms []string) sdk.Coin { return sdk.NewInt64Coin(denoms[1], 1000000) },
+		},
+		{
+			name:          "superfluid delegate more than the lock",
+			lockCoins:     func(denoms []string) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(denoms[0], 1000000)) },
+			delegateCoin:  func(denoms []string) sdk.Coin { return sdk.NewInt64Coin(denoms[0], 1000001) },
+			expectedError: true,
+		},
+		{
+			name: "superfluid delegate non superfluid asset",
+			lockCoins: func(denoms []string) sdk.Coins {
+				return sdk.NewCoins(sdk.NewInt64Coin(denoms[0], 1000000), sdk.NewInt64Coin("foo", 10))
+			},
+			delegateCoin:  func(denoms []string) sdk.Coin { return sdk.NewInt64Coin("foo", 5) },
+			expectedError: true,
+		},
+		{
+			name:          "superfluid delegate by non owner",
+			lockCoins:     func(denoms []string) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(denoms[0], 1000000)) },
+			delegateCoin:  func(denoms []string) sdk.Coin { return sdk.NewInt64Coin(denoms[0], 400000) },
+			nonOwner:      true,
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+			denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+			unbondingDuration := suite.App.StakingKeeper.GetParams(suite.Ctx).UnbondingTime
+
+			lockCoins := tc.lockCoins(denoms)
+			delegateCoin := tc.delegateCoin(denoms)
+			owner := suite.TestAccs[0]
+			suite.FundAcc(owner, lockCoins)
+			lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, owner, lockCoins, unbondingDuration)
+			suite.Require().NoError(err)
+
+			sender := owner.String()
+			if tc.nonOwner {
+				sender = suite.TestAccs[1].String()
+			}
+
+			sfLockID, err := suite.App.SuperfluidKeeper.SuperfluidDelegatePartial(suite.Ctx, sender, lock.ID, delegateCoin, valAddrs[0].String())
+			if tc.expectedError {
+				suite.Require().Error(err)
+
+				// the original lock is left untouched
+				origLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lock.ID)
+				suite.Require().NoError(err)
+				suite.Require().Equal(lockCoins, origLock.Coins)
+				suite.Require().Equal(lock.ID, suite.App.LockupKeeper.GetLastLockID(suite.Ctx))
+				return
+			}
+			suite.Require().NoError(err)
+
+			// check the superfluid delegated lock
+			sfLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, sfLockID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(sdk.NewCoins(delegateCoin), sfLock.Coins)
+			suite.Require().Equal(lock.Duration, sfLock.Duration)
+			_, err = suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, sfLockID, keeper.StakingSyntheticDenom(delegateCoin.Denom, valAddrs[0].String()))
+			suite.Require().NoError(err)
+
+			// check the rest of the lock stays plain locked
+			if sfLockID != lock.ID {
+				origLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lock.ID)
+				suite.Require().NoError(err)
+				suite.Require().Equal(lockCoins.Sub(sdk.NewCoins(delegateCoin)), origLock.Coins)
+				suite.Require().Empty(suite.App.LockupKeeper.GetAllSyntheticLockupsByLockup(suite.Ctx, lock.ID))
+			}
+
+			// check invariant is fine
+			reason, broken := keeper.AllInvariants(*suite.App.SuperfluidKeeper)(suite.Ctx)
+			suite.Require().False(broken, reason)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestSuperfluidUndelegate() {
 	testCases := []struct {
 		name                  string
@@ -546,158 +651,302 @@ func (suite *KeeperTestSuite) TestRefreshIntermediaryDelegationAmounts() {
 	}
 }
 
-// type superfluidRedelegation struct {
-// 	lockId      uint64
-// 	oldValIndex int64
-// 	newValIndex int64
-// }
-
-// func (suite *KeeperTestSuite) TestSuperfluidRedelegate() {
-// 	testCases := []struct {
-// 		name                    string
-// 		validatorStats          []stakingtypes.BondStatus
-// 		superDelegations        []superfluidDelegation
-// 		superRedelegations      []superfluidRedelegation
-// 		expSuperRedelegationErr []bool
-// 	}{
-// 		{
-// 			"with single validator and single superfluid delegation with single redelegation",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, "gamm/pool/1", 1000000}},
-// 			[]superfluidRedelegation{{1, 0, 1}}, // lock1 => val0 -> val1
-// 			[]bool{false},
-// 		},
-// 		{
-// 			"with multiple superfluid delegations with single redelegation",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, "gamm/pool/1", 1000000}, {0, "gamm/pool/1", 1000000}},
-// 			[]superfluidRedelegation{{1, 0, 1}}, // lock1 => val0 -> val1
-// 			[]bool{false},
-// 		},
-// 		{
-// 			"with multiple superfluid delegations with multiple redelegations",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, "gamm/pool/1", 1000000}, {0, "gamm/pool/1", 1000000}},
-// 			[]superfluidRedelegation{{1, 0, 1}, {2, 0, 1}}, // lock1 => val0 -> val1, lock2 => val0 -> val1
-// 			[]bool{false, false},
-// 		},
-// 		{
-// 			"try redelegating back from new validator to original validator",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, "gamm/pool/1", 1000000}, {0, "gamm/pool/1", 1000000}},
-// 			[]superfluidRedelegation{{1, 0, 1}, {1, 1, 0}}, // lock1 => val0 -> val1, lock1 => val1 -> val0
-// 			[]bool{false, true},
-// 		},
-// 		{
-// 			"not available lock id redelegation",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, "gamm/pool/1", 1000000}},
-// 			[]superfluidRedelegation{{2, 0, 1}}, // lock1 => val0 -> val1
-// 			[]bool{true},
-// 		},
-// 		{
-// 			"redelegation for same validator",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, "gamm/pool/1", 1000000}},
-// 			[]superfluidRedelegation{{1, 0, 0}}, // lock1 => val0 -> val0
-// 			[]bool{true},
-// 		},
-// 	}
-
-// 	for _, tc := range testCases {
-// 		tc := tc
-// 		suite.Run(tc.name, func() {
-// 			suite.SetupTest()
-
-// 			poolId := suite.createGammPool([]string{appparams.BaseCoinUnit, "foo"})
-// 			suite.Require().Equal(poolId, uint64(1))
-
-// 			// setup validators
-// 			valAddrs := suite.SetupValidators(tc.validatorStats)
-
-// 			// setup superfluid delegations
-// 			intermediaryAccs, _ := suite.SetupSuperfluidDelegations(valAddrs, tc.superDelegations)
-// 			suite.checkIntermediaryAccountDelegations(intermediaryAccs)
-
-// 			// execute redelegation and check changes on store
-// 			for index, srd := range tc.superRedelegations {
-// 				lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, srd.lockId)
-// 				if err != nil {
-// 					lock = &lockuptypes.PeriodLock{}
-// 				}
-
-// 				// superfluid redelegate
-// 				err = suite.App.SuperfluidKeeper.SuperfluidRedelegate(suite.Ctx, lock.Owner, srd.lockId, valAddrs[srd.newValIndex].String())
-// 				if tc.expSuperRedelegationErr[index] {
-// 					suite.Require().Error(err)
-// 					continue
-// 				}
-// 				suite.Require().NoError(err)
-
-// 				// check previous validator bonding synthetic lockup deletion
-// 				_, err = suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, srd.lockId, keeper.StakingSuffix(valAddrs[srd.oldValIndex].String()))
-// 				suite.Require().Error(err)
-
-// 				// check unbonding synthetic lockup creation
-// 				params := suite.App.SuperfluidKeeper.GetParams(suite.Ctx)
-// 				synthLock, err := suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, srd.lockId, keeper.UnstakingSuffix(valAddrs[srd.oldValIndex].String()))
-// 				suite.Require().NoError(err)
-// 				suite.Require().Equal(synthLock.UnderlyingLockId, srd.lockId)
-// 				suite.Require().Equal(synthLock.Suffix, keeper.UnstakingSuffix(valAddrs[srd.oldValIndex].String()))
-// 				suite.Require().Equal(synthLock.EndTime, suite.Ctx.BlockTime().Add(params.UnbondingDuration))
-
-// 				// check synthetic lockup creation
-// 				synthLock2, err := suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, srd.lockId, keeper.StakingSuffix(valAddrs[srd.newValIndex].String()))
-// 				suite.Require().NoError(err)
-// 				suite.Require().Equal(synthLock2.UnderlyingLockId, srd.lockId)
-// 				suite.Require().Equal(synthLock2.Suffix, keeper.StakingSuffix(valAddrs[srd.newValIndex].String()))
-// 				suite.Require().Equal(synthLock2.EndTime, time.Time{})
-
-// 				// check intermediary account creation
-// 				lock, err = suite.App.LockupKeeper.GetLockByID(suite.Ctx, srd.lockId)
-// 				suite.Require().NoError(err)
-
-// 				expAcc := types.NewSuperfluidIntermediaryAccount(lock.Coins[0].Denom, valAddrs[srd.newValIndex].String(), 1)
-// 				gotAcc := suite.App.SuperfluidKeeper.GetIntermediaryAccount(suite.Ctx, expAcc.GetAccAddress())
-// 				suite.Require().Equal(gotAcc.Denom, expAcc.Denom)
-// 				suite.Require().Equal(gotAcc.ValAddr, expAcc.ValAddr)
-
-// 				// check gauge creation
-// 				gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gotAcc.GaugeId)
-// 				suite.Require().NoError(err)
-// 				suite.Require().Equal(gauge.Id, gotAcc.GaugeId)
-// 				suite.Require().Equal(gauge.IsPerpetual, true)
-// 				suite.Require().Equal(gauge.DistributeTo, lockuptypes.QueryCondition{
-// 					LockQueryType: lockuptypes.ByDuration,
-// 					Denom:         expAcc.Denom + keeper.StakingSuffix(valAddrs[srd.newValIndex].String()),
-// 					Duration:      params.UnbondingDuration,
-// 				})
-// 				suite.Require().Equal(gauge.Coins, sdk.Coins(nil))
-// 				suite.Require().Equal(gauge.StartTime, suite.Ctx.BlockTime())
-// 				suite.Require().Equal(gauge.NumEpochsPaidOver, uint64(1))
-// 				suite.Require().Equal(gauge.FilledEpochs, uint64(0))
-// 				suite.Require().Equal(gauge.DistributedCoins, sdk.Coins(nil))
-
-// 				// Check lockID connection with intermediary account
-// 				intAcc := suite.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.Ctx, srd.lockId)
-// 				suite.Require().Equal(intAcc.String(), expAcc.GetAccAddress().String())
-
-// 				// check delegation from intermediary account to validator
-// 				_, found := suite.App.StakingKeeper.GetDelegation(suite.Ctx, expAcc.GetAccAddress(), valAddrs[srd.newValIndex])
-// 				suite.Require().True(found)
-// 			}
-
-// 			// try redelegating twice
-// 			for index, srd := range tc.superRedelegations {
-// 				if tc.expSuperRedelegationErr[index] {
-// 					continue
-// 				}
-// 				cacheCtx, _ := suite.Ctx.CacheContext()
-// 				lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, srd.lockId)
-// 				suite.Require().NoError(err)
-// 				err = suite.App.SuperfluidKeeper.SuperfluidRedelegate(cacheCtx, lock.Owner, srd.lockId, valAddrs[srd.newValIndex].String())
-// 				suite.Require().Error(err)
-// 			}
-// 		})
-// 	}
-// }
+type superfluidRedelegation struct {
+	lockId      uint64
+	oldValIndex int64
+	newValIndex int64
+}
+
+func (suite *KeeperTestSuite) TestSuperfluidRedelegate() {
+	testCases := []struct {
+		name                    string
+		validatorStats          []stakingtypes.BondStatus
+		superDelegations        []superfluidDelegation
+		superRedelegations      []superfluidRedelegation
+		expSuperRedelegationErr []bool
+	}{
+		{
+			"with single validator and single superfluid delegation with single redelegation",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
+			[]superfluidDelegation{{0, 0, 0, 1000000}},
+			[]superfluidRedelegation{{1, 0, 1}}, // lock1 => val0 -> val1
+			[]bool{false},
+		},
+		{
+			"with multiple superfluid delegations with single redelegation",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
+			[]superfluidDelegation{{0, 0, 0, 1000000}, {1, 0, 0, 1000000}},
+			[]superfluidRedelegation{{1, 0, 1}}, // lock1 => val0 -> val1
+			[]bool{false},
+		},
+		{
+			"with multiple superfluid delegations with multiple redelegations",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
+			[]superfluidDelegation{{0, 0, 0, 1000000}, {1, 0, 0, 1000000}},
+			[]superfluidRedelegation{{1, 0, 1}, {2, 0, 1}}, // lock1 => val0 -> val1, lock2 => val0 -> val1
+			[]bool{false, false},
+		},
+		{
+			"try redelegating back from new validator to original validator",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
+			[]superfluidDelegation{{0, 0, 0, 1000000}, {1, 0, 0, 1000000}},
+			[]superfluidRedelegation{{1, 0, 1}, {1, 1, 0}}, // lock1 => val0 -> val1, lock1 => val1 -> val0
+			[]bool{false, true},
+		},
+		{
+			"not available lock id redelegation",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
+			[]superfluidDelegation{{0, 0, 0, 1000000}},
+			[]superfluidRedelegation{{2, 0, 1}}, // lock2 => val0 -> val1
+			[]bool{true},
+		},
+		{
+			"redelegation for same validator",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
+			[]superfluidDelegation{{0, 0, 0, 1000000}},
+			[]superfluidRedelegation{{1, 0, 0}}, // lock1 => val0 -> val0
+			[]bool{true},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			bondDenom := suite.App.StakingKeeper.BondDenom(suite.Ctx)
+
+			// setup validators
+			valAddrs := suite.SetupValidators(tc.validatorStats)
+
+			denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+
+			// setup superfluid delegations
+			_, intermediaryAccs, _ := suite.setupSuperfluidDelegations(valAddrs, tc.superDelegations, denoms)
+			suite.checkIntermediaryAccountDelegations(intermediaryAccs)
+
+			unbondingDuration := suite.App.StakingKeeper.GetParams(suite.Ctx).UnbondingTime
+
+			// execute redelegation and check changes on store
+			for index, srd := range tc.superRedelegations {
+				lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, srd.lockId)
+				if err != nil {
+					lock = &lockuptypes.PeriodLock{}
+				}
+
+				presupplyWithOffset := suite.App.BankKeeper.GetSupplyWithOffset(suite.Ctx, bondDenom)
+
+				// superfluid redelegate
+				srcValAddr, err := suite.App.SuperfluidKeeper.SuperfluidRedelegate(suite.Ctx, lock.Owner, srd.lockId, valAddrs[srd.newValIndex].String())
+				if tc.expSuperRedelegationErr[index] {
+					suite.Require().Error(err)
+					continue
+				}
+				suite.Require().NoError(err)
+				suite.Require().Equal(valAddrs[srd.oldValIndex].String(), srcValAddr)
+
+				// ensure osmo supplywithoffset is unchanged by the redelegation
+				postsupplyWithOffset := suite.App.BankKeeper.GetSupplyWithOffset(suite.Ctx, bondDenom)
+				suite.Require().True(postsupplyWithOffset.IsEqual(presupplyWithOffset))
+
+				denom := lock.Coins[0].Denom
+				oldValAddr := valAddrs[srd.oldValIndex].String()
+				newValAddr := valAddrs[srd.newValIndex].String()
+
+				// check previous validator bonding synthetic lockup deletion
+				_, err = suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, srd.lockId, keeper.StakingSyntheticDenom(denom, oldValAddr))
+				suite.Require().Error(err)
+
+				// check unbonding synthetic lockup creation
+				synthLock, err := suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, srd.lockId, keeper.UnstakingSyntheticDenom(denom, oldValAddr))
+				suite.Require().NoError(err)
+				suite.Require().Equal(synthLock.UnderlyingLockId, srd.lockId)
+				suite.Require().Equal(synthLock.EndTime, suite.Ctx.BlockTime().Add(unbondingDuration))
+
+				// check synthetic lockup creation
+				synthLock2, err := suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, srd.lockId, keeper.StakingSyntheticDenom(denom, newValAddr))
+				suite.Require().NoError(err)
+				suite.Require().Equal(synthLock2.UnderlyingLockId, srd.lockId)
+				suite.Require().Equal(synthLock2.EndTime, time.Time{})
+
+				// check intermediary account creation
+				expAcc := types.NewSuperfluidIntermediaryAccount(denom, newValAddr, 0)
+				gotAcc := suite.App.SuperfluidKeeper.GetIntermediaryAccount(suite.Ctx, expAcc.GetAccAddress())
+				suite.Require().Equal(gotAcc.Denom, expAcc.Denom)
+				suite.Require().Equal(gotAcc.ValAddr, expAcc.ValAddr)
+
+				// check gauge creation
+				gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gotAcc.GaugeId)
+				suite.Require().NoError(err)
+				suite.Require().Equal(gauge.IsPerpetual, true)
+				suite.Require().Equal(gauge.DistributeTo, lockuptypes.QueryCondition{
+					LockQueryType: lockuptypes.ByDuration,
+					Denom:         keeper.StakingSyntheticDenom(denom, newValAddr),
+					Duration:      unbondingDuration,
+				})
+
+				// Check lockID connection with intermediary account
+				intAcc := suite.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.Ctx, srd.lockId)
+				suite.Require().Equal(intAcc.String(), expAcc.GetAccAddress().String())
+
+				// check delegation from intermediary account to validator
+				delegation, found := suite.App.StakingKeeper.GetDelegation(suite.Ctx, expAcc.GetAccAddress(), valAddrs[srd.newValIndex])
+				suite.Require().True(found)
+				suite.Require().Equal(suite.App.SuperfluidKeeper.GetExpectedDelegationAmount(suite.Ctx, gotAcc).ToDec(), delegation.Shares)
+			}
+
+			// check invariant is fine
+			reason, broken := keeper.AllInvariants(*suite.App.SuperfluidKeeper)(suite.Ctx)
+			suite.Require().False(broken, reason)
+
+			// try redelegating twice
+			for index, srd := range tc.superRedelegations {
+				if tc.expSuperRedelegationErr[index] {
+					continue
+				}
+				cacheCtx, _ := suite.Ctx.CacheContext()
+				lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, srd.lockId)
+				suite.Require().NoError(err)
+				_, err = suite.App.SuperfluidKeeper.SuperfluidRedelegate(cacheCtx, lock.Owner, srd.lockId, valAddrs[srd.oldValIndex].String())
+				suite.Require().ErrorIs(err, types.ErrUnbondingSyntheticLockupExists)
+			}
+		})
+	}
+}
+
+// TestSuperfluidRedelegateStakingLimits tests that a superfluid redelegation is held to the limits
+// of an x/staking redelegation: the lock stays slashable by the old validator and can not be
+// redelegated again until the unbonding period has passed.
+func (suite *KeeperTestSuite) TestSuperfluidRedelegateStakingLimits() {
+	suite.SetupTest()
+
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded, stakingtypes.Bonded})
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+	_, _, locks := suite.setupSuperfluidDelegations(valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}}, denoms)
+	lock := locks[0]
+	unbondingDuration := suite.App.StakingKeeper.GetParams(suite.Ctx).UnbondingTime
+	startTime := suite.Ctx.BlockTime()
+
+	_, err := suite.App.SuperfluidKeeper.SuperfluidRedelegate(suite.Ctx, lock.Owner, lock.ID, valAddrs[1].String())
+	suite.Require().NoError(err)
+
+	// an infraction of the old validator slashes the redelegated lock.
+	suite.App.SuperfluidKeeper.SlashLockupsForValidatorSlash(suite.Ctx, valAddrs[0], suite.Ctx.BlockHeight(), sdk.NewDecWithPrec(5, 2))
+	gotLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lock.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(950000), gotLock.Coins[0].Amount)
+
+	// transitive redelegations are rejected during the unbonding period.
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(unbondingDuration - time.Second))
+	suite.App.LockupKeeper.DeleteAllMaturedSyntheticLocks(suite.Ctx)
+	_, err = suite.App.SuperfluidKeeper.SuperfluidRedelegate(suite.Ctx, lock.Owner, lock.ID, valAddrs[2].String())
+	suite.Require().ErrorIs(err, types.ErrUnbondingSyntheticLockupExists)
+
+	// once the unbonding period has passed, the old validator can no longer slash the lock,
+	// and it can be redelegated again.
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(unbondingDuration))
+	suite.App.LockupKeeper.DeleteAllMaturedSyntheticLocks(suite.Ctx)
+	suite.App.SuperfluidKeeper.SlashLockupsForValidatorSlash(suite.Ctx, valAddrs[0], suite.Ctx.BlockHeight(), sdk.NewDecWithPrec(5, 2))
+	gotLock, err = suite.App.LockupKeeper.GetLockByID(suite.Ctx, lock.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(950000), gotLock.Coins[0].Amount)
+
+	srcValAddr, err := suite.App.SuperfluidKeeper.SuperfluidRedelegate(suite.Ctx, lock.Owner, lock.ID, valAddrs[2].String())
+	suite.Require().NoError(err)
+	suite.Require().Equal(valAddrs[1].String(), srcValAddr)
+}
+
+// TestSuperfluidUnbondLockAfterRedelegate tests that a redelegated lock
+// can only be unbonded once it has been undelegated from the new validator.
+func (suite *KeeperTestSuite) TestSuperfluidUnbondLockAfterRedelegate() {
+	suite.SetupTest()
+
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded})
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+	_, _, locks := suite.setupSuperfluidDelegations(valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}}, denoms)
+	lock := locks[0]
+
+	_, err := suite.App.SuperfluidKeeper.SuperfluidRedelegate(suite.Ctx, lock.Owner, lock.ID, valAddrs[1].String())
+	suite.Require().NoError(err)
+
+	// the lock is still bonded on the new validator
+	err = suite.App.SuperfluidKeeper.SuperfluidUnbondLock(suite.Ctx, lock.ID, lock.Owner)
+	suite.Require().ErrorIs(err, types.ErrBondingLockupNotSupported)
+
+	// the redelegated position only counts once towards the delegator's voting power
+	delegations := 0
+	suite.App.SuperfluidKeeper.IterateDelegations(suite.Ctx, lock.OwnerAddress(), func(_ int64, del stakingtypes.DelegationI) bool {
+		suite.Require().Equal(valAddrs[1].String(), del.GetValidatorAddr().String())
+		delegations++
+		return false
+	})
+	suite.Require().Equal(1, delegations)
+
+	err = suite.App.SuperfluidKeeper.SuperfluidUndelegate(suite.Ctx, lock.Owner, lock.ID)
+	suite.Require().NoError(err)
+
+	err = suite.App.SuperfluidKeeper.SuperfluidUnbondLock(suite.Ctx, lock.ID, lock.Owner)
+	suite.Require().NoError(err)
+}
+
+// TestSuperfluidVoteOverride tests that a superfluid delegator voting on a proposal
+// overrides the vote its validator casts for the osmo equivalent of its lock.
+func (suite *KeeperTestSuite) TestSuperfluidVoteOverride() {
+	testCases := []struct {
+		name           string
+		delegatorVotes bool
+		undelegate     bool
+	}{
+		{
+			name:           "superfluid delegator does not vote, validator vote is inherited",
+			delegatorVotes: false,
+		},
+		{
+			name:           "superfluid delegator votes, validator vote is overridden",
+			delegatorVotes: true,
+		},
+		{
+			name:           "superfluid undelegating delegator has no voting power",
+			delegatorVotes: true,
+			undelegate:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+			denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+			delAddrs, _, locks := suite.setupSuperfluidDelegations(valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}}, denoms)
+			if tc.undelegate {
+				err := suite.App.SuperfluidKeeper.SuperfluidUndelegate(suite.Ctx, locks[0].Owner, locks[0].ID)
+				suite.Require().NoError(err)
+			}
+
+			proposal, err := suite.App.GovKeeper.SubmitProposal(suite.Ctx, govtypes.NewTextProposal("title", "description"), false)
+			suite.Require().NoError(err)
+			suite.App.GovKeeper.ActivateVotingPeriod(suite.Ctx, proposal)
+
+			// the validator votes yes, with all of its tokens, including the ones of the intermediary account
+			err = suite.App.GovKeeper.AddVote(suite.Ctx, proposal.ProposalId, sdk.AccAddress(valAddrs[0]), govtypes.NewNonSplitVoteOption(govtypes.OptionYes))
+			suite.Require().NoError(err)
+			if tc.delegatorVotes {
+				err = suite.App.GovKeeper.AddVote(suite.Ctx, proposal.ProposalId, delAddrs[0], govtypes.NewNonSplitVoteOption(govtypes.OptionNo))
+				suite.Require().NoError(err)
+			}
+
+			validator, found := suite.App.StakingKeeper.GetValidator(suite.Ctx, valAddrs[0])
+			suite.Require().True(found)
+			expectedNo := sdk.ZeroInt()
+			if tc.delegatorVotes && !tc.undelegate {
+				expectedNo = suite.App.SuperfluidKeeper.GetSuperfluidOSMOTokens(suite.Ctx, denoms[0], locks[0].Coins[0].Amount)
+			}
+
+			proposal, found = suite.App.GovKeeper.GetProposal(suite.Ctx, proposal.ProposalId)
+			suite.Require().True(found)
+			_, _, tallyResult := suite.App.GovKeeper.Tally(suite.Ctx, proposal)
+			suite.Require().Equal(expectedNo, tallyResult.No)
+			suite.Require().Equal(validator.Tokens.Sub(expectedNo), tallyResult.Yes)
+		})
+	}
+}
@@ -14,7 +14,8 @@ import (
 func RandomizedGenState(simState *module.SimulationState) {
 	superfluidGenesis := &types.GenesisState{
 		Params: types.Params{
-			MinimumRiskFactor: sdk.NewDecWithPrec(5, 2), // 5%
+			MinimumRiskFactor:           sdk.NewDecWithPrec(5, 2), // 5%
+			MaxValidatorSuperfluidShare: sdk.OneDec(),
 		},
 		SuperfluidAssets:          []types.SuperfluidAsset{},
 		OsmoEquivalentMultipliers: []types.OsmoEquivalentMultiplierRecord{},
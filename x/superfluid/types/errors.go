@@ -18,6 +18,9 @@ var (
 
 	ErrNonSuperfluidAsset = sdkerrors.Register(ModuleName, 10, "provided asset is not supported for superfluid staking")
 
+	ErrValidatorSuperfluidCapExceeded = sdkerrors.Register(ModuleName, 11, "superfluid delegation exceeds the max validator superfluid share")
+	ErrAssetSuperfluidCapExceeded     = sdkerrors.Register(ModuleName, 12, "superfluid delegation exceeds the superfluid asset cap")
+
 	ErrPoolNotWhitelisted   = sdkerrors.Register(ModuleName, 41, "pool not whitelisted to unpool")
 	ErrLockUnpoolNotAllowed = sdkerrors.Register(ModuleName, 42, "lock not eligible for unpooling")
 	ErrLockLengthMismatch   = sdkerrors.Register(ModuleName, 43, "lock has more than one asset")
@@ -7,6 +7,7 @@ const (
 	TypeEvtSuperfluidDelegate           = "superfluid_delegate"
 	TypeEvtSuperfluidIncreaseDelegation = "superfluid_increase_delegation"
 	TypeEvtSuperfluidUndelegate         = "superfluid_undelegate"
+	TypeEvtSuperfluidRedelegate         = "superfluid_redelegate"
 	TypeEvtSuperfluidUnbondLock         = "superfluid_unbond_lock"
 
 	TypeEvtUnpoolId     = "unpool_pool_id"
@@ -16,5 +17,7 @@ const (
 	AttributeSuperfluidAssetType = "superfluid_asset_type"
 	AttributeLockId              = "lock_id"
 	AttributeValidator           = "validator"
+	AttributeSrcValidator        = "source_validator"
+	AttributeDstValidator        = "destination_validator"
 	AttributeAmount              = "amount"
 )
@@ -15,6 +15,9 @@ const (
 	TypeMsgSuperfluidUnbondLock      = "superfluid_unbond_underlying_lock"
 	TypeMsgLockAndSuperfluidDelegate = "lock_and_superfluid_delegate"
 	TypeMsgUnPoolWhitelistedPool     = "unpool_whitelisted_pool"
+
+	TypeMsgSuperfluidDelegatePartial        = "superfluid_delegate_partial"
+	TypeMsgLockAndSuperfluidDelegatePartial = "lock_and_superfluid_delegate_partial"
 )
 
 var _ sdk.Msg = &MsgSuperfluidDelegate{}
@@ -83,38 +86,40 @@ func (m MsgSuperfluidUndelegate) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
-// var _ sdk.Msg = &MsgSuperfluidRedelegate{}
-
-// // NewMsgSuperfluidRedelegate creates a message to do superfluid redelegation
-// func NewMsgSuperfluidRedelegate(sender sdk.AccAddress, lockId uint64, newValAddr sdk.ValAddress) *MsgSuperfluidRedelegate {
-// 	return &MsgSuperfluidRedelegate{
-// 		Sender:     sender.String(),
-// 		LockId:     lockId,
-// 		NewValAddr: newValAddr.String(),
-// 	}
-// }
-
-// func (m MsgSuperfluidRedelegate) Route() string { return RouterKey }
-// func (m MsgSuperfluidRedelegate) Type() string  { return TypeMsgSuperfluidRedelegate }
-// func (m MsgSuperfluidRedelegate) ValidateBasic() error {
-// 	if m.Sender == "" {
-// 		return fmt.Errorf("sender should not be an empty address")
-// 	}
-// 	if m.LockId == 0 {
-// 		return fmt.Errorf("lock id should be positive: %d < 0", m.LockId)
-// 	}
-// 	if m.NewValAddr == "" {
-// 		return fmt.Errorf("NewValAddr should not be empty")
-// 	}
-// 	return nil
-// }
-// func (m MsgSuperfluidRedelegate) GetSignBytes() []byte {
-// 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
-// }
-// func (m MsgSuperfluidRedelegate) GetSigners() []sdk.AccAddress {
-// 	sender, _ := sdk.AccAddressFromBech32(m.Sender)
-// 	return []sdk.AccAddress{sender}
-// }
+var _ sdk.Msg = &MsgSuperfluidRedelegate{}
+
+// NewMsgSuperfluidRedelegate creates a message to do superfluid redelegation
+func NewMsgSuperfluidRedelegate(sender sdk.AccAddress, lockId uint64, newValAddr sdk.ValAddress) *MsgSuperfluidRedelegate {
+	return &MsgSuperfluidRedelegate{
+		Sender:     sender.String(),
+		LockId:     lockId,
+		NewValAddr: newValAddr.String(),
+	}
+}
+
+func (m MsgSuperfluidRedelegate) Route() string { return RouterKey }
+func (m MsgSuperfluidRedelegate) Type() string  { return TypeMsgSuperfluidRedelegate }
+func (m MsgSuperfluidRedelegate) ValidateBasic() error {
+	if m.Sender == "" {
+		return fmt.Errorf("sender should not be an empty address")
+	}
+	if m.LockId == 0 {
+		return fmt.Errorf("lock id should be positive: %d < 0", m.LockId)
+	}
+	if m.NewValAddr == "" {
+		return fmt.Errorf("NewValAddr should not be empty")
+	}
+	return nil
+}
+
+func (m MsgSuperfluidRedelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSuperfluidRedelegate) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
 
 var _ sdk.Msg = &MsgSuperfluidUnbondLock{}
 
@@ -187,6 +192,90 @@ func (m MsgLockAndSuperfluidDelegate) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
+var _ sdk.Msg = &MsgSuperfluidDelegatePartial{}
+
+// NewMsgSuperfluidDelegatePartial creates a message to do superfluid delegation of part of a lock.
+func NewMsgSuperfluidDelegatePartial(sender sdk.AccAddress, lockId uint64, coin sdk.Coin, valAddr sdk.ValAddress) *MsgSuperfluidDelegatePartial {
+	return &MsgSuperfluidDelegatePartial{
+		Sender:  sender.String(),
+		LockId:  lockId,
+		Coin:    coin,
+		ValAddr: valAddr.String(),
+	}
+}
+
+func (m MsgSuperfluidDelegatePartial) Route() string { return RouterKey }
+func (m MsgSuperfluidDelegatePartial) Type() string  { return TypeMsgSuperfluidDelegatePartial }
+func (m MsgSuperfluidDelegatePartial) ValidateBasic() error {
+	if m.Sender == "" {
+		return fmt.Errorf("sender should not be an empty address")
+	}
+	if m.LockId == 0 {
+		return fmt.Errorf("lock id should be positive: %d < 0", m.LockId)
+	}
+	if !m.Coin.IsValid() || !m.Coin.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid coin to superfluid delegate: %s", m.Coin)
+	}
+	if m.ValAddr == "" {
+		return fmt.Errorf("ValAddr should not be empty")
+	}
+	return nil
+}
+
+func (m MsgSuperfluidDelegatePartial) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSuperfluidDelegatePartial) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgLockAndSuperfluidDelegatePartial{}
+
+// NewMsgLockAndSuperfluidDelegatePartial creates a message to create a lockup lock and superfluid delegate part of it.
+func NewMsgLockAndSuperfluidDelegatePartial(sender sdk.AccAddress, coins sdk.Coins, superfluidCoin sdk.Coin, valAddr sdk.ValAddress) *MsgLockAndSuperfluidDelegatePartial {
+	return &MsgLockAndSuperfluidDelegatePartial{
+		Sender:         sender.String(),
+		Coins:          coins,
+		SuperfluidCoin: superfluidCoin,
+		ValAddr:        valAddr.String(),
+	}
+}
+
+func (m MsgLockAndSuperfluidDelegatePartial) Route() string { return RouterKey }
+func (m MsgLockAndSuperfluidDelegatePartial) Type() string {
+	return TypeMsgLockAndSuperfluidDelegatePartial
+}
+
+func (m MsgLockAndSuperfluidDelegatePartial) ValidateBasic() error {
+	if m.Sender == "" {
+		return fmt.Errorf("sender should not be an empty address")
+	}
+	if !m.Coins.IsValid() || m.Coins.Empty() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid coins to lock: %s", m.Coins)
+	}
+	if !m.SuperfluidCoin.IsValid() || !m.SuperfluidCoin.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid coin to superfluid delegate: %s", m.SuperfluidCoin)
+	}
+	if !sdk.NewCoins(m.SuperfluidCoin).IsAllLTE(m.Coins) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "superfluid coin (%s) exceeds the coins to lock (%s)", m.SuperfluidCoin, m.Coins)
+	}
+	if m.ValAddr == "" {
+		return fmt.Errorf("ValAddr should not be empty")
+	}
+	return nil
+}
+
+func (m MsgLockAndSuperfluidDelegatePartial) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgLockAndSuperfluidDelegatePartial) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
 var _ sdk.Msg = &MsgUnPoolWhitelistedPool{}
 
 // NewMsgUnPoolWhitelistedPool creates a message to create a lockup lock and superfluid delegation
@@ -10,8 +10,11 @@ import (
 
 // Parameter store keys.
 var (
-	KeyMinimumRiskFactor     = []byte("MinimumRiskFactor")
-	defaultMinimumRiskFactor = sdk.NewDecWithPrec(5, 1) // 50%
+	KeyMinimumRiskFactor               = []byte("MinimumRiskFactor")
+	KeyMaxValidatorSuperfluidShare     = []byte("MaxValidatorSuperfluidShare")
+	KeyAssetCaps                       = []byte("AssetCaps")
+	defaultMinimumRiskFactor           = sdk.NewDecWithPrec(5, 1) // 50%
+	defaultMaxValidatorSuperfluidShare = sdk.OneDec()             // 100%, no cap
 )
 
 // ParamTable for minting module.
@@ -19,28 +22,50 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(minimumRiskFactor sdk.Dec) Params {
+func NewParams(minimumRiskFactor, maxValidatorSuperfluidShare sdk.Dec, assetCaps []SuperfluidAssetCap) Params {
 	return Params{
-		MinimumRiskFactor: minimumRiskFactor,
+		MinimumRiskFactor:           minimumRiskFactor,
+		MaxValidatorSuperfluidShare: maxValidatorSuperfluidShare,
+		AssetCaps:                   assetCaps,
 	}
 }
 
 // default minting module parameters.
 func DefaultParams() Params {
 	return Params{
-		MinimumRiskFactor: defaultMinimumRiskFactor, // 5%
+		MinimumRiskFactor:           defaultMinimumRiskFactor, // 5%
+		MaxValidatorSuperfluidShare: defaultMaxValidatorSuperfluidShare,
 	}
 }
 
 // validate params.
 func (p Params) Validate() error {
-	return nil
+	if err := ValidateMinimumRiskFactor(p.MinimumRiskFactor); err != nil {
+		return err
+	}
+	if err := ValidateMaxValidatorSuperfluidShare(p.MaxValidatorSuperfluidShare); err != nil {
+		return err
+	}
+	return ValidateAssetCaps(p.AssetCaps)
+}
+
+// GetAssetCap returns the cap of the total osmo equivalent superfluid delegated with the denom,
+// and false if the denom has no cap.
+func (p Params) GetAssetCap(denom string) (sdk.Int, bool) {
+	for _, assetCap := range p.AssetCaps {
+		if assetCap.Denom == denom {
+			return assetCap.MaxOsmoEquivalent, true
+		}
+	}
+	return sdk.Int{}, false
 }
 
 // Implements params.ParamSet.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyMinimumRiskFactor, &p.MinimumRiskFactor, ValidateMinimumRiskFactor),
+		paramtypes.NewParamSetPair(KeyMaxValidatorSuperfluidShare, &p.MaxValidatorSuperfluidShare, ValidateMaxValidatorSuperfluidShare),
+		paramtypes.NewParamSetPair(KeyAssetCaps, &p.AssetCaps, ValidateAssetCaps),
 	}
 }
 
@@ -57,6 +82,43 @@ func ValidateMinimumRiskFactor(i interface{}) error {
 	return nil
 }
 
+func ValidateMaxValidatorSuperfluidShare(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("max validator superfluid share should be in (0, 1]: %s", v.String())
+	}
+
+	return nil
+}
+
+func ValidateAssetCaps(i interface{}) error {
+	v, ok := i.([]SuperfluidAssetCap)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, assetCap := range v {
+		if err := sdk.ValidateDenom(assetCap.Denom); err != nil {
+			return err
+		}
+		if seen[assetCap.Denom] {
+			return fmt.Errorf("duplicate asset cap for denom: %s", assetCap.Denom)
+		}
+		seen[assetCap.Denom] = true
+
+		if assetCap.MaxOsmoEquivalent.IsNil() || assetCap.MaxOsmoEquivalent.IsNegative() {
+			return fmt.Errorf("asset cap of %s should not be negative", assetCap.Denom)
+		}
+	}
+
+	return nil
+}
+
 func ValidateUnbondingDuration(i interface{}) error {
 	v, ok := i.(time.Duration)
 	if !ok {
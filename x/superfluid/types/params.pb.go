@@ -32,6 +32,13 @@ type Params struct {
 	// to counter-balance the staked amount on chain's exposure to various asset
 	// volatilities, and have base staking be 'resistant' to volatility.
 	MinimumRiskFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=minimum_risk_factor,json=minimumRiskFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"minimum_risk_factor" yaml:"minimum_risk_factor"`
+	// max_validator_superfluid_share is the maximum share of the total stake of
+	// a validator that can be superfluid delegated to it, default: 100%.
+	// Superfluid delegations that would exceed the share are rejected.
+	MaxValidatorSuperfluidShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_validator_superfluid_share,json=maxValidatorSuperfluidShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_validator_superfluid_share" yaml:"max_validator_superfluid_share"`
+	// asset_caps are the maximum total osmo equivalent that can be superfluid
+	// delegated with a superfluid asset. Assets without a cap are not limited.
+	AssetCaps []SuperfluidAssetCap `protobuf:"bytes,3,rep,name=asset_caps,json=assetCaps,proto3" json:"asset_caps" yaml:"asset_caps"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -67,31 +74,96 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetAssetCaps() []SuperfluidAssetCap {
+	if m != nil {
+		return m.AssetCaps
+	}
+	return nil
+}
+
+// SuperfluidAssetCap is the maximum total osmo equivalent that can be
+// superfluid delegated with the denom.
+type SuperfluidAssetCap struct {
+	Denom             string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	MaxOsmoEquivalent github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=max_osmo_equivalent,json=maxOsmoEquivalent,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_osmo_equivalent" yaml:"max_osmo_equivalent"`
+}
+
+func (m *SuperfluidAssetCap) Reset()         { *m = SuperfluidAssetCap{} }
+func (m *SuperfluidAssetCap) String() string { return proto.CompactTextString(m) }
+func (*SuperfluidAssetCap) ProtoMessage()    {}
+func (*SuperfluidAssetCap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0985261dfaf2a82e, []int{1}
+}
+func (m *SuperfluidAssetCap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SuperfluidAssetCap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuperfluidAssetCap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SuperfluidAssetCap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuperfluidAssetCap.Merge(m, src)
+}
+func (m *SuperfluidAssetCap) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuperfluidAssetCap) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuperfluidAssetCap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuperfluidAssetCap proto.InternalMessageInfo
+
+func (m *SuperfluidAssetCap) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.superfluid.Params")
+	proto.RegisterType((*SuperfluidAssetCap)(nil), "osmosis.superfluid.SuperfluidAssetCap")
 }
 
 func init() { proto.RegisterFile("osmosis/superfluid/params.proto", fileDescriptor_0985261dfaf2a82e) }
 
 var fileDescriptor_0985261dfaf2a82e = []byte{
-	// 260 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0x2e, 0x2d, 0x48, 0x2d, 0x4a, 0xcb, 0x29, 0xcd, 0x4c, 0xd1, 0x2f,
-	0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0x2a, 0xd0,
-	0x43, 0x28, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xeb, 0x83, 0x58, 0x10, 0x95, 0x52,
-	0x72, 0xe9, 0xf9, 0xf9, 0xe9, 0x39, 0xa9, 0xfa, 0x60, 0x5e, 0x52, 0x69, 0x9a, 0x7e, 0x4a, 0x69,
-	0x51, 0x62, 0x49, 0x66, 0x7e, 0x1e, 0x44, 0x5e, 0xa9, 0x8d, 0x91, 0x8b, 0x2d, 0x00, 0x6c, 0xb4,
-	0x50, 0x0d, 0x97, 0x70, 0x6e, 0x66, 0x5e, 0x66, 0x6e, 0x69, 0x6e, 0x7c, 0x51, 0x66, 0x71, 0x76,
-	0x7c, 0x5a, 0x62, 0x72, 0x49, 0x7e, 0x91, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xa7, 0x93, 0xcf, 0x89,
-	0x7b, 0xf2, 0x0c, 0xb7, 0xee, 0xc9, 0xab, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7,
-	0xe7, 0xea, 0x27, 0x83, 0x5d, 0x01, 0xa5, 0x74, 0x8b, 0x53, 0xb2, 0xf5, 0x4b, 0x2a, 0x0b, 0x52,
-	0x8b, 0xf5, 0x5c, 0x52, 0x93, 0x3f, 0xdd, 0x93, 0x97, 0xaa, 0x4c, 0xcc, 0xcd, 0xb1, 0x52, 0xc2,
-	0x62, 0xa4, 0x52, 0x90, 0x20, 0x54, 0x34, 0x28, 0xb3, 0x38, 0xdb, 0x0d, 0x2c, 0xe6, 0x14, 0x70,
-	0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7,
-	0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x66, 0x48, 0x56, 0x42, 0xfd, 0xad,
-	0x9b, 0x93, 0x98, 0x54, 0x0c, 0xe3, 0xe8, 0x97, 0x19, 0x1a, 0xeb, 0x57, 0x20, 0x87, 0x15, 0xd8,
-	0x19, 0x49, 0x6c, 0x60, 0x1f, 0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xc2, 0x27, 0xe8, 0xb2,
-	0x4e, 0x01, 0x00, 0x00,
+	// 424 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xcd, 0x6a, 0xdb, 0x40,
+	0x10, 0xb6, 0x62, 0x1a, 0xc8, 0xf6, 0x14, 0x35, 0x07, 0xd7, 0x85, 0x55, 0x10, 0x34, 0xe4, 0x12,
+	0x2d, 0x6d, 0xa0, 0x87, 0xde, 0xea, 0xfe, 0x40, 0xa1, 0xd0, 0xa0, 0x40, 0x0b, 0xbd, 0x6c, 0xc7,
+	0xd2, 0x5a, 0x59, 0xac, 0xd5, 0xaa, 0x3b, 0x2b, 0xa3, 0x40, 0xef, 0xbd, 0xf6, 0x01, 0xfa, 0x00,
+	0x7d, 0x94, 0x1c, 0x73, 0x2c, 0x3d, 0x98, 0x62, 0xbf, 0x41, 0x9e, 0xa0, 0x68, 0xa5, 0xc4, 0x06,
+	0x97, 0x82, 0x4f, 0xd2, 0xcc, 0xf7, 0xcd, 0x37, 0x9f, 0xf4, 0x0d, 0x09, 0x34, 0x2a, 0x8d, 0x12,
+	0x19, 0x56, 0xa5, 0x30, 0x93, 0xbc, 0x92, 0x29, 0x2b, 0xc1, 0x80, 0xc2, 0xa8, 0x34, 0xda, 0x6a,
+	0xdf, 0xef, 0x08, 0xd1, 0x8a, 0x30, 0x3c, 0xc8, 0x74, 0xa6, 0x1d, 0xcc, 0x9a, 0xb7, 0x96, 0x39,
+	0xa4, 0x99, 0xd6, 0x59, 0x2e, 0x98, 0xab, 0xc6, 0xd5, 0x84, 0xa5, 0x95, 0x01, 0x2b, 0x75, 0xd1,
+	0xe2, 0xe1, 0xb7, 0x3e, 0xd9, 0x3d, 0x73, 0xd2, 0xfe, 0x57, 0xf2, 0x40, 0xc9, 0x42, 0xaa, 0x4a,
+	0x71, 0x23, 0x71, 0xca, 0x27, 0x90, 0x58, 0x6d, 0x06, 0xde, 0xa1, 0x77, 0xbc, 0x37, 0x7a, 0x77,
+	0x35, 0x0f, 0x7a, 0xbf, 0xe7, 0xc1, 0x51, 0x26, 0xed, 0x45, 0x35, 0x8e, 0x12, 0xad, 0x58, 0xe2,
+	0x5c, 0x74, 0x8f, 0x13, 0x4c, 0xa7, 0xcc, 0x5e, 0x96, 0x02, 0xa3, 0x57, 0x22, 0xb9, 0x99, 0x07,
+	0xc3, 0x4b, 0x50, 0xf9, 0xf3, 0xf0, 0x1f, 0x92, 0x61, 0xbc, 0xdf, 0x75, 0x63, 0x89, 0xd3, 0x37,
+	0xae, 0xe7, 0xff, 0xf0, 0x08, 0x55, 0x50, 0xf3, 0x19, 0xe4, 0x32, 0x05, 0xab, 0x0d, 0x5f, 0x7d,
+	0x1b, 0xc7, 0x0b, 0x30, 0x62, 0xb0, 0xe3, 0x9c, 0x7c, 0xdc, 0xda, 0xc9, 0xe3, 0xce, 0xc9, 0x7f,
+	0xd5, 0xc3, 0xf8, 0x91, 0x82, 0xfa, 0xc3, 0x2d, 0x7e, 0x7e, 0x07, 0x9f, 0x37, 0xa8, 0xff, 0x99,
+	0x10, 0x40, 0x14, 0x96, 0x27, 0x50, 0xe2, 0xa0, 0x7f, 0xd8, 0x3f, 0xbe, 0xff, 0xf4, 0x28, 0xda,
+	0x8c, 0x21, 0x5a, 0x0d, 0xbe, 0x68, 0xf8, 0x2f, 0xa1, 0x1c, 0x3d, 0x6c, 0x1c, 0xdf, 0xcc, 0x83,
+	0xfd, 0xd6, 0xc7, 0x4a, 0x27, 0x8c, 0xf7, 0xa0, 0x23, 0x61, 0xf8, 0xd3, 0x23, 0xfe, 0xe6, 0xb0,
+	0x7f, 0x40, 0xee, 0xa5, 0xa2, 0xd0, 0xaa, 0xcd, 0x21, 0x6e, 0x0b, 0x97, 0x15, 0xd4, 0xbc, 0xd9,
+	0xcf, 0xc5, 0x97, 0x4a, 0xce, 0x20, 0x17, 0x85, 0x1d, 0xec, 0x6c, 0x9d, 0xd5, 0xdb, 0xc2, 0xae,
+	0x65, 0xb5, 0x29, 0xd9, 0x64, 0x05, 0xf5, 0x7b, 0x54, 0xfa, 0xf5, 0x5d, 0x6f, 0x74, 0x76, 0xb5,
+	0xa0, 0xde, 0xf5, 0x82, 0x7a, 0x7f, 0x16, 0xd4, 0xfb, 0xbe, 0xa4, 0xbd, 0xeb, 0x25, 0xed, 0xfd,
+	0x5a, 0xd2, 0xde, 0xa7, 0x67, 0x6b, 0x2b, 0xbb, 0x9f, 0x73, 0x92, 0xc3, 0x18, 0x6f, 0x0b, 0x36,
+	0x7b, 0x72, 0xca, 0xea, 0xf5, 0xbb, 0x76, 0x36, 0xc6, 0xbb, 0xee, 0x1a, 0x4f, 0xff, 0x0e, 0x00,
+	0x70, 0xa6, 0xf7, 0x32, 0xfa, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -114,6 +186,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AssetCaps) > 0 {
+		for iNdEx := len(m.AssetCaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssetCaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.MaxValidatorSuperfluidShare.Size()
+		i -= size
+		if _, err := m.MaxValidatorSuperfluidShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size := m.MinimumRiskFactor.Size()
 		i -= size
@@ -127,6 +223,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SuperfluidAssetCap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperfluidAssetCap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuperfluidAssetCap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxOsmoEquivalent.Size()
+		i -= size
+		if _, err := m.MaxOsmoEquivalent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -146,6 +282,29 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = m.MinimumRiskFactor.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxValidatorSuperfluidShare.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.AssetCaps) > 0 {
+		for _, e := range m.AssetCaps {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SuperfluidAssetCap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.MaxOsmoEquivalent.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -218,6 +377,190 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorSuperfluidShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxValidatorSuperfluidShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetCaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetCaps = append(m.AssetCaps, SuperfluidAssetCap{})
+			if err := m.AssetCaps[len(m.AssetCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SuperfluidAssetCap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuperfluidAssetCap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuperfluidAssetCap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOsmoEquivalent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxOsmoEquivalent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1575,6 +1575,125 @@ func (m *QueryUnpoolWhitelistResponse) GetPoolIds() []uint64 {
 	return nil
 }
 
+type QuerySuperfluidDelegationHeadroomRequest struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Denom            string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QuerySuperfluidDelegationHeadroomRequest) Reset() {
+	*m = QuerySuperfluidDelegationHeadroomRequest{}
+}
+func (m *QuerySuperfluidDelegationHeadroomRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySuperfluidDelegationHeadroomRequest) ProtoMessage()    {}
+func (*QuerySuperfluidDelegationHeadroomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{32}
+}
+func (m *QuerySuperfluidDelegationHeadroomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySuperfluidDelegationHeadroomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySuperfluidDelegationHeadroomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySuperfluidDelegationHeadroomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySuperfluidDelegationHeadroomRequest.Merge(m, src)
+}
+func (m *QuerySuperfluidDelegationHeadroomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySuperfluidDelegationHeadroomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySuperfluidDelegationHeadroomRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySuperfluidDelegationHeadroomRequest proto.InternalMessageInfo
+
+func (m *QuerySuperfluidDelegationHeadroomRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *QuerySuperfluidDelegationHeadroomRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type QuerySuperfluidDelegationHeadroomResponse struct {
+	// validator_capped is false if the max validator superfluid share does not
+	// limit superfluid delegations to the validator.
+	ValidatorCapped bool `protobuf:"varint,1,opt,name=validator_capped,json=validatorCapped,proto3" json:"validator_capped,omitempty"`
+	// validator_headroom is the osmo equivalent that can still be superfluid
+	// delegated to the validator.
+	ValidatorHeadroom github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=validator_headroom,json=validatorHeadroom,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"validator_headroom" yaml:"validator_headroom"`
+	// asset_capped is false if the denom has no asset cap.
+	AssetCapped bool `protobuf:"varint,3,opt,name=asset_capped,json=assetCapped,proto3" json:"asset_capped,omitempty"`
+	// asset_headroom is the osmo equivalent that can still be superfluid
+	// delegated with the denom.
+	AssetHeadroom github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=asset_headroom,json=assetHeadroom,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"asset_headroom" yaml:"asset_headroom"`
+}
+
+func (m *QuerySuperfluidDelegationHeadroomResponse) Reset() {
+	*m = QuerySuperfluidDelegationHeadroomResponse{}
+}
+func (m *QuerySuperfluidDelegationHeadroomResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QuerySuperfluidDelegationHeadroomResponse) ProtoMessage() {}
+func (*QuerySuperfluidDelegationHeadroomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{33}
+}
+func (m *QuerySuperfluidDelegationHeadroomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySuperfluidDelegationHeadroomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySuperfluidDelegationHeadroomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySuperfluidDelegationHeadroomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySuperfluidDelegationHeadroomResponse.Merge(m, src)
+}
+func (m *QuerySuperfluidDelegationHeadroomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySuperfluidDelegationHeadroomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySuperfluidDelegationHeadroomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySuperfluidDelegationHeadroomResponse proto.InternalMessageInfo
+
+func (m *QuerySuperfluidDelegationHeadroomResponse) GetValidatorCapped() bool {
+	if m != nil {
+		return m.ValidatorCapped
+	}
+	return false
+}
+
+func (m *QuerySuperfluidDelegationHeadroomResponse) GetAssetCapped() bool {
+	if m != nil {
+		return m.AssetCapped
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.superfluid.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.superfluid.QueryParamsResponse")
@@ -1608,129 +1727,139 @@ func init() {
 	proto.RegisterType((*QueryTotalDelegationByDelegatorResponse)(nil), "osmosis.superfluid.QueryTotalDelegationByDelegatorResponse")
 	proto.RegisterType((*QueryUnpoolWhitelistRequest)(nil), "osmosis.superfluid.QueryUnpoolWhitelistRequest")
 	proto.RegisterType((*QueryUnpoolWhitelistResponse)(nil), "osmosis.superfluid.QueryUnpoolWhitelistResponse")
+	proto.RegisterType((*QuerySuperfluidDelegationHeadroomRequest)(nil), "osmosis.superfluid.QuerySuperfluidDelegationHeadroomRequest")
+	proto.RegisterType((*QuerySuperfluidDelegationHeadroomResponse)(nil), "osmosis.superfluid.QuerySuperfluidDelegationHeadroomResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/superfluid/query.proto", fileDescriptor_e3d9448e4ed3943f) }
 
 var fileDescriptor_e3d9448e4ed3943f = []byte{
-	// 1858 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4b, 0x6c, 0xd4, 0xdc,
-	0x15, 0x8e, 0x27, 0xf9, 0x13, 0x72, 0x22, 0x91, 0xe4, 0x42, 0xff, 0x24, 0x06, 0x26, 0xfc, 0x4e,
-	0x48, 0xd2, 0x00, 0x36, 0x09, 0x05, 0x02, 0x14, 0xc4, 0x0c, 0x21, 0x10, 0x29, 0x69, 0xe8, 0x84,
-	0x04, 0xa9, 0x0f, 0x59, 0xce, 0xf8, 0x66, 0x62, 0xc5, 0x63, 0x4f, 0xe6, 0x7a, 0x02, 0x23, 0x84,
-	0x2a, 0xa5, 0xaa, 0x54, 0xd4, 0x45, 0x2b, 0xb1, 0xea, 0xae, 0x5b, 0x58, 0xb4, 0xcb, 0x6e, 0xba,
-	0xa9, 0xba, 0x41, 0xaa, 0x2a, 0x21, 0x75, 0x53, 0x75, 0x01, 0x15, 0x74, 0xd9, 0x6e, 0xba, 0x6c,
-	0xa5, 0xaa, 0xf2, 0xbd, 0xd7, 0x8f, 0x99, 0xb1, 0x3d, 0x9e, 0x40, 0xe1, 0x5f, 0x65, 0xec, 0x7b,
-	0x5e, 0xdf, 0x79, 0x5d, 0x9f, 0x13, 0xc8, 0xda, 0xa4, 0x6c, 0x13, 0x83, 0x28, 0xa4, 0x56, 0xc1,
-	0xd5, 0x6d, 0xb3, 0x66, 0xe8, 0xca, 0x5e, 0x0d, 0x57, 0xeb, 0x72, 0xa5, 0x6a, 0x3b, 0x36, 0x42,
-	0xfc, 0x5c, 0x0e, 0xce, 0xc5, 0xe3, 0x25, 0xbb, 0x64, 0xd3, 0x63, 0xc5, 0xfd, 0xc5, 0x28, 0xc5,
-	0x6c, 0x91, 0x92, 0x2a, 0x5b, 0x1a, 0xc1, 0xca, 0xfe, 0xdc, 0x16, 0x76, 0xb4, 0x39, 0xa5, 0x68,
-	0x1b, 0x16, 0x3f, 0x3f, 0x59, 0xb2, 0xed, 0x92, 0x89, 0x15, 0xad, 0x62, 0x28, 0x9a, 0x65, 0xd9,
-	0x8e, 0xe6, 0x18, 0xb6, 0x45, 0xf8, 0xe9, 0x38, 0x3f, 0xa5, 0x4f, 0x5b, 0xb5, 0x6d, 0xc5, 0x31,
-	0xca, 0x98, 0x38, 0x5a, 0xb9, 0xe2, 0x89, 0x6f, 0x26, 0xd0, 0x6b, 0x55, 0x2a, 0x81, 0x9f, 0x4f,
-	0x44, 0x00, 0x09, 0x7e, 0x7a, 0x5a, 0x22, 0x88, 0x2a, 0x5a, 0x55, 0x2b, 0x7b, 0x66, 0x8c, 0x79,
-	0x04, 0xa6, 0x5d, 0xdc, 0xad, 0x55, 0xe8, 0x1f, 0x7e, 0x34, 0x1b, 0xc6, 0x47, 0x5d, 0xe4, 0xa3,
-	0xac, 0x68, 0x25, 0xc3, 0x0a, 0x1b, 0x33, 0xc9, 0x69, 0x89, 0xa3, 0xed, 0x1a, 0x56, 0xc9, 0x27,
-	0xe4, 0xcf, 0x8c, 0x4a, 0x3a, 0x0e, 0xe8, 0xbb, 0xae, 0x9c, 0xfb, 0xd4, 0x82, 0x02, 0xde, 0xab,
-	0x61, 0xe2, 0x48, 0x6b, 0x70, 0xac, 0xe1, 0x2d, 0xa9, 0xd8, 0x16, 0xc1, 0x68, 0x01, 0x7a, 0x99,
-	0xa5, 0xa3, 0xc2, 0x69, 0x61, 0x66, 0x60, 0x5e, 0x94, 0x5b, 0x23, 0x23, 0x33, 0x9e, 0x7c, 0xcf,
-	0xab, 0x37, 0xe3, 0x5d, 0x05, 0x4e, 0x2f, 0xcd, 0xc0, 0x50, 0x8e, 0x10, 0xec, 0x3c, 0xa8, 0x57,
-	0x30, 0x57, 0x82, 0x8e, 0xc3, 0x17, 0x3a, 0xb6, 0xec, 0x32, 0x15, 0xd6, 0x5f, 0x60, 0x0f, 0xd2,
-	0xf7, 0x61, 0x38, 0x44, 0xc9, 0x15, 0x2f, 0x01, 0x68, 0xee, 0x4b, 0xd5, 0xa9, 0x57, 0x30, 0xa5,
-	0x3f, 0x3a, 0x3f, 0x1d, 0xa5, 0x7c, 0xdd, 0xff, 0x19, 0x08, 0xe9, 0xd7, 0xbc, 0x9f, 0x12, 0x82,
-	0xa1, 0x9c, 0x69, 0xd2, 0x23, 0x1f, 0xeb, 0x26, 0x0c, 0x87, 0xde, 0x71, 0x85, 0x39, 0xe8, 0xa5,
-	0x5c, 0x2e, 0xd2, 0xee, 0x99, 0x81, 0xf9, 0x89, 0x14, 0xca, 0x3c, 0xc8, 0x8c, 0x51, 0x92, 0xe1,
-	0x4b, 0xfa, 0x7a, 0xb5, 0x66, 0x3a, 0x46, 0xc5, 0x34, 0x70, 0x35, 0x19, 0xf8, 0xcf, 0x04, 0x18,
-	0x69, 0x61, 0xe0, 0xe6, 0x54, 0x40, 0x74, 0xf5, 0xab, 0x78, 0xaf, 0x66, 0xec, 0x6b, 0x26, 0xb6,
-	0x1c, 0xb5, 0xec, 0x53, 0xf1, 0x60, 0xcc, 0x47, 0x99, 0xb8, 0x46, 0xca, 0xf6, 0x1d, 0x9f, 0x29,
-	0x2c, 0xb9, 0x68, 0x57, 0xf5, 0xc2, 0xa8, 0x1d, 0x73, 0x2e, 0x3d, 0x13, 0xe0, 0xab, 0x00, 0xdf,
-	0xb2, 0xe5, 0xe0, 0x6a, 0x19, 0xeb, 0x86, 0x56, 0xad, 0xe7, 0x8a, 0x45, 0xbb, 0x66, 0x39, 0xcb,
-	0xd6, 0xb6, 0x1d, 0x8d, 0x04, 0x8d, 0xc1, 0x91, 0x7d, 0xcd, 0x54, 0x35, 0x5d, 0xaf, 0x8e, 0x66,
-	0xe8, 0x41, 0xdf, 0xbe, 0x66, 0xe6, 0x74, 0xbd, 0xea, 0x1e, 0x95, 0xb4, 0x5a, 0x09, 0xab, 0x86,
-	0x3e, 0xda, 0x7d, 0x5a, 0x98, 0xe9, 0x29, 0xf4, 0xd1, 0xe7, 0x65, 0x1d, 0x8d, 0x42, 0x9f, 0xcb,
-	0x81, 0x09, 0x19, 0xed, 0x61, 0x4c, 0xfc, 0x51, 0xda, 0x81, 0x6c, 0xce, 0x34, 0x23, 0x6c, 0xf0,
-	0x62, 0xe8, 0xe6, 0x47, 0x90, 0xff, 0xdc, 0x1f, 0x53, 0x32, 0x2b, 0x00, 0xd9, 0x2d, 0x16, 0x99,
-	0xf5, 0x13, 0x5e, 0x03, 0xf2, 0x7d, 0xad, 0xe4, 0xa5, 0x61, 0x21, 0xc4, 0x29, 0xfd, 0x41, 0x80,
-	0xf1, 0x58, 0x55, 0x3c, 0x16, 0x0f, 0xe1, 0x88, 0xc6, 0xdf, 0xf1, 0xe4, 0xb8, 0x94, 0x9c, 0x1c,
-	0x31, 0xce, 0xe3, 0xe9, 0xe2, 0x0b, 0x43, 0x77, 0x1b, 0x40, 0x64, 0x28, 0x88, 0xe9, 0xb6, 0x20,
-	0x98, 0x55, 0x0d, 0x28, 0x6e, 0xc2, 0xc4, 0x6d, 0xdb, 0xb2, 0x70, 0xd1, 0xc1, 0x51, 0xca, 0x3d,
-	0xa7, 0x8d, 0x40, 0x9f, 0xdb, 0x5a, 0xdc, 0x50, 0x08, 0x34, 0x14, 0xbd, 0xee, 0xe3, 0xb2, 0x2e,
-	0x3d, 0x82, 0xc9, 0x64, 0x7e, 0xee, 0x89, 0x35, 0xe8, 0xe3, 0xc6, 0x73, 0x97, 0x1f, 0xce, 0x11,
-	0x05, 0x4f, 0x8a, 0xb4, 0x04, 0x32, 0x6d, 0x3b, 0x0f, 0x6c, 0x47, 0x33, 0x17, 0xb1, 0x89, 0x4b,
-	0x14, 0x50, 0xbe, 0xbe, 0xa9, 0x99, 0x86, 0xae, 0x39, 0x76, 0x75, 0xc9, 0xae, 0x2e, 0xba, 0x39,
-	0x96, 0x5c, 0x4a, 0x15, 0x50, 0x52, 0xcb, 0xe1, 0x58, 0x6e, 0x34, 0x15, 0xfc, 0x78, 0x14, 0x94,
-	0x40, 0x14, 0x69, 0x2a, 0xf6, 0x83, 0x0c, 0x0c, 0x84, 0x4e, 0x1b, 0x4a, 0x40, 0x68, 0x2c, 0x01,
-	0x0c, 0x03, 0x5a, 0xd9, 0x85, 0xab, 0x92, 0x6d, 0xa2, 0xb3, 0x02, 0xc9, 0x2f, 0xba, 0xd2, 0xfe,
-	0xfa, 0x66, 0x7c, 0xaa, 0x64, 0x38, 0x3b, 0xb5, 0x2d, 0xb9, 0x68, 0x97, 0x15, 0xde, 0xbf, 0xd9,
-	0x9f, 0xf3, 0x44, 0xdf, 0x55, 0xdc, 0xee, 0x47, 0xe4, 0x65, 0xcb, 0xf9, 0xd7, 0x9b, 0x71, 0x54,
-	0xd7, 0xca, 0xe6, 0x35, 0x29, 0x24, 0x4a, 0x2a, 0x00, 0x7b, 0x5a, 0xdf, 0x26, 0x3a, 0xda, 0x83,
-	0xc1, 0xa6, 0x96, 0x41, 0x0b, 0xae, 0x3f, 0x7f, 0xaf, 0x63, 0x55, 0x5f, 0x32, 0x55, 0x4d, 0xe2,
-	0xa4, 0xc2, 0xd1, 0xc6, 0xee, 0x21, 0x4d, 0xc0, 0x57, 0xd4, 0xe3, 0x41, 0xc4, 0x43, 0x2e, 0xf1,
-	0xda, 0xed, 0x0b, 0x01, 0xa4, 0x24, 0x2a, 0x1e, 0x8f, 0x03, 0x01, 0x86, 0x1d, 0x97, 0x4c, 0xd5,
-	0x83, 0x53, 0xe6, 0xca, 0xfc, 0x46, 0xc7, 0x08, 0x26, 0x18, 0x02, 0x26, 0x30, 0x08, 0x68, 0x58,
-	0xb6, 0x54, 0x18, 0x72, 0x1a, 0xd3, 0x85, 0x48, 0xcf, 0x1b, 0x9a, 0x60, 0x70, 0x92, 0x2b, 0x87,
-	0xeb, 0xe8, 0x2c, 0x0c, 0x73, 0x39, 0x76, 0x55, 0xf5, 0x5a, 0x18, 0x0b, 0xfa, 0x90, 0x7f, 0x90,
-	0x63, 0xef, 0x5d, 0xe2, 0x7d, 0x2f, 0x09, 0x7d, 0x62, 0xd6, 0x24, 0x87, 0xfc, 0x03, 0x8f, 0xd8,
-	0xcf, 0xee, 0xee, 0x70, 0x76, 0x3f, 0x13, 0x40, 0x4a, 0xb2, 0x8a, 0x7b, 0xb0, 0x08, 0xbd, 0x2c,
-	0x1d, 0x78, 0x46, 0x8f, 0x35, 0xb4, 0x12, 0xaf, 0x89, 0xdc, 0xb6, 0x0d, 0x2b, 0x7f, 0xc1, 0x75,
-	0xe8, 0xcb, 0xb7, 0xe3, 0x33, 0x29, 0x1c, 0xea, 0x32, 0x90, 0x02, 0x17, 0x2d, 0x6d, 0xc2, 0x74,
-	0x64, 0x1c, 0xf3, 0xf5, 0x45, 0x0f, 0xf9, 0x61, 0xdc, 0x24, 0xfd, 0xb6, 0x1b, 0x66, 0xda, 0x0b,
-	0xe6, 0x48, 0x1f, 0xc3, 0xa9, 0xc8, 0x98, 0xaa, 0x55, 0x7a, 0xcb, 0x79, 0x25, 0x2d, 0x27, 0x77,
-	0xa7, 0x40, 0x09, 0xbb, 0x1c, 0x79, 0x85, 0x9f, 0x20, 0xb1, 0x14, 0x04, 0xfd, 0x08, 0xbe, 0xd1,
-	0x90, 0xa4, 0x58, 0x57, 0xdd, 0xaf, 0x4d, 0x37, 0xa2, 0x1f, 0xdd, 0xe5, 0xc7, 0xc2, 0xe9, 0x89,
-	0x75, 0xfa, 0x12, 0xfd, 0x5c, 0x80, 0x2c, 0xb3, 0x20, 0xf4, 0x69, 0xe0, 0x7e, 0xe1, 0x61, 0x5d,
-	0xe5, 0xd1, 0xef, 0xa6, 0xad, 0x39, 0xc1, 0x14, 0x85, 0x9b, 0x32, 0x9d, 0xd2, 0x94, 0xc2, 0x09,
-	0xaa, 0x31, 0x28, 0xfc, 0x75, 0xaa, 0x8f, 0xa5, 0x9f, 0x64, 0xc1, 0x37, 0x03, 0x9f, 0x6e, 0x58,
-	0xfa, 0x47, 0xcb, 0x89, 0xa0, 0x1a, 0x32, 0xe1, 0x6a, 0xf8, 0x77, 0x06, 0x66, 0xd3, 0x28, 0xfc,
-	0xec, 0xb9, 0xf2, 0x63, 0x01, 0x46, 0x58, 0xa8, 0x6a, 0xd6, 0x27, 0x48, 0x17, 0x96, 0x98, 0x1b,
-	0x81, 0x2a, 0x96, 0x30, 0x2b, 0x30, 0x48, 0xea, 0x96, 0xb3, 0x83, 0x1d, 0xa3, 0xa8, 0xba, 0xf7,
-	0x3d, 0x19, 0xed, 0xa6, 0xca, 0x4f, 0xf9, 0x88, 0xd9, 0xd8, 0x21, 0xaf, 0x7b, 0x64, 0x2b, 0x76,
-	0x71, 0x97, 0x03, 0x3c, 0x4a, 0xc2, 0x2f, 0x89, 0xb4, 0x07, 0xe7, 0x62, 0xaa, 0xd4, 0xbf, 0x69,
-	0x1b, 0xae, 0xeb, 0xc8, 0xee, 0x27, 0xb4, 0xeb, 0x7e, 0x0d, 0xf1, 0x7e, 0x21, 0xc0, 0xf9, 0x94,
-	0x3a, 0x3f, 0x77, 0xc8, 0xa5, 0xa7, 0xb0, 0x70, 0x87, 0x38, 0x46, 0x59, 0x73, 0x70, 0x8b, 0x20,
-	0xaf, 0x60, 0xfe, 0x8f, 0xae, 0xfa, 0x9d, 0x00, 0x57, 0x0f, 0xa1, 0x9f, 0xbb, 0x2d, 0xb6, 0xb7,
-	0x09, 0x9f, 0xa6, 0xb7, 0x49, 0x1b, 0x30, 0x15, 0xfd, 0x15, 0xf7, 0x61, 0x57, 0xcb, 0x2f, 0x7b,
-	0x60, 0xba, 0xad, 0xdc, 0xcf, 0xde, 0x2d, 0x34, 0x38, 0xd6, 0xa0, 0x8e, 0x19, 0xc4, 0x1b, 0xc5,
-	0xac, 0xe7, 0x7b, 0x6f, 0x96, 0xf7, 0xdc, 0x1f, 0x96, 0xc3, 0x38, 0xb8, 0x2e, 0xa4, 0xb7, 0x9c,
-	0xc4, 0x07, 0xb8, 0xfb, 0xeb, 0x73, 0x79, 0xf5, 0x7c, 0xda, 0xcb, 0xeb, 0x14, 0x9c, 0xa0, 0xa9,
-	0xb1, 0x61, 0x55, 0x6c, 0xdb, 0x7c, 0xb8, 0x63, 0x38, 0xd8, 0x34, 0x88, 0xf7, 0xa5, 0x27, 0x5d,
-	0x85, 0x93, 0xd1, 0xc7, 0xdc, 0xa3, 0x63, 0x70, 0xc4, 0x3d, 0x50, 0x0d, 0x9e, 0x19, 0x3d, 0x85,
-	0x3e, 0xf7, 0x79, 0x59, 0x27, 0xf3, 0xff, 0x1d, 0x81, 0x2f, 0x28, 0x2f, 0xfa, 0x89, 0x00, 0xbd,
-	0x6c, 0x47, 0x82, 0xa6, 0xa2, 0xf2, 0xa6, 0x75, 0x1d, 0x23, 0x4e, 0xb7, 0xa5, 0x63, 0x06, 0x48,
-	0xb3, 0x07, 0x7f, 0xfe, 0xfb, 0xf3, 0xcc, 0x24, 0x92, 0x94, 0x88, 0x25, 0x53, 0xb0, 0x29, 0xa2,
-	0xca, 0x7f, 0x2a, 0x40, 0xbf, 0xbf, 0x24, 0x41, 0x93, 0x51, 0x2a, 0x9a, 0x57, 0x36, 0xe2, 0x99,
-	0x36, 0x54, 0xdc, 0x0c, 0x99, 0x9a, 0x31, 0x83, 0xa6, 0x92, 0xcc, 0x08, 0x16, 0x3a, 0xcc, 0x14,
-	0x6f, 0x07, 0x13, 0x63, 0x4a, 0xd3, 0xda, 0x26, 0xc6, 0x94, 0xe6, 0x45, 0x4e, 0x4a, 0x53, 0x4c,
-	0x53, 0x65, 0x83, 0x1c, 0xfa, 0x95, 0x00, 0x83, 0x4d, 0x5b, 0x18, 0x34, 0x1b, 0x8b, 0xba, 0x65,
-	0xb7, 0x23, 0x9e, 0x4d, 0x45, 0xcb, 0x8d, 0xfb, 0x16, 0x35, 0x4e, 0x46, 0xe7, 0xda, 0xfb, 0x29,
-	0x58, 0xf7, 0xa0, 0xdf, 0x0b, 0x30, 0x12, 0xb3, 0xa4, 0x40, 0xf3, 0x31, 0x5e, 0x49, 0x58, 0x9e,
-	0x88, 0x17, 0x3b, 0xe2, 0xe1, 0xa6, 0xdf, 0xa0, 0xa6, 0x5f, 0x41, 0x97, 0xda, 0xf9, 0xd5, 0x08,
-	0x49, 0x51, 0xfd, 0x5d, 0xc7, 0x5b, 0x01, 0x4e, 0x26, 0xed, 0x18, 0xd0, 0x95, 0x28, 0xa3, 0x52,
-	0x6c, 0x35, 0xc4, 0x85, 0xce, 0x19, 0x39, 0xa4, 0x15, 0x0a, 0x69, 0x09, 0x2d, 0x26, 0x41, 0x2a,
-	0x7a, 0x92, 0x22, 0x81, 0x29, 0x4f, 0xf8, 0x46, 0xe5, 0x29, 0xfa, 0x8d, 0x37, 0xe7, 0x26, 0xee,
-	0x1f, 0x50, 0x3e, 0xb6, 0xb4, 0x53, 0x2f, 0x41, 0xc4, 0xdb, 0x1f, 0x24, 0x83, 0xa3, 0xef, 0x42,
-	0x7f, 0x14, 0x40, 0x8c, 0x9f, 0xcc, 0x51, 0xe4, 0x72, 0xa7, 0xed, 0xbc, 0x2f, 0x5e, 0xee, 0x94,
-	0x8d, 0xdb, 0x73, 0x93, 0x46, 0x63, 0x01, 0x5d, 0x6e, 0x97, 0x60, 0xd1, 0xe3, 0x3c, 0xfa, 0x93,
-	0x00, 0x62, 0xfc, 0x94, 0x8c, 0x2e, 0xa5, 0xbd, 0xb2, 0x1b, 0x66, 0xfd, 0x68, 0x34, 0xed, 0x87,
-	0x71, 0xe9, 0x16, 0x45, 0x73, 0x0d, 0x2d, 0x24, 0xa1, 0x89, 0xfe, 0xd4, 0x60, 0x37, 0x21, 0xfa,
-	0xa7, 0x00, 0xa7, 0xdb, 0x4d, 0xc4, 0xe8, 0x7a, 0x5a, 0xf3, 0x22, 0x86, 0x31, 0xf1, 0xdb, 0x87,
-	0x63, 0xe6, 0x08, 0xbf, 0x43, 0x11, 0xde, 0x43, 0x4b, 0x1d, 0x23, 0x24, 0xca, 0x93, 0x96, 0xaf,
-	0xb7, 0xa7, 0xe8, 0x20, 0x13, 0xde, 0x72, 0xc4, 0xcd, 0x75, 0xe8, 0x46, 0xb2, 0xd1, 0x6d, 0x06,
-	0x50, 0xf1, 0xe6, 0x61, 0xd9, 0x39, 0xea, 0x1f, 0x52, 0xd4, 0x0f, 0xd1, 0x46, 0x4a, 0xd4, 0xb5,
-	0xb0, 0x40, 0x75, 0xab, 0xae, 0xfa, 0xc8, 0x23, 0x9d, 0xf0, 0x1f, 0x01, 0xce, 0xa4, 0x1a, 0x76,
-	0xd0, 0xad, 0x0e, 0x82, 0x17, 0x39, 0x70, 0x88, 0xb9, 0x0f, 0x90, 0xc0, 0xbd, 0xb1, 0x4a, 0xbd,
-	0x71, 0x17, 0xdd, 0xe9, 0x3c, 0x07, 0x5c, 0x5f, 0x04, 0xf3, 0x0e, 0xfb, 0x3f, 0xc2, 0xaf, 0x33,
-	0x30, 0xd7, 0xf1, 0xfc, 0x82, 0x56, 0xa2, 0x70, 0x1c, 0x76, 0x0c, 0x13, 0x57, 0x3f, 0x92, 0x34,
-	0xee, 0xa1, 0x1f, 0x50, 0x0f, 0x6d, 0xa2, 0x07, 0x49, 0x1e, 0xc2, 0x5c, 0xbc, 0x9a, 0xd4, 0x10,
-	0xa2, 0x1c, 0xf6, 0x0f, 0xaf, 0x83, 0x47, 0x4e, 0x35, 0xe8, 0x5a, 0xfa, 0x7b, 0xa2, 0xa5, 0x50,
-	0xae, 0x1f, 0x8a, 0x97, 0xa3, 0xde, 0xa0, 0xa8, 0xd7, 0xd0, 0x6a, 0x12, 0xea, 0xe6, 0x6d, 0x6f,
-	0xfb, 0xea, 0x78, 0x29, 0xc0, 0x60, 0xd3, 0xa7, 0x38, 0x52, 0x62, 0xed, 0x8c, 0xfe, 0xa6, 0x17,
-	0x2f, 0xa4, 0x67, 0xe8, 0xe4, 0xab, 0xad, 0x46, 0x99, 0xd5, 0x47, 0x1e, 0x77, 0xfe, 0xfe, 0xab,
-	0x77, 0x59, 0xe1, 0xf5, 0xbb, 0xac, 0xf0, 0xb7, 0x77, 0x59, 0xe1, 0x17, 0xef, 0xb3, 0x5d, 0xaf,
-	0xdf, 0x67, 0xbb, 0xfe, 0xf2, 0x3e, 0xdb, 0xf5, 0xbd, 0xcb, 0xa1, 0xd1, 0x85, 0x4b, 0x3c, 0x6f,
-	0x6a, 0x5b, 0xc4, 0x17, 0xbf, 0x3f, 0x77, 0x51, 0x79, 0x1c, 0x56, 0x42, 0xc7, 0x99, 0xad, 0x5e,
-	0xfa, 0x1f, 0xdc, 0x8b, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xfb, 0xb9, 0x77, 0x9e, 0x3f, 0x1f,
-	0x00, 0x00,
+	// 1998 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4d, 0x6c, 0xd4, 0xd8,
+	0x1d, 0x8f, 0x27, 0xd9, 0x04, 0xfe, 0x69, 0x49, 0x78, 0xb0, 0x4b, 0x62, 0x60, 0x02, 0x0e, 0x24,
+	0x43, 0x76, 0xb1, 0x97, 0x50, 0xd8, 0x2c, 0x0b, 0x68, 0x67, 0x08, 0x81, 0xa8, 0x50, 0xa8, 0x21,
+	0x20, 0xf5, 0x43, 0x96, 0x33, 0x7e, 0x99, 0x58, 0x78, 0x6c, 0xc7, 0xcf, 0x93, 0xdd, 0xe9, 0x0a,
+	0x55, 0xa2, 0xaa, 0xd4, 0x55, 0x0f, 0xad, 0xb4, 0xa7, 0xde, 0x7a, 0xdd, 0x3d, 0xb4, 0xc7, 0x5e,
+	0x7a, 0xa9, 0x7a, 0x59, 0xa9, 0xaa, 0xb4, 0x52, 0x2f, 0x55, 0x0f, 0xec, 0x0a, 0xda, 0x5b, 0x7b,
+	0xe9, 0xb1, 0xbd, 0x54, 0x7e, 0xef, 0xf9, 0x63, 0x66, 0x6c, 0x8f, 0x67, 0x48, 0x61, 0x4f, 0x19,
+	0xbf, 0xf7, 0xff, 0xfa, 0xfd, 0xbf, 0xde, 0x7b, 0x7f, 0x80, 0xb2, 0x43, 0x9a, 0x0e, 0x31, 0x89,
+	0x42, 0x5a, 0x2e, 0xf6, 0xb6, 0xac, 0x96, 0x69, 0x28, 0x3b, 0x2d, 0xec, 0xb5, 0x65, 0xd7, 0x73,
+	0x7c, 0x07, 0x21, 0xbe, 0x2f, 0xc7, 0xfb, 0xe2, 0xe1, 0x86, 0xd3, 0x70, 0xe8, 0xb6, 0x12, 0xfc,
+	0x62, 0x94, 0x62, 0xb9, 0x4e, 0x49, 0x95, 0x4d, 0x9d, 0x60, 0x65, 0xf7, 0xdc, 0x26, 0xf6, 0xf5,
+	0x73, 0x4a, 0xdd, 0x31, 0x6d, 0xbe, 0x7f, 0xac, 0xe1, 0x38, 0x0d, 0x0b, 0x2b, 0xba, 0x6b, 0x2a,
+	0xba, 0x6d, 0x3b, 0xbe, 0xee, 0x9b, 0x8e, 0x4d, 0xf8, 0xee, 0x1c, 0xdf, 0xa5, 0x5f, 0x9b, 0xad,
+	0x2d, 0xc5, 0x37, 0x9b, 0x98, 0xf8, 0x7a, 0xd3, 0x0d, 0xc5, 0x77, 0x13, 0x18, 0x2d, 0x8f, 0x4a,
+	0xe0, 0xfb, 0xf3, 0x29, 0x40, 0xe2, 0x9f, 0xa1, 0x96, 0x14, 0x22, 0x57, 0xf7, 0xf4, 0x66, 0x68,
+	0xc6, 0x6c, 0x48, 0x60, 0x39, 0xf5, 0x47, 0x2d, 0x97, 0xfe, 0xe1, 0x5b, 0x4b, 0x49, 0x7c, 0xd4,
+	0x45, 0x11, 0x4a, 0x57, 0x6f, 0x98, 0x76, 0xd2, 0x98, 0x53, 0x9c, 0x96, 0xf8, 0xfa, 0x23, 0xd3,
+	0x6e, 0x44, 0x84, 0xfc, 0x9b, 0x51, 0x49, 0x87, 0x01, 0x7d, 0x37, 0x90, 0x73, 0x97, 0x5a, 0xa0,
+	0xe2, 0x9d, 0x16, 0x26, 0xbe, 0x74, 0x07, 0x0e, 0x75, 0xac, 0x12, 0xd7, 0xb1, 0x09, 0x46, 0x2b,
+	0x30, 0xce, 0x2c, 0x9d, 0x11, 0x4e, 0x08, 0x95, 0xc9, 0x65, 0x51, 0xee, 0x8d, 0x8c, 0xcc, 0x78,
+	0x6a, 0x63, 0x9f, 0x3f, 0x9d, 0x1b, 0x51, 0x39, 0xbd, 0x54, 0x81, 0xe9, 0x2a, 0x21, 0xd8, 0xbf,
+	0xdf, 0x76, 0x31, 0x57, 0x82, 0x0e, 0xc3, 0x6b, 0x06, 0xb6, 0x9d, 0x26, 0x15, 0xb6, 0x5f, 0x65,
+	0x1f, 0xd2, 0xf7, 0xe1, 0x60, 0x82, 0x92, 0x2b, 0x5e, 0x03, 0xd0, 0x83, 0x45, 0xcd, 0x6f, 0xbb,
+	0x98, 0xd2, 0x1f, 0x58, 0x5e, 0x4c, 0x53, 0x7e, 0x2f, 0xfa, 0x19, 0x0b, 0xd9, 0xaf, 0x87, 0x3f,
+	0x25, 0x04, 0xd3, 0x55, 0xcb, 0xa2, 0x5b, 0x11, 0xd6, 0x07, 0x70, 0x30, 0xb1, 0xc6, 0x15, 0x56,
+	0x61, 0x9c, 0x72, 0x05, 0x48, 0x47, 0x2b, 0x93, 0xcb, 0xf3, 0x05, 0x94, 0x85, 0x90, 0x19, 0xa3,
+	0x24, 0xc3, 0x1b, 0x74, 0xf9, 0x76, 0xcb, 0xf2, 0x4d, 0xd7, 0x32, 0xb1, 0x97, 0x0f, 0xfc, 0xe7,
+	0x02, 0x1c, 0xe9, 0x61, 0xe0, 0xe6, 0xb8, 0x20, 0x06, 0xfa, 0x35, 0xbc, 0xd3, 0x32, 0x77, 0x75,
+	0x0b, 0xdb, 0xbe, 0xd6, 0x8c, 0xa8, 0x78, 0x30, 0x96, 0xd3, 0x4c, 0xbc, 0x43, 0x9a, 0xce, 0xf5,
+	0x88, 0x29, 0x29, 0xb9, 0xee, 0x78, 0x86, 0x3a, 0xe3, 0x64, 0xec, 0x4b, 0x1f, 0x0b, 0x70, 0x32,
+	0xc6, 0xb7, 0x6e, 0xfb, 0xd8, 0x6b, 0x62, 0xc3, 0xd4, 0xbd, 0x76, 0xb5, 0x5e, 0x77, 0x5a, 0xb6,
+	0xbf, 0x6e, 0x6f, 0x39, 0xe9, 0x48, 0xd0, 0x2c, 0xec, 0xdb, 0xd5, 0x2d, 0x4d, 0x37, 0x0c, 0x6f,
+	0xa6, 0x44, 0x37, 0x26, 0x76, 0x75, 0xab, 0x6a, 0x18, 0x5e, 0xb0, 0xd5, 0xd0, 0x5b, 0x0d, 0xac,
+	0x99, 0xc6, 0xcc, 0xe8, 0x09, 0xa1, 0x32, 0xa6, 0x4e, 0xd0, 0xef, 0x75, 0x03, 0xcd, 0xc0, 0x44,
+	0xc0, 0x81, 0x09, 0x99, 0x19, 0x63, 0x4c, 0xfc, 0x53, 0xda, 0x86, 0x72, 0xd5, 0xb2, 0x52, 0x6c,
+	0x08, 0x63, 0x18, 0xe4, 0x47, 0x9c, 0xff, 0xdc, 0x1f, 0x0b, 0x32, 0x2b, 0x00, 0x39, 0x28, 0x16,
+	0x99, 0xf5, 0x13, 0x5e, 0x03, 0xf2, 0x5d, 0xbd, 0x11, 0xa6, 0xa1, 0x9a, 0xe0, 0x94, 0xfe, 0x28,
+	0xc0, 0x5c, 0xa6, 0x2a, 0x1e, 0x8b, 0x87, 0xb0, 0x4f, 0xe7, 0x6b, 0x3c, 0x39, 0x2e, 0xe4, 0x27,
+	0x47, 0x86, 0xf3, 0x78, 0xba, 0x44, 0xc2, 0xd0, 0x8d, 0x0e, 0x10, 0x25, 0x0a, 0x62, 0xb1, 0x2f,
+	0x08, 0x66, 0x55, 0x07, 0x8a, 0xab, 0x30, 0x7f, 0xcd, 0xb1, 0x6d, 0x5c, 0xf7, 0x71, 0x9a, 0xf2,
+	0xd0, 0x69, 0x47, 0x60, 0x22, 0x68, 0x2d, 0x41, 0x28, 0x04, 0x1a, 0x8a, 0xf1, 0xe0, 0x73, 0xdd,
+	0x90, 0x3e, 0x80, 0x53, 0xf9, 0xfc, 0xdc, 0x13, 0x77, 0x60, 0x82, 0x1b, 0xcf, 0x5d, 0x3e, 0x9c,
+	0x23, 0xd4, 0x50, 0x8a, 0xb4, 0x06, 0x32, 0x6d, 0x3b, 0xf7, 0x1d, 0x5f, 0xb7, 0x56, 0xb1, 0x85,
+	0x1b, 0x14, 0x50, 0xad, 0xfd, 0x40, 0xb7, 0x4c, 0x43, 0xf7, 0x1d, 0x6f, 0xcd, 0xf1, 0x56, 0x83,
+	0x1c, 0xcb, 0x2f, 0x25, 0x17, 0x94, 0xc2, 0x72, 0x38, 0x96, 0x2b, 0x5d, 0x05, 0x3f, 0x97, 0x06,
+	0x25, 0x16, 0x45, 0xba, 0x8a, 0xfd, 0x49, 0x09, 0x26, 0x13, 0xbb, 0x1d, 0x25, 0x20, 0x74, 0x96,
+	0x00, 0x86, 0x49, 0xbd, 0x19, 0xc0, 0xd5, 0xc8, 0x16, 0x31, 0x58, 0x81, 0xd4, 0x56, 0x03, 0x69,
+	0x7f, 0x7b, 0x3a, 0xb7, 0xd0, 0x30, 0xfd, 0xed, 0xd6, 0xa6, 0x5c, 0x77, 0x9a, 0x0a, 0xef, 0xdf,
+	0xec, 0xcf, 0x59, 0x62, 0x3c, 0x52, 0x82, 0xee, 0x47, 0xe4, 0x75, 0xdb, 0xff, 0xf7, 0xd3, 0x39,
+	0xd4, 0xd6, 0x9b, 0xd6, 0x25, 0x29, 0x21, 0x4a, 0x52, 0x81, 0x7d, 0xdd, 0xdb, 0x22, 0x06, 0xda,
+	0x81, 0xa9, 0xae, 0x96, 0x41, 0x0b, 0x6e, 0x7f, 0xed, 0xe6, 0xc0, 0xaa, 0xde, 0x60, 0xaa, 0xba,
+	0xc4, 0x49, 0xea, 0x81, 0xce, 0xee, 0x21, 0xcd, 0xc3, 0x49, 0xea, 0xf1, 0x38, 0xe2, 0x09, 0x97,
+	0x84, 0xed, 0xf6, 0x53, 0x01, 0xa4, 0x3c, 0x2a, 0x1e, 0x8f, 0x27, 0x02, 0x1c, 0xf4, 0x03, 0x32,
+	0xcd, 0x88, 0x77, 0x99, 0x2b, 0x6b, 0x1b, 0x03, 0x23, 0x98, 0x67, 0x08, 0x98, 0xc0, 0x38, 0xa0,
+	0x49, 0xd9, 0x92, 0x3a, 0xed, 0x77, 0xa6, 0x0b, 0x91, 0x3e, 0xe9, 0x68, 0x82, 0xf1, 0x4e, 0xb5,
+	0x99, 0xac, 0xa3, 0x37, 0xe1, 0x20, 0x97, 0xe3, 0x78, 0x5a, 0xd8, 0xc2, 0x58, 0xd0, 0xa7, 0xa3,
+	0x8d, 0x2a, 0x5b, 0x0f, 0x88, 0x77, 0xc3, 0x24, 0x8c, 0x88, 0x59, 0x93, 0x9c, 0x8e, 0x36, 0x42,
+	0xe2, 0x28, 0xbb, 0x47, 0x93, 0xd9, 0xfd, 0xb1, 0x00, 0x52, 0x9e, 0x55, 0xdc, 0x83, 0x75, 0x18,
+	0x67, 0xe9, 0xc0, 0x33, 0x7a, 0xb6, 0xa3, 0x95, 0x84, 0x4d, 0xe4, 0x9a, 0x63, 0xda, 0xb5, 0xb7,
+	0x03, 0x87, 0x7e, 0xf6, 0xe5, 0x5c, 0xa5, 0x80, 0x43, 0x03, 0x06, 0xa2, 0x72, 0xd1, 0xd2, 0x03,
+	0x58, 0x4c, 0x8d, 0x63, 0xad, 0xbd, 0x1a, 0x22, 0x1f, 0xc6, 0x4d, 0xd2, 0xef, 0x46, 0xa1, 0xd2,
+	0x5f, 0x30, 0x47, 0xfa, 0x21, 0x1c, 0x4f, 0x8d, 0xa9, 0xe6, 0xd1, 0x53, 0x2e, 0x2c, 0x69, 0x39,
+	0xbf, 0x3b, 0xc5, 0x4a, 0xd8, 0xe1, 0xc8, 0x2b, 0xfc, 0x28, 0xc9, 0xa4, 0x20, 0xe8, 0xc7, 0xf0,
+	0x7a, 0x47, 0x92, 0x62, 0x43, 0x0b, 0x6e, 0x9b, 0x41, 0x44, 0xf7, 0xdc, 0xe5, 0x87, 0x92, 0xe9,
+	0x89, 0x0d, 0xba, 0x88, 0x7e, 0x21, 0x40, 0x99, 0x59, 0x90, 0xb8, 0x1a, 0x04, 0x37, 0x3c, 0x6c,
+	0x68, 0x3c, 0xfa, 0xa3, 0x27, 0x84, 0x7c, 0x53, 0x14, 0x6e, 0xca, 0x62, 0x41, 0x53, 0xd4, 0xa3,
+	0x54, 0x63, 0x5c, 0xf8, 0xf7, 0xa8, 0x3e, 0x96, 0x7e, 0x92, 0x0d, 0x67, 0x62, 0x9f, 0x6e, 0xd8,
+	0xc6, 0x9e, 0xe5, 0x44, 0x5c, 0x0d, 0xa5, 0x64, 0x35, 0xfc, 0xa7, 0x04, 0x4b, 0x45, 0x14, 0xbe,
+	0xf2, 0x5c, 0xf9, 0x89, 0x00, 0x47, 0x58, 0xa8, 0x5a, 0xf6, 0x4b, 0x48, 0x17, 0x96, 0x98, 0x1b,
+	0xb1, 0x2a, 0x96, 0x30, 0xb7, 0x60, 0x8a, 0xb4, 0x6d, 0x7f, 0x1b, 0xfb, 0x66, 0x5d, 0x0b, 0xce,
+	0x7b, 0x32, 0x33, 0x4a, 0x95, 0x1f, 0x8f, 0x10, 0xb3, 0x67, 0x87, 0x7c, 0x2f, 0x24, 0xbb, 0xe5,
+	0xd4, 0x1f, 0x71, 0x80, 0x07, 0x48, 0x72, 0x91, 0x48, 0x3b, 0xf0, 0x56, 0x46, 0x95, 0x46, 0x27,
+	0x6d, 0xc7, 0x71, 0x9d, 0xda, 0xfd, 0x84, 0x7e, 0xdd, 0xaf, 0x23, 0xde, 0x9f, 0x0a, 0x70, 0xb6,
+	0xa0, 0xce, 0x57, 0x1d, 0x72, 0xe9, 0x31, 0xac, 0x5c, 0x27, 0xbe, 0xd9, 0xd4, 0x7d, 0xdc, 0x23,
+	0x28, 0x2c, 0x98, 0xff, 0xa3, 0xab, 0x7e, 0x2f, 0xc0, 0xbb, 0x43, 0xe8, 0xe7, 0x6e, 0xcb, 0xec,
+	0x6d, 0xc2, 0xcb, 0xe9, 0x6d, 0xd2, 0x06, 0x2c, 0xa4, 0xdf, 0xe2, 0x5e, 0xec, 0x68, 0xf9, 0xd5,
+	0x18, 0x2c, 0xf6, 0x95, 0xfb, 0xca, 0xbb, 0x85, 0x0e, 0x87, 0x3a, 0xd4, 0x31, 0x83, 0x78, 0xa3,
+	0x58, 0x0a, 0x7d, 0x1f, 0xbe, 0xe5, 0x43, 0xf7, 0x27, 0xe5, 0x30, 0x0e, 0xae, 0x0b, 0x19, 0x3d,
+	0x3b, 0xd9, 0x01, 0x1e, 0xfd, 0xfa, 0x1c, 0x5e, 0x63, 0x2f, 0xf7, 0xf0, 0x3a, 0x0e, 0x47, 0x69,
+	0x6a, 0x6c, 0xd8, 0xae, 0xe3, 0x58, 0x0f, 0xb7, 0x4d, 0x1f, 0x5b, 0x26, 0x09, 0x6f, 0x7a, 0xd2,
+	0xbb, 0x70, 0x2c, 0x7d, 0x9b, 0x7b, 0x74, 0x16, 0xf6, 0x05, 0x1b, 0x9a, 0xc9, 0x33, 0x63, 0x4c,
+	0x9d, 0x08, 0xbe, 0xd7, 0x0d, 0x22, 0x35, 0xa1, 0x42, 0x59, 0xd3, 0xb2, 0xe2, 0x26, 0xd6, 0x0d,
+	0xcf, 0xd9, 0xd3, 0xd2, 0xff, 0x47, 0x09, 0xce, 0x14, 0xd0, 0xc7, 0xed, 0x3e, 0x03, 0xb1, 0x5c,
+	0xad, 0xae, 0xbb, 0x2e, 0x66, 0x4f, 0xc2, 0x7d, 0xea, 0x54, 0xb4, 0x7e, 0x8d, 0x2e, 0xa3, 0x1f,
+	0x01, 0x8a, 0x49, 0xb7, 0xb9, 0x20, 0xfe, 0x88, 0xf9, 0xf6, 0xc0, 0xf7, 0xf2, 0x59, 0x76, 0x2f,
+	0xef, 0x95, 0x28, 0xa9, 0xb1, 0x0b, 0x42, 0x73, 0xd1, 0x49, 0xf8, 0x06, 0x9b, 0x02, 0x71, 0x13,
+	0x47, 0xa9, 0x89, 0x93, 0x74, 0x8d, 0x9b, 0x67, 0xc3, 0x01, 0x46, 0x12, 0x99, 0x46, 0x67, 0x09,
+	0xb5, 0x1b, 0x03, 0x9b, 0xf6, 0x3a, 0x7f, 0x5f, 0x75, 0x48, 0x93, 0xd4, 0x6f, 0xd2, 0x85, 0xd0,
+	0xa4, 0xe5, 0xaf, 0x66, 0xe1, 0x35, 0xea, 0x67, 0xf4, 0x53, 0x01, 0xc6, 0xd9, 0xe8, 0x0b, 0x2d,
+	0xa4, 0xb5, 0x83, 0xde, 0x29, 0x9b, 0xb8, 0xd8, 0x97, 0x8e, 0xc5, 0x47, 0x5a, 0x7a, 0xf2, 0x97,
+	0xbf, 0x7f, 0x52, 0x3a, 0x85, 0x24, 0x25, 0x65, 0x76, 0x18, 0x0f, 0x00, 0xa9, 0xf2, 0x9f, 0x09,
+	0xb0, 0x3f, 0x9a, 0x7d, 0xa1, 0x53, 0x69, 0x2a, 0xba, 0x27, 0x71, 0xe2, 0xe9, 0x3e, 0x54, 0xdc,
+	0x0c, 0x99, 0x9a, 0x51, 0x41, 0x0b, 0x79, 0x66, 0xc4, 0x73, 0x3a, 0x66, 0x4a, 0x38, 0x5a, 0xcb,
+	0x30, 0xa5, 0x6b, 0x1a, 0x27, 0x9e, 0xee, 0x43, 0x35, 0x90, 0x29, 0x96, 0xa5, 0xe9, 0x4c, 0xf9,
+	0xaf, 0x05, 0x98, 0xea, 0x1a, 0xae, 0xa1, 0xa5, 0x4c, 0xd4, 0x3d, 0x23, 0x3b, 0xf1, 0xcd, 0x42,
+	0xb4, 0xdc, 0xb8, 0x6f, 0x51, 0xe3, 0x64, 0xf4, 0x56, 0x7f, 0x3f, 0xc5, 0x53, 0x3c, 0xf4, 0x87,
+	0x60, 0xfe, 0x97, 0x3e, 0x7b, 0x42, 0xcb, 0x19, 0x5e, 0xc9, 0x99, 0x89, 0x89, 0xe7, 0x07, 0xe2,
+	0xe1, 0xa6, 0x5f, 0xa1, 0xa6, 0xbf, 0x83, 0x2e, 0xf4, 0xf3, 0xab, 0x99, 0x90, 0xa2, 0x45, 0x23,
+	0xac, 0x2f, 0x05, 0x38, 0x96, 0x37, 0x3a, 0x42, 0xef, 0xa4, 0x19, 0x55, 0x60, 0x58, 0x25, 0xae,
+	0x0c, 0xce, 0xc8, 0x21, 0xdd, 0xa2, 0x90, 0xd6, 0xd0, 0x6a, 0x1e, 0xa4, 0x7a, 0x28, 0x29, 0x15,
+	0x98, 0xf2, 0x11, 0x1f, 0x94, 0x3d, 0x46, 0xbf, 0x0d, 0xc7, 0x17, 0xb9, 0x63, 0x25, 0x54, 0xcb,
+	0x2c, 0xed, 0xc2, 0xb3, 0x2d, 0xf1, 0xda, 0x0b, 0xc9, 0xe0, 0xe8, 0x47, 0xd0, 0x9f, 0x04, 0x10,
+	0xb3, 0x07, 0x2e, 0x28, 0x75, 0x66, 0xd7, 0x77, 0x8c, 0x23, 0x5e, 0x1c, 0x94, 0x8d, 0xdb, 0x73,
+	0x95, 0x46, 0x63, 0x05, 0x5d, 0xec, 0x97, 0x60, 0xe9, 0x53, 0x1a, 0xf4, 0x67, 0x01, 0xc4, 0xec,
+	0xe1, 0x07, 0xba, 0x50, 0xf4, 0x26, 0xd6, 0x31, 0xc2, 0x11, 0x2f, 0x0e, 0xca, 0xc6, 0xd1, 0xbc,
+	0x4f, 0xd1, 0x5c, 0x42, 0x2b, 0x79, 0x68, 0xd2, 0x6f, 0x90, 0xec, 0x82, 0x83, 0xfe, 0x25, 0xc0,
+	0x89, 0x7e, 0x83, 0x0e, 0xf4, 0x5e, 0x51, 0xf3, 0x52, 0xde, 0xd8, 0xe2, 0xe5, 0xe1, 0x98, 0x39,
+	0xc2, 0xef, 0x50, 0x84, 0x37, 0xd1, 0xda, 0xc0, 0x08, 0x89, 0xf2, 0x51, 0xcf, 0xa5, 0xfc, 0x31,
+	0x7a, 0x52, 0x4a, 0x0e, 0xaf, 0xb2, 0x9e, 0xeb, 0xe8, 0x4a, 0xbe, 0xd1, 0x7d, 0xe6, 0x0a, 0xe2,
+	0xd5, 0x61, 0xd9, 0x39, 0xea, 0x1f, 0x52, 0xd4, 0x0f, 0xd1, 0x46, 0x41, 0xd4, 0xad, 0xa4, 0x40,
+	0x6d, 0xb3, 0xad, 0x45, 0xc8, 0x53, 0x9d, 0xf0, 0x5f, 0x01, 0x4e, 0x17, 0x7a, 0xc3, 0xa2, 0xf7,
+	0x07, 0x08, 0x5e, 0xea, 0x3b, 0x52, 0xac, 0xbe, 0x80, 0x04, 0xee, 0x8d, 0xdb, 0xd4, 0x1b, 0x37,
+	0xd0, 0xf5, 0xc1, 0x73, 0x20, 0xf0, 0x45, 0x7c, 0xbb, 0x63, 0xff, 0x3c, 0xf4, 0x9b, 0x12, 0x9c,
+	0x1b, 0xf8, 0x59, 0x8a, 0x6e, 0xa5, 0xe1, 0x18, 0xf6, 0x75, 0x2d, 0xde, 0xde, 0x23, 0x69, 0xdc,
+	0x43, 0x3f, 0xa0, 0x1e, 0x7a, 0x80, 0xee, 0xe7, 0x79, 0x08, 0x73, 0xf1, 0x5a, 0x5e, 0x43, 0x48,
+	0x73, 0xd8, 0x3f, 0xc3, 0x0e, 0x9e, 0xfa, 0x58, 0x45, 0x97, 0x8a, 0x9f, 0x13, 0x3d, 0x85, 0xf2,
+	0xde, 0x50, 0xbc, 0x1c, 0xf5, 0x06, 0x45, 0x7d, 0x07, 0xdd, 0xce, 0x43, 0xdd, 0x3d, 0xc4, 0xef,
+	0x5f, 0x1d, 0xc1, 0x25, 0x22, 0xef, 0xd9, 0x82, 0x2e, 0x67, 0x1a, 0x5d, 0xe0, 0x75, 0x25, 0x5e,
+	0x19, 0x92, 0x9b, 0x83, 0xae, 0x51, 0xd0, 0x97, 0xd1, 0xa5, 0xc1, 0x5b, 0x7e, 0xf8, 0x8a, 0x40,
+	0x9f, 0x09, 0x30, 0xd5, 0xf5, 0x86, 0x44, 0x4a, 0xa6, 0x59, 0xe9, 0x8f, 0x51, 0xf1, 0xed, 0xe2,
+	0x0c, 0x83, 0xdc, 0x4b, 0x5b, 0x94, 0x59, 0xfb, 0x20, 0xe4, 0xae, 0xdd, 0xfd, 0xfc, 0x59, 0x59,
+	0xf8, 0xe2, 0x59, 0x59, 0xf8, 0xea, 0x59, 0x59, 0xf8, 0xe5, 0xf3, 0xf2, 0xc8, 0x17, 0xcf, 0xcb,
+	0x23, 0x7f, 0x7d, 0x5e, 0x1e, 0xf9, 0xde, 0xc5, 0xc4, 0x63, 0x8a, 0x4b, 0x3c, 0x6b, 0xe9, 0x9b,
+	0x24, 0x12, 0xbf, 0x7b, 0xee, 0xbc, 0xf2, 0x61, 0x52, 0x09, 0x7d, 0x60, 0x6d, 0x8e, 0xd3, 0xff,
+	0x7a, 0x70, 0xfe, 0x7f, 0x03, 0x00, 0x9e, 0xba, 0xed, 0x5f, 0xf8, 0x21, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1779,6 +1908,10 @@ type QueryClient interface {
 	EstimateSuperfluidDelegatedAmountByValidatorDenom(ctx context.Context, in *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest, opts ...grpc.CallOption) (*EstimateSuperfluidDelegatedAmountByValidatorDenomResponse, error)
 	// Returns the specified delegations for a specific delegator
 	TotalDelegationByDelegator(ctx context.Context, in *QueryTotalDelegationByDelegatorRequest, opts ...grpc.CallOption) (*QueryTotalDelegationByDelegatorResponse, error)
+	// Returns the remaining osmo equivalent that can be superfluid delegated to
+	// a validator with a superfluid asset, before reaching the validator and
+	// asset caps set in the params.
+	SuperfluidDelegationHeadroom(ctx context.Context, in *QuerySuperfluidDelegationHeadroomRequest, opts ...grpc.CallOption) (*QuerySuperfluidDelegationHeadroomResponse, error)
 	// Returns a list of whitelisted pool ids to unpool.
 	UnpoolWhitelist(ctx context.Context, in *QueryUnpoolWhitelistRequest, opts ...grpc.CallOption) (*QueryUnpoolWhitelistResponse, error)
 }
@@ -1917,6 +2050,15 @@ func (c *queryClient) TotalDelegationByDelegator(ctx context.Context, in *QueryT
 	return out, nil
 }
 
+func (c *queryClient) SuperfluidDelegationHeadroom(ctx context.Context, in *QuerySuperfluidDelegationHeadroomRequest, opts ...grpc.CallOption) (*QuerySuperfluidDelegationHeadroomResponse, error) {
+	out := new(QuerySuperfluidDelegationHeadroomResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/SuperfluidDelegationHeadroom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) UnpoolWhitelist(ctx context.Context, in *QueryUnpoolWhitelistRequest, opts ...grpc.CallOption) (*QueryUnpoolWhitelistResponse, error) {
 	out := new(QueryUnpoolWhitelistResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/UnpoolWhitelist", in, out, opts...)
@@ -1962,6 +2104,10 @@ type QueryServer interface {
 	EstimateSuperfluidDelegatedAmountByValidatorDenom(context.Context, *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) (*EstimateSuperfluidDelegatedAmountByValidatorDenomResponse, error)
 	// Returns the specified delegations for a specific delegator
 	TotalDelegationByDelegator(context.Context, *QueryTotalDelegationByDelegatorRequest) (*QueryTotalDelegationByDelegatorResponse, error)
+	// Returns the remaining osmo equivalent that can be superfluid delegated to
+	// a validator with a superfluid asset, before reaching the validator and
+	// asset caps set in the params.
+	SuperfluidDelegationHeadroom(context.Context, *QuerySuperfluidDelegationHeadroomRequest) (*QuerySuperfluidDelegationHeadroomResponse, error)
 	// Returns a list of whitelisted pool ids to unpool.
 	UnpoolWhitelist(context.Context, *QueryUnpoolWhitelistRequest) (*QueryUnpoolWhitelistResponse, error)
 }
@@ -2012,6 +2158,9 @@ func (*UnimplementedQueryServer) EstimateSuperfluidDelegatedAmountByValidatorDen
 func (*UnimplementedQueryServer) TotalDelegationByDelegator(ctx context.Context, req *QueryTotalDelegationByDelegatorRequest) (*QueryTotalDelegationByDelegatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalDelegationByDelegator not implemented")
 }
+func (*UnimplementedQueryServer) SuperfluidDelegationHeadroom(ctx context.Context, req *QuerySuperfluidDelegationHeadroomRequest) (*QuerySuperfluidDelegationHeadroomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidDelegationHeadroom not implemented")
+}
 func (*UnimplementedQueryServer) UnpoolWhitelist(ctx context.Context, req *QueryUnpoolWhitelistRequest) (*QueryUnpoolWhitelistResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnpoolWhitelist not implemented")
 }
@@ -2272,6 +2421,24 @@ func _Query_TotalDelegationByDelegator_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SuperfluidDelegationHeadroom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySuperfluidDelegationHeadroomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SuperfluidDelegationHeadroom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/SuperfluidDelegationHeadroom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SuperfluidDelegationHeadroom(ctx, req.(*QuerySuperfluidDelegationHeadroomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_UnpoolWhitelist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryUnpoolWhitelistRequest)
 	if err := dec(in); err != nil {
@@ -2350,6 +2517,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TotalDelegationByDelegator",
 			Handler:    _Query_TotalDelegationByDelegator_Handler,
 		},
+		{
+			MethodName: "SuperfluidDelegationHeadroom",
+			Handler:    _Query_SuperfluidDelegationHeadroom_Handler,
+		},
 		{
 			MethodName: "UnpoolWhitelist",
 			Handler:    _Query_UnpoolWhitelist_Handler,
@@ -3558,6 +3729,106 @@ func (m *QueryUnpoolWhitelistResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *QuerySuperfluidDelegationHeadroomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySuperfluidDelegationHeadroomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySuperfluidDelegationHeadroomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySuperfluidDelegationHeadroomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySuperfluidDelegationHeadroomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySuperfluidDelegationHeadroomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AssetHeadroom.Size()
+		i -= size
+		if _, err := m.AssetHeadroom.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.AssetCapped {
+		i--
+		if m.AssetCapped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.ValidatorHeadroom.Size()
+		i -= size
+		if _, err := m.ValidatorHeadroom.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ValidatorCapped {
+		i--
+		if m.ValidatorCapped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -4057,6 +4328,42 @@ func (m *QueryUnpoolWhitelistResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySuperfluidDelegationHeadroomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySuperfluidDelegationHeadroomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorCapped {
+		n += 2
+	}
+	l = m.ValidatorHeadroom.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.AssetCapped {
+		n += 2
+	}
+	l = m.AssetHeadroom.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -7193,6 +7500,278 @@ func (m *QueryUnpoolWhitelistResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySuperfluidDelegationHeadroomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySuperfluidDelegationHeadroomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySuperfluidDelegationHeadroomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySuperfluidDelegationHeadroomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySuperfluidDelegationHeadroomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySuperfluidDelegationHeadroomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCapped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ValidatorCapped = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorHeadroom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorHeadroom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetCapped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AssetCapped = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetHeadroom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AssetHeadroom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -303,6 +303,105 @@ func (m *MsgSuperfluidUnbondLockResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSuperfluidUnbondLockResponse proto.InternalMessageInfo
 
+// MsgSuperfluidRedelegate moves the superfluid delegation of a lock to a new
+// validator. The lock keeps an unbonding synthetic lockup on the old validator
+// for the unbonding period, during which it can not be redelegated again.
+type MsgSuperfluidRedelegate struct {
+	Sender     string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	LockId     uint64 `protobuf:"varint,2,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	NewValAddr string `protobuf:"bytes,3,opt,name=new_val_addr,json=newValAddr,proto3" json:"new_val_addr,omitempty"`
+}
+
+func (m *MsgSuperfluidRedelegate) Reset()         { *m = MsgSuperfluidRedelegate{} }
+func (m *MsgSuperfluidRedelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgSuperfluidRedelegate) ProtoMessage()    {}
+func (*MsgSuperfluidRedelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{6}
+}
+func (m *MsgSuperfluidRedelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidRedelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidRedelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidRedelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidRedelegate.Merge(m, src)
+}
+func (m *MsgSuperfluidRedelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidRedelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidRedelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidRedelegate proto.InternalMessageInfo
+
+func (m *MsgSuperfluidRedelegate) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSuperfluidRedelegate) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *MsgSuperfluidRedelegate) GetNewValAddr() string {
+	if m != nil {
+		return m.NewValAddr
+	}
+	return ""
+}
+
+type MsgSuperfluidRedelegateResponse struct {
+}
+
+func (m *MsgSuperfluidRedelegateResponse) Reset()         { *m = MsgSuperfluidRedelegateResponse{} }
+func (m *MsgSuperfluidRedelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSuperfluidRedelegateResponse) ProtoMessage()    {}
+func (*MsgSuperfluidRedelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{7}
+}
+func (m *MsgSuperfluidRedelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidRedelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidRedelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidRedelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidRedelegateResponse.Merge(m, src)
+}
+func (m *MsgSuperfluidRedelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidRedelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidRedelegateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidRedelegateResponse proto.InternalMessageInfo
+
 // MsgLockAndSuperfluidDelegate locks coins with the unbonding period duration,
 // and then does a superfluid lock from the newly created lockup, to the
 // specified validator addr.
@@ -316,7 +415,7 @@ func (m *MsgLockAndSuperfluidDelegate) Reset()         { *m = MsgLockAndSuperflu
 func (m *MsgLockAndSuperfluidDelegate) String() string { return proto.CompactTextString(m) }
 func (*MsgLockAndSuperfluidDelegate) ProtoMessage()    {}
 func (*MsgLockAndSuperfluidDelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{6}
+	return fileDescriptor_55b645f187d22814, []int{8}
 }
 func (m *MsgLockAndSuperfluidDelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -374,7 +473,7 @@ func (m *MsgLockAndSuperfluidDelegateResponse) Reset()         { *m = MsgLockAnd
 func (m *MsgLockAndSuperfluidDelegateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLockAndSuperfluidDelegateResponse) ProtoMessage()    {}
 func (*MsgLockAndSuperfluidDelegateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{7}
+	return fileDescriptor_55b645f187d22814, []int{9}
 }
 func (m *MsgLockAndSuperfluidDelegateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -410,6 +509,252 @@ func (m *MsgLockAndSuperfluidDelegateResponse) GetID() uint64 {
 	return 0
 }
 
+// MsgSuperfluidDelegatePartial superfluid delegates the given coin out of a
+// lock to the specified validator addr. If the coin is less than the locked
+// tokens, it is split out of the lock into a new lock which is superfluid
+// delegated, and the rest of the tokens stay in the original lock.
+type MsgSuperfluidDelegatePartial struct {
+	Sender  string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	LockId  uint64     `protobuf:"varint,2,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	Coin    types.Coin `protobuf:"bytes,3,opt,name=coin,proto3" json:"coin"`
+	ValAddr string     `protobuf:"bytes,4,opt,name=val_addr,json=valAddr,proto3" json:"val_addr,omitempty"`
+}
+
+func (m *MsgSuperfluidDelegatePartial) Reset()         { *m = MsgSuperfluidDelegatePartial{} }
+func (m *MsgSuperfluidDelegatePartial) String() string { return proto.CompactTextString(m) }
+func (*MsgSuperfluidDelegatePartial) ProtoMessage()    {}
+func (*MsgSuperfluidDelegatePartial) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{10}
+}
+func (m *MsgSuperfluidDelegatePartial) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidDelegatePartial) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidDelegatePartial.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidDelegatePartial) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidDelegatePartial.Merge(m, src)
+}
+func (m *MsgSuperfluidDelegatePartial) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidDelegatePartial) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidDelegatePartial.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidDelegatePartial proto.InternalMessageInfo
+
+func (m *MsgSuperfluidDelegatePartial) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSuperfluidDelegatePartial) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *MsgSuperfluidDelegatePartial) GetCoin() types.Coin {
+	if m != nil {
+		return m.Coin
+	}
+	return types.Coin{}
+}
+
+func (m *MsgSuperfluidDelegatePartial) GetValAddr() string {
+	if m != nil {
+		return m.ValAddr
+	}
+	return ""
+}
+
+type MsgSuperfluidDelegatePartialResponse struct {
+	// ID of the lock that is superfluid delegated
+	SuperfluidLockId uint64 `protobuf:"varint,1,opt,name=superfluid_lock_id,json=superfluidLockId,proto3" json:"superfluid_lock_id,omitempty"`
+}
+
+func (m *MsgSuperfluidDelegatePartialResponse) Reset()         { *m = MsgSuperfluidDelegatePartialResponse{} }
+func (m *MsgSuperfluidDelegatePartialResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSuperfluidDelegatePartialResponse) ProtoMessage()    {}
+func (*MsgSuperfluidDelegatePartialResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{11}
+}
+func (m *MsgSuperfluidDelegatePartialResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidDelegatePartialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidDelegatePartialResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidDelegatePartialResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidDelegatePartialResponse.Merge(m, src)
+}
+func (m *MsgSuperfluidDelegatePartialResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidDelegatePartialResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidDelegatePartialResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidDelegatePartialResponse proto.InternalMessageInfo
+
+func (m *MsgSuperfluidDelegatePartialResponse) GetSuperfluidLockId() uint64 {
+	if m != nil {
+		return m.SuperfluidLockId
+	}
+	return 0
+}
+
+// MsgLockAndSuperfluidDelegatePartial locks coins with the unbonding period
+// duration, and then superfluid delegates superfluid_coin out of the newly
+// created lockup to the specified validator addr.
+type MsgLockAndSuperfluidDelegatePartial struct {
+	Sender         string                                   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Coins          github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	SuperfluidCoin types.Coin                               `protobuf:"bytes,3,opt,name=superfluid_coin,json=superfluidCoin,proto3" json:"superfluid_coin" yaml:"superfluid_coin"`
+	ValAddr        string                                   `protobuf:"bytes,4,opt,name=val_addr,json=valAddr,proto3" json:"val_addr,omitempty"`
+}
+
+func (m *MsgLockAndSuperfluidDelegatePartial) Reset()         { *m = MsgLockAndSuperfluidDelegatePartial{} }
+func (m *MsgLockAndSuperfluidDelegatePartial) String() string { return proto.CompactTextString(m) }
+func (*MsgLockAndSuperfluidDelegatePartial) ProtoMessage()    {}
+func (*MsgLockAndSuperfluidDelegatePartial) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{12}
+}
+func (m *MsgLockAndSuperfluidDelegatePartial) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLockAndSuperfluidDelegatePartial) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLockAndSuperfluidDelegatePartial.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLockAndSuperfluidDelegatePartial) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLockAndSuperfluidDelegatePartial.Merge(m, src)
+}
+func (m *MsgLockAndSuperfluidDelegatePartial) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLockAndSuperfluidDelegatePartial) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLockAndSuperfluidDelegatePartial.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLockAndSuperfluidDelegatePartial proto.InternalMessageInfo
+
+func (m *MsgLockAndSuperfluidDelegatePartial) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgLockAndSuperfluidDelegatePartial) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+func (m *MsgLockAndSuperfluidDelegatePartial) GetSuperfluidCoin() types.Coin {
+	if m != nil {
+		return m.SuperfluidCoin
+	}
+	return types.Coin{}
+}
+
+func (m *MsgLockAndSuperfluidDelegatePartial) GetValAddr() string {
+	if m != nil {
+		return m.ValAddr
+	}
+	return ""
+}
+
+type MsgLockAndSuperfluidDelegatePartialResponse struct {
+	// ID of the created lock
+	ID uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	// ID of the lock that is superfluid delegated
+	SuperfluidLockId uint64 `protobuf:"varint,2,opt,name=superfluid_lock_id,json=superfluidLockId,proto3" json:"superfluid_lock_id,omitempty"`
+}
+
+func (m *MsgLockAndSuperfluidDelegatePartialResponse) Reset() {
+	*m = MsgLockAndSuperfluidDelegatePartialResponse{}
+}
+func (m *MsgLockAndSuperfluidDelegatePartialResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgLockAndSuperfluidDelegatePartialResponse) ProtoMessage() {}
+func (*MsgLockAndSuperfluidDelegatePartialResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{13}
+}
+func (m *MsgLockAndSuperfluidDelegatePartialResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLockAndSuperfluidDelegatePartialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLockAndSuperfluidDelegatePartialResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLockAndSuperfluidDelegatePartialResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLockAndSuperfluidDelegatePartialResponse.Merge(m, src)
+}
+func (m *MsgLockAndSuperfluidDelegatePartialResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLockAndSuperfluidDelegatePartialResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLockAndSuperfluidDelegatePartialResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLockAndSuperfluidDelegatePartialResponse proto.InternalMessageInfo
+
+func (m *MsgLockAndSuperfluidDelegatePartialResponse) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *MsgLockAndSuperfluidDelegatePartialResponse) GetSuperfluidLockId() uint64 {
+	if m != nil {
+		return m.SuperfluidLockId
+	}
+	return 0
+}
+
 // MsgUnPoolWhitelistedPool Unpools every lock the sender has, that is
 // associated with pool pool_id. If pool_id is not approved for unpooling by
 // governance, this is a no-op. Unpooling takes the locked gamm shares, and runs
@@ -427,7 +772,7 @@ func (m *MsgUnPoolWhitelistedPool) Reset()         { *m = MsgUnPoolWhitelistedPo
 func (m *MsgUnPoolWhitelistedPool) String() string { return proto.CompactTextString(m) }
 func (*MsgUnPoolWhitelistedPool) ProtoMessage()    {}
 func (*MsgUnPoolWhitelistedPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{8}
+	return fileDescriptor_55b645f187d22814, []int{14}
 }
 func (m *MsgUnPoolWhitelistedPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -478,7 +823,7 @@ func (m *MsgUnPoolWhitelistedPoolResponse) Reset()         { *m = MsgUnPoolWhite
 func (m *MsgUnPoolWhitelistedPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUnPoolWhitelistedPoolResponse) ProtoMessage()    {}
 func (*MsgUnPoolWhitelistedPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{9}
+	return fileDescriptor_55b645f187d22814, []int{15}
 }
 func (m *MsgUnPoolWhitelistedPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -521,8 +866,14 @@ func init() {
 	proto.RegisterType((*MsgSuperfluidUndelegateResponse)(nil), "osmosis.superfluid.MsgSuperfluidUndelegateResponse")
 	proto.RegisterType((*MsgSuperfluidUnbondLock)(nil), "osmosis.superfluid.MsgSuperfluidUnbondLock")
 	proto.RegisterType((*MsgSuperfluidUnbondLockResponse)(nil), "osmosis.superfluid.MsgSuperfluidUnbondLockResponse")
+	proto.RegisterType((*MsgSuperfluidRedelegate)(nil), "osmosis.superfluid.MsgSuperfluidRedelegate")
+	proto.RegisterType((*MsgSuperfluidRedelegateResponse)(nil), "osmosis.superfluid.MsgSuperfluidRedelegateResponse")
 	proto.RegisterType((*MsgLockAndSuperfluidDelegate)(nil), "osmosis.superfluid.MsgLockAndSuperfluidDelegate")
 	proto.RegisterType((*MsgLockAndSuperfluidDelegateResponse)(nil), "osmosis.superfluid.MsgLockAndSuperfluidDelegateResponse")
+	proto.RegisterType((*MsgSuperfluidDelegatePartial)(nil), "osmosis.superfluid.MsgSuperfluidDelegatePartial")
+	proto.RegisterType((*MsgSuperfluidDelegatePartialResponse)(nil), "osmosis.superfluid.MsgSuperfluidDelegatePartialResponse")
+	proto.RegisterType((*MsgLockAndSuperfluidDelegatePartial)(nil), "osmosis.superfluid.MsgLockAndSuperfluidDelegatePartial")
+	proto.RegisterType((*MsgLockAndSuperfluidDelegatePartialResponse)(nil), "osmosis.superfluid.MsgLockAndSuperfluidDelegatePartialResponse")
 	proto.RegisterType((*MsgUnPoolWhitelistedPool)(nil), "osmosis.superfluid.MsgUnPoolWhitelistedPool")
 	proto.RegisterType((*MsgUnPoolWhitelistedPoolResponse)(nil), "osmosis.superfluid.MsgUnPoolWhitelistedPoolResponse")
 }
@@ -530,45 +881,57 @@ func init() {
 func init() { proto.RegisterFile("osmosis/superfluid/tx.proto", fileDescriptor_55b645f187d22814) }
 
 var fileDescriptor_55b645f187d22814 = []byte{
-	// 608 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0x93, 0x90, 0xc0, 0xa2, 0x16, 0x61, 0xb5, 0xaa, 0x63, 0xc0, 0x0e, 0x06, 0xa1, 0xa0,
-	0x52, 0x6f, 0xd3, 0xa0, 0x0a, 0x71, 0x6b, 0xc8, 0x25, 0xa8, 0x91, 0x2a, 0xa3, 0x0a, 0x09, 0x09,
-	0x45, 0x76, 0x76, 0xeb, 0x5a, 0xd9, 0x78, 0x23, 0xaf, 0x63, 0xa5, 0xe2, 0x01, 0xb8, 0xf2, 0x1c,
-	0xbc, 0x08, 0x3d, 0xf6, 0xc8, 0x29, 0xa0, 0xe4, 0x0d, 0x2a, 0x1e, 0x00, 0xf9, 0x37, 0x14, 0xec,
-	0x90, 0x08, 0x7a, 0xf2, 0xce, 0xcc, 0xb7, 0xdf, 0x7c, 0xa3, 0x99, 0xf1, 0x82, 0x7b, 0x94, 0x0d,
-	0x28, 0xb3, 0x18, 0x64, 0xa3, 0x21, 0x76, 0x4e, 0xc8, 0xc8, 0x42, 0xd0, 0x1d, 0xab, 0x43, 0x87,
-	0xba, 0x94, 0xe7, 0xa3, 0xa0, 0x3a, 0x0f, 0x8a, 0x1b, 0x26, 0x35, 0x69, 0x10, 0x86, 0xfe, 0x29,
-	0x44, 0x8a, 0x92, 0x49, 0xa9, 0x49, 0x30, 0x0c, 0x2c, 0x63, 0x74, 0x02, 0xd1, 0xc8, 0xd1, 0x5d,
-	0x8b, 0xda, 0x71, 0xbc, 0x17, 0x50, 0x41, 0x43, 0x67, 0x18, 0x7a, 0x75, 0x03, 0xbb, 0x7a, 0x1d,
-	0xf6, 0xa8, 0x15, 0xc7, 0x1f, 0xa5, 0xc8, 0x98, 0x1f, 0x43, 0x90, 0xe2, 0x81, 0xcd, 0x0e, 0x33,
-	0xdf, 0x24, 0xee, 0x16, 0x26, 0xd8, 0xd4, 0x5d, 0xcc, 0x3f, 0x05, 0x25, 0x86, 0x6d, 0x84, 0x1d,
-	0x81, 0xab, 0x72, 0xb5, 0x5b, 0xcd, 0xbb, 0x97, 0x13, 0x79, 0xed, 0x4c, 0x1f, 0x90, 0x97, 0x4a,
-	0xe8, 0x57, 0xb4, 0x08, 0xc0, 0x6f, 0x81, 0x32, 0xa1, 0xbd, 0x7e, 0xd7, 0x42, 0x42, 0xbe, 0xca,
-	0xd5, 0x8a, 0x5a, 0xc9, 0x37, 0xdb, 0x88, 0xaf, 0x80, 0x9b, 0x9e, 0x4e, 0xba, 0x3a, 0x42, 0x8e,
-	0x50, 0xf0, 0x59, 0xb4, 0xb2, 0xa7, 0x93, 0x03, 0x84, 0x1c, 0x45, 0x06, 0x0f, 0x52, 0xf3, 0x6a,
-	0x98, 0x0d, 0xa9, 0xcd, 0xb0, 0xf2, 0x1e, 0x6c, 0x5d, 0x01, 0x1c, 0xdb, 0xe8, 0x3f, 0x4a, 0x53,
-	0x1e, 0x02, 0x39, 0x83, 0x7e, 0x81, 0x02, 0x83, 0xda, 0xe8, 0x90, 0xf6, 0xfa, 0xd7, 0xa4, 0x20,
-	0xa6, 0x4f, 0x14, 0x7c, 0xe1, 0xc0, 0xfd, 0x0e, 0x33, 0x7d, 0xdf, 0x81, 0x8d, 0xfe, 0xad, 0x49,
-	0x3a, 0xb8, 0xe1, 0xcf, 0x06, 0x13, 0xf2, 0xd5, 0x42, 0xed, 0xf6, 0x5e, 0x45, 0x0d, 0xa7, 0x47,
-	0xf5, 0xa7, 0x47, 0x8d, 0xa6, 0x47, 0x7d, 0x45, 0x2d, 0xbb, 0xb9, 0x7b, 0x3e, 0x91, 0x73, 0x9f,
-	0xbf, 0xc9, 0x35, 0xd3, 0x72, 0x4f, 0x47, 0x86, 0xda, 0xa3, 0x03, 0x18, 0x8d, 0x5a, 0xf8, 0xd9,
-	0x61, 0xa8, 0x0f, 0xdd, 0xb3, 0x21, 0x66, 0xc1, 0x05, 0xa6, 0x85, 0xcc, 0x8b, 0xda, 0xbd, 0x0f,
-	0x1e, 0x2f, 0x2a, 0x24, 0xae, 0x98, 0x5f, 0x07, 0xf9, 0x76, 0x2b, 0x28, 0xa6, 0xa8, 0xe5, 0xdb,
-	0x2d, 0xc5, 0x01, 0x42, 0x87, 0x99, 0xc7, 0xf6, 0x11, 0xa5, 0xe4, 0xed, 0xa9, 0xe5, 0x62, 0x62,
-	0x31, 0x17, 0x23, 0xdf, 0x5c, 0xa5, 0xf8, 0x6d, 0x50, 0x1e, 0x52, 0x4a, 0x92, 0x26, 0x34, 0xf9,
-	0xcb, 0x89, 0xbc, 0x1e, 0x62, 0xa3, 0x80, 0xa2, 0x95, 0xfc, 0x53, 0x1b, 0x29, 0xaf, 0x41, 0x35,
-	0x2b, 0x67, 0xa2, 0xf3, 0x09, 0xb8, 0x83, 0xc7, 0x96, 0x8b, 0x51, 0x37, 0x6a, 0x2e, 0x13, 0xb8,
-	0x6a, 0xa1, 0x56, 0xd4, 0xd6, 0x42, 0xf7, 0x61, 0xd0, 0x63, 0xb6, 0xf7, 0xa3, 0x08, 0x0a, 0x1d,
-	0x66, 0xf2, 0x0e, 0xe0, 0xd3, 0xda, 0xa7, 0xfe, 0xf9, 0x33, 0x50, 0x53, 0xd7, 0x42, 0xac, 0x2f,
-	0x0d, 0x4d, 0x34, 0x8e, 0xc1, 0x46, 0xea, 0xfa, 0x6c, 0xff, 0x95, 0x6a, 0x0e, 0x16, 0x1b, 0x2b,
-	0x80, 0xb3, 0x32, 0x27, 0x6b, 0xb3, 0x4c, 0xe6, 0x18, 0xbc, 0x54, 0xe6, 0xdf, 0x37, 0x86, 0xff,
-	0xc8, 0x81, 0x4a, 0xf6, 0xba, 0xec, 0x66, 0x50, 0x66, 0xde, 0x10, 0x5f, 0xac, 0x7a, 0x23, 0x51,
-	0xf2, 0x01, 0x6c, 0xa6, 0x8f, 0xed, 0xb3, 0x0c, 0xca, 0x54, 0xb4, 0xf8, 0x7c, 0x15, 0x74, 0x9c,
-	0xbc, 0x79, 0x74, 0x3e, 0x95, 0xb8, 0x8b, 0xa9, 0xc4, 0x7d, 0x9f, 0x4a, 0xdc, 0xa7, 0x99, 0x94,
-	0xbb, 0x98, 0x49, 0xb9, 0xaf, 0x33, 0x29, 0xf7, 0x6e, 0xff, 0x97, 0xa5, 0x8e, 0x98, 0x77, 0x88,
-	0x6e, 0xb0, 0xd8, 0x80, 0x5e, 0xbd, 0x01, 0xc7, 0x57, 0x5e, 0x2e, 0x7f, 0xd1, 0x8d, 0x52, 0xf0,
-	0x5c, 0x34, 0x7e, 0x06, 0x00, 0x00, 0xff, 0xff, 0x64, 0xb3, 0x1a, 0x05, 0xdc, 0x06, 0x00, 0x00,
+	// 793 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x4e, 0xdb, 0x4e,
+	0x10, 0x8e, 0x93, 0xfc, 0x02, 0xbf, 0x69, 0x81, 0xd6, 0x82, 0x12, 0xdc, 0xd6, 0x49, 0x0d, 0xaa,
+	0x52, 0x01, 0x36, 0x21, 0x15, 0x45, 0xbd, 0x54, 0x04, 0x2e, 0xa9, 0x88, 0x84, 0xdc, 0xd2, 0x4a,
+	0x95, 0xaa, 0xc8, 0x8e, 0x17, 0x63, 0xc5, 0x78, 0x23, 0xaf, 0x13, 0x82, 0x7a, 0xe8, 0xb1, 0xd7,
+	0xde, 0x7a, 0xe9, 0x13, 0xf4, 0xc8, 0x4b, 0x94, 0x23, 0xc7, 0x9e, 0xd2, 0x0a, 0xde, 0x80, 0x27,
+	0xa8, 0xfc, 0x37, 0x04, 0xec, 0x10, 0x53, 0xda, 0x53, 0x76, 0x77, 0xbe, 0x99, 0xf9, 0xbe, 0x99,
+	0xc9, 0xae, 0xe1, 0x3e, 0x26, 0x7b, 0x98, 0x68, 0x44, 0x20, 0xad, 0x26, 0x32, 0x77, 0xf4, 0x96,
+	0xa6, 0x08, 0x56, 0x87, 0x6f, 0x9a, 0xd8, 0xc2, 0x34, 0xed, 0x19, 0xf9, 0x9e, 0x91, 0x99, 0x54,
+	0xb1, 0x8a, 0x1d, 0xb3, 0x60, 0xaf, 0x5c, 0x24, 0xc3, 0xaa, 0x18, 0xab, 0x3a, 0x12, 0x9c, 0x9d,
+	0xdc, 0xda, 0x11, 0x94, 0x96, 0x29, 0x59, 0x1a, 0x36, 0x7c, 0x7b, 0xdd, 0x09, 0x25, 0xc8, 0x12,
+	0x41, 0x42, 0xbb, 0x28, 0x23, 0x4b, 0x2a, 0x0a, 0x75, 0xac, 0xf9, 0xf6, 0xd9, 0x10, 0x1a, 0xbd,
+	0xa5, 0x0b, 0xe2, 0xda, 0x30, 0x55, 0x25, 0xea, 0xab, 0xe0, 0x78, 0x03, 0xe9, 0x48, 0x95, 0x2c,
+	0x44, 0x3f, 0x81, 0x0c, 0x41, 0x86, 0x82, 0xcc, 0x2c, 0x95, 0xa7, 0x0a, 0xff, 0x97, 0xef, 0x9e,
+	0x75, 0x73, 0x63, 0x07, 0xd2, 0x9e, 0xfe, 0x9c, 0x73, 0xcf, 0x39, 0xd1, 0x03, 0xd0, 0xd3, 0x30,
+	0xa2, 0xe3, 0x7a, 0xa3, 0xa6, 0x29, 0xd9, 0x64, 0x9e, 0x2a, 0xa4, 0xc5, 0x8c, 0xbd, 0xad, 0x28,
+	0xf4, 0x0c, 0x8c, 0xb6, 0x25, 0xbd, 0x26, 0x29, 0x8a, 0x99, 0x4d, 0xd9, 0x51, 0xc4, 0x91, 0xb6,
+	0xa4, 0xaf, 0x29, 0x8a, 0xc9, 0xe5, 0xe0, 0x61, 0x68, 0x5e, 0x11, 0x91, 0x26, 0x36, 0x08, 0xe2,
+	0xde, 0xc3, 0x74, 0x1f, 0x60, 0xdb, 0x50, 0x6e, 0x90, 0x1a, 0xf7, 0x08, 0x72, 0x11, 0xe1, 0x07,
+	0x30, 0x90, 0xb1, 0xa1, 0x6c, 0xe2, 0x7a, 0xe3, 0x2f, 0x31, 0xf0, 0xc3, 0x07, 0x0c, 0x3e, 0x5e,
+	0x60, 0x20, 0xa2, 0x9b, 0xac, 0x01, 0x9d, 0x87, 0xdb, 0x06, 0xda, 0xaf, 0x5d, 0x68, 0x11, 0x18,
+	0x68, 0xff, 0x8d, 0xd7, 0xa5, 0x8b, 0x1c, 0x7b, 0x04, 0x02, 0x8e, 0xdf, 0x29, 0x78, 0x50, 0x25,
+	0xaa, 0xcd, 0x7b, 0xcd, 0x50, 0xfe, 0x6c, 0x90, 0x24, 0xf8, 0xcf, 0x9e, 0x5f, 0x92, 0x4d, 0xe6,
+	0x53, 0x85, 0x5b, 0xcb, 0x33, 0xbc, 0x3b, 0xe1, 0xbc, 0x3d, 0xe1, 0xbc, 0x37, 0xe1, 0xfc, 0x3a,
+	0xd6, 0x8c, 0xf2, 0xd2, 0x51, 0x37, 0x97, 0xf8, 0xf6, 0x33, 0x57, 0x50, 0x35, 0x6b, 0xb7, 0x25,
+	0xf3, 0x75, 0xbc, 0x27, 0x78, 0x7f, 0x07, 0xf7, 0x67, 0x91, 0x28, 0x0d, 0xc1, 0x3a, 0x68, 0x22,
+	0xe2, 0x38, 0x10, 0xd1, 0x8d, 0x3c, 0x68, 0x24, 0x57, 0x60, 0x6e, 0x90, 0x10, 0x5f, 0x31, 0x3d,
+	0x0e, 0xc9, 0xca, 0x86, 0x23, 0x26, 0x2d, 0x26, 0x2b, 0x1b, 0xdc, 0xa1, 0x5b, 0x81, 0xcb, 0x1e,
+	0x5b, 0x92, 0x69, 0x69, 0x92, 0x7e, 0x23, 0xbd, 0x2a, 0x41, 0xda, 0x16, 0xe0, 0x70, 0x1e, 0x58,
+	0x99, 0xb4, 0x5d, 0x19, 0xd1, 0x01, 0xf7, 0x89, 0x4d, 0xf7, 0x8b, 0x7d, 0x0d, 0x73, 0x83, 0x38,
+	0x07, 0x62, 0x17, 0x80, 0xee, 0xdd, 0x19, 0x35, 0x9f, 0x9b, 0x2b, 0xfe, 0x4e, 0xcf, 0xb2, 0xe9,
+	0xce, 0xf4, 0x61, 0x12, 0x66, 0x07, 0xd5, 0xf0, 0x1a, 0x15, 0xf9, 0x07, 0x33, 0x21, 0xc3, 0xc4,
+	0x39, 0x8d, 0xc3, 0x95, 0x99, 0xb5, 0x93, 0x9d, 0x75, 0x73, 0xf7, 0x3c, 0xd6, 0xfd, 0xfe, 0x9c,
+	0x38, 0xde, 0x3b, 0x59, 0xbf, 0xa2, 0x15, 0x0d, 0x98, 0x1f, 0xa2, 0x66, 0x51, 0xe3, 0x17, 0xd1,
+	0xa1, 0x64, 0x44, 0x87, 0x4c, 0xc8, 0x56, 0x89, 0xba, 0x6d, 0x6c, 0x61, 0xac, 0xbf, 0xdd, 0xd5,
+	0x2c, 0xa4, 0x6b, 0xc4, 0x42, 0x8a, 0xbd, 0x8d, 0xd3, 0x95, 0x79, 0x18, 0x69, 0x62, 0xac, 0x07,
+	0x99, 0xca, 0xf4, 0x59, 0x37, 0x37, 0xee, 0x62, 0x3d, 0x03, 0x27, 0x66, 0xec, 0x55, 0x45, 0xe1,
+	0x5e, 0x42, 0x3e, 0x2a, 0x67, 0xa0, 0xea, 0x31, 0x4c, 0xa0, 0x8e, 0x66, 0xa1, 0x40, 0x01, 0xc9,
+	0x52, 0xf9, 0x54, 0x21, 0x2d, 0x8e, 0xb9, 0xc7, 0x2e, 0x7d, 0xb2, 0xfc, 0x65, 0x14, 0x52, 0x55,
+	0xa2, 0xd2, 0x26, 0xd0, 0x61, 0x77, 0x0d, 0x7f, 0xf9, 0x75, 0xe5, 0x43, 0xe7, 0x9c, 0x29, 0x0e,
+	0x0d, 0x0d, 0x38, 0x76, 0x60, 0x32, 0xf4, 0x3d, 0x9a, 0xbf, 0x32, 0x54, 0x0f, 0xcc, 0x94, 0x62,
+	0x80, 0xc3, 0x33, 0x8b, 0x28, 0x46, 0x66, 0x11, 0xc5, 0xc8, 0x2c, 0xa2, 0xc1, 0x99, 0xcf, 0xbd,
+	0x80, 0xc3, 0x68, 0xf6, 0xc1, 0x4c, 0x29, 0x06, 0x38, 0xc8, 0xfc, 0x89, 0x82, 0x99, 0xe8, 0x57,
+	0x65, 0x29, 0x22, 0x64, 0xa4, 0x07, 0xb3, 0x1a, 0xd7, 0xa3, 0x8f, 0x49, 0xf4, 0x5d, 0xb6, 0x34,
+	0xf4, 0x20, 0x79, 0x1e, 0xcc, 0x6a, 0x5c, 0x8f, 0x80, 0xc9, 0x57, 0x0a, 0xf2, 0x57, 0x5e, 0xae,
+	0xcf, 0xe2, 0x0a, 0xf5, 0x79, 0xbd, 0xb8, 0xa6, 0x63, 0x40, 0xef, 0x03, 0x4c, 0x85, 0xdf, 0x2c,
+	0x0b, 0x11, 0x91, 0x43, 0xd1, 0xcc, 0xd3, 0x38, 0x68, 0x3f, 0x79, 0x79, 0xeb, 0xe8, 0x84, 0xa5,
+	0x8e, 0x4f, 0x58, 0xea, 0xd7, 0x09, 0x4b, 0x7d, 0x3e, 0x65, 0x13, 0xc7, 0xa7, 0x6c, 0xe2, 0xc7,
+	0x29, 0x9b, 0x78, 0xb7, 0x72, 0xee, 0x41, 0xf0, 0x22, 0x2f, 0xea, 0x92, 0x4c, 0xfc, 0x8d, 0xd0,
+	0x2e, 0x96, 0x84, 0x4e, 0xdf, 0xd7, 0xba, 0xfd, 0x48, 0xc8, 0x19, 0xe7, 0x13, 0xb9, 0xf4, 0x7b,
+	0x00, 0xac, 0x45, 0x46, 0x6f, 0xd0, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -587,11 +950,18 @@ type MsgClient interface {
 	SuperfluidDelegate(ctx context.Context, in *MsgSuperfluidDelegate, opts ...grpc.CallOption) (*MsgSuperfluidDelegateResponse, error)
 	// Execute superfluid undelegation for a lockup
 	SuperfluidUndelegate(ctx context.Context, in *MsgSuperfluidUndelegate, opts ...grpc.CallOption) (*MsgSuperfluidUndelegateResponse, error)
+	// Execute superfluid redelegation for a lockup
+	SuperfluidRedelegate(ctx context.Context, in *MsgSuperfluidRedelegate, opts ...grpc.CallOption) (*MsgSuperfluidRedelegateResponse, error)
 	// For a given lock that is being superfluidly undelegated,
 	// also unbond the underlying lock.
 	SuperfluidUnbondLock(ctx context.Context, in *MsgSuperfluidUnbondLock, opts ...grpc.CallOption) (*MsgSuperfluidUnbondLockResponse, error)
 	// Execute lockup lock and superfluid delegation in a single msg
 	LockAndSuperfluidDelegate(ctx context.Context, in *MsgLockAndSuperfluidDelegate, opts ...grpc.CallOption) (*MsgLockAndSuperfluidDelegateResponse, error)
+	// Execute superfluid delegation for part of a lockup
+	SuperfluidDelegatePartial(ctx context.Context, in *MsgSuperfluidDelegatePartial, opts ...grpc.CallOption) (*MsgSuperfluidDelegatePartialResponse, error)
+	// Execute lockup lock and superfluid delegation of part of the lock in a
+	// single msg
+	LockAndSuperfluidDelegatePartial(ctx context.Context, in *MsgLockAndSuperfluidDelegatePartial, opts ...grpc.CallOption) (*MsgLockAndSuperfluidDelegatePartialResponse, error)
 	UnPoolWhitelistedPool(ctx context.Context, in *MsgUnPoolWhitelistedPool, opts ...grpc.CallOption) (*MsgUnPoolWhitelistedPoolResponse, error)
 }
 
@@ -621,6 +991,15 @@ func (c *msgClient) SuperfluidUndelegate(ctx context.Context, in *MsgSuperfluidU
 	return out, nil
 }
 
+func (c *msgClient) SuperfluidRedelegate(ctx context.Context, in *MsgSuperfluidRedelegate, opts ...grpc.CallOption) (*MsgSuperfluidRedelegateResponse, error) {
+	out := new(MsgSuperfluidRedelegateResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/SuperfluidRedelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SuperfluidUnbondLock(ctx context.Context, in *MsgSuperfluidUnbondLock, opts ...grpc.CallOption) (*MsgSuperfluidUnbondLockResponse, error) {
 	out := new(MsgSuperfluidUnbondLockResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/SuperfluidUnbondLock", in, out, opts...)
@@ -639,6 +1018,24 @@ func (c *msgClient) LockAndSuperfluidDelegate(ctx context.Context, in *MsgLockAn
 	return out, nil
 }
 
+func (c *msgClient) SuperfluidDelegatePartial(ctx context.Context, in *MsgSuperfluidDelegatePartial, opts ...grpc.CallOption) (*MsgSuperfluidDelegatePartialResponse, error) {
+	out := new(MsgSuperfluidDelegatePartialResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/SuperfluidDelegatePartial", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LockAndSuperfluidDelegatePartial(ctx context.Context, in *MsgLockAndSuperfluidDelegatePartial, opts ...grpc.CallOption) (*MsgLockAndSuperfluidDelegatePartialResponse, error) {
+	out := new(MsgLockAndSuperfluidDelegatePartialResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/LockAndSuperfluidDelegatePartial", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UnPoolWhitelistedPool(ctx context.Context, in *MsgUnPoolWhitelistedPool, opts ...grpc.CallOption) (*MsgUnPoolWhitelistedPoolResponse, error) {
 	out := new(MsgUnPoolWhitelistedPoolResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/UnPoolWhitelistedPool", in, out, opts...)
@@ -654,11 +1051,18 @@ type MsgServer interface {
 	SuperfluidDelegate(context.Context, *MsgSuperfluidDelegate) (*MsgSuperfluidDelegateResponse, error)
 	// Execute superfluid undelegation for a lockup
 	SuperfluidUndelegate(context.Context, *MsgSuperfluidUndelegate) (*MsgSuperfluidUndelegateResponse, error)
+	// Execute superfluid redelegation for a lockup
+	SuperfluidRedelegate(context.Context, *MsgSuperfluidRedelegate) (*MsgSuperfluidRedelegateResponse, error)
 	// For a given lock that is being superfluidly undelegated,
 	// also unbond the underlying lock.
 	SuperfluidUnbondLock(context.Context, *MsgSuperfluidUnbondLock) (*MsgSuperfluidUnbondLockResponse, error)
 	// Execute lockup lock and superfluid delegation in a single msg
 	LockAndSuperfluidDelegate(context.Context, *MsgLockAndSuperfluidDelegate) (*MsgLockAndSuperfluidDelegateResponse, error)
+	// Execute superfluid delegation for part of a lockup
+	SuperfluidDelegatePartial(context.Context, *MsgSuperfluidDelegatePartial) (*MsgSuperfluidDelegatePartialResponse, error)
+	// Execute lockup lock and superfluid delegation of part of the lock in a
+	// single msg
+	LockAndSuperfluidDelegatePartial(context.Context, *MsgLockAndSuperfluidDelegatePartial) (*MsgLockAndSuperfluidDelegatePartialResponse, error)
 	UnPoolWhitelistedPool(context.Context, *MsgUnPoolWhitelistedPool) (*MsgUnPoolWhitelistedPoolResponse, error)
 }
 
@@ -672,12 +1076,21 @@ func (*UnimplementedMsgServer) SuperfluidDelegate(ctx context.Context, req *MsgS
 func (*UnimplementedMsgServer) SuperfluidUndelegate(ctx context.Context, req *MsgSuperfluidUndelegate) (*MsgSuperfluidUndelegateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidUndelegate not implemented")
 }
+func (*UnimplementedMsgServer) SuperfluidRedelegate(ctx context.Context, req *MsgSuperfluidRedelegate) (*MsgSuperfluidRedelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidRedelegate not implemented")
+}
 func (*UnimplementedMsgServer) SuperfluidUnbondLock(ctx context.Context, req *MsgSuperfluidUnbondLock) (*MsgSuperfluidUnbondLockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidUnbondLock not implemented")
 }
 func (*UnimplementedMsgServer) LockAndSuperfluidDelegate(ctx context.Context, req *MsgLockAndSuperfluidDelegate) (*MsgLockAndSuperfluidDelegateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LockAndSuperfluidDelegate not implemented")
 }
+func (*UnimplementedMsgServer) SuperfluidDelegatePartial(ctx context.Context, req *MsgSuperfluidDelegatePartial) (*MsgSuperfluidDelegatePartialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidDelegatePartial not implemented")
+}
+func (*UnimplementedMsgServer) LockAndSuperfluidDelegatePartial(ctx context.Context, req *MsgLockAndSuperfluidDelegatePartial) (*MsgLockAndSuperfluidDelegatePartialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockAndSuperfluidDelegatePartial not implemented")
+}
 func (*UnimplementedMsgServer) UnPoolWhitelistedPool(ctx context.Context, req *MsgUnPoolWhitelistedPool) (*MsgUnPoolWhitelistedPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnPoolWhitelistedPool not implemented")
 }
@@ -722,6 +1135,24 @@ func _Msg_SuperfluidUndelegate_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SuperfluidRedelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSuperfluidRedelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SuperfluidRedelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Msg/SuperfluidRedelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SuperfluidRedelegate(ctx, req.(*MsgSuperfluidRedelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SuperfluidUnbondLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSuperfluidUnbondLock)
 	if err := dec(in); err != nil {
@@ -758,29 +1189,65 @@ func _Msg_LockAndSuperfluidDelegate_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_UnPoolWhitelistedPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUnPoolWhitelistedPool)
+func _Msg_SuperfluidDelegatePartial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSuperfluidDelegatePartial)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).UnPoolWhitelistedPool(ctx, in)
+		return srv.(MsgServer).SuperfluidDelegatePartial(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/osmosis.superfluid.Msg/UnPoolWhitelistedPool",
+		FullMethod: "/osmosis.superfluid.Msg/SuperfluidDelegatePartial",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UnPoolWhitelistedPool(ctx, req.(*MsgUnPoolWhitelistedPool))
+		return srv.(MsgServer).SuperfluidDelegatePartial(ctx, req.(*MsgSuperfluidDelegatePartial))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.superfluid.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
+func _Msg_LockAndSuperfluidDelegatePartial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLockAndSuperfluidDelegatePartial)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LockAndSuperfluidDelegatePartial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Msg/LockAndSuperfluidDelegatePartial",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LockAndSuperfluidDelegatePartial(ctx, req.(*MsgLockAndSuperfluidDelegatePartial))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnPoolWhitelistedPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnPoolWhitelistedPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnPoolWhitelistedPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Msg/UnPoolWhitelistedPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnPoolWhitelistedPool(ctx, req.(*MsgUnPoolWhitelistedPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.superfluid.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
 			MethodName: "SuperfluidDelegate",
 			Handler:    _Msg_SuperfluidDelegate_Handler,
 		},
@@ -788,6 +1255,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SuperfluidUndelegate",
 			Handler:    _Msg_SuperfluidUndelegate_Handler,
 		},
+		{
+			MethodName: "SuperfluidRedelegate",
+			Handler:    _Msg_SuperfluidRedelegate_Handler,
+		},
 		{
 			MethodName: "SuperfluidUnbondLock",
 			Handler:    _Msg_SuperfluidUnbondLock_Handler,
@@ -796,6 +1267,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LockAndSuperfluidDelegate",
 			Handler:    _Msg_LockAndSuperfluidDelegate_Handler,
 		},
+		{
+			MethodName: "SuperfluidDelegatePartial",
+			Handler:    _Msg_SuperfluidDelegatePartial_Handler,
+		},
+		{
+			MethodName: "LockAndSuperfluidDelegatePartial",
+			Handler:    _Msg_LockAndSuperfluidDelegatePartial_Handler,
+		},
 		{
 			MethodName: "UnPoolWhitelistedPool",
 			Handler:    _Msg_UnPoolWhitelistedPool_Handler,
@@ -986,6 +1465,71 @@ func (m *MsgSuperfluidUnbondLockResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSuperfluidRedelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuperfluidRedelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuperfluidRedelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewValAddr) > 0 {
+		i -= len(m.NewValAddr)
+		copy(dAtA[i:], m.NewValAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewValAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LockId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSuperfluidRedelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuperfluidRedelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuperfluidRedelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgLockAndSuperfluidDelegate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1065,6 +1609,180 @@ func (m *MsgLockAndSuperfluidDelegateResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSuperfluidDelegatePartial) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuperfluidDelegatePartial) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuperfluidDelegatePartial) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValAddr) > 0 {
+		i -= len(m.ValAddr)
+		copy(dAtA[i:], m.ValAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValAddr)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.LockId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSuperfluidDelegatePartialResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuperfluidDelegatePartialResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuperfluidDelegatePartialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SuperfluidLockId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SuperfluidLockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLockAndSuperfluidDelegatePartial) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLockAndSuperfluidDelegatePartial) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLockAndSuperfluidDelegatePartial) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValAddr) > 0 {
+		i -= len(m.ValAddr)
+		copy(dAtA[i:], m.ValAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValAddr)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.SuperfluidCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLockAndSuperfluidDelegatePartialResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLockAndSuperfluidDelegatePartialResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLockAndSuperfluidDelegatePartialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SuperfluidLockId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SuperfluidLockId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgUnPoolWhitelistedPool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1121,20 +1839,20 @@ func (m *MsgUnPoolWhitelistedPoolResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	var l int
 	_ = l
 	if len(m.ExitedLockIds) > 0 {
-		dAtA2 := make([]byte, len(m.ExitedLockIds)*10)
-		var j1 int
+		dAtA4 := make([]byte, len(m.ExitedLockIds)*10)
+		var j3 int
 		for _, num := range m.ExitedLockIds {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j3++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintTx(dAtA, i, uint64(j1))
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTx(dAtA, i, uint64(j3))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -1231,7 +1949,7 @@ func (m *MsgSuperfluidUnbondLockResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgLockAndSuperfluidDelegate) Size() (n int) {
+func (m *MsgSuperfluidRedelegate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1241,32 +1959,26 @@ func (m *MsgLockAndSuperfluidDelegate) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if m.LockId != 0 {
+		n += 1 + sovTx(uint64(m.LockId))
 	}
-	l = len(m.ValAddr)
+	l = len(m.NewValAddr)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	return n
 }
 
-func (m *MsgLockAndSuperfluidDelegateResponse) Size() (n int) {
+func (m *MsgSuperfluidRedelegateResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ID != 0 {
-		n += 1 + sovTx(uint64(m.ID))
-	}
 	return n
 }
 
-func (m *MsgUnPoolWhitelistedPool) Size() (n int) {
+func (m *MsgLockAndSuperfluidDelegate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1276,8 +1988,117 @@ func (m *MsgUnPoolWhitelistedPool) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ValAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLockAndSuperfluidDelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovTx(uint64(m.ID))
+	}
+	return n
+}
+
+func (m *MsgSuperfluidDelegatePartial) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LockId != 0 {
+		n += 1 + sovTx(uint64(m.LockId))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ValAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSuperfluidDelegatePartialResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SuperfluidLockId != 0 {
+		n += 1 + sovTx(uint64(m.SuperfluidLockId))
+	}
+	return n
+}
+
+func (m *MsgLockAndSuperfluidDelegatePartial) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.SuperfluidCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ValAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLockAndSuperfluidDelegatePartialResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovTx(uint64(m.ID))
+	}
+	if m.SuperfluidLockId != 0 {
+		n += 1 + sovTx(uint64(m.SuperfluidLockId))
+	}
+	return n
+}
+
+func (m *MsgUnPoolWhitelistedPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
 	}
 	return n
 }
@@ -1293,18 +2114,503 @@ func (m *MsgUnPoolWhitelistedPoolResponse) Size() (n int) {
 		for _, e := range m.ExitedLockIds {
 			l += sovTx(uint64(e))
 		}
-		n += 1 + sovTx(uint64(l)) + l
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSuperfluidDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSuperfluidDelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidDelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSuperfluidUndelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidUndelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSuperfluidUndelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidUndelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSuperfluidUnbondLock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidUnbondLock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidUnbondLock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSuperfluidUnbondLockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidUnbondLockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidUnbondLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgSuperfluidDelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgSuperfluidRedelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1327,10 +2633,10 @@ func (m *MsgSuperfluidDelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSuperfluidDelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSuperfluidRedelegate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSuperfluidDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSuperfluidRedelegate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1386,7 +2692,7 @@ func (m *MsgSuperfluidDelegate) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1414,7 +2720,7 @@ func (m *MsgSuperfluidDelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValAddr = string(dAtA[iNdEx:postIndex])
+			m.NewValAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1437,7 +2743,7 @@ func (m *MsgSuperfluidDelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSuperfluidDelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSuperfluidRedelegateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1460,10 +2766,10 @@ func (m *MsgSuperfluidDelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSuperfluidDelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSuperfluidRedelegateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSuperfluidDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSuperfluidRedelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1487,7 +2793,7 @@ func (m *MsgSuperfluidDelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSuperfluidUndelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgLockAndSuperfluidDelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1510,10 +2816,10 @@ func (m *MsgSuperfluidUndelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSuperfluidUndelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLockAndSuperfluidDelegate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSuperfluidUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLockAndSuperfluidDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1549,10 +2855,10 @@ func (m *MsgSuperfluidUndelegate) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
 			}
-			m.LockId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1562,11 +2868,58 @@ func (m *MsgSuperfluidUndelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LockId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1588,7 +2941,7 @@ func (m *MsgSuperfluidUndelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSuperfluidUndelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLockAndSuperfluidDelegateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1611,12 +2964,31 @@ func (m *MsgSuperfluidUndelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSuperfluidUndelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLockAndSuperfluidDelegateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSuperfluidUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLockAndSuperfluidDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1638,7 +3010,7 @@ func (m *MsgSuperfluidUndelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSuperfluidUnbondLock) Unmarshal(dAtA []byte) error {
+func (m *MsgSuperfluidDelegatePartial) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1661,10 +3033,10 @@ func (m *MsgSuperfluidUnbondLock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSuperfluidUnbondLock: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSuperfluidDelegatePartial: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSuperfluidUnbondLock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSuperfluidDelegatePartial: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1718,6 +3090,71 @@ func (m *MsgSuperfluidUnbondLock) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1739,7 +3176,7 @@ func (m *MsgSuperfluidUnbondLock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSuperfluidUnbondLockResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSuperfluidDelegatePartialResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1762,12 +3199,31 @@ func (m *MsgSuperfluidUnbondLockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSuperfluidUnbondLockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSuperfluidDelegatePartialResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSuperfluidUnbondLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSuperfluidDelegatePartialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuperfluidLockId", wireType)
+			}
+			m.SuperfluidLockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SuperfluidLockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1789,7 +3245,7 @@ func (m *MsgSuperfluidUnbondLockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLockAndSuperfluidDelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgLockAndSuperfluidDelegatePartial) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1812,10 +3268,10 @@ func (m *MsgLockAndSuperfluidDelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLockAndSuperfluidDelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLockAndSuperfluidDelegatePartial: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLockAndSuperfluidDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLockAndSuperfluidDelegatePartial: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1885,6 +3341,39 @@ func (m *MsgLockAndSuperfluidDelegate) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuperfluidCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SuperfluidCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
 			}
@@ -1937,7 +3426,7 @@ func (m *MsgLockAndSuperfluidDelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLockAndSuperfluidDelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLockAndSuperfluidDelegatePartialResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1960,10 +3449,10 @@ func (m *MsgLockAndSuperfluidDelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLockAndSuperfluidDelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLockAndSuperfluidDelegatePartialResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLockAndSuperfluidDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLockAndSuperfluidDelegatePartialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1985,6 +3474,25 @@ func (m *MsgLockAndSuperfluidDelegateResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuperfluidLockId", wireType)
+			}
+			m.SuperfluidLockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SuperfluidLockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -50,7 +50,7 @@ func (hook *gammhook) AfterPoolCreated(ctx sdk.Context, sender sdk.AccAddress, p
 	}
 }
 
-func (hook *gammhook) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+func (hook *gammhook) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins, swapFee sdk.Dec) {
 	hook.k.trackChangedPool(ctx, poolId)
 }
 
@@ -61,3 +61,12 @@ func (hook *gammhook) AfterJoinPool(ctx sdk.Context, sender sdk.AccAddress, pool
 func (hook *gammhook) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, exitCoins sdk.Coins) {
 	hook.k.trackChangedPool(ctx, poolId)
 }
+
+// AfterPoolAssetsChanged is called after an asset is added to or removed from a pool
+func (hook *gammhook) AfterPoolAssetsChanged(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+	err := hook.k.afterPoolAssetsChanged(ctx, poolId)
+	// Will halt the asset change
+	if err != nil {
+		panic(err)
+	}
+}
@@ -6,6 +6,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v13/osmoutils"
+	gammkeeper "github.com/osmosis-labs/osmosis/v13/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/balancer"
 	"github.com/osmosis-labs/osmosis/v13/x/twap"
 	"github.com/osmosis-labs/osmosis/v13/x/twap/types"
 )
@@ -78,6 +80,81 @@ func (s *TestSuite) TestAfterPoolCreatedHook() {
 	}
 }
 
+// TestAfterPoolAssetsChangedHook tests that twap records are created for the denom pairs of an asset added
+// to a pool, and that the most recent records of the denom pairs of a removed asset are deleted.
+func (s *TestSuite) TestAfterPoolAssetsChangedHook() {
+	s.SetupTest()
+	governor := s.TestAccs[0]
+	s.FundAcc(governor, defaultThreeAssetCoins.Add(s.App.GAMMKeeper.GetParams(s.Ctx).PoolCreationFee...))
+	poolId, err := s.App.GAMMKeeper.CreatePool(s.Ctx, balancer.NewMsgCreateBalancerPool(governor, balancer.PoolParams{
+		SwapFee: sdk.ZeroDec(),
+		ExitFee: sdk.ZeroDec(),
+	}, []balancer.PoolAsset{
+		{Weight: sdk.NewInt(100), Token: sdk.NewCoin(denom0, defaultThreeAssetCoins.AmountOf(denom0))},
+		{Weight: sdk.NewInt(100), Token: sdk.NewCoin(denom1, defaultThreeAssetCoins.AmountOf(denom1))},
+	}, governor.String()))
+	s.Require().NoError(err)
+	s.EndBlock()
+	s.Commit()
+
+	// denom2 trades at 1 denom1 in the reference pool.
+	referencePoolCoins := sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000), sdk.NewInt64Coin(denom2, 1_000_000))
+	s.FundAcc(governor, referencePoolCoins.Add(s.App.GAMMKeeper.GetParams(s.Ctx).PoolCreationFee...))
+	referencePoolId, err := s.App.GAMMKeeper.CreatePool(s.Ctx, balancer.NewMsgCreateBalancerPool(governor, balancer.PoolParams{
+		SwapFee: sdk.ZeroDec(),
+		ExitFee: sdk.ZeroDec(),
+	}, []balancer.PoolAsset{
+		{Weight: sdk.NewInt(100), Token: sdk.NewCoin(denom1, referencePoolCoins.AmountOf(denom1))},
+		{Weight: sdk.NewInt(100), Token: sdk.NewCoin(denom2, referencePoolCoins.AmountOf(denom2))},
+	}, ""))
+	s.Require().NoError(err)
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(balancer.AddedPoolAssetTwapWindow))
+
+	// add denom2 worth 1% of the denom1 in the pool, at a weight low enough for it to be removed.
+	msgServer := gammkeeper.NewBalancerMsgServerImpl(s.App.GAMMKeeper)
+	_, err = msgServer.AddBalancerPoolAsset(sdk.WrapSDKContext(s.Ctx), &balancer.MsgAddBalancerPoolAsset{
+		Sender:          governor.String(),
+		PoolID:          poolId,
+		PoolAsset:       balancer.PoolAsset{Weight: sdk.NewInt(1), Token: sdk.NewCoin(denom2, defaultThreeAssetCoins.AmountOf(denom2).QuoRaw(100))},
+		Duration:        balancer.MinGovernedWeightChangeDuration,
+		PriceDenom:      denom1,
+		ReferencePoolID: referencePoolId,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{poolId, referencePoolId}, s.twapkeeper.GetChangedPools(s.Ctx))
+
+	allRecords, err := s.twapkeeper.GetAllMostRecentRecordsForPool(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal(3, len(allRecords))
+	expectedRecord, err := twap.NewTwapRecord(s.App.GAMMKeeper, s.Ctx, poolId, denom1, denom2)
+	s.Require().NoError(err)
+	actualRecord, err := s.twapkeeper.GetMostRecentRecordStoreRepresentation(s.Ctx, poolId, denom1, denom2)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedRecord, actualRecord)
+	s.Require().NoError(s.twapkeeper.UpdateRecords(s.Ctx, poolId))
+	s.EndBlock()
+	s.Commit()
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(balancer.GovernedWeightChangeDelay + balancer.MinGovernedWeightChangeDuration))
+
+	_, err = msgServer.RemoveBalancerPoolAsset(sdk.WrapSDKContext(s.Ctx), &balancer.MsgRemoveBalancerPoolAsset{
+		Sender: governor.String(),
+		PoolID: poolId,
+		Denom:  denom2,
+	})
+	s.Require().NoError(err)
+
+	allRecords, err = s.twapkeeper.GetAllMostRecentRecordsForPool(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal(1, len(allRecords))
+	s.Require().Equal(denom0, allRecords[0].Asset0Denom)
+	s.Require().Equal(denom1, allRecords[0].Asset1Denom)
+	s.Require().NoError(s.twapkeeper.UpdateRecords(s.Ctx, poolId))
+
+	// the history of the removed denom pairs is kept.
+	_, err = s.twapkeeper.GetRecordAtOrBeforeTime(s.Ctx, poolId, s.Ctx.BlockTime(), denom1, denom2)
+	s.Require().NoError(err)
+}
+
 // TestEndBlock tests if records are correctly updated upon endblock.
 func (s *TestSuite) TestEndBlock() {
 	tests := []struct {
@@ -98,6 +98,47 @@ func (k Keeper) afterCreatePool(ctx sdk.Context, poolId uint64) error {
 	return err
 }
 
+// afterPoolAssetsChanged brings the twap records of the pool in line with its current denoms,
+// after an asset was added to or removed from the pool.
+// New records are created for the denom pairs without one, and the most recent records of
+// denom pairs no longer in the pool are deleted, leaving their history to be pruned.
+func (k Keeper) afterPoolAssetsChanged(ctx sdk.Context, poolId uint64) error {
+	records, err := k.getAllMostRecentRecordsForPool(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	denoms, err := k.ammkeeper.GetPoolDenoms(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	denomPairs := types.GetAllUniqueDenomPairs(denoms)
+	hasRecord := make(map[types.DenomPair]bool, len(records))
+	for _, record := range records {
+		hasRecord[types.DenomPair{Denom0: record.Asset0Denom, Denom1: record.Asset1Denom}] = true
+	}
+	inPool := make(map[types.DenomPair]bool, len(denomPairs))
+	for _, denomPair := range denomPairs {
+		inPool[denomPair] = true
+		if hasRecord[denomPair] {
+			continue
+		}
+		record, err := newTwapRecord(k.ammkeeper, ctx, poolId, denomPair.Denom0, denomPair.Denom1)
+		if err != nil {
+			return err
+		}
+		k.storeNewRecord(ctx, record)
+	}
+	for _, record := range records {
+		if !inPool[types.DenomPair{Denom0: record.Asset0Denom, Denom1: record.Asset1Denom}] {
+			k.deleteMostRecentRecord(ctx, record)
+		}
+	}
+
+	k.trackChangedPool(ctx, poolId)
+	return nil
+}
+
 func (k Keeper) EndBlock(ctx sdk.Context) {
 	// get changed pools grabs all altered pool ids from the transient store.
 	// 'altered pool ids' gets automatically cleared on commit by being a transient store
@@ -123,6 +123,13 @@ func (k Keeper) deleteHistoricalRecord(ctx sdk.Context, twap types.TwapRecord) {
 	store.Delete(key2)
 }
 
+// deleteMostRecentRecord deletes the most recent record of the record's (pool, asset0, asset1) triplet.
+// The historical records are kept, and pruned as usual.
+func (k Keeper) deleteMostRecentRecord(ctx sdk.Context, twap types.TwapRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.FormatMostRecentTWAPKey(twap.PoolId, twap.Asset0Denom, twap.Asset1Denom))
+}
+
 // getMostRecentRecordStoreRepresentation returns the most recent twap record in the store
 // for the provided (pool, asset0, asset1) triplet.
 // Its called store representation, because most recent record can refer to it being
@@ -33,21 +33,27 @@ func IsArbTxLoose(tx sdk.Tx) bool {
 			}
 		}
 
-		swapMsg, isSwapMsg := m.(gammtypes.SwapMsgRoute)
-		if !isSwapMsg {
-			continue
+		swapMsgs := []gammtypes.SwapMsgRoute{}
+		if swapMsg, isSwapMsg := m.(gammtypes.SwapMsgRoute); isSwapMsg {
+			swapMsgs = append(swapMsgs, swapMsg)
 		}
-
-		// (1) Check that swap denom in != swap denom out
-		if swapMsg.TokenInDenom() == swapMsg.TokenOutDenom() {
-			return true
+		// Messages swapping through several routes are checked as one swap per route.
+		if multiSwapMsg, isMultiSwapMsg := m.(gammtypes.MultiSwapMsgRoute); isMultiSwapMsg {
+			swapMsgs = append(swapMsgs, multiSwapMsg.GetSwapMsgs()...)
 		}
 
-		// (2)
-		if swapInDenom != "" && swapMsg.TokenInDenom() != swapInDenom {
-			return true
+		for _, swapMsg := range swapMsgs {
+			// (1) Check that swap denom in != swap denom out
+			if swapMsg.TokenInDenom() == swapMsg.TokenOutDenom() {
+				return true
+			}
+
+			// (2)
+			if swapInDenom != "" && swapMsg.TokenInDenom() != swapInDenom {
+				return true
+			}
+			swapInDenom = swapMsg.TokenInDenom()
 		}
-		swapInDenom = swapMsg.TokenInDenom()
 	}
 
 	return false